@@ -188,18 +188,7 @@ func (a *access) hasPermission(roles []string) bool {
 }
 
 func (a *access) hasRequiredRole(role string) bool {
-	switch a.requiredRole {
-	case OwnerRole:
-		return role == OwnerRole
-	case MemberRole:
-		return role == OwnerRole || role == MemberRole
-	case OperatorRole:
-		return role == OwnerRole || role == MemberRole || role == OperatorRole
-	case ViewerRole:
-		return role == OwnerRole || role == MemberRole || role == OperatorRole || role == ViewerRole
-	default:
-		return false
-	}
+	return RoleSatisfies(role, a.requiredRole)
 }
 
 func (a *access) claims() map[string]interface{} {
@@ -5,12 +5,29 @@ import (
 )
 
 const (
-	MemberRole   = "member"
-	OwnerRole    = "owner"
-	OperatorRole = "pipeline-operator"
-	ViewerRole   = "viewer"
+	MemberRole   = atc.MemberRole
+	OwnerRole    = atc.OwnerRole
+	OperatorRole = atc.OperatorRole
+	ViewerRole   = atc.ViewerRole
 )
 
+// RoleSatisfies returns whether the given role grants at least the permissions
+// of the required role.
+func RoleSatisfies(role string, requiredRole string) bool {
+	switch requiredRole {
+	case OwnerRole:
+		return role == OwnerRole
+	case MemberRole:
+		return role == OwnerRole || role == MemberRole
+	case OperatorRole:
+		return role == OwnerRole || role == MemberRole || role == OperatorRole
+	case ViewerRole:
+		return role == OwnerRole || role == MemberRole || role == OperatorRole || role == ViewerRole
+	default:
+		return false
+	}
+}
+
 var DefaultRoles = map[string]string{
 	atc.SaveConfig:                     MemberRole,
 	atc.GetConfig:                      ViewerRole,
@@ -24,9 +41,11 @@ var DefaultRoles = map[string]string{
 	atc.AbortBuild:                     OperatorRole,
 	atc.GetBuildPreparation:            ViewerRole,
 	atc.GetJob:                         ViewerRole,
+	atc.GetJobStats:                    ViewerRole,
 	atc.CreateJobBuild:                 OperatorRole,
 	atc.RerunJobBuild:                  OperatorRole,
 	atc.SetBuildComment:                OperatorRole,
+	atc.ApproveBuild:                   ViewerRole,
 	atc.ListAllJobs:                    ViewerRole,
 	atc.ListJobs:                       ViewerRole,
 	atc.ListJobBuilds:                  ViewerRole,
@@ -36,6 +55,7 @@ var DefaultRoles = map[string]string{
 	atc.UnpauseJob:                     OperatorRole,
 	atc.ScheduleJob:                    OperatorRole,
 	atc.GetVersionsDB:                  ViewerRole,
+	atc.ListNotificationDeliveries:     ViewerRole,
 	atc.JobBadge:                       ViewerRole,
 	atc.MainJobBadge:                   ViewerRole,
 	atc.ClearTaskCache:                 OperatorRole,
@@ -47,6 +67,7 @@ var DefaultRoles = map[string]string{
 	atc.SetPinCommentOnResource:        OperatorRole,
 	atc.CheckResource:                  OperatorRole,
 	atc.CheckResourceWebHook:           OperatorRole,
+	atc.CheckTeamWebHook:               OperatorRole,
 	atc.CheckResourceType:              OperatorRole,
 	atc.CheckPrototype:                 OperatorRole,
 	atc.ListResourceVersions:           ViewerRole,
@@ -100,8 +121,17 @@ var DefaultRoles = map[string]string{
 	atc.RenameTeam:                     OwnerRole,
 	atc.DestroyTeam:                    OwnerRole,
 	atc.ListTeamBuilds:                 ViewerRole,
+	atc.ListSecrets:                    MemberRole,
+	atc.SetSecret:                      MemberRole,
+	atc.DeleteSecret:                   MemberRole,
+	atc.SearchTeamBuildLogs:            ViewerRole,
+	atc.SearchPipelineBuildLogs:        ViewerRole,
+	atc.SearchJobBuildLogs:             ViewerRole,
 	atc.CreateArtifact:                 MemberRole,
 	atc.GetArtifact:                    MemberRole,
 	atc.ListBuildArtifacts:             ViewerRole,
+	atc.ListBuildTestResults:           ViewerRole,
+	atc.ListBuildSecretAccesses:        ViewerRole,
+	atc.ListJobTestSummaries:           ViewerRole,
 	atc.GetWall:                        ViewerRole,
 }
@@ -42,6 +42,7 @@ var (
 	clusterName      = "Test Cluster"
 	featureFlagsJson = ` {
 	"across_step": false,
+	"build_log_search": false,
 	"build_rerun": false,
 	"cache_streamed_volumes": false,
 	"global_resources": false,
@@ -71,6 +72,7 @@ var (
 	dbCheckFactory          *dbfakes.FakeCheckFactory
 	dbTeam                  *dbfakes.FakeTeam
 	dbWall                  *dbfakes.FakeWall
+	dbSecretStore           *dbfakes.FakeSecretStore
 	fakeSecretManager       *credsfakes.FakeSecrets
 	fakeVarSourcePool       *credsfakes.FakeVarSourcePool
 	fakePolicyChecker       *policycheckerfakes.FakePolicyChecker
@@ -119,6 +121,7 @@ var _ = BeforeEach(func() {
 	dbUserFactory = new(dbfakes.FakeUserFactory)
 	dbCheckFactory = new(dbfakes.FakeCheckFactory)
 	dbWall = new(dbfakes.FakeWall)
+	dbSecretStore = new(dbfakes.FakeSecretStore)
 
 	interceptTimeoutFactory = new(containerserverfakes.FakeInterceptTimeoutFactory)
 	interceptTimeout = new(containerserverfakes.FakeInterceptTimeout)
@@ -227,6 +230,7 @@ var _ = BeforeEach(func() {
 		interceptTimeoutFactory,
 		time.Second,
 		dbWall,
+		dbSecretStore,
 		fakeClock,
 	)
 
@@ -13,6 +13,7 @@ import (
 type CheckBuildReadAccessHandlerFactory interface {
 	AnyJobHandler(delegateHandler http.Handler, rejector Rejector) http.Handler
 	CheckIfPrivateJobHandler(delegateHandler http.Handler, rejector Rejector) http.Handler
+	TeamHandler(delegateHandler http.Handler, rejector Rejector) http.Handler
 }
 
 type checkBuildReadAccessHandlerFactory struct {
@@ -51,11 +52,26 @@ func (f *checkBuildReadAccessHandlerFactory) CheckIfPrivateJobHandler(
 	}
 }
 
+// TeamHandler only allows access to the build by its own team, even if its
+// pipeline and job are public.
+func (f *checkBuildReadAccessHandlerFactory) TeamHandler(
+	delegateHandler http.Handler,
+	rejector Rejector,
+) http.Handler {
+	return checkBuildReadAccessHandler{
+		rejector:        rejector,
+		buildFactory:    f.buildFactory,
+		delegateHandler: delegateHandler,
+		teamOnly:        true,
+	}
+}
+
 type checkBuildReadAccessHandler struct {
 	rejector        Rejector
 	buildFactory    db.BuildFactory
 	delegateHandler http.Handler
 	allowPrivateJob bool
+	teamOnly        bool
 }
 
 func (h checkBuildReadAccessHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -104,6 +120,10 @@ func (h checkBuildReadAccessHandler) ServeHTTP(w http.ResponseWriter, r *http.Re
 var errDisappeared = errors.New("internal: build parent disappeared")
 
 func (h checkBuildReadAccessHandler) allow(build db.BuildForAPI, acc accessor.Access) (bool, error) {
+	if h.teamOnly {
+		return acc.IsAuthenticated() && acc.IsAuthorized(build.TeamName()), nil
+	}
+
 	if acc.IsAuthenticated() {
 		allTeams := build.AllAssociatedTeamNames()
 		for _, team := range allTeams {
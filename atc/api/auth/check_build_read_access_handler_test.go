@@ -356,6 +356,68 @@ var _ = Describe("CheckBuildReadAccessHandler", func() {
 			})
 		})
 	})
+
+	Context("TeamHandler", func() {
+		BeforeEach(func() {
+			innerHandler := handlerFactory.TeamHandler(delegate, auth.UnauthorizedRejector{})
+
+			handler = accessor.NewHandler(
+				logger,
+				"some-action",
+				innerHandler,
+				fakeAccessor,
+				new(auditorfakes.FakeAuditor),
+				map[string]string{},
+			)
+
+			pipeline.PublicReturns(true)
+
+			fakeJob := new(dbfakes.FakeJob)
+			fakeJob.PublicReturns(true)
+			pipeline.JobReturns(fakeJob, true, nil)
+		})
+
+		Context("when authenticated and accessing same team's build", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+			})
+
+			WithExistingBuild(func() {
+				ItReturnsTheBuild()
+
+				It("checks authorization against the build's team", func() {
+					Expect(fakeaccess.IsAuthorizedArgsForCall(0)).To(Equal("some-team"))
+				})
+			})
+		})
+
+		Context("when authenticated but accessing different team's build", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			WithExistingBuild(func() {
+				It("returns 403 even though the pipeline and job are public", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			WithExistingBuild(func() {
+				It("returns 401 even though the pipeline and job are public", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+					Expect(delegate.IsCalled).To(BeFalse())
+				})
+			})
+		})
+	})
 })
 
 type buildDelegateHandler struct {
@@ -0,0 +1,295 @@
+package api_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Build Logs API", func() {
+	var (
+		response    *http.Response
+		queryParams string
+	)
+
+	BeforeEach(func() {
+		queryParams = "?query=connection+refused"
+		atc.EnableBuildLogSearch = true
+	})
+
+	AfterEach(func() {
+		atc.EnableBuildLogSearch = false
+	})
+
+	Describe("GET /api/v1/teams/:team_name/build_logs", func() {
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/build_logs" + queryParams)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(dbBuildFactory.SearchBuildLogsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbBuildFactory.SearchBuildLogsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the search succeeds", func() {
+				BeforeEach(func() {
+					build := new(dbfakes.FakeBuildForAPI)
+					build.IDReturns(42)
+					build.NameReturns("7")
+					build.JobNameReturns("some-job")
+					build.PipelineNameReturns("some-pipeline")
+					build.TeamNameReturns("some-team")
+					build.StatusReturns(db.BuildStatusFailed)
+
+					dbBuildFactory.SearchBuildLogsReturns([]db.BuildLogMatch{
+						{
+							Build: build,
+							Lines: []db.BuildLogLine{
+								{EventID: 3, Line: "dial tcp: connection refused"},
+							},
+						},
+					}, nil)
+				})
+
+				It("searches the builds of the team", func() {
+					Expect(dbBuildFactory.SearchBuildLogsCallCount()).To(Equal(1))
+					Expect(dbBuildFactory.SearchBuildLogsArgsForCall(0)).To(Equal(db.BuildLogSearch{
+						Query:  "connection refused",
+						TeamID: 734,
+						Limit:  100,
+					}))
+				})
+
+				It("returns the matching builds and lines", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+					var matches []atc.BuildLogMatch
+					err := json.NewDecoder(response.Body).Decode(&matches)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(matches).To(HaveLen(1))
+					Expect(matches[0].Build.ID).To(Equal(42))
+					Expect(matches[0].Build.JobName).To(Equal("some-job"))
+					Expect(matches[0].Build.Status).To(Equal(atc.StatusFailed))
+					Expect(matches[0].Lines).To(Equal([]atc.BuildLogLine{
+						{EventID: 3, Line: "dial tcp: connection refused"},
+					}))
+				})
+			})
+
+			Context("when filters are given", func() {
+				BeforeEach(func() {
+					queryParams = "?query=oops&status=failed&status=errored&since=100&until=200&limit=5"
+				})
+
+				It("passes them through", func() {
+					Expect(dbBuildFactory.SearchBuildLogsArgsForCall(0)).To(Equal(db.BuildLogSearch{
+						Query:    "oops",
+						TeamID:   734,
+						Statuses: []db.BuildStatus{db.BuildStatusFailed, db.BuildStatusErrored},
+						Since:    time.Unix(100, 0),
+						Until:    time.Unix(200, 0),
+						Limit:    5,
+					}))
+				})
+			})
+
+			Context("when the query is missing", func() {
+				BeforeEach(func() {
+					queryParams = ""
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(dbBuildFactory.SearchBuildLogsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when a status is invalid", func() {
+				BeforeEach(func() {
+					queryParams = "?query=oops&status=bogus"
+				})
+
+				It("returns 400 with the reason", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(body)).To(ContainSubstring("invalid status 'bogus'"))
+				})
+			})
+
+			Context("when a time is invalid", func() {
+				BeforeEach(func() {
+					queryParams = "?query=oops&since=yesterday"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when build log search is disabled", func() {
+				BeforeEach(func() {
+					atc.EnableBuildLogSearch = false
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(dbBuildFactory.SearchBuildLogsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the search fails", func() {
+				BeforeEach(func() {
+					dbBuildFactory.SearchBuildLogsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/build_logs", func() {
+		BeforeEach(func() {
+			fakePipeline.IDReturns(12)
+			fakePipeline.TeamIDReturns(734)
+			fakePipeline.TeamNameReturns("some-team")
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/pipelines/some-pipeline/build_logs" + queryParams)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated and the pipeline is private", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+				fakePipeline.PublicReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when not authenticated and the pipeline is public", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+				fakePipeline.PublicReturns(true)
+			})
+
+			It("only searches the builds of public jobs", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(dbBuildFactory.SearchBuildLogsArgsForCall(0)).To(Equal(db.BuildLogSearch{
+					Query:          "connection refused",
+					TeamID:         734,
+					PipelineID:     12,
+					PublicJobsOnly: true,
+					Limit:          100,
+				}))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			It("searches the builds of all jobs", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(dbBuildFactory.SearchBuildLogsArgsForCall(0)).To(Equal(db.BuildLogSearch{
+					Query:      "connection refused",
+					TeamID:     734,
+					PipelineID: 12,
+					Limit:      100,
+				}))
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/build_logs", func() {
+		var fakeJob *dbfakes.FakeJob
+
+		BeforeEach(func() {
+			fakeAccess.IsAuthenticatedReturns(true)
+			fakeAccess.IsAuthorizedReturns(true)
+
+			fakePipeline.IDReturns(12)
+			fakePipeline.TeamIDReturns(734)
+			fakePipeline.TeamNameReturns("some-team")
+
+			fakeJob = new(dbfakes.FakeJob)
+			fakeJob.IDReturns(56)
+			fakePipeline.JobReturns(fakeJob, true, nil)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/build_logs" + queryParams)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("searches the builds of the job", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+			Expect(fakePipeline.JobArgsForCall(0)).To(Equal("some-job"))
+			Expect(dbBuildFactory.SearchBuildLogsArgsForCall(0)).To(Equal(db.BuildLogSearch{
+				Query:      "connection refused",
+				TeamID:     734,
+				PipelineID: 12,
+				JobID:      56,
+				Limit:      100,
+			}))
+		})
+
+		Context("when the job is not found", func() {
+			BeforeEach(func() {
+				fakePipeline.JobReturns(nil, false, nil)
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				Expect(dbBuildFactory.SearchBuildLogsCallCount()).To(BeZero())
+			})
+		})
+	})
+})
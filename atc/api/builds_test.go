@@ -1120,6 +1120,214 @@ var _ = Describe("Builds API", func() {
 		})
 	})
 
+	Describe("PUT /api/v1/builds/:build_id/approval", func() {
+		var (
+			query    string
+			response *http.Response
+		)
+
+		BeforeEach(func() {
+			query = ""
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/builds/128/approval"+query, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated and authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				fakeAccess.TeamRolesReturns(map[string][]string{"some-team": {"member"}})
+				fakeAccess.UserInfoReturns(atc.UserInfo{DisplayUserId: "some-user"})
+
+				build.TeamNameReturns("some-team")
+				build.AllAssociatedTeamNamesReturns([]string{"some-team"})
+				build.IsRunningReturns(true)
+				build.PendingApprovalsReturns([]db.BuildApproval{
+					{PlanID: "some-plan", Name: "deploy", Role: "member"},
+				}, nil)
+				build.DecideApprovalReturns(true, nil)
+				dbBuildFactory.BuildForAPIReturns(build, true, nil)
+			})
+
+			It("approves the pending step as the user", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+				Expect(build.DecideApprovalCallCount()).To(Equal(1))
+				planID, approved, decidedBy := build.DecideApprovalArgsForCall(0)
+				Expect(planID).To(Equal(atc.PlanID("some-plan")))
+				Expect(approved).To(BeTrue())
+				Expect(decidedBy).To(Equal("some-user"))
+			})
+
+			Context("when rejecting", func() {
+				BeforeEach(func() {
+					query = "?decision=rejected"
+				})
+
+				It("rejects the pending step", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+					_, approved, _ := build.DecideApprovalArgsForCall(0)
+					Expect(approved).To(BeFalse())
+				})
+			})
+
+			Context("when the decision is unknown", func() {
+				BeforeEach(func() {
+					query = "?decision=maybe"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(build.DecideApprovalCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the user does not have the required role", func() {
+				BeforeEach(func() {
+					fakeAccess.TeamRolesReturns(map[string][]string{"some-team": {"viewer"}})
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(build.DecideApprovalCallCount()).To(BeZero())
+				})
+
+				Context("when the user is an admin", func() {
+					BeforeEach(func() {
+						fakeAccess.IsAdminReturns(true)
+					})
+
+					It("returns 204", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+					})
+				})
+			})
+
+			Context("when the build is not running", func() {
+				BeforeEach(func() {
+					build.IsRunningReturns(false)
+				})
+
+				It("returns 409", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusConflict))
+				})
+			})
+
+			Context("when no step is waiting for approval", func() {
+				BeforeEach(func() {
+					build.PendingApprovalsReturns(nil, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when multiple steps are waiting for approval", func() {
+				BeforeEach(func() {
+					build.PendingApprovalsReturns([]db.BuildApproval{
+						{PlanID: "some-plan", Name: "deploy", Role: "member"},
+						{PlanID: "other-plan", Name: "release", Role: "owner"},
+					}, nil)
+				})
+
+				It("returns 400 listing the plan ids of the steps", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(body)).To(ContainSubstring("some-plan: deploy"))
+					Expect(string(body)).To(ContainSubstring("other-plan: release"))
+				})
+
+				Context("when a step is specified", func() {
+					BeforeEach(func() {
+						query = "?step=deploy"
+					})
+
+					It("decides on that step", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+						planID, _, _ := build.DecideApprovalArgsForCall(0)
+						Expect(planID).To(Equal(atc.PlanID("some-plan")))
+					})
+				})
+
+				Context("when the steps share a name", func() {
+					BeforeEach(func() {
+						build.PendingApprovalsReturns([]db.BuildApproval{
+							{PlanID: "some-plan", Name: "deploy", Role: "member"},
+							{PlanID: "other-plan", Name: "deploy", Role: "member"},
+						}, nil)
+					})
+
+					Context("when only the step is specified", func() {
+						BeforeEach(func() {
+							query = "?step=deploy"
+						})
+
+						It("returns 400", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+							Expect(build.DecideApprovalCallCount()).To(BeZero())
+						})
+					})
+
+					Context("when a plan id is specified", func() {
+						BeforeEach(func() {
+							query = "?plan_id=other-plan"
+						})
+
+						It("decides on that step", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+							planID, _, _ := build.DecideApprovalArgsForCall(0)
+							Expect(planID).To(Equal(atc.PlanID("other-plan")))
+						})
+					})
+				})
+			})
+
+			Context("when the approval has already been decided", func() {
+				BeforeEach(func() {
+					build.DecideApprovalReturns(false, nil)
+				})
+
+				It("returns 409", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusConflict))
+				})
+			})
+
+			Context("when deciding fails", func() {
+				BeforeEach(func() {
+					build.DecideApprovalReturns(false, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
 	Describe("GET /api/v1/builds/:build_id/preparation", func() {
 		var response *http.Response
 
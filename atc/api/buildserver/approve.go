@@ -0,0 +1,123 @@
+package buildserver
+
+import (
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// ApproveBuild approves or rejects a pending approve step of the build. The
+// decision is given by the 'decision' query param, which may be 'approved'
+// (the default) or 'rejected'. The 'step' query param names the step to
+// decide on, and may be omitted if only one step is waiting for approval. As
+// several steps may share a name, e.g. within an across step, the 'plan_id'
+// query param picks out a single one.
+func (s *Server) ApproveBuild(build db.BuildForAPI) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("approve", build.LagerData())
+
+		var approved bool
+		switch decision := r.URL.Query().Get("decision"); decision {
+		case "", "approved":
+			approved = true
+		case "rejected":
+			approved = false
+		default:
+			logger.Info("malformed-request", lager.Data{"decision": decision})
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "unknown decision '%s' (must be approved or rejected)\n", decision)
+			return
+		}
+
+		if !build.IsRunning() {
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprintln(w, "build is not running")
+			return
+		}
+
+		pending, err := build.PendingApprovals()
+		if err != nil {
+			logger.Error("failed-to-get-pending-approvals", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		stepName := r.URL.Query().Get("step")
+		planID := atc.PlanID(r.URL.Query().Get("plan_id"))
+
+		var candidates []db.BuildApproval
+		for _, approval := range pending {
+			if stepName != "" && approval.Name != stepName {
+				continue
+			}
+
+			if planID != "" && approval.PlanID != planID {
+				continue
+			}
+
+			candidates = append(candidates, approval)
+		}
+
+		if len(candidates) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintln(w, "no step is waiting for approval")
+			return
+		}
+
+		if len(candidates) > 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintln(w, "multiple steps are waiting for approval; specify a plan id:")
+			for _, approval := range candidates {
+				fmt.Fprintf(w, "  %s: %s\n", approval.PlanID, approval.Name)
+			}
+			return
+		}
+
+		approval := candidates[0]
+
+		acc := accessor.GetAccessor(r)
+		if !acc.IsAdmin() && !hasRole(acc.TeamRoles()[build.TeamName()], approval.Role) {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprintf(w, "approving step '%s' requires role '%s'\n", approval.Name, approval.Role)
+			return
+		}
+
+		decidedBy := acc.UserInfo().DisplayUserId
+
+		decided, err := build.DecideApproval(approval.PlanID, approved, decidedBy)
+		if err != nil {
+			logger.Error("failed-to-decide-approval", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !decided {
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprintln(w, "approval has already been decided")
+			return
+		}
+
+		logger.Info("decided", lager.Data{
+			"step":       approval.Name,
+			"plan-id":    approval.PlanID,
+			"approved":   approved,
+			"decided-by": decidedBy,
+		})
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func hasRole(roles []string, requiredRole string) bool {
+	for _, role := range roles {
+		if accessor.RoleSatisfies(role, requiredRole) {
+			return true
+		}
+	}
+
+	return false
+}
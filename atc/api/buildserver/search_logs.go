@@ -0,0 +1,180 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) SearchTeamBuildLogs(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("search-team-build-logs")
+
+		search, err := parseBuildLogSearch(r)
+		if err != nil {
+			logger.Info("malformed-request", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintln(w, err)
+			return
+		}
+
+		search.TeamID = team.ID()
+
+		s.searchBuildLogs(logger, w, search)
+	})
+}
+
+func (s *Server) SearchPipelineBuildLogs(pipeline db.Pipeline) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("search-pipeline-build-logs")
+
+		search, err := parseBuildLogSearch(r)
+		if err != nil {
+			logger.Info("malformed-request", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintln(w, err)
+			return
+		}
+
+		search.TeamID = pipeline.TeamID()
+		search.PipelineID = pipeline.ID()
+
+		// the pipeline may only be visible because it is public, in which case
+		// the logs of private jobs are not
+		search.PublicJobsOnly = !accessor.GetAccessor(r).IsAuthorized(pipeline.TeamName())
+
+		s.searchBuildLogs(logger, w, search)
+	})
+}
+
+func (s *Server) SearchJobBuildLogs(pipeline db.Pipeline) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("search-job-build-logs")
+
+		search, err := parseBuildLogSearch(r)
+		if err != nil {
+			logger.Info("malformed-request", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintln(w, err)
+			return
+		}
+
+		job, found, err := pipeline.Job(r.FormValue(":job_name"))
+		if err != nil {
+			logger.Error("failed-to-get-job", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		search.TeamID = pipeline.TeamID()
+		search.PipelineID = pipeline.ID()
+		search.JobID = job.ID()
+		search.PublicJobsOnly = !accessor.GetAccessor(r).IsAuthorized(pipeline.TeamName())
+
+		s.searchBuildLogs(logger, w, search)
+	})
+}
+
+func (s *Server) searchBuildLogs(logger lager.Logger, w http.ResponseWriter, search db.BuildLogSearch) {
+	if !atc.EnableBuildLogSearch {
+		logger.Info("build-log-search-disabled")
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	matches, err := s.buildFactory.SearchBuildLogs(search)
+	if err != nil {
+		logger.Error("failed-to-search-build-logs", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	presented := make([]atc.BuildLogMatch, len(matches))
+	for i, match := range matches {
+		presented[i] = present.BuildLogMatch(match)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	err = json.NewEncoder(w).Encode(presented)
+	if err != nil {
+		logger.Error("failed-to-encode-build-log-matches", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+var errMissingSearchQuery = errors.New("missing search query")
+
+func parseBuildLogSearch(r *http.Request) (db.BuildLogSearch, error) {
+	search := db.BuildLogSearch{
+		Query: r.FormValue(atc.BuildLogSearchQuery),
+		Limit: atc.PaginationAPIDefaultLimit,
+	}
+
+	if search.Query == "" {
+		return db.BuildLogSearch{}, errMissingSearchQuery
+	}
+
+	for _, status := range r.Form[atc.BuildLogSearchStatus] {
+		switch atc.BuildStatus(status) {
+		case atc.StatusStarted,
+			atc.StatusPending,
+			atc.StatusSucceeded,
+			atc.StatusFailed,
+			atc.StatusErrored,
+			atc.StatusAborted:
+			search.Statuses = append(search.Statuses, db.BuildStatus(status))
+		default:
+			return db.BuildLogSearch{}, fmt.Errorf("invalid status '%s'", status)
+		}
+	}
+
+	var err error
+	search.Since, err = parseSearchTime(r, atc.BuildLogSearchSince)
+	if err != nil {
+		return db.BuildLogSearch{}, err
+	}
+
+	search.Until, err = parseSearchTime(r, atc.BuildLogSearchUntil)
+	if err != nil {
+		return db.BuildLogSearch{}, err
+	}
+
+	if urlLimit := r.FormValue(atc.PaginationQueryLimit); urlLimit != "" {
+		search.Limit, err = strconv.Atoi(urlLimit)
+		if err != nil || search.Limit <= 0 {
+			return db.BuildLogSearch{}, fmt.Errorf("invalid limit '%s'", urlLimit)
+		}
+	}
+
+	return search, nil
+}
+
+func parseSearchTime(r *http.Request, param string) (time.Time, error) {
+	value := r.FormValue(param)
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	unix, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s '%s': must be a unix timestamp", param, value)
+	}
+
+	return time.Unix(unix, 0), nil
+}
@@ -176,6 +176,13 @@ func validateCredParams(credMgrVars vars.Variables, config atc.Config, session l
 		if err != nil {
 			errs = multierror.Append(errs, err)
 		}
+
+		if resource.Webhook != nil {
+			_, err = creds.NewString(credMgrVars, resource.Webhook.Secret).Evaluate()
+			if err != nil {
+				errs = multierror.Append(errs, err)
+			}
+		}
 	}
 
 	for _, job := range config.Jobs {
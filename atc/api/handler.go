@@ -20,6 +20,7 @@ import (
 	"github.com/concourse/concourse/atc/api/pipelineserver"
 	"github.com/concourse/concourse/atc/api/resourceserver"
 	"github.com/concourse/concourse/atc/api/resourceserver/versionserver"
+	"github.com/concourse/concourse/atc/api/secretserver"
 	"github.com/concourse/concourse/atc/api/teamserver"
 	"github.com/concourse/concourse/atc/api/usersserver"
 	"github.com/concourse/concourse/atc/api/volumeserver"
@@ -79,6 +80,7 @@ func NewHandler(
 	interceptTimeoutFactory containerserver.InterceptTimeoutFactory,
 	interceptUpdateInterval time.Duration,
 	dbWall db.Wall,
+	dbSecretStore db.SecretStore,
 	clock clock.Clock,
 ) (http.Handler, error) {
 
@@ -109,6 +111,7 @@ func NewHandler(
 	artifactServer := artifactserver.NewServer(logger, workerPool)
 	usersServer := usersserver.NewServer(logger, dbUserFactory)
 	wallServer := wallserver.NewServer(dbWall, logger)
+	secretServer := secretserver.NewServer(logger, dbSecretStore)
 
 	handlers := map[string]http.Handler{
 		atc.GetConfig:  http.HandlerFunc(configServer.GetConfig),
@@ -126,6 +129,16 @@ func NewHandler(
 		atc.BuildEvents:         buildHandlerFactory.HandlerFor(buildServer.BuildEvents),
 		atc.ListBuildArtifacts:  buildHandlerFactory.HandlerFor(buildServer.GetBuildArtifacts),
 		atc.SetBuildComment:     buildHandlerFactory.HandlerFor(buildServer.SetBuildComment),
+		atc.ApproveBuild:        buildHandlerFactory.HandlerFor(buildServer.ApproveBuild),
+
+		atc.SearchTeamBuildLogs:     teamHandlerFactory.HandlerFor(buildServer.SearchTeamBuildLogs),
+		atc.SearchPipelineBuildLogs: pipelineHandlerFactory.HandlerFor(buildServer.SearchPipelineBuildLogs),
+		atc.SearchJobBuildLogs:      pipelineHandlerFactory.HandlerFor(buildServer.SearchJobBuildLogs),
+
+		atc.ListBuildTestResults:    buildHandlerFactory.HandlerFor(buildServer.ListBuildTestResults),
+		atc.ListBuildSecretAccesses: buildHandlerFactory.HandlerFor(buildServer.ListBuildSecretAccesses),
+		atc.ListJobTestSummaries:    pipelineHandlerFactory.HandlerFor(jobServer.ListJobTestSummaries),
+		atc.GetJobStats:             pipelineHandlerFactory.HandlerFor(jobServer.GetJobStats),
 
 		atc.ListAllJobs:    http.HandlerFunc(jobServer.ListAllJobs),
 		atc.ListJobs:       pipelineHandlerFactory.HandlerFor(jobServer.ListJobs),
@@ -146,6 +159,8 @@ func NewHandler(
 
 		atc.ClearTaskCache: pipelineHandlerFactory.HandlerFor(jobServer.ClearTaskCache),
 
+		atc.ListNotificationDeliveries: pipelineHandlerFactory.HandlerFor(pipelineServer.ListNotificationDeliveries),
+
 		atc.ListAllPipelines:          http.HandlerFunc(pipelineServer.ListAllPipelines),
 		atc.ListPipelines:             http.HandlerFunc(pipelineServer.ListPipelines),
 		atc.GetPipeline:               pipelineHandlerFactory.HandlerFor(pipelineServer.GetPipeline),
@@ -173,6 +188,7 @@ func NewHandler(
 		atc.SetPinCommentOnResource:   pipelineHandlerFactory.HandlerFor(resourceServer.SetPinCommentOnResource),
 		atc.CheckResource:             pipelineHandlerFactory.HandlerFor(resourceServer.CheckResource),
 		atc.CheckResourceWebHook:      pipelineHandlerFactory.HandlerFor(resourceServer.CheckResourceWebHook),
+		atc.CheckTeamWebHook:          teamHandlerFactory.HandlerFor(resourceServer.CheckTeamWebHook),
 		atc.CheckResourceType:         pipelineHandlerFactory.HandlerFor(resourceServer.CheckResourceType),
 		atc.CheckPrototype:            pipelineHandlerFactory.HandlerFor(resourceServer.CheckPrototype),
 		atc.ClearResourceCache:        pipelineHandlerFactory.HandlerFor(resourceServer.ClearResourceCache),
@@ -230,6 +246,10 @@ func NewHandler(
 		atc.GetWall:   http.HandlerFunc(wallServer.GetWall),
 		atc.SetWall:   http.HandlerFunc(wallServer.SetWall),
 		atc.ClearWall: http.HandlerFunc(wallServer.ClearWall),
+
+		atc.ListSecrets:  teamHandlerFactory.HandlerFor(secretServer.ListSecrets),
+		atc.SetSecret:    teamHandlerFactory.HandlerFor(secretServer.SetSecret),
+		atc.DeleteSecret: teamHandlerFactory.HandlerFor(secretServer.DeleteSecret),
 	}
 
 	return rata.NewRouter(atc.Routes, wrapper.Wrap(handlers))
@@ -489,6 +489,35 @@ var _ = Describe("Jobs API", func() {
 
 							})
 
+							Context("when the job has a schedule", func() {
+								BeforeEach(func() {
+									fakeJob.ConfigReturns(atc.JobConfig{
+										Name:     "some-job",
+										Schedule: &atc.JobSchedule{Cron: "0 3 * * *", Timezone: "Europe/London"},
+									}, nil)
+									fakeJob.ScheduleNextTriggerReturns(time.Unix(1667444400, 0))
+								})
+
+								It("returns the schedule and the next trigger", func() {
+									var job atc.Job
+									err := json.NewDecoder(response.Body).Decode(&job)
+									Expect(err).NotTo(HaveOccurred())
+
+									Expect(job.Schedule).To(Equal(&atc.JobSchedule{Cron: "0 3 * * *", Timezone: "Europe/London"}))
+									Expect(job.NextScheduledTrigger).To(Equal(int64(1667444400)))
+								})
+							})
+
+							Context("when getting the job config fails", func() {
+								BeforeEach(func() {
+									fakeJob.ConfigReturns(atc.JobConfig{}, errors.New("nope"))
+								})
+
+								It("returns 500", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+								})
+							})
+
 							Context("when there are no running or finished builds", func() {
 								BeforeEach(func() {
 									fakeJob.FinishedAndNextBuildReturns(nil, nil, nil)
@@ -1517,7 +1546,7 @@ var _ = Describe("Jobs API", func() {
 					})
 
 					It("does not trigger the build", func() {
-						Expect(fakeJob.CreateBuildCallCount()).To(Equal(0))
+						Expect(fakeJob.CreateBuildWithParamsCallCount()).To(Equal(0))
 					})
 				})
 
@@ -1526,9 +1555,108 @@ var _ = Describe("Jobs API", func() {
 						fakeJob.DisableManualTriggerReturns(false)
 					})
 
+					Context("when getting the job config fails", func() {
+						BeforeEach(func() {
+							fakeJob.ConfigReturns(atc.JobConfig{}, errors.New("nope"))
+						})
+
+						It("returns a 500", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+
+						It("does not trigger the build", func() {
+							Expect(fakeJob.CreateBuildWithParamsCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when the job declares params", func() {
+						BeforeEach(func() {
+							fakeJob.ConfigReturns(atc.JobConfig{
+								Params: atc.JobParams{
+									{Name: "target"},
+									{Name: "dry-run", Type: atc.JobParamTypeBool, Default: false},
+								},
+							}, nil)
+
+							fakeJob.CreateBuildWithParamsReturns(new(dbfakes.FakeBuild), nil)
+						})
+
+						Context("when valid values are given", func() {
+							BeforeEach(func() {
+								request.Body = ioutil.NopCloser(strings.NewReader(`{"params":{"target":"prod"}}`))
+							})
+
+							It("triggers the build with the resolved params", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusOK))
+								Expect(fakeJob.CreateBuildWithParamsCallCount()).To(Equal(1))
+
+								_, params, priority := fakeJob.CreateBuildWithParamsArgsForCall(0)
+								Expect(params).To(Equal(map[string]interface{}{
+									"target":  "prod",
+									"dry-run": false,
+								}))
+								Expect(priority).To(BeNil())
+							})
+						})
+
+						Context("when a priority is given", func() {
+							BeforeEach(func() {
+								request.Body = ioutil.NopCloser(strings.NewReader(`{"params":{"target":"prod"},"priority":10}`))
+							})
+
+							It("triggers the build with the priority", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusOK))
+								Expect(fakeJob.CreateBuildWithParamsCallCount()).To(Equal(1))
+
+								_, _, priority := fakeJob.CreateBuildWithParamsArgsForCall(0)
+								Expect(priority).ToNot(BeNil())
+								Expect(*priority).To(Equal(10))
+							})
+						})
+
+						Context("when the priority is out of range", func() {
+							BeforeEach(func() {
+								request.Body = ioutil.NopCloser(strings.NewReader(`{"params":{"target":"prod"},"priority":1000}`))
+							})
+
+							It("returns a 400", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+								Expect(fakeJob.CreateBuildWithParamsCallCount()).To(BeZero())
+
+								body, err := ioutil.ReadAll(response.Body)
+								Expect(err).NotTo(HaveOccurred())
+								Expect(string(body)).To(ContainSubstring("priority must be between -100 and 100"))
+							})
+						})
+
+						Context("when a required value is missing", func() {
+							It("returns a 400", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+								body, err := ioutil.ReadAll(response.Body)
+								Expect(err).NotTo(HaveOccurred())
+								Expect(string(body)).To(ContainSubstring("missing value for param 'target'"))
+							})
+
+							It("does not trigger the build", func() {
+								Expect(fakeJob.CreateBuildWithParamsCallCount()).To(Equal(0))
+							})
+						})
+
+						Context("when the request body is malformed", func() {
+							BeforeEach(func() {
+								request.Body = ioutil.NopCloser(strings.NewReader(`{`))
+							})
+
+							It("returns a 400", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+							})
+						})
+					})
+
 					Context("when triggering the build fails", func() {
 						BeforeEach(func() {
-							fakeJob.CreateBuildReturns(nil, errors.New("nopers"))
+							fakeJob.CreateBuildWithParamsReturns(nil, errors.New("nopers"))
 						})
 						It("returns a 500", func() {
 							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
@@ -1547,11 +1675,11 @@ var _ = Describe("Jobs API", func() {
 							build.StartTimeReturns(time.Unix(1, 0))
 							build.EndTimeReturns(time.Unix(100, 0))
 
-							fakeJob.CreateBuildReturns(build, nil)
+							fakeJob.CreateBuildWithParamsReturns(build, nil)
 						})
 
 						It("triggers the build", func() {
-							Expect(fakeJob.CreateBuildCallCount()).To(Equal(1))
+							Expect(fakeJob.CreateBuildWithParamsCallCount()).To(Equal(1))
 						})
 
 						Context("when finding the pipeline resources fails", func() {
@@ -2199,6 +2327,59 @@ var _ = Describe("Jobs API", func() {
 						}`))
 							})
 						})
+
+						Context("when resuming from the failed step", func() {
+							BeforeEach(func() {
+								request.URL.RawQuery = "from_failed=true"
+							})
+
+							Context("when the build can be resumed", func() {
+								BeforeEach(func() {
+									build := new(dbfakes.FakeBuild)
+									build.IDReturns(2)
+									build.NameReturns("1.1")
+
+									fakeJob.ResumeBuildReturns(build, nil)
+								})
+
+								It("resumes the build", func() {
+									Expect(fakeJob.ResumeBuildCallCount()).To(Equal(1))
+									Expect(fakeJob.RerunBuildCallCount()).To(BeZero())
+
+									resumedBuild, _ := fakeJob.ResumeBuildArgsForCall(0)
+									Expect(resumedBuild).To(Equal(fakeBuild))
+								})
+
+								It("returns 200 OK", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusOK))
+								})
+							})
+
+							Context("when the build cannot be resumed", func() {
+								BeforeEach(func() {
+									fakeJob.ResumeBuildReturns(nil, db.ErrBuildCannotBeResumed)
+								})
+
+								It("returns 409 Conflict", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusConflict))
+								})
+							})
+
+							Context("when resuming builds is disabled", func() {
+								BeforeEach(func() {
+									atc.BuildResumeWindow = 0
+								})
+
+								AfterEach(func() {
+									atc.BuildResumeWindow = 12 * time.Hour
+								})
+
+								It("returns 409 Conflict without resuming the build", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusConflict))
+									Expect(fakeJob.ResumeBuildCallCount()).To(BeZero())
+								})
+							})
+						})
 					})
 				})
 			})
@@ -2360,6 +2541,184 @@ var _ = Describe("Jobs API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/stats", func() {
+		var response *http.Response
+		var queryParams string
+
+		BeforeEach(func() {
+			queryParams = ""
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/stats" + queryParams)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authorized and the pipeline is private", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+				fakePipeline.PublicReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when the pipeline is public", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+				fakePipeline.PublicReturns(true)
+				fakePipeline.JobReturns(fakeJob, true, nil)
+			})
+
+			Context("when the job is not public", func() {
+				BeforeEach(func() {
+					fakeJob.PublicReturns(false)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(fakeJob.StatsCallCount()).To(BeZero())
+				})
+
+				Context("when not authenticated", func() {
+					BeforeEach(func() {
+						fakeAccess.IsAuthenticatedReturns(false)
+					})
+
+					It("returns 401", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+					})
+				})
+			})
+
+			Context("when the job is public", func() {
+				BeforeEach(func() {
+					fakeJob.PublicReturns(true)
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the job is found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(fakeJob, true, nil)
+					fakeJob.StatsReturns(atc.JobStats{
+						Since:       100,
+						Until:       200,
+						Builds:      3,
+						Succeeded:   2,
+						Failed:      1,
+						SuccessRate: 2.0 / 3.0,
+						Steps: []atc.StepStats{
+							{Name: "unit", Type: "task", Runs: 3, P50: 10, P90: 20, P95: 25},
+						},
+					}, nil)
+				})
+
+				It("looks up the job by name", func() {
+					Expect(fakePipeline.JobArgsForCall(0)).To(Equal("some-job"))
+				})
+
+				It("covers the last 30 days by default", func() {
+					Expect(fakeJob.StatsCallCount()).To(Equal(1))
+
+					since, until := fakeJob.StatsArgsForCall(0)
+					Expect(until).To(BeTemporally("~", time.Now(), time.Minute))
+					Expect(until.Sub(since)).To(Equal(30 * 24 * time.Hour))
+				})
+
+				It("returns the stats of the job", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+					var stats atc.JobStats
+					err := json.NewDecoder(response.Body).Decode(&stats)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(stats).To(Equal(atc.JobStats{
+						Since:       100,
+						Until:       200,
+						Builds:      3,
+						Succeeded:   2,
+						Failed:      1,
+						SuccessRate: 2.0 / 3.0,
+						Steps: []atc.StepStats{
+							{Name: "unit", Type: "task", Runs: 3, P50: 10, P90: 20, P95: 25},
+						},
+					}))
+				})
+
+				Context("when a window is given", func() {
+					BeforeEach(func() {
+						queryParams = "?since=100&until=200"
+					})
+
+					It("covers the window", func() {
+						since, until := fakeJob.StatsArgsForCall(0)
+						Expect(since).To(Equal(time.Unix(100, 0)))
+						Expect(until).To(Equal(time.Unix(200, 0)))
+					})
+				})
+
+				Context("when the window is malformed", func() {
+					BeforeEach(func() {
+						queryParams = "?since=yesterday"
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(fakeJob.StatsCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when the window ends before it starts", func() {
+					BeforeEach(func() {
+						queryParams = "?since=200&until=100"
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(fakeJob.StatsCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when computing the stats fails", func() {
+					BeforeEach(func() {
+						fakeJob.StatsReturns(atc.JobStats{}, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
+			Context("when the job is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+	})
+
 	Describe("DELETE /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/tasks/:step_name/cache", func() {
 		var (
 			request  *http.Request
@@ -3,10 +3,14 @@ package jobserver
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 
+	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/present"
 	"github.com/concourse/concourse/atc/db"
@@ -36,8 +40,38 @@ func (s *Server) CreateJobBuild(pipeline db.Pipeline) http.Handler {
 			return
 		}
 
+		var reqBody atc.CreateJobBuildBody
+		err = json.NewDecoder(r.Body).Decode(&reqBody)
+		if err != nil && err != io.EOF {
+			logger.Info("malformed-request", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if reqBody.Priority != nil && (*reqBody.Priority < atc.MinJobPriority || *reqBody.Priority > atc.MaxJobPriority) {
+			logger.Info("invalid-priority", lager.Data{"priority": *reqBody.Priority})
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "priority must be between %d and %d\n", atc.MinJobPriority, atc.MaxJobPriority)
+			return
+		}
+
+		config, err := job.Config()
+		if err != nil {
+			logger.Error("failed-to-get-job-config", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		params, err := config.Params.Resolve(reqBody.Params)
+		if err != nil {
+			logger.Info("invalid-params", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintln(w, err.Error())
+			return
+		}
+
 		acc := accessor.GetAccessor(r)
-		build, err := job.CreateBuild(acc.UserInfo().DisplayUserId)
+		build, err := job.CreateBuildWithParams(acc.UserInfo().DisplayUserId, params, reqBody.Priority)
 		if err != nil {
 			logger.Error("failed-to-create-job-build", err)
 			w.WriteHeader(http.StatusInternalServerError)
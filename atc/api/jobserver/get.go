@@ -40,6 +40,13 @@ func (s *Server) GetJob(pipeline db.Pipeline) http.Handler {
 			return
 		}
 
+		config, err := job.Config()
+		if err != nil {
+			logger.Error("could-not-get-job-config", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
 		finished, next, err := job.FinishedAndNextBuild()
 		if err != nil {
 			logger.Error("could-not-get-job-finished-and-next-build", err)
@@ -49,10 +56,7 @@ func (s *Server) GetJob(pipeline db.Pipeline) http.Handler {
 
 		teamName := r.FormValue(":team_name")
 
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusOK)
-
-		err = json.NewEncoder(w).Encode(present.Job(
+		presentedJob := present.Job(
 			teamName,
 			job,
 			accessor.GetAccessor(r),
@@ -61,7 +65,13 @@ func (s *Server) GetJob(pipeline db.Pipeline) http.Handler {
 			finished,
 			next,
 			nil,
-		))
+		)
+		presentedJob.Schedule = config.Schedule
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(presentedJob)
 		if err != nil {
 			logger.Error("failed-to-encode-job", err)
 			w.WriteHeader(http.StatusInternalServerError)
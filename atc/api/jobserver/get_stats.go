@@ -0,0 +1,99 @@
+package jobserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// defaultJobStatsWindow is how far back the statistics of a job go when no
+// start of the window is given.
+const defaultJobStatsWindow = 30 * 24 * time.Hour
+
+func (s *Server) GetJobStats(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("get-job-stats")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		jobName := r.FormValue(":job_name")
+
+		until, err := parseStatsTime(r, atc.JobStatsUntil, time.Now())
+		if err != nil {
+			logger.Info("malformed-request", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintln(w, err)
+			return
+		}
+
+		since, err := parseStatsTime(r, atc.JobStatsSince, until.Add(-defaultJobStatsWindow))
+		if err != nil {
+			logger.Info("malformed-request", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintln(w, err)
+			return
+		}
+
+		if !since.Before(until) {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "%s must be before %s\n", atc.JobStatsSince, atc.JobStatsUntil)
+			return
+		}
+
+		job, found, err := pipeline.Job(jobName)
+		if err != nil {
+			logger.Error("failed-to-get-job", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		acc := accessor.GetAccessor(r)
+		if !job.Public() && !acc.IsAuthorized(pipeline.TeamName()) {
+			if acc.IsAuthenticated() {
+				w.WriteHeader(http.StatusForbidden)
+			} else {
+				w.WriteHeader(http.StatusUnauthorized)
+			}
+			return
+		}
+
+		stats, err := job.Stats(since, until)
+		if err != nil {
+			logger.Error("failed-to-get-job-stats", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(stats)
+		if err != nil {
+			logger.Error("failed-to-encode-job-stats", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+func parseStatsTime(r *http.Request, param string, def time.Time) (time.Time, error) {
+	value := r.FormValue(param)
+	if value == "" {
+		return def, nil
+	}
+
+	unix, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s '%s': must be a unix timestamp", param, value)
+	}
+
+	return time.Unix(unix, 0), nil
+}
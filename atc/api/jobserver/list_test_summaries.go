@@ -0,0 +1,70 @@
+package jobserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// defaultTestSummaryBuilds is the number of recent builds whose test results
+// are summarized when no limit is given.
+const defaultTestSummaryBuilds = 20
+
+func (s *Server) ListJobTestSummaries(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("list-job-test-summaries")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		jobName := r.FormValue(":job_name")
+
+		limit := defaultTestSummaryBuilds
+		if urlLimit := r.FormValue(atc.PaginationQueryLimit); urlLimit != "" {
+			var err error
+			limit, err = strconv.Atoi(urlLimit)
+			if err != nil || limit <= 0 {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+		}
+
+		job, found, err := pipeline.Job(jobName)
+		if err != nil {
+			logger.Error("failed-to-get-job", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		acc := accessor.GetAccessor(r)
+		if !job.Public() && !acc.IsAuthorized(pipeline.TeamName()) {
+			if acc.IsAuthenticated() {
+				w.WriteHeader(http.StatusForbidden)
+			} else {
+				w.WriteHeader(http.StatusUnauthorized)
+			}
+			return
+		}
+
+		summaries, err := job.TestSummaries(limit)
+		if err != nil {
+			logger.Error("failed-to-get-test-summaries", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(summaries)
+		if err != nil {
+			logger.Error("failed-to-encode-test-summaries", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
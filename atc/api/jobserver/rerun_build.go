@@ -3,8 +3,10 @@ package jobserver
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/present"
 	"github.com/concourse/concourse/atc/db"
@@ -49,8 +51,26 @@ func (s *Server) RerunJobBuild(pipeline db.Pipeline) http.Handler {
 		}
 
 		acc := accessor.GetAccessor(r)
-		build, err := job.RerunBuild(buildToRerun, acc.UserInfo().DisplayUserId)
+
+		var build db.Build
+		if r.URL.Query().Get("from_failed") == "true" {
+			if atc.BuildResumeWindow == 0 {
+				w.WriteHeader(http.StatusConflict)
+				fmt.Fprintln(w, "resuming builds is disabled")
+				return
+			}
+
+			build, err = job.ResumeBuild(buildToRerun, acc.UserInfo().DisplayUserId)
+		} else {
+			build, err = job.RerunBuild(buildToRerun, acc.UserInfo().DisplayUserId)
+		}
 		if err != nil {
+			if errors.Is(err, db.ErrBuildCannotBeResumed) {
+				w.WriteHeader(http.StatusConflict)
+				fmt.Fprintln(w, "build did not fail or is too old to be resumed")
+				return
+			}
+
 			logger.Error("failed-to-retrigger-build", err)
 			w.WriteHeader(http.StatusInternalServerError)
 			return
@@ -1649,6 +1649,151 @@ var _ = Describe("Pipelines API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/notifications", func() {
+		var response *http.Response
+		var query string
+
+		BeforeEach(func() {
+			query = ""
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			request, err := http.NewRequest("GET", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/notifications"+query, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				fakeTeam.PipelineReturns(dbPipeline, true, nil)
+			})
+
+			Context("when getting the deliveries works", func() {
+				BeforeEach(func() {
+					dbPipeline.NotificationDeliveriesReturns([]atc.NotificationDelivery{
+						{
+							ID:           2,
+							Notification: "some-hook",
+							BuildID:      12,
+							BuildName:    "3",
+							JobName:      "some-job",
+							Status:       atc.StatusFailed,
+							State:        atc.NotificationDeliveryPending,
+							Attempts:     1,
+							ResponseCode: 502,
+							Error:        "unexpected response: 502 Bad Gateway",
+							CreatedAt:    100,
+						},
+						{
+							ID:           1,
+							Notification: "some-hook",
+							BuildID:      12,
+							BuildName:    "3",
+							JobName:      "some-job",
+							Status:       atc.StatusStarted,
+							State:        atc.NotificationDeliveryDelivered,
+							Attempts:     1,
+							ResponseCode: 200,
+							CreatedAt:    90,
+							DeliveredAt:  91,
+						},
+					}, nil)
+				})
+
+				It("returns 200 with the deliveries", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response).Should(IncludeHeaderEntries(map[string]string{
+						"Content-Type": "application/json",
+					}))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[
+						{
+							"id": 2,
+							"notification": "some-hook",
+							"build_id": 12,
+							"build_name": "3",
+							"job_name": "some-job",
+							"status": "failed",
+							"state": "pending",
+							"attempts": 1,
+							"response_code": 502,
+							"error": "unexpected response: 502 Bad Gateway",
+							"created_at": 100
+						},
+						{
+							"id": 1,
+							"notification": "some-hook",
+							"build_id": 12,
+							"build_name": "3",
+							"job_name": "some-job",
+							"status": "started",
+							"state": "delivered",
+							"attempts": 1,
+							"response_code": 200,
+							"created_at": 90,
+							"delivered_at": 91
+						}
+					]`))
+				})
+
+				It("uses the default limit", func() {
+					Expect(dbPipeline.NotificationDeliveriesArgsForCall(0)).To(Equal(atc.PaginationAPIDefaultLimit))
+				})
+
+				Context("when a limit is given", func() {
+					BeforeEach(func() {
+						query = "?limit=5"
+					})
+
+					It("uses it", func() {
+						Expect(dbPipeline.NotificationDeliveriesArgsForCall(0)).To(Equal(5))
+					})
+				})
+			})
+
+			Context("when getting the deliveries fails", func() {
+				BeforeEach(func() {
+					dbPipeline.NotificationDeliveriesReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
 	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/rename", func() {
 		var response *http.Response
 		var requestBody string
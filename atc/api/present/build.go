@@ -31,6 +31,9 @@ func Build(build db.BuildForAPI, job db.Job, access accessor.Access) atc.Build {
 		Status:               atc.BuildStatus(build.Status()),
 		APIURL:               apiURL,
 		CreatedBy:            build.CreatedBy(),
+		TriggerReason:        build.TriggerReason(),
+		AbortReason:          build.AbortReason(),
+		Priority:             build.Priority(),
 	}
 
 	showComments := false
@@ -46,6 +49,13 @@ func Build(build db.BuildForAPI, job db.Job, access accessor.Access) atc.Build {
 		atcBuild.Comment = comment
 	}
 
+	// params are given by whoever triggered the build, so unlike comments
+	// they are not shown to viewers of public jobs who are not on the team
+	showParams := access != nil && access.IsAuthorized(build.TeamName())
+	if showParams {
+		atcBuild.Params = build.Params()
+	}
+
 	if build.RerunOf() != 0 {
 		atcBuild.RerunNumber = build.RerunNumber()
 		atcBuild.RerunOf = &atc.RerunOfBuild{
@@ -0,0 +1,21 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func BuildLogMatch(match db.BuildLogMatch) atc.BuildLogMatch {
+	lines := make([]atc.BuildLogLine, len(match.Lines))
+	for i, line := range match.Lines {
+		lines[i] = atc.BuildLogLine{
+			EventID: line.EventID,
+			Line:    line.Line,
+		}
+	}
+
+	return atc.BuildLogMatch{
+		Build: Build(match.Build, nil, nil),
+		Lines: lines,
+	}
+}
@@ -3,6 +3,7 @@ package present_test
 import (
 	"fmt"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/api/present"
@@ -52,4 +53,61 @@ var _ = Describe("Build", func() {
 			})
 		}
 	})
+
+	Describe("Params", func() {
+		BeforeEach(func() {
+			dbBuild.ParamsReturns(map[string]interface{}{"target": "prod"})
+		})
+
+		It("should not be set if neither job nor accessor is passed in", func() {
+			build := present.Build(&dbBuild, nil, nil)
+			Expect(build.Params).To(BeNil())
+		})
+
+		It("should be set if accessor allows it", func() {
+			var accessor accessorfakes.FakeAccess
+			accessor.IsAuthorizedReturns(true)
+
+			build := present.Build(&dbBuild, nil, &accessor)
+			Expect(build.Params).To(Equal(map[string]interface{}{"target": "prod"}))
+		})
+
+		It("should not be set if only the job is public", func() {
+			var dbJob dbfakes.FakeJob
+			dbJob.PublicReturns(true)
+
+			var accessor accessorfakes.FakeAccess
+			accessor.IsAuthorizedReturns(false)
+
+			build := present.Build(&dbBuild, &dbJob, &accessor)
+			Expect(build.Params).To(BeNil())
+		})
+	})
+
+	Describe("TriggerReason", func() {
+		It("is presented", func() {
+			dbBuild.TriggerReasonReturns(atc.BuildTriggerReasonScheduled)
+
+			build := present.Build(&dbBuild, nil, nil)
+			Expect(build.TriggerReason).To(Equal("scheduled"))
+		})
+	})
+
+	Describe("Priority", func() {
+		It("is presented", func() {
+			dbBuild.PriorityReturns(10)
+
+			build := present.Build(&dbBuild, nil, nil)
+			Expect(build.Priority).To(Equal(10))
+		})
+	})
+
+	Describe("AbortReason", func() {
+		It("is presented", func() {
+			dbBuild.AbortReasonReturns("superseded by some-pipeline/some-job #2")
+
+			build := present.Build(&dbBuild, nil, nil)
+			Expect(build.AbortReason).To(Equal("superseded by some-pipeline/some-job #2"))
+		})
+	})
 })
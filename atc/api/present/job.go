@@ -78,5 +78,9 @@ func Job(
 		atcJob.PausedAt = job.PausedAt().Unix()
 	}
 
+	if !job.ScheduleNextTrigger().IsZero() {
+		atcJob.NextScheduledTrigger = job.ScheduleNextTrigger().Unix()
+	}
+
 	return atcJob
 }
@@ -24,6 +24,11 @@ func Resource(resource db.Resource) atc.Resource {
 		atcResource.LastChecked = resource.LastCheckEndTime().Unix()
 	}
 
+	if resource.CheckInterval() != 0 {
+		atcResource.CheckInterval = resource.CheckInterval().String()
+		atcResource.CheckIntervalReason = resource.CheckIntervalReason()
+	}
+
 	if resource.ConfigPinnedVersion() != nil {
 		atcResource.PinnedVersion = resource.ConfigPinnedVersion()
 		atcResource.PinnedInConfig = true
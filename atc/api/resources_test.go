@@ -2,6 +2,9 @@ package api_test
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -1125,6 +1128,39 @@ var _ = Describe("Resources API", func() {
 					})
 				})
 
+				Context("when the resource's check interval has been adjusted", func() {
+					BeforeEach(func() {
+						resource1 := new(dbfakes.FakeResource)
+						resource1.TeamNameReturns("a-team")
+						resource1.PipelineIDReturns(1)
+						resource1.PipelineNameReturns("a-pipeline")
+						resource1.NameReturns("resource-1")
+						resource1.TypeReturns("type-1")
+						resource1.LastCheckEndTimeReturns(time.Unix(1513364881, 0))
+						resource1.CheckIntervalReturns(30 * time.Minute)
+						resource1.CheckIntervalReasonReturns(atc.CheckIntervalIdle)
+
+						fakePipeline.ResourceReturns(resource1, true, nil)
+					})
+
+					It("returns the resource's check interval and why", func() {
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(body).To(MatchJSON(`
+							{
+								"name": "resource-1",
+								"pipeline_id": 1,
+								"pipeline_name": "a-pipeline",
+								"team_name": "a-team",
+								"type": "type-1",
+								"last_checked": 1513364881,
+								"check_interval": "30m0s",
+								"check_interval_reason": "idle"
+							}`))
+					})
+				})
+
 				Context("when the resource version is pinned via the API", func() {
 					BeforeEach(func() {
 						resource1 := new(dbfakes.FakeResource)
@@ -1645,12 +1681,16 @@ var _ = Describe("Resources API", func() {
 	Describe("POST /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/check/webhook", func() {
 		var (
 			checkRequestBody atc.CheckRequestBody
+			requestQuery     string
+			requestHeader    http.Header
 			response         *http.Response
 			fakeResource     *dbfakes.FakeResource
 		)
 
 		BeforeEach(func() {
 			checkRequestBody = atc.CheckRequestBody{}
+			requestQuery = "?webhook_token=fake-token"
+			requestHeader = http.Header{}
 
 			fakeResource = new(dbfakes.FakeResource)
 			fakeResource.NameReturns("resource-name")
@@ -1661,8 +1701,9 @@ var _ = Describe("Resources API", func() {
 			reqPayload, err := json.Marshal(checkRequestBody)
 			Expect(err).NotTo(HaveOccurred())
 
-			request, err := http.NewRequest("POST", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/resources/resource-name/check/webhook?webhook_token=fake-token", bytes.NewBuffer(reqPayload))
+			request, err := http.NewRequest("POST", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/resources/resource-name/check/webhook"+requestQuery, bytes.NewBuffer(reqPayload))
 			Expect(err).NotTo(HaveOccurred())
+			request.Header = requestHeader
 			request.Header.Set("Content-Type", "application/json")
 
 			response, err = client.Do(request)
@@ -1808,6 +1849,216 @@ var _ = Describe("Resources API", func() {
 				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
 			})
 		})
+
+		Context("when the resource verifies the payload's signature", func() {
+			BeforeEach(func() {
+				requestQuery = ""
+
+				variables = vars.StaticVariables{
+					"webhook-secret": "s3cr3t",
+				}
+				fakePipeline.VariablesReturns(variables, nil)
+				fakeResource.WebhookReturns(&atc.WebhookConfig{
+					Secret: "((webhook-secret))",
+					Scheme: atc.WebhookSchemeGitHub,
+				})
+				fakePipeline.ResourceReturns(fakeResource, true, nil)
+
+				fakeBuild := new(dbfakes.FakeBuild)
+				fakeBuild.IDReturns(10)
+				dbCheckFactory.TryCreateCheckReturns(fakeBuild, true, nil)
+			})
+
+			Context("when the payload is signed with the secret", func() {
+				BeforeEach(func() {
+					payload, err := json.Marshal(checkRequestBody)
+					Expect(err).NotTo(HaveOccurred())
+
+					mac := hmac.New(sha256.New, []byte("s3cr3t"))
+					mac.Write(payload)
+					requestHeader.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+				})
+
+				It("returns 201", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusCreated))
+				})
+
+				It("creates a check", func() {
+					Expect(dbCheckFactory.TryCreateCheckCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the payload is signed with another secret", func() {
+				BeforeEach(func() {
+					mac := hmac.New(sha256.New, []byte("not-the-secret"))
+					mac.Write([]byte("{}"))
+					requestHeader.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+				})
+
+				It("returns 401", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				})
+
+				It("does not create a check", func() {
+					Expect(dbCheckFactory.TryCreateCheckCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the payload is not signed", func() {
+				BeforeEach(func() {
+					requestQuery = "?webhook_token=fake-token"
+				})
+
+				It("returns 401", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				})
+			})
+		})
+	})
+
+	Describe("POST /api/v1/teams/:team_name/webhooks/:webhook_name", func() {
+		var (
+			payload   []byte
+			signature string
+			response  *http.Response
+
+			matchingResource  *dbfakes.FakeResource
+			otherRepoResource *dbfakes.FakeResource
+		)
+
+		sign := func(secret string) string {
+			mac := hmac.New(sha256.New, []byte(secret))
+			mac.Write(payload)
+			return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+		}
+
+		newResource := func(name string, uri string) *dbfakes.FakeResource {
+			resource := new(dbfakes.FakeResource)
+			resource.NameReturns(name)
+			resource.TeamIDReturns(734)
+			resource.PipelineIDReturns(1)
+			resource.PipelineNameReturns("a-pipeline")
+			resource.PipelineReturns(fakePipeline, true, nil)
+			resource.SourceReturns(atc.Source{"uri": uri})
+			resource.WebhookReturns(&atc.WebhookConfig{
+				Secret:  "((webhook-secret))",
+				Scheme:  atc.WebhookSchemeGitHub,
+				Name:    "github",
+				Filters: atc.WebhookFilters{"uri": "repository.clone_url"},
+			})
+			return resource
+		}
+
+		BeforeEach(func() {
+			payload = []byte(`{"repository":{"clone_url":"https://example.com/some-repo.git"}}`)
+			signature = sign("s3cr3t")
+
+			dbTeam.IDReturns(734)
+			dbTeam.NameReturns("a-team")
+
+			matchingResource = newResource("matching", "https://example.com/some-repo.git")
+			otherRepoResource = newResource("other-repo", "https://example.com/other-repo.git")
+
+			dbResourceFactory.TeamWebhookResourcesReturns([]db.Resource{
+				matchingResource,
+				otherRepoResource,
+			}, nil)
+
+			fakePipeline.VariablesReturns(vars.StaticVariables{
+				"webhook-secret": "s3cr3t",
+			}, nil)
+
+			fakeBuild := new(dbfakes.FakeBuild)
+			fakeBuild.IDReturns(42)
+			dbCheckFactory.TryCreateCheckReturns(fakeBuild, true, nil)
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("POST", server.URL+"/api/v1/teams/a-team/webhooks/github", bytes.NewBuffer(payload))
+			Expect(err).NotTo(HaveOccurred())
+			request.Header.Set("Content-Type", "application/json")
+			request.Header.Set("X-Hub-Signature-256", signature)
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("looks up the team's resources listening to the webhook", func() {
+			Expect(dbResourceFactory.TeamWebhookResourcesCallCount()).To(Equal(1))
+			teamID, webhookName := dbResourceFactory.TeamWebhookResourcesArgsForCall(0)
+			Expect(teamID).To(Equal(734))
+			Expect(webhookName).To(Equal("github"))
+		})
+
+		It("only checks the resources matching the payload", func() {
+			Expect(dbCheckFactory.TryCreateCheckCallCount()).To(Equal(1))
+			_, checkable, _, _, manuallyTriggered, _, _ := dbCheckFactory.TryCreateCheckArgsForCall(0)
+			Expect(checkable).To(Equal(matchingResource))
+			Expect(manuallyTriggered).To(BeTrue())
+		})
+
+		It("returns 200 with the triggered resources", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+			Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(body).To(MatchJSON(`[{
+				"pipeline_id": 1,
+				"pipeline_name": "a-pipeline",
+				"resource_name": "matching",
+				"build_id": 42
+			}]`))
+		})
+
+		Context("when the payload is not signed with the resource's secret", func() {
+			BeforeEach(func() {
+				signature = sign("not-the-secret")
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+
+			It("does not check the resource", func() {
+				Expect(dbCheckFactory.TryCreateCheckCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the payload is not signed at all", func() {
+			BeforeEach(func() {
+				signature = ""
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+
+			It("does not resolve the webhook's secret", func() {
+				Expect(fakePipeline.VariablesCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the payload is not JSON", func() {
+			BeforeEach(func() {
+				payload = []byte("not-json")
+				signature = sign("s3cr3t")
+			})
+
+			It("returns 400", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		Context("when looking up the resources fails", func() {
+			BeforeEach(func() {
+				dbResourceFactory.TeamWebhookResourcesReturns(nil, errors.New("nope"))
+			})
+
+			It("returns 500", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
 	})
 
 	Describe("DELETE /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/cache", func() {
@@ -0,0 +1,189 @@
+package resourceserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/webhook"
+	"github.com/concourse/concourse/vars"
+	"github.com/tedsuo/rata"
+)
+
+// CheckTeamWebHook receives an event sent to one of the team's webhooks and
+// checks every resource listening to it whose filters match the payload. Each
+// resource verifies the payload's signature with its own secret, so that a
+// single forge webhook can serve all of the team's pipelines.
+func (s *Server) CheckTeamWebHook(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		webhookName := rata.Param(r, "webhook_name")
+
+		logger := s.logger.Session("check-team-webhook", lager.Data{
+			"team":    team.Name(),
+			"webhook": webhookName,
+		})
+
+		body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxWebhookPayloadSize))
+		if err != nil {
+			logger.Error("failed-to-read-payload", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var payload interface{}
+		err = json.Unmarshal(body, &payload)
+		if err != nil {
+			logger.Info("malformed-payload", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		resources, err := s.resourceFactory.TeamWebhookResources(team.ID(), webhookName)
+		if err != nil {
+			logger.Error("failed-to-get-resources", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		pipelines := map[int]*webhookPipeline{}
+
+		triggers := []atc.WebhookTrigger{}
+		rejected := 0
+		for _, resource := range resources {
+			config := resource.Webhook()
+			if config == nil {
+				continue
+			}
+
+			if !webhook.Match(config.Filters, resource.Source(), payload) {
+				continue
+			}
+
+			// turn away requests which aren't signed at all before resolving
+			// the secret, so that they can't be used to hammer the credential
+			// manager
+			err = webhook.Signed(*config, r.Header)
+			if err != nil {
+				logger.Info("missing-signature", lager.Data{"resource": resource.Name(), "error": err.Error()})
+				rejected++
+				continue
+			}
+
+			resourceLogger := logger.Session("resource", lager.Data{
+				"pipeline": resource.PipelineRef().String(),
+				"resource": resource.Name(),
+			})
+
+			pipeline, found := pipelines[resource.PipelineID()]
+			if !found {
+				pipeline, err = s.loadWebhookPipeline(resourceLogger, resource)
+				if err != nil {
+					resourceLogger.Error("failed-to-load-pipeline", err)
+					continue
+				}
+
+				pipelines[resource.PipelineID()] = pipeline
+			}
+
+			secret, err := creds.NewString(pipeline.variables, config.Secret).Evaluate()
+			if err != nil {
+				resourceLogger.Error("failed-to-evaluate-webhook-secret", err)
+				continue
+			}
+
+			err = webhook.Verify(*config, secret, r.Header, body)
+			if err != nil {
+				resourceLogger.Info("invalid-signature", lager.Data{"error": err.Error()})
+				rejected++
+				continue
+			}
+
+			build, created, err := s.checkFactory.TryCreateCheck(
+				lagerctx.NewContext(context.Background(), resourceLogger),
+				resource,
+				pipeline.resourceTypes,
+				nil,
+				true,
+				false,
+				true,
+			)
+			if err != nil {
+				resourceLogger.Error("failed-to-create-check", err)
+				continue
+			}
+
+			if !created {
+				resourceLogger.Info("check-not-created")
+				continue
+			}
+
+			resourceLogger.Info("triggered-check", lager.Data{"build": build.ID()})
+
+			triggers = append(triggers, atc.WebhookTrigger{
+				PipelineID:           resource.PipelineID(),
+				PipelineName:         resource.PipelineName(),
+				PipelineInstanceVars: resource.PipelineInstanceVars(),
+				ResourceName:         resource.Name(),
+				BuildID:              build.ID(),
+			})
+		}
+
+		if len(triggers) == 0 && rejected > 0 {
+			metric.Metrics.WebhooksRejected.Inc()
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(triggers)
+		if err != nil {
+			logger.Error("failed-to-encode-triggers", err)
+		}
+	})
+}
+
+var errPipelineNotFound = errors.New("pipeline not found")
+
+// webhookPipeline holds what is needed to check the resources of a pipeline,
+// so that it's only loaded once for all of its matching resources.
+type webhookPipeline struct {
+	variables     vars.Variables
+	resourceTypes db.ResourceTypes
+}
+
+func (s *Server) loadWebhookPipeline(logger lager.Logger, resource db.Resource) (*webhookPipeline, error) {
+	pipeline, found, err := resource.Pipeline()
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, errPipelineNotFound
+	}
+
+	variables, err := pipeline.Variables(logger, s.secretManager, s.varSourcePool)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceTypes, err := pipeline.ResourceTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhookPipeline{
+		variables:     variables,
+		resourceTypes: resourceTypes,
+	}, nil
+}
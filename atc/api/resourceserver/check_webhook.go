@@ -3,6 +3,8 @@ package resourceserver
 import (
 	"context"
 	"encoding/json"
+	"io"
+	"io/ioutil"
 	"net/http"
 
 	"code.cloudfoundry.org/lager"
@@ -10,10 +12,17 @@ import (
 	"github.com/concourse/concourse/atc/api/present"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/webhook"
 	"github.com/tedsuo/rata"
 )
 
-// CheckResourceWebHook defines a handler for process a check resource request via an access token.
+// maxWebhookPayloadSize limits how much of a payload is read when verifying
+// its signature. Forges send payloads well below this size.
+const maxWebhookPayloadSize = 25 * 1024 * 1024
+
+// CheckResourceWebHook defines a handler for process a check resource request via an access token
+// or, if the resource configures a webhook, a signed payload.
 func (s *Server) CheckResourceWebHook(dbPipeline db.Pipeline) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resourceName := rata.Param(r, "resource_name")
@@ -23,12 +32,6 @@ func (s *Server) CheckResourceWebHook(dbPipeline db.Pipeline) http.Handler {
 			"resource": resourceName,
 		})
 
-		if webhookToken == "" {
-			logger.Info("no-webhook-token", lager.Data{"error": "missing webhook_token"})
-			w.WriteHeader(http.StatusBadRequest)
-			return
-		}
-
 		dbResource, found, err := dbPipeline.Resource(resourceName)
 		if err != nil {
 			logger.Error("failed-to-get-resource", err)
@@ -42,22 +45,57 @@ func (s *Server) CheckResourceWebHook(dbPipeline db.Pipeline) http.Handler {
 			return
 		}
 
-		variables, err := dbPipeline.Variables(logger, s.secretManager, s.varSourcePool)
-		if err != nil {
-			logger.Error("failed-to-create-var-sources", err)
-			w.WriteHeader(http.StatusInternalServerError)
+		webhookConfig := dbResource.Webhook()
+
+		if webhookConfig == nil && webhookToken == "" {
+			logger.Info("no-webhook-token", lager.Data{"error": "missing webhook_token"})
+			metric.Metrics.WebhooksRejected.Inc()
+			w.WriteHeader(http.StatusBadRequest)
 			return
 		}
-		token, err := creds.NewString(variables, dbResource.WebhookToken()).Evaluate()
+
+		variables, err := dbPipeline.Variables(logger, s.secretManager, s.varSourcePool)
 		if err != nil {
-			logger.Error("failed-to-evaluate-webhook-token", err)
+			logger.Error("failed-to-create-var-sources", err)
 			w.WriteHeader(http.StatusInternalServerError)
 			return
 		}
-		if token != webhookToken {
-			logger.Info("invalid-token", lager.Data{"token": webhookToken})
-			w.WriteHeader(http.StatusUnauthorized)
-			return
+
+		if webhookConfig != nil {
+			secret, err := creds.NewString(variables, webhookConfig.Secret).Evaluate()
+			if err != nil {
+				logger.Error("failed-to-evaluate-webhook-secret", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			payload, err := ioutil.ReadAll(io.LimitReader(r.Body, maxWebhookPayloadSize))
+			if err != nil {
+				logger.Error("failed-to-read-payload", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			err = webhook.Verify(*webhookConfig, secret, r.Header, payload)
+			if err != nil {
+				logger.Info("invalid-signature", lager.Data{"error": err.Error()})
+				metric.Metrics.WebhooksRejected.Inc()
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+		} else {
+			token, err := creds.NewString(variables, dbResource.WebhookToken()).Evaluate()
+			if err != nil {
+				logger.Error("failed-to-evaluate-webhook-token", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			if token != webhookToken {
+				logger.Info("invalid-token", lager.Data{"token": webhookToken})
+				metric.Metrics.WebhooksRejected.Inc()
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
 		}
 
 		dbResourceTypes, err := dbPipeline.ResourceTypes()
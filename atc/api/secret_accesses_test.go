@@ -0,0 +1,124 @@
+package api_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Secret Accesses API", func() {
+	var response *http.Response
+
+	Describe("GET /api/v1/builds/:build_id/secret_accesses", func() {
+		BeforeEach(func() {
+			build.TeamNameReturns("some-team")
+			build.AllAssociatedTeamNamesReturns([]string{"some-team"})
+			build.JobIDReturns(42)
+			build.JobNameReturns("job1")
+			build.PipelineIDReturns(42)
+			build.PipelineReturns(fakePipeline, true, nil)
+			dbBuildFactory.BuildForAPIReturns(build, true, nil)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/builds/3/secret_accesses")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated and the pipeline is private", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+				fakePipeline.PublicReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(build.SecretAccessesCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when not authenticated and the pipeline and job are public", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+				fakePipeline.PublicReturns(true)
+
+				fakeJob := new(dbfakes.FakeJob)
+				fakeJob.PublicReturns(true)
+				fakePipeline.JobReturns(fakeJob, true, nil)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(build.SecretAccessesCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when authenticated, but not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(build.SecretAccessesCallCount()).To(BeZero())
+			})
+
+			Context("even if the pipeline is public", func() {
+				BeforeEach(func() {
+					fakePipeline.PublicReturns(true)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+		})
+
+		Context("when authenticated and authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the secret accesses are found", func() {
+				BeforeEach(func() {
+					build.SecretAccessesReturns([]atc.SecretAccess{
+						{Path: "/concourse/some-team/some-var", CredentialManager: "vault", Found: true},
+						{Source: "some-source", Path: "some-var", CredentialManager: "dummy", Found: true, Cached: true},
+					}, nil)
+				})
+
+				It("returns the secret accesses of the build", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+					var accesses []atc.SecretAccess
+					err := json.NewDecoder(response.Body).Decode(&accesses)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(accesses).To(Equal([]atc.SecretAccess{
+						{Path: "/concourse/some-team/some-var", CredentialManager: "vault", Found: true},
+						{Source: "some-source", Path: "some-var", CredentialManager: "dummy", Found: true, Cached: true},
+					}))
+				})
+			})
+
+			Context("when getting the secret accesses fails", func() {
+				BeforeEach(func() {
+					build.SecretAccessesReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+})
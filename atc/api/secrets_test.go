@@ -0,0 +1,291 @@
+package api_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Secrets API", func() {
+	var response *http.Response
+	var fakeTeam *dbfakes.FakeTeam
+
+	BeforeEach(func() {
+		fakeTeam = new(dbfakes.FakeTeam)
+		fakeTeam.IDReturns(2)
+		fakeTeam.NameReturns("a-team")
+		dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+	})
+
+	Describe("GET /api/v1/teams/:team_name/secrets", func() {
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/a-team/secrets")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when not authorized", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthorizedReturns(false)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+
+			Context("when authorized", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthorizedReturns(true)
+
+					dbSecretStore.ListReturns([]atc.Secret{
+						{Name: "some-secret", TeamName: "a-team", UpdatedAt: 42},
+						{Name: "other-secret", TeamName: "a-team", PipelineName: "some-pipeline", UpdatedAt: 43},
+					}, nil)
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("returns Content-Type 'application/json'", func() {
+					expectedHeaderEntries := map[string]string{
+						"Content-Type": "application/json",
+					}
+					Expect(response).Should(IncludeHeaderEntries(expectedHeaderEntries))
+				})
+
+				It("lists the team's secrets without their values", func() {
+					Expect(dbSecretStore.ListCallCount()).To(Equal(1))
+					Expect(dbSecretStore.ListArgsForCall(0)).To(Equal(2))
+
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+						{"name":"some-secret","team_name":"a-team","updated_at":42},
+						{"name":"other-secret","team_name":"a-team","pipeline_name":"some-pipeline","updated_at":43}
+					]`))
+				})
+
+				Context("when listing the secrets fails", func() {
+					BeforeEach(func() {
+						dbSecretStore.ListReturns(nil, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/secrets/:secret_name", func() {
+		var secretName string
+		var requestBody string
+
+		BeforeEach(func() {
+			secretName = "some-secret"
+			requestBody = `{"pipeline_name":"some-pipeline","value":{"username":"admin"}}`
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest(
+				"PUT",
+				server.URL+"/api/v1/teams/a-team/secrets/"+secretName,
+				bytes.NewBufferString(requestBody),
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+
+			It("does not set the secret", func() {
+				Expect(dbSecretStore.SetCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when authenticated and authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			It("returns 204", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+			})
+
+			It("sets the secret", func() {
+				Expect(dbSecretStore.SetCallCount()).To(Equal(1))
+				teamID, pipelineName, name, value := dbSecretStore.SetArgsForCall(0)
+				Expect(teamID).To(Equal(2))
+				Expect(pipelineName).To(Equal("some-pipeline"))
+				Expect(name).To(Equal("some-secret"))
+				Expect(value).To(Equal(map[string]interface{}{"username": "admin"}))
+			})
+
+			Context("when the request is malformed", func() {
+				BeforeEach(func() {
+					requestBody = `{`
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when no value is given", func() {
+				BeforeEach(func() {
+					requestBody = `{"pipeline_name":"some-pipeline"}`
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{"errors":["secret value must be provided"]}`))
+				})
+			})
+
+			Context("when the pipeline name contains a slash", func() {
+				BeforeEach(func() {
+					requestBody = `{"pipeline_name":"some/pipeline","value":"foo"}`
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{"errors":["pipeline name must not contain '/'"]}`))
+				})
+
+				It("does not set the secret", func() {
+					Expect(dbSecretStore.SetCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when setting the secret fails", func() {
+				BeforeEach(func() {
+					dbSecretStore.SetReturns(errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/teams/:team_name/secrets/:secret_name", func() {
+		var query string
+
+		BeforeEach(func() {
+			query = "?pipeline_name=some-pipeline"
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest(
+				"DELETE",
+				server.URL+"/api/v1/teams/a-team/secrets/some-secret"+query,
+				nil,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated and authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the secret exists", func() {
+				BeforeEach(func() {
+					dbSecretStore.DeleteReturns(true, nil)
+				})
+
+				It("returns 204", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+				})
+
+				It("deletes the pipeline's secret", func() {
+					Expect(dbSecretStore.DeleteCallCount()).To(Equal(1))
+					teamID, pipelineName, name := dbSecretStore.DeleteArgsForCall(0)
+					Expect(teamID).To(Equal(2))
+					Expect(pipelineName).To(Equal("some-pipeline"))
+					Expect(name).To(Equal("some-secret"))
+				})
+
+				Context("when no pipeline is given", func() {
+					BeforeEach(func() {
+						query = ""
+					})
+
+					It("deletes the team's secret", func() {
+						_, pipelineName, _ := dbSecretStore.DeleteArgsForCall(0)
+						Expect(pipelineName).To(BeEmpty())
+					})
+				})
+			})
+
+			Context("when the secret does not exist", func() {
+				BeforeEach(func() {
+					dbSecretStore.DeleteReturns(false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when deleting the secret fails", func() {
+				BeforeEach(func() {
+					dbSecretStore.DeleteReturns(false, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+})
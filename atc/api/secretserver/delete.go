@@ -0,0 +1,43 @@
+package secretserver
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	. "github.com/concourse/concourse/atc/api/helpers"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
+)
+
+func (s *Server) DeleteSecret(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		secretName := rata.Param(r, "secret_name")
+		pipelineName := r.URL.Query().Get("pipeline_name")
+
+		logger := s.logger.Session("delete-secret", lager.Data{
+			"team":     team.Name(),
+			"pipeline": pipelineName,
+			"secret":   secretName,
+		})
+
+		errs := validate(secretName, pipelineName)
+		if len(errs) > 0 {
+			HandleBadRequest(w, errs...)
+			return
+		}
+
+		deleted, err := s.secretStore.Delete(team.ID(), pipelineName, secretName)
+		if err != nil {
+			logger.Error("failed-to-delete-secret", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !deleted {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
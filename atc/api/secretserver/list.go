@@ -0,0 +1,31 @@
+package secretserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListSecrets(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("list-secrets", lager.Data{"team": team.Name()})
+
+		secrets, err := s.secretStore.List(team.ID())
+		if err != nil {
+			logger.Error("failed-to-list-secrets", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(secrets)
+		if err != nil {
+			logger.Error("failed-to-encode-secrets", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+}
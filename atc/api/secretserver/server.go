@@ -0,0 +1,18 @@
+package secretserver
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Server struct {
+	logger      lager.Logger
+	secretStore db.SecretStore
+}
+
+func NewServer(logger lager.Logger, secretStore db.SecretStore) *Server {
+	return &Server{
+		logger:      logger,
+		secretStore: secretStore,
+	}
+}
@@ -0,0 +1,67 @@
+package secretserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/atc/api/helpers"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
+)
+
+func (s *Server) SetSecret(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		secretName := rata.Param(r, "secret_name")
+
+		logger := s.logger.Session("set-secret", lager.Data{
+			"team":   team.Name(),
+			"secret": secretName,
+		})
+
+		var request atc.SetSecretRequest
+		err := json.NewDecoder(r.Body).Decode(&request)
+		if err != nil {
+			logger.Info("malformed-request", lager.Data{"error": err.Error()})
+			HandleBadRequest(w, "malformed request payload")
+			return
+		}
+
+		errs := validate(secretName, request.PipelineName)
+		if request.Value == nil {
+			errs = append(errs, "secret value must be provided")
+		}
+
+		if len(errs) > 0 {
+			HandleBadRequest(w, errs...)
+			return
+		}
+
+		err = s.secretStore.Set(team.ID(), request.PipelineName, secretName, request.Value)
+		if err != nil {
+			logger.Error("failed-to-set-secret", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// validate rejects names which would be ambiguous in a secret's lookup path,
+// which is made of the team, pipeline and secret names separated by slashes.
+func validate(secretName string, pipelineName string) []string {
+	var errs []string
+
+	if strings.Contains(secretName, "/") {
+		errs = append(errs, "secret name must not contain '/'")
+	}
+
+	if strings.Contains(pipelineName, "/") {
+		errs = append(errs, "pipeline name must not contain '/'")
+	}
+
+	return errs
+}
@@ -272,6 +272,123 @@ var _ = Describe("Teams API", func() {
 						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
 					})
 				})
+
+				It("leaves the team's var sources alone", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(fakeTeam.UpdateVarSourcesCallCount()).To(BeZero())
+				})
+
+				Context("when an empty list of var sources is given", func() {
+					BeforeEach(func() {
+						atcTeam.VarSources = &atc.VarSourceConfigs{}
+					})
+
+					It("clears the team's var sources", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(fakeTeam.UpdateVarSourcesCallCount()).To(Equal(1))
+						Expect(fakeTeam.UpdateVarSourcesArgsForCall(0)).To(BeEmpty())
+					})
+				})
+
+				Context("when var sources are given", func() {
+					BeforeEach(func() {
+						atcTeam.VarSources = &atc.VarSourceConfigs{
+							{
+								Name:   "some-source",
+								Type:   "dummy",
+								Config: map[string]interface{}{"vars": map[string]interface{}{"foo": "bar"}},
+							},
+						}
+					})
+
+					It("updates the team's var sources", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(fakeTeam.UpdateVarSourcesCallCount()).To(Equal(1))
+						Expect(fakeTeam.UpdateVarSourcesArgsForCall(0)).To(Equal(*atcTeam.VarSources))
+					})
+
+					Context("when updating the var sources fails", func() {
+						BeforeEach(func() {
+							fakeTeam.UpdateVarSourcesReturns(errors.New("nope"))
+						})
+
+						It("returns 500 Internal Server error", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+					})
+				})
+
+				Context("when the var sources are invalid", func() {
+					BeforeEach(func() {
+						atcTeam.VarSources = &atc.VarSourceConfigs{
+							{Name: "some-source", Type: "bogus"},
+						}
+					})
+
+					It("returns 400 with the errors", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+							"errors": ["invalid variable sources:\n\tunknown credential manager type: bogus\n"],
+							"team": {}
+						}`))
+					})
+
+					It("does not update the team", func() {
+						Expect(fakeTeam.UpdateProviderAuthCallCount()).To(BeZero())
+						Expect(fakeTeam.UpdateVarSourcesCallCount()).To(BeZero())
+					})
+				})
+
+				It("leaves the team's notifications alone", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(fakeTeam.UpdateNotificationsCallCount()).To(BeZero())
+				})
+
+				Context("when notifications are given", func() {
+					BeforeEach(func() {
+						atcTeam.Notifications = &atc.NotificationConfigs{
+							{Name: "failures", URL: "https://example.com/hook", Statuses: []atc.BuildStatus{atc.StatusFailed}},
+						}
+					})
+
+					It("updates the team's notifications", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(fakeTeam.UpdateNotificationsCallCount()).To(Equal(1))
+						Expect(fakeTeam.UpdateNotificationsArgsForCall(0)).To(Equal(*atcTeam.Notifications))
+					})
+
+					Context("when updating the notifications fails", func() {
+						BeforeEach(func() {
+							fakeTeam.UpdateNotificationsReturns(errors.New("nope"))
+						})
+
+						It("returns 500 Internal Server error", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+					})
+				})
+
+				Context("when the notifications are invalid", func() {
+					BeforeEach(func() {
+						atcTeam.Notifications = &atc.NotificationConfigs{
+							{Name: "failures"},
+						}
+					})
+
+					It("returns 400 with the errors", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+							"errors": ["invalid notifications:\n\tnotifications.failures has no url\n"],
+							"team": {}
+						}`))
+					})
+
+					It("does not update the team", func() {
+						Expect(fakeTeam.UpdateProviderAuthCallCount()).To(BeZero())
+						Expect(fakeTeam.UpdateNotificationsCallCount()).To(BeZero())
+					})
+				})
+
 				Context("when provider auth is empty", func() {
 					BeforeEach(func() {
 						atcTeam = atc.Team{}
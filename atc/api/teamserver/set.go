@@ -9,6 +9,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/configvalidate"
 )
 
 type SetTeamResponse struct {
@@ -40,6 +41,34 @@ func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	var warnings []atc.ConfigWarning
+	var errorMessages []string
+	if atcTeam.VarSources != nil {
+		warnings, errorMessages = configvalidate.ValidateVarSources(*atcTeam.VarSources)
+	}
+
+	if atcTeam.Notifications != nil {
+		notificationWarnings, notificationErrors := configvalidate.ValidateNotifications(*atcTeam.Notifications)
+		warnings = append(warnings, notificationWarnings...)
+		errorMessages = append(errorMessages, notificationErrors...)
+	}
+
+	if len(errorMessages) > 0 {
+		hLog.Info("ignoring-team-with-invalid-config")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+
+		err = json.NewEncoder(w).Encode(SetTeamResponse{
+			Errors:   errorMessages,
+			Warnings: warnings,
+		})
+		if err != nil {
+			hLog.Error("failed-to-encode-response", err)
+		}
+		return
+	}
+
 	atcTeam.Name = teamName
 
 	team, found, err := s.teamFactory.FindTeam(teamName)
@@ -49,7 +78,7 @@ func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	response := SetTeamResponse{}
+	response := SetTeamResponse{Warnings: warnings}
 	if found {
 		hLog.Debug("updating-credentials")
 		err = team.UpdateProviderAuth(atcTeam.Auth)
@@ -59,6 +88,24 @@ func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		if atcTeam.VarSources != nil {
+			err = team.UpdateVarSources(*atcTeam.VarSources)
+			if err != nil {
+				hLog.Error("failed-to-update-team-var-sources", err, lager.Data{"teamName": teamName})
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+		}
+
+		if atcTeam.Notifications != nil {
+			err = team.UpdateNotifications(*atcTeam.Notifications)
+			if err != nil {
+				hLog.Error("failed-to-update-team-notifications", err, lager.Data{"teamName": teamName})
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+		}
+
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 	} else if acc.IsAdmin() {
@@ -0,0 +1,235 @@
+package api_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Test Results API", func() {
+	var response *http.Response
+
+	Describe("GET /api/v1/builds/:build_id/test_results", func() {
+		BeforeEach(func() {
+			build.TeamNameReturns("some-team")
+			build.AllAssociatedTeamNamesReturns([]string{"some-team"})
+			build.JobIDReturns(42)
+			build.JobNameReturns("job1")
+			build.PipelineIDReturns(42)
+			build.PipelineReturns(fakePipeline, true, nil)
+			dbBuildFactory.BuildForAPIReturns(build, true, nil)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/builds/3/test_results")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated and the pipeline is private", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+				fakePipeline.PublicReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(build.TestResultsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when authenticated, but not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(build.TestResultsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when authenticated and authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the test results are found", func() {
+				BeforeEach(func() {
+					build.TestResultsReturns([]atc.TestResult{
+						{Step: "unit", Suite: "pkg", Name: "TestA", Status: atc.TestStatusPassed, Duration: 0.5},
+						{Step: "unit", Suite: "pkg", Name: "TestB", Status: atc.TestStatusFailed, Message: "boom"},
+					}, nil)
+				})
+
+				It("returns the test results of the build", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+					var results []atc.TestResult
+					err := json.NewDecoder(response.Body).Decode(&results)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(results).To(Equal([]atc.TestResult{
+						{Step: "unit", Suite: "pkg", Name: "TestA", Status: atc.TestStatusPassed, Duration: 0.5},
+						{Step: "unit", Suite: "pkg", Name: "TestB", Status: atc.TestStatusFailed, Message: "boom"},
+					}))
+				})
+			})
+
+			Context("when getting the test results fails", func() {
+				BeforeEach(func() {
+					build.TestResultsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/test_results", func() {
+		var (
+			fakeJob     *dbfakes.FakeJob
+			queryParams string
+		)
+
+		BeforeEach(func() {
+			fakeJob = new(dbfakes.FakeJob)
+			queryParams = ""
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/test_results" + queryParams)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authorized and the pipeline is private", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+				fakePipeline.PublicReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when not authorized and the pipeline is public", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+				fakeAccess.IsAuthorizedReturns(false)
+				fakePipeline.PublicReturns(true)
+				fakePipeline.JobReturns(fakeJob, true, nil)
+			})
+
+			Context("and the job is private", func() {
+				BeforeEach(func() {
+					fakeJob.PublicReturns(false)
+				})
+
+				It("returns 401", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+					Expect(fakeJob.TestSummariesCallCount()).To(BeZero())
+				})
+			})
+
+			Context("and the job is public", func() {
+				BeforeEach(func() {
+					fakeJob.PublicReturns(true)
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the job is found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(fakeJob, true, nil)
+					fakeJob.TestSummariesReturns([]atc.TestSummary{
+						{Suite: "pkg", Name: "TestB", Runs: 3, Passed: 2, Failed: 1, LastFailedBuild: "12"},
+					}, nil)
+				})
+
+				It("looks up the job by name", func() {
+					Expect(fakePipeline.JobArgsForCall(0)).To(Equal("some-job"))
+				})
+
+				It("summarizes the test results of the job's recent builds", func() {
+					Expect(fakeJob.TestSummariesCallCount()).To(Equal(1))
+					Expect(fakeJob.TestSummariesArgsForCall(0)).To(Equal(20))
+
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+					var summaries []atc.TestSummary
+					err := json.NewDecoder(response.Body).Decode(&summaries)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(summaries).To(Equal([]atc.TestSummary{
+						{Suite: "pkg", Name: "TestB", Runs: 3, Passed: 2, Failed: 1, LastFailedBuild: "12"},
+					}))
+				})
+
+				Context("when a limit is given", func() {
+					BeforeEach(func() {
+						queryParams = "?limit=5"
+					})
+
+					It("summarizes that many builds", func() {
+						Expect(fakeJob.TestSummariesArgsForCall(0)).To(Equal(5))
+					})
+				})
+
+				Context("when the limit is invalid", func() {
+					BeforeEach(func() {
+						queryParams = "?limit=nope"
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(fakeJob.TestSummariesCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when summarizing fails", func() {
+					BeforeEach(func() {
+						fakeJob.TestSummariesReturns(nil, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
+			Context("when the job is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+	})
+})
@@ -29,24 +29,31 @@ import (
 	"github.com/concourse/concourse/atc/api/pipelineserver"
 	"github.com/concourse/concourse/atc/api/policychecker"
 	"github.com/concourse/concourse/atc/auditor"
+	"github.com/concourse/concourse/atc/blobstore"
 	"github.com/concourse/concourse/atc/builds"
 	"github.com/concourse/concourse/atc/component"
 	"github.com/concourse/concourse/atc/compression"
 	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/builtin"
 	"github.com/concourse/concourse/atc/creds/noop"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/encryption"
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/db/migration"
 	"github.com/concourse/concourse/atc/engine"
+	"github.com/concourse/concourse/atc/eventstore"
+	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/gc"
+	"github.com/concourse/concourse/atc/idtoken"
 	"github.com/concourse/concourse/atc/lidar"
 	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/notifier"
 	"github.com/concourse/concourse/atc/pauser"
 	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/scheduler"
 	"github.com/concourse/concourse/atc/scheduler/algorithm"
 	"github.com/concourse/concourse/atc/syslog"
+	"github.com/concourse/concourse/atc/taskcache"
 	"github.com/concourse/concourse/atc/util"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/wrappa"
@@ -150,6 +157,7 @@ type RunCommand struct {
 	GlobalResourceCheckTimeout          time.Duration `long:"global-resource-check-timeout" default:"1h" description:"Time limit on checking for new versions of resources."`
 	ResourceCheckingInterval            time.Duration `long:"resource-checking-interval" default:"1m" description:"Interval on which to check for new versions of resources."`
 	ResourceWithWebhookCheckingInterval time.Duration `long:"resource-with-webhook-checking-interval" default:"1m" description:"Interval on which to check for new versions of resources that has webhook defined."`
+	MaxCheckInterval                    time.Duration `long:"max-check-interval" default:"0s" description:"Longest interval resources can be checked on. When longer than a resource's interval, resources which rarely have new versions or whose checks keep failing are checked less often, up to this interval. A value of zero disables this."`
 	MaxChecksPerSecond                  int           `long:"max-checks-per-second" description:"Maximum number of checks that can be started per second. If not specified, this will be calculated as (# of resources)/(resource checking interval). -1 value will remove this maximum limit of checks per second."`
 	PausePipelinesAfter                 int           `long:"pause-pipelines-after" default:"0" description:"The number of days after which a pipeline will be automatically paused if none of its jobs have run in more than the given number of days. A value of zero disables this component."`
 	PipelinePauserInterval              time.Duration `long:"pipeline-pauser-interval" default:"24h" hidden:"true" description:"The frequency on which the Pipeline Pauser component will be run to check if any pipelines need to be paused."`
@@ -197,10 +205,34 @@ type RunCommand struct {
 		FailedGracePeriod      time.Duration `long:"failed-grace-period" default:"120h" description:"Period after which failed containers will be garbage collected"`
 		CheckRecyclePeriod     time.Duration `long:"check-recycle-period" default:"1m" description:"Period after which to reap checks that are completed."`
 		VarSourceRecyclePeriod time.Duration `long:"var-source-recycle-period" default:"5m" description:"Period after which to reap var_sources that are not used."`
+
+		RemoteTaskCacheMaxAge  time.Duration `long:"remote-task-cache-max-age" default:"168h" description:"Period after which task caches kept in the remote task cache store are removed if they have not changed since. 0 means no limit."`
+		RemoteTaskCacheMaxSize uint64        `long:"remote-task-cache-max-size" description:"Maximum total size in bytes of the task caches kept in the remote task cache store. The least recently saved caches are removed first. 0 means no limit."`
 	} `group:"Garbage Collection" namespace:"gc"`
 
+	RemoteTaskCache blobstore.S3Config `group:"Remote Task Cache" namespace:"remote-task-cache"`
+
+	BuildEventStore struct {
+		Dir         string             `long:"dir" description:"Directory in which to keep the events of finished builds."`
+		S3          blobstore.S3Config `namespace:"s3"`
+		GracePeriod time.Duration      `long:"grace-period" default:"1h" description:"Period after a build finishes before its events are moved out of the database."`
+		Interval    time.Duration      `long:"interval" default:"1m" description:"Interval on which to move the events of finished builds out of the database."`
+	} `group:"Build Event Store" namespace:"build-event-store"`
+
+	Notifications struct {
+		Interval    time.Duration `long:"interval" default:"10s" description:"Interval on which to deliver build notifications."`
+		Timeout     time.Duration `long:"timeout" default:"30s" description:"Timeout for a single delivery of a build notification."`
+		MaxAttempts int           `long:"max-attempts" default:"10" description:"Number of times the delivery of a build notification is attempted before giving up."`
+	} `group:"Notifications" namespace:"notifications"`
+
+	IDTokens struct {
+		KeyRotationPeriod time.Duration `long:"key-rotation-period" default:"168h" description:"Age at which the key identity tokens are signed with is replaced. Replaced keys are kept until the tokens they signed have expired."`
+	} `group:"Identity Tokens" namespace:"id-tokens"`
+
 	BuildTrackerInterval time.Duration `long:"build-tracker-interval" default:"10s" description:"Interval on which to run build tracking."`
 
+	BuildResumeWindow time.Duration `long:"build-resume-window" default:"12h" description:"Period during which a failed job build can be resumed from the step that failed. The artifacts of the build's steps are kept on the workers until then. 0 disables resuming builds."`
+
 	TelemetryOptIn bool `long:"telemetry-opt-in" hidden:"true" description:"Enable anonymous concourse version reporting."`
 
 	DefaultBuildLogsToRetain uint64 `long:"default-build-logs-to-retain" description:"Default build logs to retain, 0 means all"`
@@ -253,6 +285,7 @@ type RunCommand struct {
 		EnableP2PVolumeStreaming             bool `long:"enable-p2p-volume-streaming" description:"Enable P2P volume streaming. NOTE: All workers must be on the same LAN network"`
 		EnableCacheStreamedVolumes           bool `long:"enable-cache-streamed-volumes" description:"When enabled, streamed resource volumes will be cached on the destination worker."`
 		EnableResourceCausality              bool `long:"enable-resource-causality" description:"Enable the resource causality page. Computing causality can be expensive for the database. "`
+		EnableBuildLogSearch                 bool `long:"enable-build-log-search" description:"Enable searching the logs of builds. Every line of build output is also stored in the database, where it is kept after the build's events are offloaded, until the build is reaped."`
 	} `group:"Feature Flags"`
 
 	BaseResourceTypeDefaults flag.File `long:"base-resource-type-defaults" description:"Base resource type defaults"`
@@ -533,8 +566,10 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 	atc.EnablePipelineInstances = cmd.FeatureFlags.EnablePipelineInstances
 	atc.EnableCacheStreamedVolumes = cmd.FeatureFlags.EnableCacheStreamedVolumes
 	atc.EnableResourceCausality = cmd.FeatureFlags.EnableResourceCausality
+	atc.EnableBuildLogSearch = cmd.FeatureFlags.EnableBuildLogSearch
 	atc.DefaultCheckInterval = cmd.ResourceCheckingInterval
 	atc.DefaultWebhookInterval = cmd.ResourceWithWebhookCheckingInterval
+	atc.BuildResumeWindow = cmd.BuildResumeWindow
 
 	if cmd.BaseResourceTypeDefaults.Path() != "" {
 		content, err := ioutil.ReadFile(cmd.BaseResourceTypeDefaults.Path())
@@ -627,6 +662,10 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 		return nil, err
 	}
 
+	if manager, ok := cmd.CredentialManagers["builtin"].(*builtin.Manager); ok {
+		manager.SetStore(db.NewSecretStore(backendConn))
+	}
+
 	secretManager, err := cmd.secretManager(logger)
 	if err != nil {
 		return nil, err
@@ -710,18 +749,32 @@ func (cmd *RunCommand) constructMembers(
 	if err != nil {
 		return nil, err
 	}
+
+	// the API reads the events which the backend offloads to the store, so
+	// they share it
+	buildEventStore, err := cmd.buildEventStore()
+	if err != nil {
+		return nil, err
+	}
+
+	// task steps save caches to the store which the collector cleans up
+	remoteTaskCaches, err := cmd.remoteTaskCaches()
+	if err != nil {
+		return nil, err
+	}
+
 	checkBuildsChan := make(chan db.Build, 2000)
-	apiMembers, err := cmd.constructAPIMembers(logger, reconfigurableSink, apiConn, workerConn, storage, lockFactory, secretManager, policyChecker, workerCache, checkBuildsChan)
+	apiMembers, err := cmd.constructAPIMembers(logger, reconfigurableSink, apiConn, workerConn, storage, lockFactory, secretManager, policyChecker, workerCache, checkBuildsChan, buildEventStore)
 	if err != nil {
 		return nil, err
 	}
 
-	backendComponents, err := cmd.backendComponents(logger, backendConn, lockFactory, secretManager, policyChecker, workerCache, checkBuildsChan)
+	backendComponents, err := cmd.backendComponents(logger, backendConn, lockFactory, secretManager, policyChecker, workerCache, checkBuildsChan, buildEventStore, remoteTaskCaches)
 	if err != nil {
 		return nil, err
 	}
 
-	gcComponents, err := cmd.gcComponents(logger, gcConn, lockFactory)
+	gcComponents, err := cmd.gcComponents(logger, gcConn, lockFactory, remoteTaskCaches)
 	if err != nil {
 		return nil, err
 	}
@@ -781,6 +834,7 @@ func (cmd *RunCommand) constructAPIMembers(
 	policyChecker policy.Checker,
 	workerCache *db.WorkerCache,
 	checkBuildsChan chan db.Build,
+	buildEventStore *eventstore.Store,
 ) ([]grouper.Member, error) {
 
 	httpClient, err := cmd.skyHttpClient()
@@ -825,6 +879,7 @@ func (cmd *RunCommand) constructAPIMembers(
 	dbAccessTokenFactory := db.NewAccessTokenFactory(dbConn)
 	dbClock := db.NewClock()
 	dbWall := db.NewWall(dbConn, &dbClock)
+	dbSecretStore := db.NewSecretStore(dbConn)
 
 	tokenVerifier := cmd.constructTokenVerifier(dbAccessTokenFactory)
 
@@ -872,7 +927,9 @@ func (cmd *RunCommand) constructAPIMembers(
 		credsManagers,
 		accessFactory,
 		dbWall,
+		dbSecretStore,
 		policyChecker,
+		buildEventStore,
 	)
 	if err != nil {
 		return nil, err
@@ -910,6 +967,12 @@ func (cmd *RunCommand) constructAPIMembers(
 		return nil, err
 	}
 
+	idTokenHandler := idtoken.NewHandler(
+		logger.Session("id-token"),
+		cmd.ExternalURL.String(),
+		db.NewSigningKeyFactory(dbConn),
+	)
+
 	var httpHandler, httpsHandler http.Handler
 	if cmd.isTLSEnabled() {
 		httpHandler = cmd.constructHTTPHandler(
@@ -945,6 +1008,7 @@ func (cmd *RunCommand) constructAPIMembers(
 				externalHost:  cmd.ExternalURL.URL.Host,
 				baseHandler:   legacyHandler,
 			},
+			idTokenHandler,
 			middleware,
 		)
 
@@ -955,6 +1019,7 @@ func (cmd *RunCommand) constructAPIMembers(
 			authHandler,
 			loginHandler,
 			legacyHandler,
+			idTokenHandler,
 			middleware,
 		)
 	} else {
@@ -965,6 +1030,7 @@ func (cmd *RunCommand) constructAPIMembers(
 			authHandler,
 			loginHandler,
 			legacyHandler,
+			idTokenHandler,
 			middleware,
 		)
 	}
@@ -1003,6 +1069,8 @@ func (cmd *RunCommand) backendComponents(
 	policyChecker policy.Checker,
 	workerCache *db.WorkerCache,
 	checkBuildsChan chan db.Build,
+	buildEventStore *eventstore.Store,
+	remoteTaskCaches blobstore.ObjectStore,
 ) ([]RunnableComponent, error) {
 
 	if cmd.Syslog.Address != "" && cmd.Syslog.Transport == "" {
@@ -1027,6 +1095,7 @@ func (cmd *RunCommand) backendComponents(
 	dbPipelinePauser := db.NewPipelinePauser(dbConn, lockFactory)
 
 	dbWorkerFactory := db.NewWorkerFactory(dbConn, workerCache)
+	dbSigningKeyFactory := db.NewSigningKeyFactory(dbConn)
 
 	alg := algorithm.New(db.NewVersionsDB(dbConn, algorithmLimitRows, schedulerCache))
 
@@ -1054,8 +1123,17 @@ func (cmd *RunCommand) backendComponents(
 		clock.NewClock(),
 	)
 
+	var taskCacheStore exec.TaskCacheStore
+	if remoteTaskCaches != nil {
+		taskCacheStore = taskcache.NewStore(remoteTaskCaches)
+	}
+
+	idTokenIssuer := idtoken.NewIssuer(cmd.ExternalURL.String(), dbSigningKeyFactory, clock.NewClock())
+
 	engine := cmd.constructEngine(
 		pool,
+		taskCacheStore,
+		idTokenIssuer,
 		dbWorkerFactory,
 		teamFactory,
 		dbBuildFactory,
@@ -1094,6 +1172,7 @@ func (cmd *RunCommand) backendComponents(
 			Runnable: lidar.NewScanner(
 				dbCheckFactory,
 				atc.NewPlanFactory(time.Now().Unix()),
+				cmd.MaxCheckInterval,
 			),
 		},
 		{
@@ -1130,6 +1209,17 @@ func (cmd *RunCommand) backendComponents(
 			},
 			Runnable: builds.NewTracker(logger, dbBuildFactory, engine, checkBuildsChan),
 		},
+		{
+			Component: atc.Component{
+				Name:     atc.ComponentSigningKeyRotator,
+				Interval: time.Minute,
+			},
+			Runnable: idtoken.NewRotator(
+				dbSigningKeyFactory,
+				cmd.IDTokens.KeyRotationPeriod,
+				clock.NewClock(),
+			),
+		},
 		{
 			Component: atc.Component{
 				Name:     atc.ComponentBuildReaper,
@@ -1138,6 +1228,7 @@ func (cmd *RunCommand) backendComponents(
 			Runnable: gc.NewBuildLogCollector(
 				dbPipelineFactory,
 				dbPipelineLifecycle,
+				teamFactory,
 				500,
 				gc.NewBuildLogRetentionCalculator(
 					cmd.DefaultBuildLogsToRetain,
@@ -1146,10 +1237,43 @@ func (cmd *RunCommand) backendComponents(
 					cmd.MaxDaysToRetainBuildLogs,
 				),
 				syslogDrainConfigured,
+				buildEventStore,
+			),
+		},
+		{
+			Component: atc.Component{
+				Name:     atc.ComponentNotifier,
+				Interval: cmd.Notifications.Interval,
+			},
+			Runnable: notifier.NewNotifier(
+				db.NewBuildNotificationFactory(dbConn),
+				dbBuildFactory,
+				secretManager,
+				cmd.varSourcePool,
+				&http.Client{Timeout: cmd.Notifications.Timeout},
+				cmd.ExternalURL.String(),
+				cmd.Notifications.MaxAttempts,
+				clock.NewClock(),
 			),
 		},
 	}
 
+	if buildEventStore != nil {
+		components = append(components, RunnableComponent{
+			Component: atc.Component{
+				Name:     atc.ComponentBuildEventOffloader,
+				Interval: cmd.BuildEventStore.Interval,
+			},
+			Runnable: eventstore.NewOffloader(
+				dbBuildFactory,
+				buildEventStore,
+				cmd.BuildEventStore.GracePeriod,
+				500,
+				syslogDrainConfigured,
+			),
+		})
+	}
+
 	if syslogDrainConfigured {
 		components = append(components, RunnableComponent{
 			Component: atc.Component{
@@ -1186,6 +1310,40 @@ func (cmd *RunCommand) streamer(cacheFactory db.ResourceCacheFactory) worker.Str
 	})
 }
 
+func (cmd *RunCommand) remoteTaskCaches() (blobstore.ObjectStore, error) {
+	if !cmd.RemoteTaskCache.Enabled() {
+		return nil, nil
+	}
+
+	objects, err := blobstore.NewS3ObjectStore(cmd.RemoteTaskCache)
+	if err != nil {
+		return nil, fmt.Errorf("remote task cache: %w", err)
+	}
+
+	return objects, nil
+}
+
+func (cmd *RunCommand) buildEventStore() (*eventstore.Store, error) {
+	if cmd.BuildEventStore.Dir != "" && cmd.BuildEventStore.S3.Enabled() {
+		return nil, errors.New("build event store: cannot configure both a directory and an S3 bucket")
+	}
+
+	if cmd.BuildEventStore.Dir != "" {
+		return eventstore.NewStore(blobstore.NewFilesystemObjectStore(cmd.BuildEventStore.Dir)), nil
+	}
+
+	if cmd.BuildEventStore.S3.Enabled() {
+		objects, err := blobstore.NewS3ObjectStore(cmd.BuildEventStore.S3)
+		if err != nil {
+			return nil, fmt.Errorf("build event store: %w", err)
+		}
+
+		return eventstore.NewStore(objects), nil
+	}
+
+	return nil, nil
+}
+
 func (cmd *RunCommand) constructPool(dbConn db.Conn, lockFactory lock.LockFactory, workerCache *db.WorkerCache) (worker.Pool, error) {
 	dbResourceCacheFactory := db.NewResourceCacheFactory(dbConn, lockFactory)
 	dbWorkerBaseResourceTypeFactory := db.NewWorkerBaseResourceTypeFactory(dbConn)
@@ -1228,12 +1386,14 @@ func (cmd *RunCommand) gcComponents(
 	logger lager.Logger,
 	gcConn db.Conn,
 	lockFactory lock.LockFactory,
+	remoteTaskCaches blobstore.ObjectStore,
 ) ([]RunnableComponent, error) {
 	dbWorkerLifecycle := db.NewWorkerLifecycle(gcConn)
 	dbResourceCacheLifecycle := db.NewResourceCacheLifecycle(gcConn)
 	dbTaskCacheLifecycle := db.NewTaskCacheLifecycle(gcConn)
+	dbTaskCacheFactory := db.NewTaskCacheFactory(gcConn)
 	dbContainerRepository := db.NewContainerRepository(gcConn)
-	dbArtifactLifecycle := db.NewArtifactLifecycle(gcConn)
+	dbArtifactLifecycle := db.NewArtifactLifecycle(gcConn, cmd.BuildResumeWindow)
 	dbAccessTokenLifecycle := db.NewAccessTokenLifecycle(gcConn)
 	resourceConfigCheckSessionLifecycle := db.NewResourceConfigCheckSessionLifecycle(gcConn)
 	dbBuildFactory := db.NewBuildFactory(gcConn, lockFactory, cmd.GC.OneOffBuildGracePeriod, cmd.GC.FailedGracePeriod)
@@ -1257,7 +1417,7 @@ func (cmd *RunCommand) gcComponents(
 		atc.ComponentCollectorWorkers:           gc.NewWorkerCollector(dbWorkerLifecycle),
 		atc.ComponentCollectorResourceConfigs:   gc.NewResourceConfigCollector(dbResourceConfigFactory, unreferencedConfigGracePeriod),
 		atc.ComponentCollectorResourceCaches:    gc.NewResourceCacheCollector(dbResourceCacheLifecycle),
-		atc.ComponentCollectorTaskCaches:        gc.NewTaskCacheCollector(dbTaskCacheLifecycle),
+		atc.ComponentCollectorTaskCaches:        gc.NewTaskCacheCollector(dbTaskCacheLifecycle, dbTaskCacheFactory, remoteTaskCaches, cmd.GC.RemoteTaskCacheMaxAge, cmd.GC.RemoteTaskCacheMaxSize),
 		atc.ComponentCollectorResourceCacheUses: gc.NewResourceCacheUseCollector(dbResourceCacheLifecycle),
 		atc.ComponentCollectorArtifacts:         gc.NewArtifactCollector(dbArtifactLifecycle),
 		atc.ComponentCollectorVolumes:           gc.NewVolumeCollector(dbVolumeRepository, cmd.GC.MissingGracePeriod),
@@ -1350,39 +1510,86 @@ func workerVersion() (version.Version, error) {
 }
 
 func (cmd *RunCommand) secretManager(logger lager.Logger) (creds.Secrets, error) {
+	if len(cmd.CredentialManagement.Chain) > 0 {
+		return cmd.chainedSecretManager(logger)
+	}
+
 	var secretsFactory creds.SecretsFactory = noop.NewNoopFactory()
 	for name, manager := range cmd.CredentialManagers {
 		if !manager.IsConfigured() {
 			continue
 		}
 
-		credsLogger := logger.Session("credential-manager", lager.Data{
-			"name": name,
-		})
-
-		credsLogger.Info("configured credentials manager")
-
-		err := manager.Init(credsLogger)
+		factory, err := cmd.initCredentialManager(logger, name, manager)
 		if err != nil {
 			return nil, err
 		}
 
-		err = manager.Validate()
-		if err != nil {
-			return nil, fmt.Errorf("credential manager '%s' misconfigured: %s", name, err)
+		secretsFactory = creds.NamedSecretsFactory{
+			Name:    name,
+			Factory: factory,
+		}
+
+		break
+	}
+
+	return cmd.CredentialManagement.NewSecrets(secretsFactory), nil
+}
+
+func (cmd *RunCommand) chainedSecretManager(logger lager.Logger) (creds.Secrets, error) {
+	var factories []creds.NamedSecretsFactory
+	for _, name := range cmd.CredentialManagement.Chain {
+		manager, found := cmd.CredentialManagers[name]
+		if !found {
+			return nil, fmt.Errorf("unknown credential manager '%s'", name)
+		}
+
+		if !manager.IsConfigured() {
+			return nil, fmt.Errorf("credential manager '%s' is not configured", name)
+		}
+
+		for _, factory := range factories {
+			if factory.Name == name {
+				return nil, fmt.Errorf("credential manager '%s' is specified more than once", name)
+			}
 		}
 
-		secretsFactory, err = manager.NewSecretsFactory(credsLogger)
+		secretsFactory, err := cmd.initCredentialManager(logger, name, manager)
 		if err != nil {
 			return nil, err
 		}
 
-		break
+		factories = append(factories, creds.NamedSecretsFactory{
+			Name:    name,
+			Factory: secretsFactory,
+		})
 	}
 
+	secretsFactory := creds.NewChainedSecretsFactory(logger.Session("credential-managers"), factories)
+
 	return cmd.CredentialManagement.NewSecrets(secretsFactory), nil
 }
 
+func (cmd *RunCommand) initCredentialManager(logger lager.Logger, name string, manager creds.Manager) (creds.SecretsFactory, error) {
+	credsLogger := logger.Session("credential-manager", lager.Data{
+		"name": name,
+	})
+
+	credsLogger.Info("configured credentials manager")
+
+	err := manager.Init(credsLogger)
+	if err != nil {
+		return nil, err
+	}
+
+	err = manager.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("credential manager '%s' misconfigured: %s", name, err)
+	}
+
+	return manager.NewSecretsFactory(credsLogger)
+}
+
 func (cmd *RunCommand) newKey() *encryption.Key {
 	var newKey *encryption.Key
 	if cmd.EncryptionKey.AEAD != nil {
@@ -1708,6 +1915,8 @@ func (cmd *RunCommand) configureAuthForDefaultTeam(teamFactory db.TeamFactory) e
 
 func (cmd *RunCommand) constructEngine(
 	workerPool worker.Pool,
+	taskCacheStore exec.TaskCacheStore,
+	idTokenIssuer exec.IDTokenIssuer,
 	workerFactory db.WorkerFactory,
 	teamFactory db.TeamFactory,
 	buildFactory db.BuildFactory,
@@ -1727,6 +1936,8 @@ func (cmd *RunCommand) constructEngine(
 			engine.NewCoreStepFactory(
 				workerPool,
 				cmd.streamer(resourceCacheFactory),
+				taskCacheStore,
+				idTokenIssuer,
 				lockFactory,
 				teamFactory,
 				buildFactory,
@@ -1759,6 +1970,7 @@ func (cmd *RunCommand) constructHTTPHandler(
 	authHandler http.Handler,
 	loginHandler http.Handler,
 	legacyHandler http.Handler,
+	idTokenHandler http.Handler,
 	middleware token.Middleware,
 ) http.Handler {
 
@@ -1774,6 +1986,7 @@ func (cmd *RunCommand) constructHTTPHandler(
 	webMux.Handle("/auth/", legacyHandler)
 	webMux.Handle("/login", legacyHandler)
 	webMux.Handle("/logout", legacyHandler)
+	webMux.Handle("/.well-known/", idTokenHandler)
 	webMux.Handle("/", webHandler)
 
 	httpHandler := wrappa.LoggerHandler{
@@ -1922,7 +2135,9 @@ func (cmd *RunCommand) constructAPIHandler(
 	credsManagers creds.Managers,
 	accessFactory accessor.AccessFactory,
 	dbWall db.Wall,
+	dbSecretStore db.SecretStore,
 	policyChecker policy.Checker,
+	buildEventStore *eventstore.Store,
 ) (http.Handler, error) {
 
 	checkPipelineAccessHandlerFactory := auth.NewCheckPipelineAccessHandlerFactory(teamFactory)
@@ -1950,6 +2165,13 @@ func (cmd *RunCommand) constructAPIHandler(
 		return nil, err
 	}
 
+	eventHandlerFactory := buildserver.NewEventHandler
+	if buildEventStore != nil {
+		eventHandlerFactory = func(logger lager.Logger, build db.BuildForAPI) http.Handler {
+			return buildserver.NewEventHandler(logger, eventstore.WithStore(build, buildEventStore))
+		}
+	}
+
 	apiWrapper := wrappa.MultiWrappa{
 		wrappa.NewConcurrentRequestLimitsWrappa(
 			logger,
@@ -1994,7 +2216,7 @@ func (cmd *RunCommand) constructAPIHandler(
 		resourceConfigFactory,
 		dbUserFactory,
 
-		buildserver.NewEventHandler,
+		eventHandlerFactory,
 
 		workerPool,
 
@@ -2011,6 +2233,7 @@ func (cmd *RunCommand) constructAPIHandler(
 		containerserver.NewInterceptTimeoutFactory(cmd.InterceptIdleTimeout),
 		time.Minute,
 		dbWall,
+		dbSecretStore,
 		clock.NewClock(),
 	)
 }
@@ -65,12 +65,19 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.BuildEvents,
 		atc.BuildResources,
 		atc.AbortBuild,
+		atc.ApproveBuild,
 		atc.GetBuildPreparation,
 		atc.ListBuildsWithVersionAsInput,
 		atc.ListBuildsWithVersionAsOutput,
 		atc.CreateArtifact,
 		atc.GetArtifact,
-		atc.ListBuildArtifacts:
+		atc.ListBuildArtifacts,
+		atc.SearchTeamBuildLogs,
+		atc.SearchPipelineBuildLogs,
+		atc.SearchJobBuildLogs,
+		atc.ListBuildTestResults,
+		atc.ListBuildSecretAccesses,
+		atc.ListJobTestSummaries:
 		return a.EnableBuildAuditLog
 	case atc.ListContainers,
 		atc.GetContainer,
@@ -79,6 +86,7 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.ReportWorkerContainers:
 		return a.EnableContainerAuditLog
 	case atc.GetJob,
+		atc.GetJobStats,
 		atc.CreateJobBuild,
 		atc.ListAllJobs,
 		atc.ListJobs,
@@ -104,6 +112,7 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.HidePipeline,
 		atc.RenamePipeline,
 		atc.ListPipelineBuilds,
+		atc.ListNotificationDeliveries,
 		atc.CreatePipelineBuild,
 		atc.PipelineBadge:
 		return a.EnablePipelineAuditLog
@@ -115,6 +124,7 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.SetPinCommentOnResource,
 		atc.CheckResource,
 		atc.CheckResourceWebHook,
+		atc.CheckTeamWebHook,
 		atc.CheckResourceType,
 		atc.CheckPrototype,
 		atc.ListResourceVersions,
@@ -152,6 +162,9 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.RenameTeam,
 		atc.DestroyTeam,
 		atc.ListTeamBuilds,
+		atc.ListSecrets,
+		atc.SetSecret,
+		atc.DeleteSecret,
 		atc.GetTeam:
 		return a.EnableTeamAuditLog
 	case atc.RegisterWorker,
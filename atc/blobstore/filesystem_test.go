@@ -0,0 +1,94 @@
+package blobstore_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc/blobstore"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FilesystemObjectStore", func() {
+	var (
+		ctx   context.Context
+		dir   string
+		store blobstore.ObjectStore
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		dir = GinkgoT().TempDir()
+		store = blobstore.NewFilesystemObjectStore(filepath.Join(dir, "blobs"))
+	})
+
+	It("puts objects as files, with slashes denoting directories", func() {
+		err := store.Put(ctx, "some-dir/some-key", strings.NewReader("some-content"))
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(os.ReadFile(filepath.Join(dir, "blobs", "some-dir", "some-key"))).To(Equal([]byte("some-content")))
+	})
+
+	It("gets objects that were put", func() {
+		Expect(store.Put(ctx, "some-key", strings.NewReader("some-content"))).To(Succeed())
+
+		content, found, err := store.Get(ctx, "some-key")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+
+		defer content.Close()
+		Expect(io.ReadAll(content)).To(Equal([]byte("some-content")))
+	})
+
+	It("does not find objects that were never put", func() {
+		_, found, err := store.Get(ctx, "bogus-key")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+	})
+
+	It("lists the objects under a given prefix", func() {
+		Expect(store.Put(ctx, "some-dir/some-key", strings.NewReader("some-content"))).To(Succeed())
+		Expect(store.Put(ctx, "other-dir/other-key", strings.NewReader("other"))).To(Succeed())
+
+		objects, err := store.List(ctx, "some-dir/")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objects).To(ConsistOf(And(
+			HaveField("Key", "some-dir/some-key"),
+			HaveField("Size", int64(12)),
+			HaveField("LastModified", BeTemporally("~", time.Now(), time.Minute)),
+		)))
+
+		objects, err = store.List(ctx, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objects).To(HaveLen(2))
+	})
+
+	It("lists nothing before anything is put", func() {
+		objects, err := store.List(ctx, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objects).To(BeEmpty())
+	})
+
+	It("deletes objects", func() {
+		Expect(store.Put(ctx, "some-key", strings.NewReader("some-content"))).To(Succeed())
+
+		Expect(store.Delete(ctx, "some-key")).To(Succeed())
+
+		_, found, err := store.Get(ctx, "some-key")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+	})
+
+	It("ignores deleting objects that do not exist", func() {
+		Expect(store.Delete(ctx, "bogus-key")).To(Succeed())
+	})
+
+	It("rejects keys outside of the directory", func() {
+		err := store.Put(ctx, "../some-key", strings.NewReader("some-content"))
+		Expect(err).To(HaveOccurred())
+	})
+})
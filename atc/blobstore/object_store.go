@@ -0,0 +1,26 @@
+// Package blobstore provides stores for blobs kept outside of the database
+// and the workers.
+package blobstore
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+
+// Object describes an entry in an ObjectStore.
+type Object struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+}
+
+//counterfeiter:generate . ObjectStore
+type ObjectStore interface {
+	Put(ctx context.Context, key string, content io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, bool, error)
+	List(ctx context.Context, prefix string) ([]Object, error)
+	Delete(ctx context.Context, key string) error
+}
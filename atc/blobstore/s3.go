@@ -0,0 +1,138 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+type S3Config struct {
+	Bucket          string `long:"bucket" description:"Name of the bucket."`
+	Prefix          string `long:"prefix" description:"Prefix of the keys in the bucket."`
+	Endpoint        string `long:"endpoint" description:"Endpoint of an S3-compatible object store. Defaults to AWS S3."`
+	Region          string `long:"region" default:"us-east-1" description:"Region of the bucket."`
+	AccessKeyID     string `long:"access-key" description:"Access key ID used to access the bucket."`
+	SecretAccessKey string `long:"secret-key" description:"Secret access key used to access the bucket."`
+	SessionToken    string `long:"session-token" description:"Session token used to access the bucket."`
+	ForcePathStyle  bool   `long:"force-path-style" description:"Address the bucket by path rather than by subdomain, as most S3-compatible object stores require."`
+}
+
+// Enabled returns whether a bucket has been configured.
+func (config S3Config) Enabled() bool {
+	return config.Bucket != ""
+}
+
+type s3ObjectStore struct {
+	client   *s3.S3
+	uploader *s3manager.Uploader
+
+	bucket string
+	prefix string
+}
+
+// NewS3ObjectStore constructs an ObjectStore which keeps its objects in an S3
+// bucket, under the configured prefix.
+func NewS3ObjectStore(config S3Config) (ObjectStore, error) {
+	awsConfig := &aws.Config{
+		Region:           aws.String(config.Region),
+		S3ForcePathStyle: aws.Bool(config.ForcePathStyle),
+	}
+
+	if config.Endpoint != "" {
+		awsConfig.Endpoint = aws.String(config.Endpoint)
+	}
+
+	if config.AccessKeyID != "" {
+		awsConfig.Credentials = credentials.NewStaticCredentials(config.AccessKeyID, config.SecretAccessKey, config.SessionToken)
+	}
+
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	client := s3.New(sess)
+
+	return &s3ObjectStore{
+		client:   client,
+		uploader: s3manager.NewUploaderWithClient(client),
+		bucket:   config.Bucket,
+		prefix:   config.Prefix,
+	}, nil
+}
+
+func (store *s3ObjectStore) Put(ctx context.Context, key string, content io.Reader) error {
+	_, err := store.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket: aws.String(store.bucket),
+		Key:    aws.String(store.prefix + key),
+		Body:   content,
+	})
+	return err
+}
+
+func (store *s3ObjectStore) Get(ctx context.Context, key string) (io.ReadCloser, bool, error) {
+	output, err := store.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(store.bucket),
+		Key:    aws.String(store.prefix + key),
+	})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	return output.Body, true, nil
+}
+
+func (store *s3ObjectStore) List(ctx context.Context, prefix string) ([]Object, error) {
+	var objects []Object
+
+	err := store.client.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(store.bucket),
+		Prefix: aws.String(store.prefix + prefix),
+	}, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		for _, object := range page.Contents {
+			objects = append(objects, Object{
+				Key:          strings.TrimPrefix(aws.StringValue(object.Key), store.prefix),
+				Size:         aws.Int64Value(object.Size),
+				LastModified: aws.TimeValue(object.LastModified),
+			})
+		}
+
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return objects, nil
+}
+
+func (store *s3ObjectStore) Delete(ctx context.Context, key string) error {
+	_, err := store.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(store.bucket),
+		Key:    aws.String(store.prefix + key),
+	})
+	return err
+}
+
+func isNotFound(err error) bool {
+	var requestErr awserr.RequestFailure
+	if errors.As(err, &requestErr) && requestErr.StatusCode() == http.StatusNotFound {
+		return true
+	}
+
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == s3.ErrCodeNoSuchKey
+}
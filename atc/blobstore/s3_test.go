@@ -0,0 +1,220 @@
+package blobstore_test
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/concourse/concourse/atc/blobstore"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("S3ObjectStore", func() {
+	var (
+		ctx    context.Context
+		server *httptest.Server
+		bucket *fakeBucket
+
+		store blobstore.ObjectStore
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		bucket = &fakeBucket{name: "some-bucket", objects: map[string]fakeObject{}}
+		server = httptest.NewServer(bucket)
+
+		var err error
+		store, err = blobstore.NewS3ObjectStore(blobstore.S3Config{
+			Bucket:          "some-bucket",
+			Prefix:          "some-prefix/",
+			Endpoint:        server.URL,
+			Region:          "us-east-1",
+			AccessKeyID:     "some-access-key",
+			SecretAccessKey: "some-secret-key",
+			ForcePathStyle:  true,
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("puts objects under the prefix", func() {
+		err := store.Put(ctx, "some-key", strings.NewReader("some-content"))
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(bucket.objects).To(HaveKey("some-prefix/some-key"))
+		Expect(string(bucket.objects["some-prefix/some-key"].content)).To(Equal("some-content"))
+	})
+
+	It("gets objects that were put", func() {
+		err := store.Put(ctx, "1/some-step/some%2Fpath.tgz", strings.NewReader("some-content"))
+		Expect(err).ToNot(HaveOccurred())
+
+		content, found, err := store.Get(ctx, "1/some-step/some%2Fpath.tgz")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+
+		defer content.Close()
+		Expect(io.ReadAll(content)).To(Equal([]byte("some-content")))
+	})
+
+	It("does not find objects that were never put", func() {
+		_, found, err := store.Get(ctx, "bogus-key")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+	})
+
+	It("lists the objects under the prefix", func() {
+		Expect(store.Put(ctx, "some-key", strings.NewReader("some-content"))).To(Succeed())
+		Expect(store.Put(ctx, "other-key", strings.NewReader("other"))).To(Succeed())
+		bucket.objects["unrelated-key"] = fakeObject{content: []byte("unrelated")}
+
+		objects, err := store.List(ctx, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objects).To(ConsistOf(
+			And(
+				HaveField("Key", "some-key"),
+				HaveField("Size", int64(12)),
+				HaveField("LastModified", BeTemporally("~", time.Now(), time.Minute)),
+			),
+			And(
+				HaveField("Key", "other-key"),
+				HaveField("Size", int64(5)),
+			),
+		))
+	})
+
+	It("lists the objects under a given prefix", func() {
+		Expect(store.Put(ctx, "some-dir/some-key", strings.NewReader("some-content"))).To(Succeed())
+		Expect(store.Put(ctx, "other-dir/other-key", strings.NewReader("other"))).To(Succeed())
+
+		objects, err := store.List(ctx, "some-dir/")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objects).To(ConsistOf(HaveField("Key", "some-dir/some-key")))
+	})
+
+	It("deletes objects", func() {
+		Expect(store.Put(ctx, "some-key", strings.NewReader("some-content"))).To(Succeed())
+
+		err := store.Delete(ctx, "some-key")
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(bucket.objects).To(BeEmpty())
+	})
+
+	Context("when the object store errors", func() {
+		BeforeEach(func() {
+			bucket.fail = true
+		})
+
+		It("returns the error", func() {
+			_, _, err := store.Get(ctx, "some-key")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
+
+type fakeObject struct {
+	content      []byte
+	lastModified time.Time
+}
+
+// fakeBucket is a minimal stand-in for an S3-compatible object store,
+// serving a single bucket addressed by path.
+type fakeBucket struct {
+	name string
+	fail bool
+
+	lock    sync.Mutex
+	objects map[string]fakeObject
+}
+
+type listBucketResult struct {
+	XMLName     xml.Name `xml:"ListBucketResult"`
+	Name        string
+	Prefix      string
+	KeyCount    int
+	IsTruncated bool
+	Contents    []listBucketContents
+}
+
+type listBucketContents struct {
+	Key          string
+	Size         int
+	LastModified string
+}
+
+func (bucket *fakeBucket) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	bucket.lock.Lock()
+	defer bucket.lock.Unlock()
+
+	if bucket.fail {
+		w.WriteHeader(http.StatusInternalServerError)
+		io.WriteString(w, `<Error><Code>InternalError</Code><Message>nope</Message></Error>`)
+		return
+	}
+
+	key := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, "/"+bucket.name), "/")
+
+	switch {
+	case r.Method == http.MethodGet && key == "":
+		prefix := r.URL.Query().Get("prefix")
+
+		result := listBucketResult{Name: bucket.name, Prefix: prefix}
+		for key, object := range bucket.objects {
+			if !strings.HasPrefix(key, prefix) {
+				continue
+			}
+
+			result.Contents = append(result.Contents, listBucketContents{
+				Key:          key,
+				Size:         len(object.content),
+				LastModified: object.lastModified.UTC().Format("2006-01-02T15:04:05.000Z"),
+			})
+		}
+
+		sort.Slice(result.Contents, func(i, j int) bool {
+			return result.Contents[i].Key < result.Contents[j].Key
+		})
+
+		result.KeyCount = len(result.Contents)
+
+		xml.NewEncoder(w).Encode(result)
+
+	case r.Method == http.MethodPut:
+		content, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		bucket.objects[key] = fakeObject{content: content, lastModified: time.Now()}
+
+	case r.Method == http.MethodGet:
+		object, found := bucket.objects[key]
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `<Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`)
+			return
+		}
+
+		w.Write(object.content)
+
+	case r.Method == http.MethodDelete:
+		delete(bucket.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
@@ -1,5 +1,12 @@
 package atc
 
+import "time"
+
+// BuildResumeWindow is how long a failed or errored job build can be resumed
+// from the step that failed. The artifacts of the build's steps are kept on
+// the workers for as long. A value of zero disables resuming builds.
+var BuildResumeWindow = 12 * time.Hour
+
 type BuildStatus string
 
 const (
@@ -16,23 +23,27 @@ func (status BuildStatus) String() string {
 }
 
 type Build struct {
-	ID                   int           `json:"id"`
-	TeamName             string        `json:"team_name"`
-	Name                 string        `json:"name"`
-	Status               BuildStatus   `json:"status"`
-	APIURL               string        `json:"api_url"`
-	Comment              string        `json:"comment,omitempty"`
-	JobName              string        `json:"job_name,omitempty"`
-	ResourceName         string        `json:"resource_name,omitempty"`
-	PipelineID           int           `json:"pipeline_id,omitempty"`
-	PipelineName         string        `json:"pipeline_name,omitempty"`
-	PipelineInstanceVars InstanceVars  `json:"pipeline_instance_vars,omitempty"`
-	StartTime            int64         `json:"start_time,omitempty"`
-	EndTime              int64         `json:"end_time,omitempty"`
-	ReapTime             int64         `json:"reap_time,omitempty"`
-	RerunNumber          int           `json:"rerun_number,omitempty"`
-	RerunOf              *RerunOfBuild `json:"rerun_of,omitempty"`
-	CreatedBy            *string       `json:"created_by,omitempty"`
+	ID                   int                    `json:"id"`
+	TeamName             string                 `json:"team_name"`
+	Name                 string                 `json:"name"`
+	Status               BuildStatus            `json:"status"`
+	APIURL               string                 `json:"api_url"`
+	Comment              string                 `json:"comment,omitempty"`
+	JobName              string                 `json:"job_name,omitempty"`
+	ResourceName         string                 `json:"resource_name,omitempty"`
+	PipelineID           int                    `json:"pipeline_id,omitempty"`
+	PipelineName         string                 `json:"pipeline_name,omitempty"`
+	PipelineInstanceVars InstanceVars           `json:"pipeline_instance_vars,omitempty"`
+	StartTime            int64                  `json:"start_time,omitempty"`
+	EndTime              int64                  `json:"end_time,omitempty"`
+	ReapTime             int64                  `json:"reap_time,omitempty"`
+	RerunNumber          int                    `json:"rerun_number,omitempty"`
+	RerunOf              *RerunOfBuild          `json:"rerun_of,omitempty"`
+	CreatedBy            *string                `json:"created_by,omitempty"`
+	Params               map[string]interface{} `json:"params,omitempty"`
+	TriggerReason        string                 `json:"trigger_reason,omitempty"`
+	AbortReason          string                 `json:"abort_reason,omitempty"`
+	Priority             int                    `json:"priority,omitempty"`
 }
 
 type RerunOfBuild struct {
@@ -57,6 +68,18 @@ func (b Build) OneOff() bool {
 	return b.JobName == ""
 }
 
+// BuildLogMatch is a build whose log output matched a search, along with
+// the first few matching lines.
+type BuildLogMatch struct {
+	Build Build          `json:"build"`
+	Lines []BuildLogLine `json:"lines"`
+}
+
+type BuildLogLine struct {
+	EventID int    `json:"event_id"`
+	Line    string `json:"line"`
+}
+
 type BuildPreparationStatus string
 
 const (
@@ -69,6 +69,8 @@ func (visitor *planVisitor) VisitTask(step *atc.TaskStep) error {
 		OutputMapping:     step.OutputMapping,
 		ImageArtifactName: step.ImageArtifactName,
 		Timeout:           step.Timeout,
+		CacheResult:       step.CacheResult,
+		IDToken:           step.IDToken,
 
 		ResourceTypes:     visitor.resourceTypes,
 		CheckSkipInterval: visitor.manuallyTriggered,
@@ -78,23 +80,41 @@ func (visitor *planVisitor) VisitTask(step *atc.TaskStep) error {
 }
 
 func (visitor *planVisitor) VisitRun(step *atc.RunStep) error {
-	prototype, found := visitor.prototypes.Lookup(step.Type)
-	if !found {
-		return UnknownPrototypeError{step.Type}
-	}
+	var prototype atc.Prototype
+	object := step.Params
+
+	if step.ImageArtifactName == "" {
+		var found bool
+		prototype, found = visitor.prototypes.Lookup(step.Type)
+		if !found {
+			return UnknownPrototypeError{step.Type}
+		}
 
-	object := prototype.Defaults.Merge(atc.Source(step.Params))
+		object = atc.Params(prototype.Defaults.Merge(atc.Source(step.Params)))
+	}
 
 	visitor.plan = visitor.planFactory.NewPlan(atc.RunPlan{
 		Message:    step.Message,
 		Type:       step.Type,
-		Object:     atc.Params(object),
+		Object:     object,
 		Privileged: step.Privileged,
 		Tags:       step.Tags,
 		Limits:     step.Limits,
 		Timeout:    step.Timeout,
+
+		ImageArtifactName: step.ImageArtifactName,
+
+		Inputs:        step.Inputs,
+		Outputs:       step.Outputs,
+		InputMapping:  step.InputMapping,
+		OutputMapping: step.OutputMapping,
+		SetVars:       step.SetVars,
 	})
 
+	if step.ImageArtifactName == "" {
+		visitor.plan.Run.TypeImage = prototype.ImageFor(visitor.plan.ID, visitor.resourceTypes, step.Tags, visitor.manuallyTriggered)
+	}
+
 	return nil
 }
 
@@ -285,6 +305,20 @@ func (visitor *planVisitor) VisitLoadVar(step *atc.LoadVarStep) error {
 	return nil
 }
 
+func (visitor *planVisitor) VisitApprove(step *atc.ApproveStep) error {
+	role := step.Role
+	if role == "" {
+		role = atc.DefaultApprovalRole
+	}
+
+	visitor.plan = visitor.planFactory.NewPlan(atc.ApprovePlan{
+		Name: step.Name,
+		Role: role,
+	})
+
+	return nil
+}
+
 func (visitor *planVisitor) VisitTry(step *atc.TryStep) error {
 	err := step.Step.Config.Visit(visitor)
 	if err != nil {
@@ -312,6 +346,20 @@ func (visitor *planVisitor) VisitTimeout(step *atc.TimeoutStep) error {
 	return nil
 }
 
+func (visitor *planVisitor) VisitIf(step *atc.IfStep) error {
+	err := step.Step.Visit(visitor)
+	if err != nil {
+		return err
+	}
+
+	visitor.plan = visitor.planFactory.NewPlan(atc.IfPlan{
+		Condition: step.Condition,
+		Step:      visitor.plan,
+	})
+
+	return nil
+}
+
 func (visitor *planVisitor) VisitRetry(step *atc.RetryStep) error {
 	retryStep := make(atc.RetryPlan, step.Attempts)
 
@@ -325,6 +373,7 @@ func (visitor *planVisitor) VisitRetry(step *atc.RetryStep) error {
 	}
 
 	visitor.plan = visitor.planFactory.NewPlan(retryStep)
+	visitor.plan.RetryPolicy = step.RetryPolicy()
 
 	return nil
 }
@@ -1257,6 +1257,11 @@ var factoryTests = []PlannerTest{
 			OutputMapping:     map[string]string{"specific": "generic"},
 			ImageArtifactName: "some-image",
 			Timeout:           "1h",
+			CacheResult:       true,
+			IDToken: &atc.IDTokenConfig{
+				Param:    "ID_TOKEN",
+				Audience: []string{"sts.amazonaws.com"},
+			},
 		},
 
 		PlanJSON: `{
@@ -1276,6 +1281,8 @@ var factoryTests = []PlannerTest{
 				"output_mapping": {"specific": "generic"},
 				"image": "some-image",
 				"timeout": "1h",
+				"cache_result": true,
+				"id_token": {"param": "ID_TOKEN", "audience": ["sts.amazonaws.com"]},
 				"resource_types": [
 					{
 						"name": "some-resource-type",
@@ -1406,7 +1413,12 @@ var factoryTests = []PlannerTest{
 				CPU:    newCPULimit(456),
 				Memory: newMemoryLimit(2048),
 			},
-			Timeout: "1h",
+			Timeout:       "1h",
+			Inputs:        []string{"some-input"},
+			Outputs:       []string{"some-output"},
+			InputMapping:  map[string]string{"some-input": "some-artifact"},
+			OutputMapping: map[string]string{"some-output": "other-artifact"},
+			SetVars:       map[string]string{"some-var": "some-field"},
 		},
 
 		PlanJSON: `{
@@ -1422,10 +1434,72 @@ var factoryTests = []PlannerTest{
 				"privileged": true,
 				"tags": ["tag-1", "tag-2"],
 				"container_limits": {"cpu": 456, "memory": 2048},
-				"timeout": "1h"
+				"timeout": "1h",
+				"inputs": ["some-input"],
+				"outputs": ["some-output"],
+				"input_mapping": {"some-input": "some-artifact"},
+				"output_mapping": {"some-output": "other-artifact"},
+				"set_vars": {"some-var": "some-field"},
+				"image": {
+					"base_type": "some-base-resource-type",
+					"check_plan": {
+						"id": "(unique)",
+						"check": {
+							"name": "some-prototype",
+							"type": "some-base-resource-type",
+							"source": {"some": "prototype-source"},
+							"prototype": "some-prototype",
+							"interval": "1m0s",
+							"tags": ["tag-1", "tag-2"],
+							"image": {"base_type": "some-base-resource-type"}
+						}
+					},
+					"get_plan": {
+						"id": "(unique)",
+						"get": {
+							"name": "some-prototype",
+							"type": "some-base-resource-type",
+							"source": {"some": "prototype-source"},
+							"tags": ["tag-1", "tag-2"],
+							"version_from": "(unique)",
+							"image": {"base_type": "some-base-resource-type"}
+						}
+					}
+				}
 			}
 		}`,
 	},
+	{
+		Title: "run step with an image artifact",
+
+		Config: &atc.RunStep{
+			Message:           "some-message",
+			ImageArtifactName: "some-image",
+			Params:            atc.Params{"some-param": "some-val"},
+		},
+
+		PlanJSON: `{
+			"id": "(unique)",
+			"run": {
+				"message": "some-message",
+				"type": "",
+				"object": {"some-param": "some-val"},
+				"privileged": false,
+				"image_artifact": "some-image",
+				"image": {}
+			}
+		}`,
+	},
+	{
+		Title: "run step with an unknown prototype",
+
+		Config: &atc.RunStep{
+			Message: "some-message",
+			Type:    "bogus-prototype",
+		},
+
+		Err: builds.UnknownPrototypeError{Prototype: "bogus-prototype"},
+	},
 	{
 		Title: "set_pipeline step",
 
@@ -1468,6 +1542,21 @@ var factoryTests = []PlannerTest{
 			}
 		}`,
 	},
+	{
+		Title: "approve step",
+
+		Config: &atc.ApproveStep{
+			Name: "deploy-to-prod",
+		},
+
+		PlanJSON: `{
+			"id": "(unique)",
+			"approve": {
+				"name": "deploy-to-prod",
+				"role": "member"
+			}
+		}`,
+	},
 	{
 		Title: "try step",
 
@@ -1647,6 +1736,31 @@ var factoryTests = []PlannerTest{
 			}
 		}`,
 	},
+	{
+		Title: "if modifier",
+
+		Config: &atc.IfStep{
+			Step: &atc.LoadVarStep{
+				Name: "some-var",
+				File: "some-file",
+			},
+			Condition: "((.:deploy))",
+		},
+
+		PlanJSON: `{
+			"id": "(unique)",
+			"if": {
+				"step": {
+					"id": "(unique)",
+					"load_var": {
+						"name": "some-var",
+						"file": "some-file"
+					}
+				},
+				"condition": "((.:deploy))"
+			}
+		}`,
+	},
 	{
 		Title: "attempts modifier",
 
@@ -1686,6 +1800,44 @@ var factoryTests = []PlannerTest{
 			]
 		}`,
 	},
+	{
+		Title: "attempts modifier with backoff",
+
+		Config: &atc.RetryStep{
+			Step: &atc.LoadVarStep{
+				Name: "some-var",
+				File: "some-file",
+			},
+			Attempts: 2,
+			Backoff:  "10s",
+			RetryOn:  &atc.RetryOnConfig{Failed: true},
+		},
+
+		CompareIDs: true,
+		PlanJSON: `{
+			"id": "3",
+			"retry": [
+				{
+					"id": "1",
+					"load_var": {
+						"name": "some-var",
+						"file": "some-file"
+					}
+				},
+				{
+					"id": "2",
+					"load_var": {
+						"name": "some-var",
+						"file": "some-file"
+					}
+				}
+			],
+			"retry_policy": {
+				"backoff": "10s",
+				"retry_on": ["failed"]
+			}
+		}`,
+	},
 	{
 		Title: "on_success step",
 
@@ -8,6 +8,9 @@ const (
 	ComponentLidarScanner               = "scanner"
 	ComponentBuildReaper                = "reaper"
 	ComponentSyslogDrainer              = "drainer"
+	ComponentBuildEventOffloader        = "build_event_offloader"
+	ComponentNotifier                   = "notifier"
+	ComponentSigningKeyRotator          = "signing_key_rotator"
 	ComponentCollectorAccessTokens      = "collector_access_tokens"
 	ComponentCollectorArtifacts         = "collector_artifacts"
 	ComponentCollectorBuilds            = "collector_builds"
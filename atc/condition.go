@@ -0,0 +1,472 @@
+package atc
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/concourse/concourse/vars"
+)
+
+// Condition is a parsed `if:` expression. Conditions are parsed when the
+// pipeline is configured so that syntax errors are reported early, and
+// evaluated at runtime against the build's variables (including `((vars))`,
+// `load_var` values and `across` values).
+//
+// The supported syntax is intentionally small:
+//
+//	((var))                   a var reference, evaluated for truthiness
+//	"literal" / 'literal'     a string literal
+//	true / false / null / 42  JSON-style literals
+//	a == b, a != b            equality comparison
+//	!a, a && b, a || b        boolean logic
+//	( a )                     grouping
+type Condition struct {
+	raw  string
+	expr conditionExpr
+}
+
+type conditionExpr interface {
+	evaluate(vars.Variables) (interface{}, error)
+}
+
+// ParseCondition parses the given expression.
+func ParseCondition(raw string) (Condition, error) {
+	tokens, err := tokenizeCondition(raw)
+	if err != nil {
+		return Condition{}, err
+	}
+
+	if len(tokens) == 0 {
+		return Condition{}, fmt.Errorf("empty condition")
+	}
+
+	parser := &conditionParser{tokens: tokens}
+
+	expr, err := parser.parseOr()
+	if err != nil {
+		return Condition{}, err
+	}
+
+	if !parser.done() {
+		return Condition{}, fmt.Errorf("unexpected '%s' in condition", parser.peek().text)
+	}
+
+	return Condition{raw: raw, expr: expr}, nil
+}
+
+// String returns the original expression.
+func (c Condition) String() string {
+	return c.raw
+}
+
+// Evaluate resolves any var references in the condition and returns whether
+// the result is truthy.
+func (c Condition) Evaluate(variables vars.Variables) (bool, error) {
+	val, err := c.expr.evaluate(variables)
+	if err != nil {
+		return false, err
+	}
+
+	return truthy(val), nil
+}
+
+// truthy determines whether a value should be considered true. Since most
+// credential managers and `load_var` steps produce strings, the strings
+// "false" and "0" are treated as false in addition to the empty string.
+func truthy(val interface{}) bool {
+	switch v := val.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case string:
+		switch strings.ToLower(strings.TrimSpace(v)) {
+		case "", "false", "0":
+			return false
+		}
+		return true
+	}
+
+	if f, ok := toFloat(val); ok {
+		return f != 0
+	}
+
+	rv := reflect.ValueOf(val)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Array:
+		return rv.Len() != 0
+	}
+
+	return true
+}
+
+func toFloat(val interface{}) (float64, bool) {
+	switch v := val.(type) {
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	}
+
+	return 0, false
+}
+
+func conditionValuesEqual(a, b interface{}) bool {
+	if fa, ok := toFloat(a); ok {
+		if fb, ok := toFloat(b); ok {
+			return fa == fb
+		}
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+type literalExpr struct {
+	value interface{}
+}
+
+func (e literalExpr) evaluate(vars.Variables) (interface{}, error) {
+	return e.value, nil
+}
+
+type varExpr struct {
+	ref vars.Reference
+}
+
+func (e varExpr) evaluate(variables vars.Variables) (interface{}, error) {
+	val, found, err := variables.Get(e.ref)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, vars.UndefinedVarsError{Vars: []string{e.ref.String()}}
+	}
+
+	return val, nil
+}
+
+type notExpr struct {
+	expr conditionExpr
+}
+
+func (e notExpr) evaluate(variables vars.Variables) (interface{}, error) {
+	val, err := e.expr.evaluate(variables)
+	if err != nil {
+		return nil, err
+	}
+
+	return !truthy(val), nil
+}
+
+type andExpr struct {
+	left, right conditionExpr
+}
+
+func (e andExpr) evaluate(variables vars.Variables) (interface{}, error) {
+	left, err := e.left.evaluate(variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if !truthy(left) {
+		return false, nil
+	}
+
+	right, err := e.right.evaluate(variables)
+	if err != nil {
+		return nil, err
+	}
+
+	return truthy(right), nil
+}
+
+type orExpr struct {
+	left, right conditionExpr
+}
+
+func (e orExpr) evaluate(variables vars.Variables) (interface{}, error) {
+	left, err := e.left.evaluate(variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if truthy(left) {
+		return true, nil
+	}
+
+	right, err := e.right.evaluate(variables)
+	if err != nil {
+		return nil, err
+	}
+
+	return truthy(right), nil
+}
+
+type equalExpr struct {
+	left, right conditionExpr
+	negate      bool
+}
+
+func (e equalExpr) evaluate(variables vars.Variables) (interface{}, error) {
+	left, err := e.left.evaluate(variables)
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := e.right.evaluate(variables)
+	if err != nil {
+		return nil, err
+	}
+
+	return conditionValuesEqual(left, right) != e.negate, nil
+}
+
+type conditionTokenKind int
+
+const (
+	tokenVar conditionTokenKind = iota
+	tokenString
+	tokenLiteral
+	tokenOperator
+	tokenOpenParen
+	tokenCloseParen
+)
+
+type conditionToken struct {
+	kind conditionTokenKind
+	text string
+}
+
+func tokenizeCondition(raw string) ([]conditionToken, error) {
+	var tokens []conditionToken
+
+	rest := raw
+	for {
+		rest = strings.TrimLeftFunc(rest, unicode.IsSpace)
+		if rest == "" {
+			return tokens, nil
+		}
+
+		switch {
+		case strings.HasPrefix(rest, "(("):
+			// a run of more than two parens is grouping followed by a var, e.g.
+			// '(((foo)) == "bar")'
+			opening := len(rest) - len(strings.TrimLeft(rest, "("))
+			for i := 0; i < opening-2; i++ {
+				tokens = append(tokens, conditionToken{kind: tokenOpenParen, text: "("})
+			}
+			rest = rest[opening-2:]
+
+			end := strings.Index(rest, "))")
+			if end == -1 {
+				return nil, fmt.Errorf("unterminated var reference in condition '%s'", raw)
+			}
+
+			tokens = append(tokens, conditionToken{kind: tokenVar, text: strings.TrimSpace(rest[2:end])})
+			rest = rest[end+2:]
+
+		case rest[0] == '(':
+			tokens = append(tokens, conditionToken{kind: tokenOpenParen, text: "("})
+			rest = rest[1:]
+
+		case rest[0] == ')':
+			tokens = append(tokens, conditionToken{kind: tokenCloseParen, text: ")"})
+			rest = rest[1:]
+
+		case rest[0] == '"' || rest[0] == '\'':
+			quote := rest[0]
+			end := strings.IndexByte(rest[1:], quote)
+			if end == -1 {
+				return nil, fmt.Errorf("unterminated string in condition '%s'", raw)
+			}
+
+			tokens = append(tokens, conditionToken{kind: tokenString, text: rest[1 : end+1]})
+			rest = rest[end+2:]
+
+		case strings.HasPrefix(rest, "=="), strings.HasPrefix(rest, "!="),
+			strings.HasPrefix(rest, "&&"), strings.HasPrefix(rest, "||"):
+			tokens = append(tokens, conditionToken{kind: tokenOperator, text: rest[:2]})
+			rest = rest[2:]
+
+		case rest[0] == '!':
+			tokens = append(tokens, conditionToken{kind: tokenOperator, text: "!"})
+			rest = rest[1:]
+
+		default:
+			end := strings.IndexFunc(rest, func(r rune) bool {
+				return unicode.IsSpace(r) || strings.ContainsRune("()!=&|\"'", r)
+			})
+			if end == -1 {
+				end = len(rest)
+			}
+
+			tokens = append(tokens, conditionToken{kind: tokenLiteral, text: rest[:end]})
+			rest = rest[end:]
+		}
+	}
+}
+
+type conditionParser struct {
+	tokens []conditionToken
+	pos    int
+}
+
+func (p *conditionParser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *conditionParser) peek() conditionToken {
+	return p.tokens[p.pos]
+}
+
+func (p *conditionParser) acceptOperator(op string) bool {
+	if p.done() {
+		return false
+	}
+
+	tok := p.peek()
+	if tok.kind == tokenOperator && tok.text == op {
+		p.pos++
+		return true
+	}
+
+	return false
+}
+
+func (p *conditionParser) parseOr() (conditionExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.acceptOperator("||") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		left = orExpr{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *conditionParser) parseAnd() (conditionExpr, error) {
+	left, err := p.parseEquality()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.acceptOperator("&&") {
+		right, err := p.parseEquality()
+		if err != nil {
+			return nil, err
+		}
+
+		left = andExpr{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *conditionParser) parseEquality() (conditionExpr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		var negate bool
+		if p.acceptOperator("==") {
+			negate = false
+		} else if p.acceptOperator("!=") {
+			negate = true
+		} else {
+			return left, nil
+		}
+
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		left = equalExpr{left: left, right: right, negate: negate}
+	}
+}
+
+func (p *conditionParser) parseUnary() (conditionExpr, error) {
+	if p.acceptOperator("!") {
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return notExpr{expr: expr}, nil
+	}
+
+	return p.parseOperand()
+}
+
+func (p *conditionParser) parseOperand() (conditionExpr, error) {
+	if p.done() {
+		return nil, fmt.Errorf("unexpected end of condition")
+	}
+
+	tok := p.peek()
+	p.pos++
+
+	switch tok.kind {
+	case tokenVar:
+		ref, err := vars.ParseReference(tok.text)
+		if err != nil {
+			return nil, err
+		}
+
+		return varExpr{ref: ref}, nil
+
+	case tokenString:
+		return literalExpr{value: tok.text}, nil
+
+	case tokenLiteral:
+		switch tok.text {
+		case "true":
+			return literalExpr{value: true}, nil
+		case "false":
+			return literalExpr{value: false}, nil
+		case "null":
+			return literalExpr{value: nil}, nil
+		}
+
+		f, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected '%s' in condition (strings must be quoted)", tok.text)
+		}
+
+		return literalExpr{value: f}, nil
+
+	case tokenOpenParen:
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if p.done() || p.peek().kind != tokenCloseParen {
+			return nil, fmt.Errorf("missing ')' in condition")
+		}
+		p.pos++
+
+		return expr, nil
+	}
+
+	return nil, fmt.Errorf("unexpected '%s' in condition", tok.text)
+}
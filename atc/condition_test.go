@@ -0,0 +1,77 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/vars"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Condition", func() {
+	var variables vars.StaticVariables
+
+	BeforeEach(func() {
+		variables = vars.StaticVariables{
+			"env":     "prod",
+			"enabled": true,
+			"off":     "false",
+			"count":   3,
+			"nested":  map[string]interface{}{"branch": "main"},
+		}
+	})
+
+	DescribeTable("evaluating",
+		func(expr string, expected bool) {
+			condition, err := atc.ParseCondition(expr)
+			Expect(err).ToNot(HaveOccurred())
+
+			result, err := condition.Evaluate(variables)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(expected))
+		},
+		Entry("true literal", "true", true),
+		Entry("false literal", "false", false),
+		Entry("truthy var", "((enabled))", true),
+		Entry("string 'false' var", "((off))", false),
+		Entry("negated var", "!((off))", true),
+		Entry("equality", `((env)) == "prod"`, true),
+		Entry("single quoted equality", `((env)) == 'dev'`, false),
+		Entry("inequality", `((env)) != "dev"`, true),
+		Entry("numeric equality", "((count)) == 3", true),
+		Entry("nested field", `((nested.branch)) == "main"`, true),
+		Entry("and", `((enabled)) && ((env)) == "prod"`, true),
+		Entry("or", `((off)) || ((count)) == 4`, false),
+		Entry("grouping", `!(((env)) == "dev" || ((off)))`, true),
+	)
+
+	DescribeTable("parse errors",
+		func(expr string) {
+			_, err := atc.ParseCondition(expr)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("empty", ""),
+		Entry("unquoted string", "((env)) == prod"),
+		Entry("unterminated var", "((env"),
+		Entry("unterminated string", `"prod`),
+		Entry("dangling operator", "((env)) =="),
+		Entry("unbalanced parens", `(((env)) == "prod"`),
+	)
+
+	It("errors when a var is not defined", func() {
+		condition, err := atc.ParseCondition("((missing))")
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = condition.Evaluate(variables)
+		Expect(err).To(Equal(vars.UndefinedVarsError{Vars: []string{"missing"}}))
+	})
+
+	It("short-circuits before evaluating undefined vars", func() {
+		condition, err := atc.ParseCondition("((off)) && ((missing))")
+		Expect(err).ToNot(HaveOccurred())
+
+		result, err := condition.Evaluate(variables)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeFalse())
+	})
+})
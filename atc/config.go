@@ -18,13 +18,14 @@ const ConfigVersionHeader = "X-Concourse-Config-Version"
 const DefaultTeamName = "main"
 
 type Config struct {
-	Groups        GroupConfigs     `json:"groups,omitempty"`
-	VarSources    VarSourceConfigs `json:"var_sources,omitempty"`
-	Resources     ResourceConfigs  `json:"resources,omitempty"`
-	ResourceTypes ResourceTypes    `json:"resource_types,omitempty"`
-	Prototypes    Prototypes       `json:"prototypes,omitempty"`
-	Jobs          JobConfigs       `json:"jobs,omitempty"`
-	Display       *DisplayConfig   `json:"display,omitempty"`
+	Groups        GroupConfigs        `json:"groups,omitempty"`
+	VarSources    VarSourceConfigs    `json:"var_sources,omitempty"`
+	Resources     ResourceConfigs     `json:"resources,omitempty"`
+	ResourceTypes ResourceTypes       `json:"resource_types,omitempty"`
+	Prototypes    Prototypes          `json:"prototypes,omitempty"`
+	Jobs          JobConfigs          `json:"jobs,omitempty"`
+	Notifications NotificationConfigs `json:"notifications,omitempty"`
+	Display       *DisplayConfig      `json:"display,omitempty"`
 }
 
 func UnmarshalConfig(payload []byte, config interface{}) error {
@@ -36,6 +37,7 @@ func UnmarshalConfig(payload []byte, config interface{}) error {
 		ResourceTypes interface{} `json:"resource_types,omitempty"`
 		Prototypes    interface{} `json:"prototypes,omitempty"`
 		Jobs          interface{} `json:"jobs,omitempty"`
+		Notifications interface{} `json:"notifications,omitempty"`
 		Display       interface{} `json:"display,omitempty"`
 	}
 
@@ -176,18 +178,19 @@ func (c VarSourceConfigs) OrderByDependency() (VarSourceConfigs, error) {
 }
 
 type ResourceConfig struct {
-	Name                 string      `json:"name"`
-	OldName              string      `json:"old_name,omitempty"`
-	Public               bool        `json:"public,omitempty"`
-	WebhookToken         string      `json:"webhook_token,omitempty"`
-	Type                 string      `json:"type"`
-	Source               Source      `json:"source"`
-	CheckEvery           *CheckEvery `json:"check_every,omitempty"`
-	CheckTimeout         string      `json:"check_timeout,omitempty"`
-	Tags                 Tags        `json:"tags,omitempty"`
-	Version              Version     `json:"version,omitempty"`
-	Icon                 string      `json:"icon,omitempty"`
-	ExposeBuildCreatedBy bool        `json:"expose_build_created_by,omitempty"`
+	Name                 string         `json:"name"`
+	OldName              string         `json:"old_name,omitempty"`
+	Public               bool           `json:"public,omitempty"`
+	WebhookToken         string         `json:"webhook_token,omitempty"`
+	Webhook              *WebhookConfig `json:"webhook,omitempty"`
+	Type                 string         `json:"type"`
+	Source               Source         `json:"source"`
+	CheckEvery           *CheckEvery    `json:"check_every,omitempty"`
+	CheckTimeout         string         `json:"check_timeout,omitempty"`
+	Tags                 Tags           `json:"tags,omitempty"`
+	Version              Version        `json:"version,omitempty"`
+	Icon                 string         `json:"icon,omitempty"`
+	ExposeBuildCreatedBy bool           `json:"expose_build_created_by,omitempty"`
 }
 
 type ResourceType struct {
@@ -216,6 +219,65 @@ type DisplayConfig struct {
 	BackgroundImage string `json:"background_image,omitempty"`
 }
 
+// WebhookConfig configures how the payloads sent to a resource's check
+// webhook are verified, as an alternative to passing a webhook_token in the
+// URL.
+type WebhookConfig struct {
+	Secret string        `json:"secret"`
+	Scheme WebhookScheme `json:"scheme,omitempty"`
+
+	// Header carrying the signature of the payload when using the hmac
+	// scheme. Defaults to X-Concourse-Signature.
+	Header string `json:"header,omitempty"`
+
+	// Name of the team webhook the resource listens to. Events received by
+	// the team webhook trigger a check of every resource listening to it
+	// whose filters match the payload.
+	Name string `json:"name,omitempty"`
+
+	// Filters maps fields of the resource's source to the path of a value in
+	// the payload, e.g. 'uri: repository.clone_url'.
+	Filters WebhookFilters `json:"filters,omitempty"`
+}
+
+type WebhookFilters map[string]string
+
+// WebhookTrigger describes a resource checked as a result of an event
+// received by a team webhook.
+type WebhookTrigger struct {
+	PipelineID           int          `json:"pipeline_id"`
+	PipelineName         string       `json:"pipeline_name"`
+	PipelineInstanceVars InstanceVars `json:"pipeline_instance_vars,omitempty"`
+	ResourceName         string       `json:"resource_name"`
+	BuildID              int          `json:"build_id"`
+}
+
+type WebhookScheme string
+
+const (
+	// WebhookSchemeHMAC expects a hex encoded HMAC-SHA256 of the payload,
+	// optionally prefixed with 'sha256='.
+	WebhookSchemeHMAC WebhookScheme = "hmac"
+
+	// WebhookSchemeGitHub expects the X-Hub-Signature-256 header sent by
+	// GitHub.
+	WebhookSchemeGitHub WebhookScheme = "github"
+
+	// WebhookSchemeGitLab expects the secret in the X-Gitlab-Token header
+	// sent by GitLab.
+	WebhookSchemeGitLab WebhookScheme = "gitlab"
+)
+
+const DefaultWebhookSignatureHeader = "X-Concourse-Signature"
+
+func (config WebhookConfig) SchemeOrDefault() WebhookScheme {
+	if config.Scheme == "" {
+		return WebhookSchemeHMAC
+	}
+
+	return config.Scheme
+}
+
 type CheckEvery struct {
 	Never    bool
 	Interval time.Duration
@@ -337,6 +399,30 @@ func (types ResourceTypes) ImageForType(planID PlanID, resourceType string, step
 	}
 }
 
+// ImageFor returns the image of the prototype, for running a message against
+// it in a run step.
+func (prototype Prototype) ImageFor(planID PlanID, resourceTypes ResourceTypes, stepTags Tags, skipInterval bool) TypeImage {
+	imageResource := ImageResource{
+		Name:   prototype.Name,
+		Type:   prototype.Type,
+		Source: prototype.Source,
+		Params: prototype.Params,
+		Tags:   prototype.Tags,
+	}
+
+	getPlan, checkPlan := FetchImagePlan(planID, imageResource, resourceTypes, stepTags, skipInterval, prototype.CheckEvery)
+	checkPlan.Check.Prototype = prototype.Name
+
+	return TypeImage{
+		BaseType: getPlan.Get.TypeImage.BaseType,
+
+		Privileged: prototype.Privileged,
+
+		GetPlan:   &getPlan,
+		CheckPlan: checkPlan,
+	}
+}
+
 func FetchImagePlan(planID PlanID, image ImageResource, resourceTypes ResourceTypes, stepTags Tags, skipInterval bool, checkEvery *CheckEvery) (Plan, *Plan) {
 	// If resource type is a custom type, recurse in order to resolve nested resource types
 	getPlanID := planID + "/image-get"
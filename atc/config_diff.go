@@ -106,6 +106,21 @@ func (index VarSourceIndex) FindEquivalent(obj interface{}) (interface{}, bool)
 	return VarSourceConfigs(index).Lookup(name(obj))
 }
 
+type NotificationIndex NotificationConfigs
+
+func (index NotificationIndex) Slice() []interface{} {
+	slice := make([]interface{}, len(index))
+	for i, object := range index {
+		slice[i] = object
+	}
+
+	return slice
+}
+
+func (index NotificationIndex) FindEquivalent(obj interface{}) (interface{}, bool) {
+	return NotificationConfigs(index).Lookup(name(obj))
+}
+
 type JobIndex JobConfigs
 
 func (index JobIndex) Slice() []interface{} {
@@ -329,6 +344,16 @@ func (c Config) Diff(out io.Writer, newConfig Config) bool {
 		}
 	}
 
+	notificationDiffs := diffIndices(NotificationIndex(c.Notifications), NotificationIndex(newConfig.Notifications))
+	if len(notificationDiffs) > 0 {
+		diffExists = true
+		fmt.Fprintln(out, "notifications:")
+
+		for _, diff := range notificationDiffs {
+			diff.Render(indent, "notification")
+		}
+	}
+
 	displayDiff, diff := diffDisplay(c.Display, newConfig.Display)
 	if diff {
 		diffExists = true
@@ -140,6 +140,57 @@ var _ = Describe("Config diff", func() {
 		})
 	})
 
+	Describe("notifications", func() {
+		var notification NotificationConfig
+		BeforeEach(func() {
+			notification = NotificationConfig{
+				Name: "some-hook",
+				URL:  "https://example.com/hook",
+			}
+		})
+
+		Context("when a notification is added", func() {
+			It("says it has been added", func() {
+				buffer := NewBuffer()
+				diff := Config{}.Diff(buffer, Config{
+					Notifications: NotificationConfigs{notification},
+				})
+				Expect(diff).To(BeTrue())
+				Eventually(buffer).Should(Say("notifications:"))
+				Eventually(buffer).Should(Say("notification some-hook has been added:"))
+				Eventually(buffer).Should(Say(`\+.*url: https://example.com/hook`))
+			})
+		})
+
+		Context("when a notification changes", func() {
+			It("says it has changed", func() {
+				changed := notification
+				changed.Statuses = []BuildStatus{StatusFailed}
+
+				buffer := NewBuffer()
+				diff := Config{
+					Notifications: NotificationConfigs{notification},
+				}.Diff(buffer, Config{
+					Notifications: NotificationConfigs{changed},
+				})
+				Expect(diff).To(BeTrue())
+				Eventually(buffer).Should(Say("notification some-hook has changed:"))
+				Eventually(buffer).Should(Say(`\+.*- failed`))
+			})
+		})
+
+		Context("when the notifications are unchanged", func() {
+			It("says there are no changes to apply", func() {
+				config := Config{
+					Notifications: NotificationConfigs{notification},
+				}
+
+				diff := config.Diff(GinkgoWriter, config)
+				Expect(diff).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("display config", func() {
 		var display DisplayConfig
 		BeforeEach(func() {
@@ -68,7 +68,7 @@ func Validate(c atc.Config) ([]atc.ConfigWarning, []string) {
 	}
 	warnings = append(warnings, prototypesWarnings...)
 
-	varSourcesWarnings, varSourcesErr := validateVarSources(c)
+	varSourcesWarnings, varSourcesErr := validateVarSources(c.VarSources)
 	if varSourcesErr != nil {
 		errorMessages = append(errorMessages, formatErr("variable sources", varSourcesErr))
 	}
@@ -80,6 +80,12 @@ func Validate(c atc.Config) ([]atc.ConfigWarning, []string) {
 	}
 	warnings = append(warnings, jobWarnings...)
 
+	notificationsWarnings, notificationsErr := validateNotifications(c)
+	if notificationsErr != nil {
+		errorMessages = append(errorMessages, formatErr("notifications", notificationsErr))
+	}
+	warnings = append(warnings, notificationsWarnings...)
+
 	displayWarnings, displayErr := validateDisplay(c)
 	if displayErr != nil {
 		errorMessages = append(errorMessages, formatErr("display config", displayErr))
@@ -206,6 +212,10 @@ func validateResources(c atc.Config) ([]atc.ConfigWarning, error) {
 		if resource.Type == "" {
 			errorMessages = append(errorMessages, identifier+" has no type")
 		}
+
+		if resource.Webhook != nil {
+			errorMessages = append(errorMessages, validateWebhook(identifier, resource)...)
+		}
 	}
 
 	errorMessages = append(errorMessages, validateResourcesUnused(c)...)
@@ -287,6 +297,47 @@ func validatePrototypes(c atc.Config, seenTypes map[string]location) ([]atc.Conf
 	return warnings, compositeErr(errorMessages)
 }
 
+func validateWebhook(identifier string, resource atc.ResourceConfig) []string {
+	var errorMessages []string
+
+	if resource.WebhookToken != "" {
+		errorMessages = append(errorMessages, identifier+" cannot have both a webhook_token and a webhook")
+	}
+
+	if resource.Webhook.Secret == "" {
+		errorMessages = append(errorMessages, identifier+".webhook has no secret")
+	}
+
+	switch resource.Webhook.SchemeOrDefault() {
+	case atc.WebhookSchemeHMAC:
+	case atc.WebhookSchemeGitHub, atc.WebhookSchemeGitLab:
+		if resource.Webhook.Header != "" {
+			errorMessages = append(errorMessages, fmt.Sprintf("%s.webhook can only have a header with the hmac scheme", identifier))
+		}
+	default:
+		errorMessages = append(errorMessages, fmt.Sprintf("%s.webhook has an unknown scheme '%s' (must be hmac, github or gitlab)", identifier, resource.Webhook.Scheme))
+	}
+
+	if resource.Webhook.Name != "" {
+		// the name is part of the team webhook's URL, so unlike other
+		// identifiers an invalid one is not just a warning
+		warning, _ := atc.ValidateIdentifier(resource.Webhook.Name, identifier+".webhook.name")
+		if warning != nil {
+			errorMessages = append(errorMessages, warning.Message)
+		}
+	} else if len(resource.Webhook.Filters) > 0 {
+		errorMessages = append(errorMessages, identifier+".webhook can only have filters when it has a name")
+	}
+
+	for field, path := range resource.Webhook.Filters {
+		if path == "" {
+			errorMessages = append(errorMessages, fmt.Sprintf("%s.webhook.filters.%s has no path", identifier, field))
+		}
+	}
+
+	return errorMessages
+}
+
 func validateResourcesUnused(c atc.Config) []string {
 	usedResources := usedResources(c)
 
@@ -368,6 +419,13 @@ func validateJobs(c atc.Config) ([]atc.ConfigWarning, error) {
 			)
 		}
 
+		if job.Priority < atc.MinJobPriority || job.Priority > atc.MaxJobPriority {
+			errorMessages = append(
+				errorMessages,
+				identifier+fmt.Sprintf(" has priority %d outside of %d to %d", job.Priority, atc.MinJobPriority, atc.MaxJobPriority),
+			)
+		}
+
 		if job.BuildLogRetention != nil {
 			if job.BuildLogRetention.Builds < 0 {
 				errorMessages = append(
@@ -395,6 +453,18 @@ func validateJobs(c atc.Config) ([]atc.ConfigWarning, error) {
 			}
 		}
 
+		errorMessages = append(errorMessages, validateJobParams(identifier, job.Params)...)
+
+		if job.Schedule != nil {
+			if err := job.Schedule.Validate(); err != nil {
+				errorMessages = append(errorMessages, fmt.Sprintf("%s.schedule has %s", identifier, err))
+			}
+		}
+
+		if job.ConcurrencyGroup != nil && job.ConcurrencyGroup.Name == "" {
+			errorMessages = append(errorMessages, identifier+".concurrency_group has no name")
+		}
+
 		step := job.Step()
 
 		validator := atc.NewStepValidator(c, []string{identifier, ".plan"})
@@ -409,6 +479,53 @@ func validateJobs(c atc.Config) ([]atc.ConfigWarning, error) {
 	return warnings, compositeErr(errorMessages)
 }
 
+func validateJobParams(identifier string, params atc.JobParams) []string {
+	var errorMessages []string
+
+	names := map[string]int{}
+
+	for i, param := range params {
+		paramIdentifier := fmt.Sprintf("%s.params[%d]", identifier, i)
+
+		if param.Name == "" {
+			errorMessages = append(errorMessages, paramIdentifier+" has no name")
+		} else if other, exists := names[param.Name]; exists {
+			errorMessages = append(errorMessages,
+				fmt.Sprintf(
+					"%s.params[%d] and %s have the same name ('%s')",
+					identifier, other, paramIdentifier, param.Name))
+		} else {
+			names[param.Name] = i
+		}
+
+		switch param.ParamType() {
+		case atc.JobParamTypeString, atc.JobParamTypeBool:
+			if len(param.Values) > 0 {
+				errorMessages = append(errorMessages,
+					fmt.Sprintf("%s has values but is not of type '%s'", paramIdentifier, atc.JobParamTypeEnum))
+			}
+		case atc.JobParamTypeEnum:
+			if len(param.Values) == 0 {
+				errorMessages = append(errorMessages, paramIdentifier+" is an enum but has no values")
+			}
+		default:
+			errorMessages = append(errorMessages,
+				fmt.Sprintf("%s has unknown type '%s'", paramIdentifier, param.Type))
+			continue
+		}
+
+		if param.Default != nil {
+			_, err := param.Coerce(param.Default)
+			if err != nil {
+				errorMessages = append(errorMessages,
+					fmt.Sprintf("%s has invalid default: %s", paramIdentifier, err))
+			}
+		}
+	}
+
+	return errorMessages
+}
+
 func compositeErr(errorMessages []string) error {
 	if len(errorMessages) == 0 {
 		return nil
@@ -417,13 +534,24 @@ func compositeErr(errorMessages []string) error {
 	return errors.New(strings.Join(errorMessages, "\n"))
 }
 
-func validateVarSources(c atc.Config) ([]atc.ConfigWarning, error) {
+// ValidateVarSources validates var_sources given outside of a pipeline
+// config, i.e. those shared by every pipeline in a team.
+func ValidateVarSources(varSources atc.VarSourceConfigs) ([]atc.ConfigWarning, []string) {
+	warnings, err := validateVarSources(varSources)
+	if err != nil {
+		return warnings, []string{formatErr("variable sources", err)}
+	}
+
+	return warnings, nil
+}
+
+func validateVarSources(varSources atc.VarSourceConfigs) ([]atc.ConfigWarning, error) {
 	var warnings []atc.ConfigWarning
 	var errorMessages []string
 
 	names := map[string]location{}
 
-	for i, varSource := range c.VarSources {
+	for i, varSource := range varSources {
 		location := location{section: "var_sources", index: i}
 		identifier := location.Identifier(varSource.Name)
 
@@ -465,13 +593,88 @@ func validateVarSources(c atc.Config) ([]atc.ConfigWarning, error) {
 		}
 	}
 
-	if _, err := c.VarSources.OrderByDependency(); err != nil {
+	if _, err := varSources.OrderByDependency(); err != nil {
 		errorMessages = append(errorMessages, fmt.Sprintf("failed to order by dependency: %s", err.Error()))
 	}
 
 	return warnings, compositeErr(errorMessages)
 }
 
+// ValidateNotifications validates notifications given outside of a pipeline
+// config, i.e. those sent for builds of every pipeline in a team.
+func ValidateNotifications(notifications atc.NotificationConfigs) ([]atc.ConfigWarning, []string) {
+	warnings, errorMessages := validateNotificationConfigs(notifications)
+	if len(errorMessages) > 0 {
+		return warnings, []string{formatErr("notifications", compositeErr(errorMessages))}
+	}
+
+	return warnings, nil
+}
+
+func validateNotifications(c atc.Config) ([]atc.ConfigWarning, error) {
+	warnings, errorMessages := validateNotificationConfigs(c.Notifications)
+
+	for i, notification := range c.Notifications {
+		identifier := location{section: "notifications", index: i}.Identifier(notification.Name)
+
+		for _, jobName := range notification.Jobs {
+			if _, found := c.Jobs.Lookup(jobName); !found {
+				errorMessages = append(errorMessages, fmt.Sprintf("%s refers to a job that does not exist ('%s')", identifier, jobName))
+			}
+		}
+	}
+
+	return warnings, compositeErr(errorMessages)
+}
+
+func validateNotificationConfigs(notifications atc.NotificationConfigs) ([]atc.ConfigWarning, []string) {
+	var warnings []atc.ConfigWarning
+	var errorMessages []string
+
+	names := map[string]location{}
+
+	for i, notification := range notifications {
+		location := location{section: "notifications", index: i}
+		identifier := location.Identifier(notification.Name)
+
+		warning, err := atc.ValidateIdentifier(notification.Name, identifier)
+		if err != nil {
+			errorMessages = append(errorMessages, err.Error())
+		}
+		if warning != nil {
+			warnings = append(warnings, *warning)
+		}
+
+		if other, ok := names[notification.Name]; ok {
+			errorMessages = append(errorMessages,
+				fmt.Sprintf(
+					"%s and %s have the same name ('%s')",
+					other, location, notification.Name))
+		}
+		names[notification.Name] = location
+
+		if notification.URL == "" {
+			errorMessages = append(errorMessages, identifier+" has no url")
+		} else if !strings.Contains(notification.URL, "((") {
+			// the url can only be checked once it is not interpolated
+			u, err := url.Parse(notification.URL)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				errorMessages = append(errorMessages, fmt.Sprintf("%s has an invalid url '%s': must be an http or https URL", identifier, notification.URL))
+			}
+		}
+
+		for _, status := range notification.Statuses {
+			switch status {
+			case atc.StatusStarted, atc.StatusSucceeded, atc.StatusFailed, atc.StatusErrored, atc.StatusAborted:
+			default:
+				errorMessages = append(errorMessages, fmt.Sprintf("%s has an unknown status '%s' in statuses (must be started, succeeded, failed, errored or aborted)", identifier, status))
+			}
+		}
+	}
+
+	return warnings, errorMessages
+}
+
 func validateDisplay(c atc.Config) ([]atc.ConfigWarning, error) {
 	var warnings []atc.ConfigWarning
 
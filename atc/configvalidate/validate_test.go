@@ -555,6 +555,112 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 
+		Context("when a resource has a webhook", func() {
+			BeforeEach(func() {
+				config.Resources[0].Webhook = &atc.WebhookConfig{
+					Secret: "((webhook-secret))",
+					Scheme: atc.WebhookSchemeGitHub,
+				}
+			})
+
+			It("returns no errors", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+
+			Context("when the resource also has a webhook_token", func() {
+				BeforeEach(func() {
+					config.Resources[0].WebhookToken = "some-token"
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid resources:"))
+					Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource cannot have both a webhook_token and a webhook"))
+				})
+			})
+
+			Context("when the webhook has no secret", func() {
+				BeforeEach(func() {
+					config.Resources[0].Webhook.Secret = ""
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource.webhook has no secret"))
+				})
+			})
+
+			Context("when the webhook has an unknown scheme", func() {
+				BeforeEach(func() {
+					config.Resources[0].Webhook.Scheme = "bitbucket"
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource.webhook has an unknown scheme 'bitbucket' (must be hmac, github or gitlab)"))
+				})
+			})
+
+			Context("when the webhook listens to a team webhook", func() {
+				BeforeEach(func() {
+					config.Resources[0].Webhook.Name = "github"
+					config.Resources[0].Webhook.Filters = atc.WebhookFilters{
+						"uri": "repository.clone_url",
+					}
+				})
+
+				It("returns no errors", func() {
+					Expect(errorMessages).To(HaveLen(0))
+				})
+
+				Context("when the name is not a valid identifier", func() {
+					BeforeEach(func() {
+						config.Resources[0].Webhook.Name = "Git/Hub"
+					})
+
+					It("returns an error", func() {
+						Expect(errorMessages).To(HaveLen(1))
+						Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource.webhook.name: 'Git/Hub' is not a valid identifier"))
+					})
+				})
+
+				Context("when a filter has no path", func() {
+					BeforeEach(func() {
+						config.Resources[0].Webhook.Filters["branch"] = ""
+					})
+
+					It("returns an error", func() {
+						Expect(errorMessages).To(HaveLen(1))
+						Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource.webhook.filters.branch has no path"))
+					})
+				})
+			})
+
+			Context("when the webhook has filters but no name", func() {
+				BeforeEach(func() {
+					config.Resources[0].Webhook.Filters = atc.WebhookFilters{
+						"uri": "repository.clone_url",
+					}
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource.webhook can only have filters when it has a name"))
+				})
+			})
+
+			Context("when the webhook has a header with a scheme other than hmac", func() {
+				BeforeEach(func() {
+					config.Resources[0].Webhook.Header = "X-Signature"
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource.webhook can only have a header with the hmac scheme"))
+				})
+			})
+		})
+
 		Context("when two resources have the same name", func() {
 			BeforeEach(func() {
 				config.Resources = append(config.Resources, config.Resources...)
@@ -969,6 +1075,19 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 
+		Context("when a job has a priority out of range", func() {
+			BeforeEach(func() {
+				job.Priority = atc.MaxJobPriority + 1
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job has priority 101 outside of -100 to 100"))
+			})
+		})
+
 		Context("when a job has duplicate inputs", func() {
 			BeforeEach(func() {
 				job.PlanSequence = append(job.PlanSequence, atc.Step{
@@ -1190,6 +1309,59 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a run plan specifies both a prototype and an image", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.RunStep{
+							Message:           "some-message",
+							Type:              "some-prototype",
+							ImageArtifactName: "some-image",
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].run(some-prototype.some-message): cannot specify both type and image"))
+				})
+			})
+
+			Context("when a run plan specifies neither a prototype nor an image", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.RunStep{
+							Message: "some-message",
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].run(.some-message): must specify either type or image"))
+				})
+			})
+
+			Context("when a run plan uses an artifact as its image", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.RunStep{
+							Message:           "some-message",
+							ImageArtifactName: "some-image",
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(BeEmpty())
+				})
+			})
+
 			Context("when a get plan has a custom name but refers to a resource that does exist", func() {
 				BeforeEach(func() {
 					job.PlanSequence = append(job.PlanSequence, atc.Step{
@@ -1756,6 +1928,113 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a task requests an id token", func() {
+				var idToken *atc.IDTokenConfig
+
+				BeforeEach(func() {
+					idToken = &atc.IDTokenConfig{
+						Audience:  []string{"sts.amazonaws.com"},
+						ExpiresIn: "15m",
+					}
+
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.TaskStep{
+							Name:       "some-task",
+							ConfigPath: "some-task.yml",
+							IDToken:    idToken,
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(BeEmpty())
+				})
+
+				Context("when the param is not a valid environment variable name", func() {
+					BeforeEach(func() {
+						idToken.Param = "ID-TOKEN"
+					})
+
+					It("returns an error", func() {
+						Expect(errorMessages).To(HaveLen(1))
+						Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].task(some-task).id_token.param: 'ID-TOKEN' is not a valid environment variable name"))
+					})
+				})
+
+				Context("when an audience is empty", func() {
+					BeforeEach(func() {
+						idToken.Audience = []string{"vault", ""}
+					})
+
+					It("returns an error", func() {
+						Expect(errorMessages).To(HaveLen(1))
+						Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].task(some-task).id_token.audience[1]: must not be empty"))
+					})
+				})
+
+				Context("when the expiry is not a duration", func() {
+					BeforeEach(func() {
+						idToken.ExpiresIn = "nope"
+					})
+
+					It("returns an error", func() {
+						Expect(errorMessages).To(HaveLen(1))
+						Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].task(some-task).id_token.expires_in: invalid duration 'nope'"))
+					})
+				})
+
+				Context("when the expiry is too long", func() {
+					BeforeEach(func() {
+						idToken.ExpiresIn = "48h"
+					})
+
+					It("returns an error", func() {
+						Expect(errorMessages).To(HaveLen(1))
+						Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].task(some-task).id_token.expires_in: must be between 0s and 24h0m0s"))
+					})
+				})
+			})
+
+			Context("when a plan has an invalid if condition in a step", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.IfStep{
+							Step: &atc.GetStep{
+								Name: "some-resource",
+							},
+							Condition: "((env)) == prod",
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("throws a validation error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].if: invalid condition '((env)) == prod'"))
+				})
+			})
+
+			Context("when an approve step has an unknown role", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.ApproveStep{
+							Name: "deploy-to-prod",
+							Role: "admin",
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("throws a validation error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].approve(deploy-to-prod): unknown role 'admin' (must be one of owner, member, pipeline-operator, viewer)"))
+				})
+			})
+
 			Context("when a retry plan has a negative attempts number", func() {
 				BeforeEach(func() {
 					job.PlanSequence = append(job.PlanSequence, atc.Step{
@@ -1776,6 +2055,29 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a retry plan has an invalid backoff", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.RetryStep{
+							Step: &atc.PutStep{
+								Name: "some-resource",
+							},
+							Attempts: 2,
+							Backoff:  "nope",
+							Jitter:   2,
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does return an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].backoff: invalid duration 'nope'"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].jitter: must be between 0 and 1"))
+				})
+			})
+
 			Context("when a set_pipeline step has no name or file configured", func() {
 				BeforeEach(func() {
 					job.PlanSequence = append(job.PlanSequence, atc.Step{
@@ -2190,6 +2492,74 @@ var _ = Describe("ValidateConfig", func() {
 				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job has negative build_log_retention.days: -1"))
 			})
 		})
+
+		Context("when a job has valid params", func() {
+			BeforeEach(func() {
+				config.Jobs[0].Params = atc.JobParams{
+					{Name: "target"},
+					{Name: "region", Type: atc.JobParamTypeEnum, Values: []string{"eu", "us"}, Default: "eu"},
+					{Name: "dry-run", Type: atc.JobParamTypeBool, Default: false},
+				}
+			})
+
+			It("does not return an error", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+		})
+
+		Context("when a job has invalid params", func() {
+			BeforeEach(func() {
+				config.Jobs[0].Params = atc.JobParams{
+					{Name: ""},
+					{Name: "region", Type: atc.JobParamTypeEnum},
+					{Name: "region", Type: atc.JobParamTypeEnum, Values: []string{"eu"}, Default: "us"},
+					{Name: "dry-run", Type: atc.JobParamTypeBool, Default: "yes"},
+					{Name: "count", Type: "number"},
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job.params[0] has no name"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job.params[1] is an enum but has no values"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job.params[1] and jobs.some-job.params[2] have the same name ('region')"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job.params[2] has invalid default: param 'region' must be one of eu"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job.params[3] has invalid default: param 'dry-run' must be true or false"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job.params[4] has unknown type 'number'"))
+			})
+		})
+
+		Context("when a job has a valid schedule", func() {
+			BeforeEach(func() {
+				config.Jobs[0].Schedule = &atc.JobSchedule{Cron: "0 3 * * 1-5", Timezone: "Europe/London"}
+			})
+
+			It("does not return an error", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+		})
+
+		Context("when a job has an invalid schedule", func() {
+			BeforeEach(func() {
+				config.Jobs[0].Schedule = &atc.JobSchedule{Cron: "0 3 * *"}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job.schedule has invalid cron expression '0 3 * *'"))
+			})
+		})
+
+		Context("when a job has a concurrency group with no name", func() {
+			BeforeEach(func() {
+				config.Jobs[0].ConcurrencyGroup = &atc.ConcurrencyGroupConfig{CancelInProgress: true}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job.concurrency_group has no name"))
+			})
+		})
 	})
 
 	Describe("validating display config", func() {
@@ -2246,6 +2616,84 @@ var _ = Describe("ValidateConfig", func() {
 		})
 	})
 
+	Describe("validating notifications", func() {
+		BeforeEach(func() {
+			config.Notifications = atc.NotificationConfigs{
+				{
+					Name:     "some-hook",
+					URL:      "https://example.com/hook",
+					Secret:   "((hook-secret))",
+					Statuses: []atc.BuildStatus{atc.StatusFailed, atc.StatusErrored},
+					Jobs:     []string{"some-job"},
+				},
+				{
+					Name: "some-other-hook",
+					URL:  "((hook-url))",
+				},
+			}
+		})
+
+		It("does not return an error", func() {
+			Expect(errorMessages).To(HaveLen(0))
+		})
+
+		Context("when two notifications have the same name", func() {
+			BeforeEach(func() {
+				config.Notifications[1].Name = "some-hook"
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid notifications:"))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications[0] and notifications[1] have the same name ('some-hook')"))
+			})
+		})
+
+		Context("when a notification has no url", func() {
+			BeforeEach(func() {
+				config.Notifications[1].URL = ""
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications.some-other-hook has no url"))
+			})
+		})
+
+		Context("when a notification's url is not an http url", func() {
+			BeforeEach(func() {
+				config.Notifications[0].URL = "ftp://example.com/hook"
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications.some-hook has an invalid url 'ftp://example.com/hook'"))
+			})
+		})
+
+		Context("when a notification is on an unknown status", func() {
+			BeforeEach(func() {
+				config.Notifications[0].Statuses = []atc.BuildStatus{"pending"}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications.some-hook has an unknown status 'pending'"))
+			})
+		})
+
+		Context("when a notification refers to a job that does not exist", func() {
+			BeforeEach(func() {
+				config.Notifications[0].Jobs = []string{"bogus-job"}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications.some-hook refers to a job that does not exist ('bogus-job')"))
+			})
+		})
+	})
+
 	Describe("invalid pipeline", func() {
 		Context("contains zero jobs", func() {
 			BeforeEach(func() {
@@ -0,0 +1,62 @@
+package builtin
+
+import (
+	"encoding/json"
+	"errors"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Manager struct {
+	Enabled bool `long:"enabled" description:"Store secrets in the database, managed with 'fly set-secret'. Secrets are encrypted with the encryption key when one is configured."`
+
+	store db.SecretStore
+}
+
+// SetStore sets the store the secrets are read from, as the database is only
+// connected to after the flags are parsed.
+func (manager *Manager) SetStore(store db.SecretStore) {
+	manager.store = store
+}
+
+func (manager *Manager) Init(log lager.Logger) error {
+	return nil
+}
+
+func (manager *Manager) MarshalJSON() ([]byte, error) {
+	health, err := manager.Health()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&map[string]interface{}{
+		"health": health,
+	})
+}
+
+func (manager *Manager) IsConfigured() bool {
+	return manager.Enabled
+}
+
+func (manager *Manager) Validate() error {
+	if manager.store == nil {
+		return errors.New("no secret store")
+	}
+
+	return nil
+}
+
+func (manager *Manager) Health() (*creds.HealthResponse, error) {
+	return &creds.HealthResponse{
+		Method: "database",
+	}, nil
+}
+
+func (manager *Manager) Close(logger lager.Logger) {
+}
+
+func (manager *Manager) NewSecretsFactory(logger lager.Logger) (creds.SecretsFactory, error) {
+	return NewSecretsFactory(manager.store), nil
+}
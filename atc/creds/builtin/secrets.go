@@ -0,0 +1,71 @@
+package builtin
+
+import (
+	"path"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type SecretsFactory struct {
+	store db.SecretStore
+}
+
+func NewSecretsFactory(store db.SecretStore) *SecretsFactory {
+	return &SecretsFactory{
+		store: store,
+	}
+}
+
+func (factory *SecretsFactory) NewSecrets() creds.Secrets {
+	return &Secrets{
+		store: factory.store,
+	}
+}
+
+type Secrets struct {
+	store db.SecretStore
+}
+
+// NewSecretLookupPaths looks up secrets scoped to the pipeline first, then
+// those of the team. There are no secrets shared between teams.
+func (secrets *Secrets) NewSecretLookupPaths(teamName string, pipelineName string, allowRootPath bool) []creds.SecretLookupPath {
+	lookupPaths := []creds.SecretLookupPath{}
+
+	if len(pipelineName) > 0 {
+		lookupPaths = append(lookupPaths, creds.NewSecretLookupWithPrefix(path.Join(teamName, pipelineName)+"/"))
+	}
+
+	lookupPaths = append(lookupPaths, creds.NewSecretLookupWithPrefix(teamName+"/"))
+
+	return lookupPaths
+}
+
+// Get looks up a secret by its path, either TEAM/SECRET or
+// TEAM/PIPELINE/SECRET.
+func (secrets *Secrets) Get(secretPath string) (interface{}, *time.Time, bool, error) {
+	var teamName, pipelineName, name string
+
+	parts := strings.Split(secretPath, "/")
+	switch len(parts) {
+	case 2:
+		teamName, name = parts[0], parts[1]
+	case 3:
+		teamName, pipelineName, name = parts[0], parts[1], parts[2]
+	default:
+		return nil, nil, false, nil
+	}
+
+	value, found, err := secrets.store.Get(teamName, pipelineName, name)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	if !found {
+		return nil, nil, false, nil
+	}
+
+	return value, nil, true, nil
+}
@@ -0,0 +1,72 @@
+package builtin_test
+
+import (
+	"errors"
+
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/builtin"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/vars"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Secrets", func() {
+	var (
+		fakeStore *dbfakes.FakeSecretStore
+		variables vars.Variables
+		stored    map[string]interface{}
+	)
+
+	BeforeEach(func() {
+		stored = map[string]interface{}{}
+
+		fakeStore = new(dbfakes.FakeSecretStore)
+		fakeStore.GetStub = func(teamName string, pipelineName string, name string) (interface{}, bool, error) {
+			value, found := stored[teamName+"/"+pipelineName+"/"+name]
+			return value, found, nil
+		}
+
+		variables = creds.NewVariables(builtin.NewSecretsFactory(fakeStore).NewSecrets(), "some-team", "some-pipeline", false)
+	})
+
+	It("looks up secrets scoped to the pipeline", func() {
+		stored["some-team/some-pipeline/token"] = "pipeline-token"
+		stored["some-team//token"] = "team-token"
+
+		value, err := creds.NewString(variables, "((token))").Evaluate()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal("pipeline-token"))
+	})
+
+	It("falls back on secrets of the team", func() {
+		stored["some-team//token"] = "team-token"
+
+		value, err := creds.NewString(variables, "((token))").Evaluate()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal("team-token"))
+	})
+
+	It("does not look up secrets of other teams", func() {
+		stored["other-team//token"] = "other-token"
+
+		_, err := creds.NewString(variables, "((token))").Evaluate()
+		Expect(err).To(Equal(vars.UndefinedVarsError{Vars: []string{"token"}}))
+	})
+
+	It("looks up fields of structured secrets", func() {
+		stored["some-team//creds"] = map[string]interface{}{"username": "admin"}
+
+		value, err := creds.NewString(variables, "((creds.username))").Evaluate()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal("admin"))
+	})
+
+	It("returns errors from the store", func() {
+		fakeStore.GetReturns(nil, false, errors.New("nope"))
+		fakeStore.GetStub = nil
+
+		_, err := creds.NewString(variables, "((token))").Evaluate()
+		Expect(err).To(MatchError(ContainSubstring("nope")))
+	})
+})
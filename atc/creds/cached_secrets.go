@@ -23,6 +23,7 @@ type CacheEntry struct {
 	value      interface{}
 	expiration *time.Time
 	found      bool
+	info       SecretLookupInfo
 }
 
 func NewCachedSecrets(secrets Secrets, cacheConfig SecretCacheConfig) *CachedSecrets {
@@ -37,24 +38,31 @@ func NewCachedSecrets(secrets Secrets, cacheConfig SecretCacheConfig) *CachedSec
 }
 
 func (cs *CachedSecrets) Get(secretPath string) (interface{}, *time.Time, bool, error) {
+	value, expiration, found, _, err := cs.GetWithInfo(secretPath)
+	return value, expiration, found, err
+}
+
+func (cs *CachedSecrets) GetWithInfo(secretPath string) (interface{}, *time.Time, bool, SecretLookupInfo, error) {
 	// if there is a corresponding entry in the cache, return it
 	entry, found := cs.cache.Get(secretPath)
 	if found {
 		result := entry.(CacheEntry)
-		return result.value, result.expiration, result.found, nil
+		info := result.info
+		info.Cached = true
+		return result.value, result.expiration, result.found, info, nil
 	}
 
 	// otherwise, let's make a request to the underlying secret manager
-	value, expiration, found, err := cs.secrets.Get(secretPath)
+	value, expiration, found, info, err := GetWithInfo(cs.secrets, secretPath)
 
 	// we don't want to cache errors, let the errors be retried the next time around
 	if err != nil {
-		return nil, nil, false, err
+		return nil, nil, false, info, err
 	}
 
 	// here we want to cache secret value, expiration, and found flag too
 	// meaning that "secret not found" responses will be cached too!
-	entry = CacheEntry{value: value, expiration: expiration, found: found}
+	entry = CacheEntry{value: value, expiration: expiration, found: found, info: info}
 
 	if found {
 		// take default cache ttl
@@ -71,7 +79,7 @@ func (cs *CachedSecrets) Get(secretPath string) (interface{}, *time.Time, bool,
 		cs.cache.Set(secretPath, entry, cs.cacheConfig.DurationNotFound)
 	}
 
-	return value, expiration, found, nil
+	return value, expiration, found, info, nil
 }
 
 func (cs *CachedSecrets) NewSecretLookupPaths(teamName string, pipelineName string, allowRootPath bool) []SecretLookupPath {
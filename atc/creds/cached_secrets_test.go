@@ -162,4 +162,20 @@ var _ = Describe("Caching of secrets", func() {
 		Expect(underlyingMisses).To(BeIdenticalTo(4))
 	})
 
+	It("should describe cached entries as served from the cache", func() {
+		secretManager.GetStub = makeGetStub("foo", "value", nil, true, nil, &underlyingReads, &underlyingMisses)
+		namedCachedSecretManager := creds.NewCachedSecrets(creds.NewNamedSecrets("vault", secretManager), cacheConfig)
+
+		_, _, found, info, err := namedCachedSecretManager.GetWithInfo("foo")
+		Expect(err).To(BeNil())
+		Expect(found).To(BeTrue())
+		Expect(info).To(Equal(creds.SecretLookupInfo{CredentialManager: "vault"}))
+
+		_, _, found, info, err = namedCachedSecretManager.GetWithInfo("foo")
+		Expect(err).To(BeNil())
+		Expect(found).To(BeTrue())
+		Expect(info).To(Equal(creds.SecretLookupInfo{CredentialManager: "vault", Cached: true}))
+		Expect(underlyingReads).To(BeIdenticalTo(1))
+	})
+
 })
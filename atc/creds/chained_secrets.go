@@ -0,0 +1,150 @@
+package creds
+
+import (
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+)
+
+// chainedPathSeparator separates the name of the credential manager from the
+// secret path in the paths looked up in a chain.
+const chainedPathSeparator = ":"
+
+// NamedSecretsFactory is the secrets factory of a credential manager, along
+// with the name the manager is configured with.
+type NamedSecretsFactory struct {
+	Name    string
+	Factory SecretsFactory
+}
+
+// NewSecrets returns the manager's secrets, which describe the manager as
+// having served each secret.
+func (f NamedSecretsFactory) NewSecrets() Secrets {
+	return NewNamedSecrets(f.Name, f.Factory.NewSecrets())
+}
+
+type chainedSecretsFactory struct {
+	logger    lager.Logger
+	factories []NamedSecretsFactory
+}
+
+// NewChainedSecretsFactory returns a factory for secrets which are looked up
+// in each of the given credential managers in order, until one of them has
+// the var.
+func NewChainedSecretsFactory(logger lager.Logger, factories []NamedSecretsFactory) SecretsFactory {
+	return &chainedSecretsFactory{
+		logger:    logger,
+		factories: factories,
+	}
+}
+
+func (factory *chainedSecretsFactory) NewSecrets() Secrets {
+	chain := &ChainedSecrets{
+		logger: factory.logger,
+	}
+
+	for _, f := range factory.factories {
+		chain.names = append(chain.names, f.Name)
+		chain.secrets = append(chain.secrets, f.Factory.NewSecrets())
+	}
+
+	return chain
+}
+
+// ChainedSecrets consults several credential managers in order. The lookup
+// paths of every manager are prefixed with the manager's name, so that a var
+// is looked up using all of the first manager's paths before falling back to
+// the next one.
+//
+// An error from a manager ends the lookup rather than falling back, as a
+// later manager may hold a stale copy of the var during a migration. The
+// name of the manager which served or failed a lookup is reported through
+// GetWithInfo, so that it ends up in the build's trail of secret accesses.
+type ChainedSecrets struct {
+	logger lager.Logger
+
+	names   []string
+	secrets []Secrets
+}
+
+func (cs *ChainedSecrets) Get(secretPath string) (interface{}, *time.Time, bool, error) {
+	value, expiration, found, _, err := cs.GetWithInfo(secretPath)
+	return value, expiration, found, err
+}
+
+func (cs *ChainedSecrets) GetWithInfo(secretPath string) (interface{}, *time.Time, bool, SecretLookupInfo, error) {
+	name, path, ok := strings.Cut(secretPath, chainedPathSeparator)
+	if ok {
+		for i, n := range cs.names {
+			if n == name {
+				return cs.get(n, cs.secrets[i], path)
+			}
+		}
+	}
+
+	// not a path from our lookup paths; try it as-is with each manager
+	for i, n := range cs.names {
+		value, expiration, found, info, err := cs.get(n, cs.secrets[i], secretPath)
+		if err != nil || found {
+			return value, expiration, found, info, err
+		}
+	}
+
+	return nil, nil, false, SecretLookupInfo{}, nil
+}
+
+func (cs *ChainedSecrets) get(name string, secrets Secrets, path string) (interface{}, *time.Time, bool, SecretLookupInfo, error) {
+	logger := cs.logger.WithData(lager.Data{
+		"credential-manager": name,
+		"path":               path,
+	})
+
+	info := SecretLookupInfo{CredentialManager: name}
+
+	value, expiration, found, err := secrets.Get(path)
+	if err != nil {
+		logger.Error("failed-to-get-secret", err)
+		return nil, nil, false, info, err
+	}
+
+	if found {
+		logger.Info("found-secret")
+	}
+
+	return value, expiration, found, info, nil
+}
+
+func (cs *ChainedSecrets) NewSecretLookupPaths(teamName string, pipelineName string, allowRootPath bool) []SecretLookupPath {
+	var lookupPaths []SecretLookupPath
+	for i, name := range cs.names {
+		paths := cs.secrets[i].NewSecretLookupPaths(teamName, pipelineName, allowRootPath)
+		if len(paths) == 0 {
+			// managers without lookup paths map vars to secrets 1-to-1
+			paths = []SecretLookupPath{NewSecretLookupWithPrefix("")}
+		}
+
+		for _, path := range paths {
+			lookupPaths = append(lookupPaths, chainedLookupPath{
+				name: name,
+				path: path,
+			})
+		}
+	}
+
+	return lookupPaths
+}
+
+type chainedLookupPath struct {
+	name string
+	path SecretLookupPath
+}
+
+func (lp chainedLookupPath) VariableToSecretPath(varName string) (string, error) {
+	secretPath, err := lp.path.VariableToSecretPath(varName)
+	if err != nil {
+		return "", err
+	}
+
+	return lp.name + chainedPathSeparator + secretPath, nil
+}
@@ -0,0 +1,165 @@
+package creds_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/credsfakes"
+	"github.com/concourse/concourse/vars"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ChainedSecrets", func() {
+	var (
+		vaultSecrets *credsfakes.FakeSecrets
+		ssmSecrets   *credsfakes.FakeSecrets
+
+		recorder *credsfakes.FakeSecretAccessRecorder
+
+		variables vars.Variables
+
+		value interface{}
+		found bool
+		err   error
+	)
+
+	secretsWith := func(values map[string]interface{}) *credsfakes.FakeSecrets {
+		secrets := new(credsfakes.FakeSecrets)
+		secrets.GetStub = func(path string) (interface{}, *time.Time, bool, error) {
+			value, found := values[path]
+			return value, nil, found, nil
+		}
+		return secrets
+	}
+
+	BeforeEach(func() {
+		vaultSecrets = secretsWith(map[string]interface{}{
+			"/concourse/some-team/some-pipeline/pipeline-var": "vault-pipeline-value",
+			"/concourse/some-team/shared-var":                 "vault-team-value",
+		})
+		vaultSecrets.NewSecretLookupPathsStub = func(team string, pipeline string, _ bool) []creds.SecretLookupPath {
+			return []creds.SecretLookupPath{
+				creds.NewSecretLookupWithPrefix("/concourse/" + team + "/" + pipeline + "/"),
+				creds.NewSecretLookupWithPrefix("/concourse/" + team + "/"),
+			}
+		}
+
+		ssmSecrets = secretsWith(map[string]interface{}{
+			"/concourse/some-team/some-pipeline/shared-var": "ssm-pipeline-value",
+			"/concourse/some-team/ssm-var":                  "ssm-team-value",
+		})
+		ssmSecrets.NewSecretLookupPathsStub = vaultSecrets.NewSecretLookupPathsStub
+
+		recorder = new(credsfakes.FakeSecretAccessRecorder)
+	})
+
+	JustBeforeEach(func() {
+		vaultFactory := new(credsfakes.FakeSecretsFactory)
+		vaultFactory.NewSecretsReturns(vaultSecrets)
+
+		ssmFactory := new(credsfakes.FakeSecretsFactory)
+		ssmFactory.NewSecretsReturns(ssmSecrets)
+
+		secrets := creds.NewChainedSecretsFactory(lagertest.NewTestLogger("test"), []creds.NamedSecretsFactory{
+			{Name: "vault", Factory: vaultFactory},
+			{Name: "ssm", Factory: ssmFactory},
+		}).NewSecrets()
+
+		variables = creds.NewRecordedVariables(secrets, "some-team", "some-pipeline", false, "", recorder)
+	})
+
+	It("finds vars in the first manager", func() {
+		value, found, err = variables.Get(vars.Reference{Path: "pipeline-var"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(value).To(Equal("vault-pipeline-value"))
+	})
+
+	It("consults all of a manager's lookup paths before the next manager", func() {
+		value, found, err = variables.Get(vars.Reference{Path: "shared-var"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(value).To(Equal("vault-team-value"))
+	})
+
+	It("falls back to later managers", func() {
+		value, found, err = variables.Get(vars.Reference{Path: "ssm-var"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(value).To(Equal("ssm-team-value"))
+
+		Expect(ssmSecrets.GetCallCount()).To(Equal(2))
+		Expect(ssmSecrets.GetArgsForCall(1)).To(Equal("/concourse/some-team/ssm-var"))
+	})
+
+	It("records each path looked up along with the manager consulted", func() {
+		_, _, err = variables.Get(vars.Reference{Path: "ssm-var"})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(recorder.RecordSecretAccessCallCount()).To(Equal(4))
+		Expect(recorder.RecordSecretAccessArgsForCall(0)).To(Equal(atc.SecretAccess{
+			Path:              "vault:/concourse/some-team/some-pipeline/ssm-var",
+			CredentialManager: "vault",
+		}))
+		Expect(recorder.RecordSecretAccessArgsForCall(3)).To(Equal(atc.SecretAccess{
+			Path:              "ssm:/concourse/some-team/ssm-var",
+			CredentialManager: "ssm",
+			Found:             true,
+		}))
+	})
+
+	It("does not find vars missing from every manager", func() {
+		_, found, err = variables.Get(vars.Reference{Path: "missing-var"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+	})
+
+	Context("when a manager has no lookup paths", func() {
+		BeforeEach(func() {
+			vaultSecrets.NewSecretLookupPathsStub = nil
+			vaultSecrets.NewSecretLookupPathsReturns(nil)
+			vaultSecrets.GetReturns("raw-value", nil, true, nil)
+		})
+
+		It("looks up the var by its name", func() {
+			value, found, err = variables.Get(vars.Reference{Path: "some-var"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("raw-value"))
+
+			Expect(vaultSecrets.GetArgsForCall(0)).To(Equal("some-var"))
+		})
+	})
+
+	Context("when a manager fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			vaultSecrets.GetStub = nil
+			vaultSecrets.GetReturns(nil, nil, false, disaster)
+		})
+
+		It("returns the error without falling back", func() {
+			_, _, err = variables.Get(vars.Reference{Path: "ssm-var"})
+			Expect(err).To(Equal(disaster))
+			Expect(ssmSecrets.GetCallCount()).To(BeZero())
+		})
+
+		It("records the failed lookup along with the manager which failed it", func() {
+			_, _, err = variables.Get(vars.Reference{Path: "ssm-var"})
+			Expect(err).To(Equal(disaster))
+
+			Expect(recorder.RecordSecretAccessCallCount()).To(Equal(1))
+			Expect(recorder.RecordSecretAccessArgsForCall(0)).To(Equal(atc.SecretAccess{
+				Path:              "vault:/concourse/some-team/some-pipeline/ssm-var",
+				CredentialManager: "vault",
+				Error:             "nope",
+			}))
+		})
+	})
+})
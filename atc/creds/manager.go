@@ -23,6 +23,8 @@ type ManagerFactory interface {
 type Managers map[string]Manager
 
 type CredentialManagementConfig struct {
+	Chain []string `long:"credential-manager" description:"Name of a configured credential manager to look up vars in. Can be specified multiple times; the managers are consulted in the given order until one of them has the var."`
+
 	RetryConfig SecretRetryConfig
 	CacheConfig SecretCacheConfig
 }
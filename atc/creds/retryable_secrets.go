@@ -23,20 +23,25 @@ func NewRetryableSecrets(secrets Secrets, retryConfig SecretRetryConfig) Secrets
 
 // Get retrieves the value and expiration of an individual secret
 func (rs RetryableSecrets) Get(secretPath string) (interface{}, *time.Time, bool, error) {
+	result, expiration, exists, _, err := rs.GetWithInfo(secretPath)
+	return result, expiration, exists, err
+}
+
+func (rs RetryableSecrets) GetWithInfo(secretPath string) (interface{}, *time.Time, bool, SecretLookupInfo, error) {
 	r := &retryhttp.DefaultRetryer{}
 	for i := 0; i < rs.retryConfig.Attempts-1; i++ {
-		result, expiration, exists, err := rs.secrets.Get(secretPath)
+		result, expiration, exists, info, err := GetWithInfo(rs.secrets, secretPath)
 		if err != nil && r.IsRetryable(err) {
 			time.Sleep(rs.retryConfig.Interval)
 			continue
 		}
-		return result, expiration, exists, err
+		return result, expiration, exists, info, err
 	}
-	result, expiration, exists, err := rs.secrets.Get(secretPath)
+	result, expiration, exists, info, err := GetWithInfo(rs.secrets, secretPath)
 	if err != nil {
 		err = fmt.Errorf("%s (after %d retries)", err, rs.retryConfig.Attempts)
 	}
-	return result, expiration, exists, err
+	return result, expiration, exists, info, err
 }
 
 // NewSecretLookupPaths defines how variables will be searched in the underlying secret manager
@@ -0,0 +1,66 @@
+package creds
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// SecretLookupInfo describes how a secret was looked up, for the trail of
+// secrets accessed by builds.
+type SecretLookupInfo struct {
+	CredentialManager string
+	Cached            bool
+}
+
+// SecretsWithInfo is implemented by Secrets which can describe how a secret
+// was looked up, e.g. which credential manager served it.
+type SecretsWithInfo interface {
+	GetWithInfo(string) (interface{}, *time.Time, bool, SecretLookupInfo, error)
+}
+
+// GetWithInfo gets the secret at the given path, describing how it was looked
+// up if the secrets are able to.
+func GetWithInfo(secrets Secrets, secretPath string) (interface{}, *time.Time, bool, SecretLookupInfo, error) {
+	if withInfo, ok := secrets.(SecretsWithInfo); ok {
+		return withInfo.GetWithInfo(secretPath)
+	}
+
+	value, expiration, found, err := secrets.Get(secretPath)
+	return value, expiration, found, SecretLookupInfo{}, err
+}
+
+//counterfeiter:generate . SecretAccessRecorder
+type SecretAccessRecorder interface {
+	RecordSecretAccess(atc.SecretAccess)
+}
+
+// NamedSecrets are the secrets of the credential manager with the given name.
+type NamedSecrets struct {
+	name    string
+	secrets Secrets
+}
+
+func NewNamedSecrets(name string, secrets Secrets) *NamedSecrets {
+	return &NamedSecrets{
+		name:    name,
+		secrets: secrets,
+	}
+}
+
+func (ns *NamedSecrets) Get(secretPath string) (interface{}, *time.Time, bool, error) {
+	return ns.secrets.Get(secretPath)
+}
+
+func (ns *NamedSecrets) GetWithInfo(secretPath string) (interface{}, *time.Time, bool, SecretLookupInfo, error) {
+	value, expiration, found, info, err := GetWithInfo(ns.secrets, secretPath)
+	if info.CredentialManager == "" {
+		info.CredentialManager = ns.name
+	}
+
+	return value, expiration, found, info, err
+}
+
+func (ns *NamedSecrets) NewSecretLookupPaths(teamName string, pipelineName string, allowRootPath bool) []SecretLookupPath {
+	return ns.secrets.NewSecretLookupPaths(teamName, pipelineName, allowRootPath)
+}
@@ -1,12 +1,19 @@
 package creds
 
 import (
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/vars"
 )
 
 type VariableLookupFromSecrets struct {
 	Secrets     Secrets
 	LookupPaths []SecretLookupPath
+
+	// Source is the name of the var source the secrets belong to, if any.
+	Source string
+
+	// Recorder, if set, is told about every secret looked up.
+	Recorder SecretAccessRecorder
 }
 
 func NewVariables(secrets Secrets, teamName string, pipelineName string, allowRootPath bool) vars.Variables {
@@ -16,6 +23,17 @@ func NewVariables(secrets Secrets, teamName string, pipelineName string, allowRo
 	}
 }
 
+// NewRecordedVariables is like NewVariables, but tells the recorder about
+// every secret looked up through the variables.
+func NewRecordedVariables(secrets Secrets, teamName string, pipelineName string, allowRootPath bool, source string, recorder SecretAccessRecorder) vars.Variables {
+	return VariableLookupFromSecrets{
+		Secrets:     secrets,
+		LookupPaths: secrets.NewSecretLookupPaths(teamName, pipelineName, allowRootPath),
+		Source:      source,
+		Recorder:    recorder,
+	}
+}
+
 func (sl VariableLookupFromSecrets) Get(ref vars.Reference) (interface{}, bool, error) {
 	val, found, err := sl.get(ref.Path)
 	if err != nil {
@@ -34,8 +52,7 @@ func (sl VariableLookupFromSecrets) Get(ref vars.Reference) (interface{}, bool,
 func (sl VariableLookupFromSecrets) get(path string) (interface{}, bool, error) {
 	if len(sl.LookupPaths) == 0 {
 		// if no paths are specified (i.e. for fake & noop secret managers), then try 1-to-1 var->secret mapping
-		result, _, found, err := sl.Secrets.Get(path)
-		return result, found, err
+		return sl.getSecret(path)
 	}
 	// try to find a secret according to our var->secret lookup paths
 	for _, rule := range sl.LookupPaths {
@@ -44,7 +61,7 @@ func (sl VariableLookupFromSecrets) get(path string) (interface{}, bool, error)
 		if err != nil {
 			return nil, false, err
 		}
-		result, _, found, err := sl.Secrets.Get(secretPath)
+		result, found, err := sl.getSecret(secretPath)
 		if err != nil {
 			return nil, false, err
 		}
@@ -56,6 +73,36 @@ func (sl VariableLookupFromSecrets) get(path string) (interface{}, bool, error)
 	return nil, false, nil
 }
 
+func (sl VariableLookupFromSecrets) getSecret(secretPath string) (interface{}, bool, error) {
+	if sl.Recorder == nil {
+		result, _, found, err := sl.Secrets.Get(secretPath)
+		return result, found, err
+	}
+
+	result, _, found, info, err := GetWithInfo(sl.Secrets, secretPath)
+
+	access := atc.SecretAccess{
+		Source:            sl.Source,
+		Path:              secretPath,
+		CredentialManager: info.CredentialManager,
+		Found:             found,
+		Cached:            info.Cached,
+	}
+
+	if err != nil {
+		access.Found = false
+		access.Error = err.Error()
+	}
+
+	sl.Recorder.RecordSecretAccess(access)
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	return result, found, nil
+}
+
 func (sl VariableLookupFromSecrets) List() ([]vars.Reference, error) {
 	return nil, nil
 }
@@ -97,7 +97,13 @@ var buildsQuery = psql.Select(`
 		rb.name,
 		b.rerun_number,
 		b.span_context,
-		COALESCE(bc.comment, '')
+		COALESCE(bc.comment, ''),
+		b.params,
+		b.trigger_reason,
+		b.abort_reason,
+		COALESCE(b.priority, j.priority, 0),
+		b.resumed_from,
+		b.events_offloaded
 	`).
 	From("builds b").
 	JoinClause("LEFT OUTER JOIN jobs j ON b.job_id = j.id").
@@ -160,6 +166,11 @@ type Build interface {
 	RerunOfName() string
 	RerunNumber() int
 	CreatedBy() *string
+	Params() map[string]interface{}
+	TriggerReason() string
+	AbortReason() string
+	Priority() int
+	ResumedFrom() int
 
 	LagerData() lager.Data
 	TracingAttrs() tracing.Attrs
@@ -201,9 +212,29 @@ type Build interface {
 	IsAborted() bool
 	AbortNotifier() (Notifier, error)
 
+	RequestApproval(planID atc.PlanID, name string, role string) error
+	Approval(atc.PlanID) (BuildApproval, bool, error)
+	PendingApprovals() ([]BuildApproval, error)
+	DecideApproval(planID atc.PlanID, approved bool, decidedBy string) (bool, error)
+	WithdrawApproval(atc.PlanID) error
+	ApprovalNotifier(atc.PlanID) (Notifier, error)
+
+	SaveStepCheckpoint(BuildStepCheckpoint) error
+	ResumedStepCheckpoint(atc.PlanID) (BuildStepCheckpoint, bool, error)
+
+	SaveTaskResult(stepName string, key string, outputs map[string]string) error
+	TaskResult(stepName string, key string) (map[string]string, bool, error)
+
+	SaveTestResults(stepName string, results []atc.TestResult) error
+	TestResults() ([]atc.TestResult, error)
+	SecretAccesses() ([]atc.SecretAccess, error)
+
 	IsDrained() bool
 	SetDrained(bool) error
 
+	EventsOffloaded() bool
+	MarkEventsOffloaded() error
+
 	SpanContext() propagation.TextMapCarrier
 
 	SavePipeline(
@@ -245,10 +276,20 @@ type build struct {
 
 	createdBy *string
 
+	params map[string]interface{}
+
+	triggerReason string
+	abortReason   string
+	priority      int
+
 	rerunOf     int
 	rerunOfName string
 	rerunNumber int
 
+	resumedFrom int
+
+	eventsOffloaded bool
+
 	schema      string
 	privatePlan atc.Plan
 	publicPlan  *json.RawMessage
@@ -274,6 +315,7 @@ func newEmptyBuild(conn Conn, lockFactory lock.LockFactory) *build {
 var ErrBuildDisappeared = errors.New("build disappeared from db")
 var ErrBuildHasNoPipeline = errors.New("build has no pipeline")
 var ErrBuildArtifactNotFound = errors.New("build artifact not found")
+var ErrBuildCannotBeResumed = errors.New("build cannot be resumed")
 
 type ResourceNotFoundInPipeline struct {
 	Resource string
@@ -379,6 +421,7 @@ func (b *build) Comment() string                  { return b.comment }
 func (b *build) Status() BuildStatus              { return b.status }
 func (b *build) IsScheduled() bool                { return b.scheduled }
 func (b *build) IsDrained() bool                  { return b.drained }
+func (b *build) EventsOffloaded() bool            { return b.eventsOffloaded }
 func (b *build) IsRunning() bool                  { return !b.completed }
 func (b *build) IsAborted() bool                  { return b.aborted }
 func (b *build) IsCompleted() bool                { return b.completed }
@@ -387,6 +430,11 @@ func (b *build) RerunOf() int                     { return b.rerunOf }
 func (b *build) RerunOfName() string              { return b.rerunOfName }
 func (b *build) RerunNumber() int                 { return b.rerunNumber }
 func (b *build) CreatedBy() *string               { return b.createdBy }
+func (b *build) Params() map[string]interface{}   { return b.params }
+func (b *build) TriggerReason() string            { return b.triggerReason }
+func (b *build) AbortReason() string              { return b.abortReason }
+func (b *build) Priority() int                    { return b.priority }
+func (b *build) ResumedFrom() int                 { return b.resumedFrom }
 
 func (b *build) isNewerThanLastCheckOf(input Resource) bool {
 	return b.createTime.After(input.LastCheckEndTime())
@@ -602,6 +650,18 @@ func (b *build) start(tx Tx, plan atc.Plan) (bool, error) {
 		return false, err
 	}
 
+	if b.jobID != 0 {
+		err = insertStepTimings(tx, b.id, plan)
+		if err != nil {
+			return false, err
+		}
+
+		err = enqueueNotifications(tx, b.pipelineID, b.teamID, b.id, b.jobName, atc.StatusStarted)
+		if err != nil {
+			return false, err
+		}
+	}
+
 	return true, nil
 }
 
@@ -615,12 +675,25 @@ func (b *build) Finish(status BuildStatus) error {
 
 	var endTime time.Time
 
-	err = psql.Update("builds").
+	// the plan of a failed job build is kept around so that the build can be
+	// resumed from the step that failed; it is cleared along with the build's
+	// artifacts once the resume window has passed
+	resumable := atc.BuildResumeWindow > 0 &&
+		b.jobID != 0 &&
+		(status == BuildStatusFailed || status == BuildStatusErrored)
+
+	builder := psql.Update("builds").
 		Set("status", status).
 		Set("end_time", sq.Expr("now()")).
-		Set("completed", true).
-		Set("private_plan", nil).
-		Set("nonce", nil).
+		Set("completed", true)
+
+	if !resumable {
+		builder = builder.
+			Set("private_plan", nil).
+			Set("nonce", nil)
+	}
+
+	err = builder.
 		Where(sq.Eq{"id": b.id}).
 		Suffix("RETURNING end_time").
 		RunWith(tx).
@@ -638,6 +711,25 @@ func (b *build) Finish(status BuildStatus) error {
 		return err
 	}
 
+	if b.jobID != 0 {
+		err = enqueueNotifications(tx, b.pipelineID, b.teamID, b.id, b.jobName, atc.BuildStatus(status))
+		if err != nil {
+			return err
+		}
+	}
+
+	if resumable {
+		err = b.retainStepCheckpoints(tx)
+	} else {
+		_, err = psql.Delete("build_step_checkpoints").
+			Where(sq.Eq{"build_id": b.id}).
+			RunWith(tx).
+			Exec()
+	}
+	if err != nil {
+		return err
+	}
+
 	if b.jobID != 0 && status == BuildStatusSucceeded {
 		_, err = psql.Delete("build_image_resource_caches").
 			Where(sq.And{
@@ -813,12 +905,18 @@ WITH RECURSIVE pipelines_to_archive AS (
 }
 
 // Variables creates variables for this build. If the build is a one-off build, it
-// just uses the global secrets manager. If it belongs to a pipeline, it combines
-// the global secrets manager with the pipeline's var_sources.
+// combines the global secrets manager with the team's var_sources. If it
+// belongs to a pipeline, the pipeline's var_sources are used as well. Every
+// secret looked up through the variables is recorded against the build.
 func (b *build) Variables(logger lager.Logger, globalSecrets creds.Secrets, varSourcePool creds.VarSourcePool) (vars.Variables, error) {
 	// "fly execute" generated build will have no pipeline.
 	if b.pipelineID == 0 {
-		return creds.NewVariables(globalSecrets, b.teamName, b.pipelineName, false), nil
+		teamVarSources, err := teamVarSources(b.conn, b.teamID)
+		if err != nil {
+			return nil, err
+		}
+
+		return newVariables(logger, globalSecrets, varSourcePool, b.teamName, b.pipelineName, teamVarSources, b.secretAccessRecorder(logger))
 	}
 	pipeline, found, err := b.Pipeline()
 	if err != nil {
@@ -828,7 +926,7 @@ func (b *build) Variables(logger lager.Logger, globalSecrets creds.Secrets, varS
 		return nil, errors.New("pipeline not found")
 	}
 
-	return pipeline.Variables(logger, globalSecrets, varSourcePool)
+	return pipelineVariables(logger, b.conn, pipeline, globalSecrets, varSourcePool, b.secretAccessRecorder(logger))
 }
 
 func (b *build) SetDrained(drained bool) error {
@@ -844,6 +942,43 @@ func (b *build) SetDrained(drained bool) error {
 	return err
 }
 
+// MarkEventsOffloaded deletes the build's events from the database once they
+// have been moved to an external store.
+func (b *build) MarkEventsOffloaded() error {
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Delete(b.eventsTable()).
+		Where(sq.Eq{"build_id": b.id}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Update("builds").
+		Set("events_offloaded", true).
+		Where(sq.Eq{"id": b.id}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	b.eventsOffloaded = true
+
+	return nil
+}
+
 func (b *build) Delete() (bool, error) {
 	rows, err := psql.Delete("builds").
 		Where(sq.Eq{
@@ -881,28 +1016,47 @@ func (b *build) MarkAsAborted() error {
 
 	defer Rollback(tx)
 
-	_, err = psql.Update("builds").
+	err = markBuildAsAborted(tx, b.id, b.jobID, b.status, "")
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return b.conn.Bus().Notify(buildAbortChannel(b.id))
+}
+
+// markBuildAsAborted marks the build as aborted, with the reason if given.
+// The job of a pending build is requested to be scheduled so that the build
+// gets finished. The caller is responsible for notifying the build's abort
+// channel once the transaction is committed.
+func markBuildAsAborted(tx Tx, buildID int, jobID int, status BuildStatus, reason string) error {
+	update := psql.Update("builds").
 		Set("aborted", true).
-		Where(sq.Eq{"id": b.id}).
+		Where(sq.Eq{"id": buildID})
+
+	if reason != "" {
+		update = update.Set("abort_reason", reason)
+	}
+
+	_, err := update.
 		RunWith(tx).
 		Exec()
 	if err != nil {
 		return err
 	}
 
-	if b.status == BuildStatusPending {
-		err = requestSchedule(tx, b.jobID)
+	if status == BuildStatusPending {
+		err = requestSchedule(tx, jobID)
 		if err != nil {
 			return err
 		}
 	}
 
-	err = tx.Commit()
-	if err != nil {
-		return err
-	}
-
-	return b.conn.Bus().Notify(buildAbortChannel(b.id))
+	return nil
 }
 
 // AbortNotifier returns a Notifier that can be watched for when the build
@@ -1857,13 +2011,13 @@ func newNullInt64(i int) sql.NullInt64 {
 
 func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy) error {
 	var (
-		jobID, resourceID, resourceTypeID, pipelineID, rerunOf, rerunNumber               sql.NullInt64
+		jobID, resourceID, resourceTypeID, pipelineID, rerunOf, rerunNumber, resumedFrom  sql.NullInt64
 		schema, privatePlan, jobName, resourceName, pipelineName, publicPlan, rerunOfName sql.NullString
 		createTime, startTime, endTime, reapTime                                          pq.NullTime
 		nonce, spanContext, createdBy                                                     sql.NullString
-		drained, aborted, completed                                                       bool
+		drained, aborted, completed, eventsOffloaded                                      bool
 		status                                                                            string
-		pipelineInstanceVars, comment                                                     sql.NullString
+		pipelineInstanceVars, comment, params, triggerReason, abortReason                 sql.NullString
 	)
 
 	err := row.Scan(
@@ -1900,6 +2054,12 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 		&rerunNumber,
 		&spanContext,
 		&comment,
+		&params,
+		&triggerReason,
+		&abortReason,
+		&b.priority,
+		&resumedFrom,
+		&eventsOffloaded,
 	)
 	if err != nil {
 		return err
@@ -1919,11 +2079,13 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 	b.endTime = endTime.Time
 	b.reapTime = reapTime.Time
 	b.drained = drained
+	b.eventsOffloaded = eventsOffloaded
 	b.aborted = aborted
 	b.completed = completed
 	b.rerunOf = int(rerunOf.Int64)
 	b.rerunOfName = rerunOfName.String
 	b.rerunNumber = int(rerunNumber.Int64)
+	b.resumedFrom = int(resumedFrom.Int64)
 	b.comment = comment.String
 
 	var (
@@ -1973,6 +2135,16 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 		b.createdBy = &createdBy.String
 	}
 
+	if params.Valid {
+		err = json.Unmarshal([]byte(params.String), &b.params)
+		if err != nil {
+			return err
+		}
+	}
+
+	b.triggerReason = triggerReason.String
+	b.abortReason = abortReason.String
+
 	return nil
 }
 
@@ -1989,12 +2161,33 @@ func (b *build) saveEvent(tx Tx, event atc.Event) error {
 		}
 	}
 
+	eventID := b.eventIdSeq.Next()
+
 	_, err = psql.Insert(b.eventsTable()).
 		Columns("event_id", "build_id", "type", "version", "payload").
-		Values(b.eventIdSeq.Next(), b.id, string(event.EventType()), string(event.Version()), payload).
+		Values(eventID, b.id, string(event.EventType()), string(event.Version()), payload).
 		RunWith(tx).
 		Exec()
-	return err
+	if err != nil {
+		return err
+	}
+
+	if b.isForCheck() {
+		return nil
+	}
+
+	if b.jobID != 0 {
+		err = recordStepTiming(tx, b.id, event)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !atc.EnableBuildLogSearch {
+		return nil
+	}
+
+	return indexBuildLogLines(tx, b.id, eventID, event)
 }
 
 func (b *build) isForCheck() bool {
@@ -0,0 +1,199 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/lib/pq"
+)
+
+// BuildApproval is a request made by an approve step for a user to approve or
+// reject the build.
+type BuildApproval struct {
+	PlanID      atc.PlanID
+	Name        string
+	Role        string
+	RequestedAt time.Time
+
+	Decided   bool
+	Approved  bool
+	DecidedBy string
+	DecidedAt time.Time
+}
+
+var buildApprovalsQuery = psql.Select(
+	"plan_id",
+	"name",
+	"role",
+	"requested_at",
+	"approved",
+	"decided_by",
+	"decided_at",
+).From("build_approvals")
+
+// RequestApproval records that the given step is waiting to be approved. If
+// the step has already requested approval, e.g. because the build is being
+// resumed, the existing request and any decision on it is kept.
+func (b *build) RequestApproval(planID atc.PlanID, name string, role string) error {
+	_, err := psql.Insert("build_approvals").
+		Columns("build_id", "plan_id", "name", "role").
+		Values(b.id, string(planID), name, role).
+		Suffix("ON CONFLICT (build_id, plan_id) DO NOTHING").
+		RunWith(b.conn).
+		Exec()
+	return err
+}
+
+func (b *build) Approval(planID atc.PlanID) (BuildApproval, bool, error) {
+	approval, err := scanBuildApproval(buildApprovalsQuery.
+		Where(sq.Eq{
+			"build_id": b.id,
+			"plan_id":  string(planID),
+		}).
+		RunWith(b.conn).
+		QueryRow())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return BuildApproval{}, false, nil
+		}
+
+		return BuildApproval{}, false, err
+	}
+
+	return approval, true, nil
+}
+
+// PendingApprovals returns the approval requests of the build which have not
+// been decided yet.
+func (b *build) PendingApprovals() ([]BuildApproval, error) {
+	rows, err := buildApprovalsQuery.
+		Where(sq.Eq{
+			"build_id":   b.id,
+			"decided_at": nil,
+		}).
+		OrderBy("requested_at").
+		RunWith(b.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var approvals []BuildApproval
+	for rows.Next() {
+		approval, err := scanBuildApproval(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		approvals = append(approvals, approval)
+	}
+
+	return approvals, nil
+}
+
+// DecideApproval approves or rejects a pending approval request, notifying
+// the step waiting on it. It returns false if the request does not exist or
+// has already been decided.
+func (b *build) DecideApproval(planID atc.PlanID, approved bool, decidedBy string) (bool, error) {
+	result, err := psql.Update("build_approvals").
+		Set("approved", approved).
+		Set("decided_by", decidedBy).
+		Set("decided_at", sq.Expr("now()")).
+		Where(sq.Eq{
+			"build_id":   b.id,
+			"plan_id":    string(planID),
+			"decided_at": nil,
+		}).
+		RunWith(b.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	if affected == 0 {
+		return false, nil
+	}
+
+	return true, b.conn.Bus().Notify(buildApprovalChannel(b.id))
+}
+
+// WithdrawApproval removes an approval request which has not been decided,
+// e.g. when the step waiting on it is interrupted.
+func (b *build) WithdrawApproval(planID atc.PlanID) error {
+	_, err := psql.Delete("build_approvals").
+		Where(sq.Eq{
+			"build_id":   b.id,
+			"plan_id":    string(planID),
+			"decided_at": nil,
+		}).
+		RunWith(b.conn).
+		Exec()
+	return err
+}
+
+// ApprovalNotifier notifies when the given approval request has been
+// decided.
+func (b *build) ApprovalNotifier(planID atc.PlanID) (Notifier, error) {
+	return newConditionNotifier(b.conn.Bus(), buildApprovalChannel(b.id), func() (bool, error) {
+		var decided bool
+		err := psql.Select("decided_at IS NOT NULL").
+			From("build_approvals").
+			Where(sq.Eq{
+				"build_id": b.id,
+				"plan_id":  string(planID),
+			}).
+			RunWith(b.conn).
+			QueryRow().
+			Scan(&decided)
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+
+		return decided, err
+	})
+}
+
+func scanBuildApproval(row scannable) (BuildApproval, error) {
+	var (
+		approval  BuildApproval
+		planID    string
+		approved  sql.NullBool
+		decidedBy sql.NullString
+		decidedAt pq.NullTime
+	)
+
+	err := row.Scan(
+		&planID,
+		&approval.Name,
+		&approval.Role,
+		&approval.RequestedAt,
+		&approved,
+		&decidedBy,
+		&decidedAt,
+	)
+	if err != nil {
+		return BuildApproval{}, err
+	}
+
+	approval.PlanID = atc.PlanID(planID)
+	approval.Decided = decidedAt.Valid
+	approval.Approved = approved.Bool
+	approval.DecidedBy = decidedBy.String
+	approval.DecidedAt = decidedAt.Time
+
+	return approval, nil
+}
+
+func buildApprovalChannel(buildID int) string {
+	return fmt.Sprintf("build_approval_%d", buildID)
+}
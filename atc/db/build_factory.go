@@ -10,6 +10,7 @@ import (
 	"code.cloudfoundry.org/lager"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db/lock"
 )
 
@@ -53,17 +54,26 @@ type BuildForAPI interface {
 	RerunOfName() string
 	RerunNumber() int
 	CreatedBy() *string
+	Params() map[string]interface{}
+	TriggerReason() string
+	AbortReason() string
+	Priority() int
 
 	IsDrained() bool
 	IsRunning() bool
+	EventsOffloaded() bool
 
 	Artifacts() ([]WorkerArtifact, error)
 	Events(uint) (EventSource, error)
 	Resources() ([]BuildInput, []BuildOutput, error)
 	Preparation() (BuildPreparation, bool, error)
+	TestResults() ([]atc.TestResult, error)
+	SecretAccesses() ([]atc.SecretAccess, error)
 
 	MarkAsAborted() error
 	SetComment(string) error
+	PendingApprovals() ([]BuildApproval, error)
+	DecideApproval(planID atc.PlanID, approved bool, decidedBy string) (bool, error)
 }
 
 //counterfeiter:generate . BuildFactory
@@ -76,6 +86,9 @@ type BuildFactory interface {
 	Build(int) (Build, bool, error)
 	GetAllStartedBuilds() ([]Build, error)
 	GetDrainableBuilds() ([]Build, error)
+	GetOffloadableBuilds(gracePeriod time.Duration, limit int, drainedOnly bool) ([]Build, error)
+
+	SearchBuildLogs(BuildLogSearch) ([]BuildLogMatch, error)
 
 	// TODO: move to BuildLifecycle, new interface (see WorkerLifecycle)
 	MarkNonInterceptibleBuilds() error
@@ -223,6 +236,33 @@ func (f *buildFactory) GetDrainableBuilds() ([]Build, error) {
 	return getBuilds(query, f.conn, f.lockFactory)
 }
 
+// GetOffloadableBuilds returns the oldest builds which completed longer than
+// the grace period ago and whose events are still in the database. If
+// drainedOnly is set, builds whose events have not been drained yet are left
+// out, so that they don't hold up the ones after them.
+func (f *buildFactory) GetOffloadableBuilds(gracePeriod time.Duration, limit int, drainedOnly bool) ([]Build, error) {
+	conditions := sq.Eq{
+		"b.completed":        true,
+		"b.events_offloaded": false,
+		"b.reap_time":        nil,
+		"b.resource_id":      nil,
+		"b.resource_type_id": nil,
+	}
+
+	if drainedOnly {
+		conditions["b.drained"] = true
+	}
+
+	query := buildsQuery.Where(sq.And{
+		conditions,
+		sq.Expr(fmt.Sprintf("now() - b.end_time > '%d seconds'::interval", int(gracePeriod.Seconds()))),
+	}).
+		OrderBy("b.end_time ASC").
+		Limit(uint64(limit))
+
+	return getBuilds(query, f.conn, f.lockFactory)
+}
+
 func (f *buildFactory) GetAllStartedBuilds() ([]Build, error) {
 	query := buildsQuery.Where(sq.Eq{
 		"b.status": BuildStatusStarted,
@@ -7,6 +7,8 @@ import (
 	"code.cloudfoundry.org/lager/lagerctx"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/lib/pq"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/types"
@@ -507,6 +509,227 @@ var _ = Describe("BuildFactory", func() {
 		})
 	})
 
+	Describe("GetOffloadableBuilds", func() {
+		var oldBuild, newBuild, offloadedBuild db.Build
+
+		BeforeEach(func() {
+			var err error
+			oldBuild, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			newBuild, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			offloadedBuild, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			for _, build := range []db.Build{oldBuild, newBuild, offloadedBuild} {
+				err = build.Finish(db.BuildStatusSucceeded)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			err = offloadedBuild.MarkEventsOffloaded()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = dbConn.Exec(`UPDATE builds SET end_time = now() - '2 hours'::interval WHERE id = ANY($1)`, pq.Array([]int{oldBuild.ID(), offloadedBuild.ID()}))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns the completed builds whose events are still in the database and which finished before the grace period", func() {
+			builds, err := buildFactory.GetOffloadableBuilds(time.Hour, 10, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			var buildIds []int
+			for _, build := range builds {
+				buildIds = append(buildIds, build.ID())
+			}
+
+			Expect(buildIds).To(ConsistOf(oldBuild.ID()))
+		})
+
+		Context("when only drained builds are wanted", func() {
+			It("leaves out the builds which have not been drained", func() {
+				builds, err := buildFactory.GetOffloadableBuilds(time.Hour, 10, true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(builds).To(BeEmpty())
+
+				err = oldBuild.SetDrained(true)
+				Expect(err).NotTo(HaveOccurred())
+
+				builds, err = buildFactory.GetOffloadableBuilds(time.Hour, 10, true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(builds).To(HaveLen(1))
+				Expect(builds[0].ID()).To(Equal(oldBuild.ID()))
+			})
+		})
+	})
+
+	Describe("SearchBuildLogs", func() {
+		var failedBuild, succeededBuild, oneOffBuild db.Build
+
+		BeforeEach(func() {
+			atc.EnableBuildLogSearch = true
+
+			var err error
+			failedBuild, err = defaultJob.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).NotTo(HaveOccurred())
+
+			succeededBuild, err = defaultJob.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).NotTo(HaveOccurred())
+
+			oneOffBuild, err = defaultTeam.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			for _, build := range []db.Build{failedBuild, succeededBuild, oneOffBuild} {
+				started, err := build.Start(atc.Plan{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(started).To(BeTrue())
+			}
+
+			err = failedBuild.SaveEvent(event.Log{Payload: "fetching\n\x1b[31mdial tcp: connection refused\x1b[0m\nretrying\n"})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = failedBuild.SaveEvent(event.Log{Payload: "connection refused again"})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = succeededBuild.SaveEvent(event.Log{Payload: "refused connection, but recovered"})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = oneOffBuild.SaveEvent(event.Log{Payload: "connection refused"})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = failedBuild.Finish(db.BuildStatusFailed)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = succeededBuild.Finish(db.BuildStatusSucceeded)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			atc.EnableBuildLogSearch = false
+		})
+
+		search := func(search db.BuildLogSearch) map[int][]string {
+			matches, err := buildFactory.SearchBuildLogs(search)
+			Expect(err).NotTo(HaveOccurred())
+
+			lines := map[int][]string{}
+			for _, match := range matches {
+				lines[match.Build.ID()] = []string{}
+				for _, line := range match.Lines {
+					lines[match.Build.ID()] = append(lines[match.Build.ID()], line.Line)
+				}
+			}
+
+			return lines
+		}
+
+		It("returns the builds of the team with matching lines", func() {
+			Expect(search(db.BuildLogSearch{
+				Query:  "connection refused",
+				TeamID: defaultTeam.ID(),
+			})).To(Equal(map[int][]string{
+				failedBuild.ID(): {"dial tcp: connection refused", "connection refused again"},
+				oneOffBuild.ID(): {"connection refused"},
+			}))
+		})
+
+		It("does not return the builds of other teams", func() {
+			Expect(search(db.BuildLogSearch{
+				Query:  "connection refused",
+				TeamID: team.ID(),
+			})).To(BeEmpty())
+		})
+
+		It("filters by pipeline, job and status", func() {
+			Expect(search(db.BuildLogSearch{
+				Query:      "connection",
+				TeamID:     defaultTeam.ID(),
+				PipelineID: defaultPipeline.ID(),
+				JobID:      defaultJob.ID(),
+				Statuses:   []db.BuildStatus{db.BuildStatusSucceeded},
+			})).To(Equal(map[int][]string{
+				succeededBuild.ID(): {"refused connection, but recovered"},
+			}))
+		})
+
+		It("filters by start time", func() {
+			Expect(search(db.BuildLogSearch{
+				Query:  "connection refused",
+				TeamID: defaultTeam.ID(),
+				Since:  time.Now().Add(time.Hour),
+			})).To(BeEmpty())
+		})
+
+		It("limits the number of builds, newest first", func() {
+			Expect(search(db.BuildLogSearch{
+				Query:  "connection refused",
+				TeamID: defaultTeam.ID(),
+				Limit:  1,
+			})).To(HaveKey(oneOffBuild.ID()))
+		})
+
+		Context("when only public jobs are searched", func() {
+			It("does not return the builds of private jobs", func() {
+				Expect(search(db.BuildLogSearch{
+					Query:          "connection refused",
+					TeamID:         defaultTeam.ID(),
+					PipelineID:     defaultPipeline.ID(),
+					PublicJobsOnly: true,
+				})).To(BeEmpty())
+			})
+		})
+
+		Context("when the builds' events are reaped", func() {
+			BeforeEach(func() {
+				err := defaultPipeline.DeleteBuildEventsByBuildIDs([]int{failedBuild.ID()})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("no longer returns them", func() {
+				Expect(search(db.BuildLogSearch{
+					Query:  "connection refused",
+					TeamID: defaultTeam.ID(),
+				})).To(Equal(map[int][]string{
+					oneOffBuild.ID(): {"connection refused"},
+				}))
+			})
+		})
+
+		Context("when the builds' events are offloaded", func() {
+			BeforeEach(func() {
+				err := failedBuild.MarkEventsOffloaded()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("still returns them", func() {
+				Expect(search(db.BuildLogSearch{
+					Query:  "connection refused",
+					TeamID: defaultTeam.ID(),
+				})).To(HaveKey(failedBuild.ID()))
+			})
+		})
+
+		Context("when build log search is disabled", func() {
+			BeforeEach(func() {
+				atc.EnableBuildLogSearch = false
+
+				err := oneOffBuild.SaveEvent(event.Log{Payload: "no route to host"})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("does not index the lines", func() {
+				Expect(search(db.BuildLogSearch{
+					Query:  "no route to host",
+					TeamID: defaultTeam.ID(),
+				})).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("GetAllStartedBuilds", func() {
 		var build1DB db.Build
 		var build2DB db.Build
@@ -71,9 +71,14 @@ func (b *inMemoryCheckBuildForApi) StartTime() time.Time              { return b
 func (b *inMemoryCheckBuildForApi) EndTime() time.Time                { return b.endTime }
 func (b *inMemoryCheckBuildForApi) Status() BuildStatus               { return b.status }
 func (b *inMemoryCheckBuildForApi) CreatedBy() *string                { return nil }
+func (b *inMemoryCheckBuildForApi) Params() map[string]interface{}    { return nil }
+func (b *inMemoryCheckBuildForApi) TriggerReason() string             { return "" }
+func (b *inMemoryCheckBuildForApi) AbortReason() string               { return "" }
+func (b *inMemoryCheckBuildForApi) Priority() int                     { return 0 }
 func (b *inMemoryCheckBuildForApi) Schema() string                    { return schema }
 func (b *inMemoryCheckBuildForApi) IsRunning() bool                   { return b.status == BuildStatusStarted }
 func (b *inMemoryCheckBuildForApi) IsDrained() bool                   { return false }
+func (b *inMemoryCheckBuildForApi) EventsOffloaded() bool             { return false }
 func (b *inMemoryCheckBuildForApi) PipelineInstanceVars() atc.InstanceVars {
 	return b.checkable.PipelineInstanceVars()
 }
@@ -255,9 +260,18 @@ func (b *inMemoryCheckBuildForApi) MarkAsAborted() error {
 func (b *inMemoryCheckBuildForApi) Preparation() (BuildPreparation, bool, error) {
 	return BuildPreparation{}, false, errors.New("not implemented for in memory build")
 }
+func (b *inMemoryCheckBuildForApi) TestResults() ([]atc.TestResult, error) {
+	return []atc.TestResult{}, nil
+}
 func (b *inMemoryCheckBuildForApi) SetComment(string) error {
 	return errors.New("not implemented for in memory build")
 }
+func (b *inMemoryCheckBuildForApi) PendingApprovals() ([]BuildApproval, error) {
+	return nil, nil
+}
+func (b *inMemoryCheckBuildForApi) DecideApproval(atc.PlanID, bool, string) (bool, error) {
+	return false, errors.New("not implemented for in memory build")
+}
 
 // inMemoryCheckBuild implements db.Build. It handles in-memory check builds
 // only, thus it just implement the necessary function of interface Build.
@@ -275,6 +289,8 @@ type inMemoryCheckBuild struct {
 
 	cacheEvents []atc.Event
 	eventIdSeq  util.SequenceGenerator
+
+	secretAccesses *buildSecretAccessRecorder
 }
 
 func newRunningInMemoryCheckBuild(conn Conn, lockFactory lock.LockFactory, checkable Checkable, plan atc.Plan, spanContext SpanContext, seqGen util.SequenceGenerator) (*inMemoryCheckBuild, error) {
@@ -516,7 +532,11 @@ func (b *inMemoryCheckBuild) Variables(logger lager.Logger, secrets creds.Secret
 		return nil, errors.New("pipeline not found")
 	}
 
-	return pipeline.Variables(logger, secrets, varSourcePool)
+	// the build has no id until it is saved to the database, so its secret
+	// accesses are held onto until then
+	b.secretAccesses = newBuildSecretAccessRecorder(logger, b.conn, b.id)
+
+	return pipelineVariables(logger, b.conn, pipeline, secrets, varSourcePool, b.secretAccesses)
 }
 
 func (b *inMemoryCheckBuild) SaveEvent(ev atc.Event) error {
@@ -554,6 +574,36 @@ func (b *inMemoryCheckBuild) AbortNotifier() (Notifier, error) {
 	return nil, nil
 }
 
+func (b *inMemoryCheckBuild) RequestApproval(atc.PlanID, string, string) error {
+	return errors.New("not implemented for in memory build")
+}
+func (b *inMemoryCheckBuild) Approval(atc.PlanID) (BuildApproval, bool, error) {
+	return BuildApproval{}, false, errors.New("not implemented for in memory build")
+}
+func (b *inMemoryCheckBuild) WithdrawApproval(atc.PlanID) error {
+	return errors.New("not implemented for in memory build")
+}
+func (b *inMemoryCheckBuild) ApprovalNotifier(atc.PlanID) (Notifier, error) {
+	return nil, errors.New("not implemented for in memory build")
+}
+
+func (b *inMemoryCheckBuild) ResumedFrom() int { return 0 }
+func (b *inMemoryCheckBuild) SaveStepCheckpoint(BuildStepCheckpoint) error {
+	return errors.New("not implemented for in memory build")
+}
+func (b *inMemoryCheckBuild) ResumedStepCheckpoint(atc.PlanID) (BuildStepCheckpoint, bool, error) {
+	return BuildStepCheckpoint{}, false, nil
+}
+func (b *inMemoryCheckBuild) SaveTaskResult(string, string, map[string]string) error {
+	return errors.New("not implemented for in memory build")
+}
+func (b *inMemoryCheckBuild) TaskResult(string, string) (map[string]string, bool, error) {
+	return nil, false, nil
+}
+func (b *inMemoryCheckBuild) SaveTestResults(string, []atc.TestResult) error {
+	return errors.New("not implemented for in memory build")
+}
+
 // ResourceCacheUser will use in-memory build's preId as key in order to avoid unnecessary
 // db init. To ensure preId is unique across all ATCs, also use build's create time in
 // the key.
@@ -592,6 +642,13 @@ func (b *inMemoryCheckBuild) initDbStuff(tx Tx) error {
 		}
 	}
 
+	if b.secretAccesses != nil {
+		err := b.secretAccesses.flush(tx, b.id)
+		if err != nil {
+			return err
+		}
+	}
+
 	if b.resourceId != 0 {
 		_, err := psql.Update("resources").
 			Set("in_memory_build_id", b.id).
@@ -642,6 +699,9 @@ func (b *inMemoryCheckBuild) InputsReady() bool     { return false }
 func (b *inMemoryCheckBuild) SetDrained(bool) error {
 	return errors.New("not implemented for in memory build")
 }
+func (b *inMemoryCheckBuild) MarkEventsOffloaded() error {
+	return errors.New("not implemented for in memory build")
+}
 func (b *inMemoryCheckBuild) Delete() (bool, error) {
 	return false, errors.New("not implemented for in memory build")
 }
@@ -0,0 +1,186 @@
+package db
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/lib/pq"
+)
+
+// maxBuildLogSearchLines is the number of matching lines returned for each
+// build found by a search.
+const maxBuildLogSearchLines = 5
+
+var ansiEscapeRegexp = regexp.MustCompile(`\x1b\[[0-9;?]*[A-Za-z]`)
+
+// BuildLogSearch describes a search of the log output of a team's builds.
+// Builds are filtered by the pipeline and job, if set, and by their status
+// and start time.
+type BuildLogSearch struct {
+	Query string
+
+	TeamID     int
+	PipelineID int
+	JobID      int
+
+	Statuses []BuildStatus
+	Since    time.Time
+	Until    time.Time
+
+	// PublicJobsOnly limits the search to builds of public jobs, for
+	// requesters who are not members of the team.
+	PublicJobsOnly bool
+
+	Limit int
+}
+
+// BuildLogMatch is a build whose log output matched a search, along with
+// the first few matching lines.
+type BuildLogMatch struct {
+	Build BuildForAPI
+	Lines []BuildLogLine
+}
+
+type BuildLogLine struct {
+	EventID int
+	Line    string
+}
+
+func (f *buildFactory) SearchBuildLogs(search BuildLogSearch) ([]BuildLogMatch, error) {
+	query := buildsQuery.
+		Where(sq.Eq{"b.team_id": search.TeamID}).
+		Where(sq.Expr(`EXISTS (
+			SELECT 1
+			FROM build_log_lines l
+			WHERE l.build_id = b.id
+			AND to_tsvector('simple', l.line) @@ phraseto_tsquery('simple', ?)
+		)`, search.Query)).
+		OrderBy("b.id DESC")
+
+	if search.PipelineID != 0 {
+		query = query.Where(sq.Eq{"b.pipeline_id": search.PipelineID})
+	}
+
+	if search.JobID != 0 {
+		query = query.Where(sq.Eq{"b.job_id": search.JobID})
+	}
+
+	if len(search.Statuses) != 0 {
+		statuses := make([]string, len(search.Statuses))
+		for i, status := range search.Statuses {
+			statuses[i] = string(status)
+		}
+
+		query = query.Where(sq.Eq{"b.status": statuses})
+	}
+
+	if !search.Since.IsZero() {
+		query = query.Where(sq.GtOrEq{"b.start_time": search.Since})
+	}
+
+	if !search.Until.IsZero() {
+		query = query.Where(sq.LtOrEq{"b.start_time": search.Until})
+	}
+
+	if search.PublicJobsOnly {
+		query = query.Where(sq.Eq{"j.public": true})
+	}
+
+	if search.Limit != 0 {
+		query = query.Limit(uint64(search.Limit))
+	}
+
+	builds, err := getBuilds(query, f.conn, f.lockFactory)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(builds) == 0 {
+		return []BuildLogMatch{}, nil
+	}
+
+	matches := make([]BuildLogMatch, len(builds))
+	matchIndexes := map[int]int{}
+	buildIDs := make([]int, len(builds))
+	for i, build := range builds {
+		matches[i] = BuildLogMatch{Build: build}
+		matchIndexes[build.ID()] = i
+		buildIDs[i] = build.ID()
+	}
+
+	rows, err := f.conn.Query(`
+		SELECT build_id, event_id, line
+		FROM (
+			SELECT build_id, event_id, line, row_number() OVER (PARTITION BY build_id ORDER BY event_id) AS n
+			FROM build_log_lines
+			WHERE build_id = ANY($1)
+			AND to_tsvector('simple', line) @@ phraseto_tsquery('simple', $2)
+		) matching_lines
+		WHERE n <= $3
+		ORDER BY build_id, event_id
+	`, pq.Array(buildIDs), search.Query, maxBuildLogSearchLines)
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	for rows.Next() {
+		var buildID int
+		var line BuildLogLine
+		err = rows.Scan(&buildID, &line.EventID, &line.Line)
+		if err != nil {
+			return nil, err
+		}
+
+		i := matchIndexes[buildID]
+		matches[i].Lines = append(matches[i].Lines, line)
+	}
+
+	return matches, rows.Err()
+}
+
+// indexBuildLogLines makes the lines of a log event searchable. Escape
+// sequences are removed so that colored output matches plain queries.
+//
+// The lines are a copy of the log output, and unlike the events they are not
+// removed when the events are offloaded, so that offloaded builds can still
+// be searched. This is why indexing has to be enabled. They are removed along
+// with the events when the build is reaped.
+func indexBuildLogLines(tx Tx, buildID int, eventID int, ev atc.Event) error {
+	var payload string
+	switch log := ev.(type) {
+	case event.Log:
+		payload = log.Payload
+	case *event.Log:
+		payload = log.Payload
+	default:
+		return nil
+	}
+
+	insert := psql.Insert("build_log_lines").
+		Columns("build_id", "event_id", "line")
+
+	var lines int
+	for _, line := range strings.Split(payload, "\n") {
+		line = ansiEscapeRegexp.ReplaceAllString(line, "")
+		line = strings.TrimSpace(strings.ReplaceAll(line, "\x00", ""))
+		if line == "" {
+			continue
+		}
+
+		insert = insert.Values(buildID, eventID, line)
+		lines++
+	}
+
+	if lines == 0 {
+		return nil
+	}
+
+	_, err := insert.RunWith(tx).Exec()
+	return err
+}
@@ -0,0 +1,337 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+// saveNotifications stores the pipeline's notification endpoints. They are
+// encrypted, as the endpoints' secrets may be given literally.
+func saveNotifications(tx Tx, notifications atc.NotificationConfigs, pipelineID int) error {
+	_, err := psql.Delete("pipeline_notifications").
+		Where(sq.Eq{"pipeline_id": pipelineID}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(notifications)
+	if err != nil {
+		return err
+	}
+
+	encryptedPayload, nonce, err := tx.EncryptionStrategy().Encrypt(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("pipeline_notifications").
+		Columns("pipeline_id", "config", "nonce").
+		Values(pipelineID, encryptedPayload, nonce).
+		RunWith(tx).
+		Exec()
+	return err
+}
+
+// enqueueNotifications queues a delivery for each of the pipeline's
+// notification endpoints interested in the build changing to the given
+// status, including those configured for the pipeline's whole team. The
+// deliveries are made asynchronously so that the build is never held up by a
+// slow or unavailable endpoint.
+func enqueueNotifications(tx Tx, pipelineID int, teamID int, buildID int, jobName string, status atc.BuildStatus) error {
+	pipelineNotifications, err := pipelineNotifications(tx, pipelineID)
+	if err != nil {
+		return err
+	}
+
+	teamNotifications, err := teamNotifications(tx, tx.EncryptionStrategy(), teamID)
+	if err != nil {
+		return err
+	}
+
+	notifications := mergeNotifications(teamNotifications, pipelineNotifications)
+
+	insert := psql.Insert("build_notifications").
+		Columns("build_id", "pipeline_id", "notification", "status")
+
+	var queued int
+	for _, notification := range notifications {
+		if !notification.Notifies(jobName, status) {
+			continue
+		}
+
+		insert = insert.Values(buildID, pipelineID, notification.Name, string(status))
+		queued++
+	}
+
+	if queued == 0 {
+		return nil
+	}
+
+	_, err = insert.RunWith(tx).Exec()
+	return err
+}
+
+func pipelineNotifications(tx Tx, pipelineID int) (atc.NotificationConfigs, error) {
+	var config string
+	var nonce sql.NullString
+	err := psql.Select("config", "nonce").
+		From("pipeline_notifications").
+		Where(sq.Eq{"pipeline_id": pipelineID}).
+		RunWith(tx).
+		QueryRow().
+		Scan(&config, &nonce)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var nonceStr *string
+	if nonce.Valid {
+		nonceStr = &nonce.String
+	}
+
+	decrypted, err := tx.EncryptionStrategy().Decrypt(config, nonceStr)
+	if err != nil {
+		return nil, err
+	}
+
+	var notifications atc.NotificationConfigs
+	err = json.Unmarshal(decrypted, &notifications)
+	if err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+// LookupNotification returns the notification with the given name, which is
+// either the pipeline's own or, failing that, one configured for its team.
+func (p *pipeline) LookupNotification(name string) (atc.NotificationConfig, bool, error) {
+	if notification, found := p.notifications.Lookup(name); found {
+		return notification, true, nil
+	}
+
+	notifications, err := teamNotifications(p.conn, p.conn.EncryptionStrategy(), p.teamID)
+	if err != nil {
+		return atc.NotificationConfig{}, false, err
+	}
+
+	notification, found := notifications.Lookup(name)
+	return notification, found, nil
+}
+
+//counterfeiter:generate . BuildNotificationFactory
+type BuildNotificationFactory interface {
+	DueNotifications(limit int) ([]BuildNotification, error)
+}
+
+type buildNotificationFactory struct {
+	conn Conn
+}
+
+func NewBuildNotificationFactory(conn Conn) BuildNotificationFactory {
+	return &buildNotificationFactory{
+		conn: conn,
+	}
+}
+
+// DueNotifications returns the pending notification deliveries whose next
+// attempt is due, oldest first.
+func (f *buildNotificationFactory) DueNotifications(limit int) ([]BuildNotification, error) {
+	rows, err := psql.Select("id", "build_id", "pipeline_id", "notification", "status", "attempts").
+		From("build_notifications").
+		Where(sq.And{
+			sq.Eq{"state": string(atc.NotificationDeliveryPending)},
+			sq.Expr("next_attempt_at <= now()"),
+		}).
+		OrderBy("id ASC").
+		Limit(uint64(limit)).
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var notifications []BuildNotification
+	for rows.Next() {
+		n := &buildNotification{conn: f.conn}
+
+		var status string
+		err = rows.Scan(&n.id, &n.buildID, &n.pipelineID, &n.notification, &status, &n.attempts)
+		if err != nil {
+			return nil, err
+		}
+
+		n.status = atc.BuildStatus(status)
+
+		notifications = append(notifications, n)
+	}
+
+	return notifications, rows.Err()
+}
+
+//counterfeiter:generate . BuildNotification
+type BuildNotification interface {
+	ID() int
+	BuildID() int
+	PipelineID() int
+	Notification() string
+	Status() atc.BuildStatus
+	Attempts() int
+
+	Delivered(responseCode int) error
+	Retry(responseCode int, reason string, at time.Time) error
+	Fail(responseCode int, reason string) error
+}
+
+type buildNotification struct {
+	id           int
+	buildID      int
+	pipelineID   int
+	notification string
+	status       atc.BuildStatus
+	attempts     int
+
+	conn Conn
+}
+
+func (n *buildNotification) ID() int                 { return n.id }
+func (n *buildNotification) BuildID() int            { return n.buildID }
+func (n *buildNotification) PipelineID() int         { return n.pipelineID }
+func (n *buildNotification) Notification() string    { return n.notification }
+func (n *buildNotification) Status() atc.BuildStatus { return n.status }
+func (n *buildNotification) Attempts() int           { return n.attempts }
+
+func (n *buildNotification) Delivered(responseCode int) error {
+	return n.update(map[string]interface{}{
+		"state":         string(atc.NotificationDeliveryDelivered),
+		"response_code": newNullInt64(responseCode),
+		"error":         nil,
+		"delivered_at":  sq.Expr("now()"),
+	})
+}
+
+func (n *buildNotification) Retry(responseCode int, reason string, at time.Time) error {
+	return n.update(map[string]interface{}{
+		"response_code":   newNullInt64(responseCode),
+		"error":           reason,
+		"next_attempt_at": at,
+	})
+}
+
+func (n *buildNotification) Fail(responseCode int, reason string) error {
+	return n.update(map[string]interface{}{
+		"state":         string(atc.NotificationDeliveryFailed),
+		"response_code": newNullInt64(responseCode),
+		"error":         reason,
+	})
+}
+
+func (n *buildNotification) update(values map[string]interface{}) error {
+	_, err := psql.Update("build_notifications").
+		SetMap(values).
+		Set("attempts", sq.Expr("attempts + 1")).
+		Where(sq.Eq{"id": n.id}).
+		RunWith(n.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	n.attempts++
+
+	return nil
+}
+
+// NotificationDeliveries returns the most recent deliveries of the
+// pipeline's build notifications.
+func (p *pipeline) NotificationDeliveries(limit int) ([]atc.NotificationDelivery, error) {
+	rows, err := psql.Select(
+		"n.id",
+		"n.notification",
+		"n.build_id",
+		"b.name",
+		"j.name",
+		"n.status",
+		"n.state",
+		"n.attempts",
+		"n.response_code",
+		"n.error",
+		"n.created_at",
+		"n.delivered_at",
+	).
+		From("build_notifications n").
+		Join("builds b ON b.id = n.build_id").
+		LeftJoin("jobs j ON j.id = b.job_id").
+		Where(sq.Eq{"n.pipeline_id": p.id}).
+		OrderBy("n.id DESC").
+		Limit(uint64(limit)).
+		RunWith(p.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	deliveries := []atc.NotificationDelivery{}
+	for rows.Next() {
+		var (
+			delivery     atc.NotificationDelivery
+			jobName      sql.NullString
+			status       string
+			state        string
+			responseCode sql.NullInt64
+			reason       sql.NullString
+			createdAt    time.Time
+			deliveredAt  sql.NullTime
+		)
+
+		err = rows.Scan(
+			&delivery.ID,
+			&delivery.Notification,
+			&delivery.BuildID,
+			&delivery.BuildName,
+			&jobName,
+			&status,
+			&state,
+			&delivery.Attempts,
+			&responseCode,
+			&reason,
+			&createdAt,
+			&deliveredAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		delivery.JobName = jobName.String
+		delivery.Status = atc.BuildStatus(status)
+		delivery.State = atc.NotificationDeliveryState(state)
+		delivery.ResponseCode = int(responseCode.Int64)
+		delivery.Error = reason.String
+		delivery.CreatedAt = createdAt.Unix()
+
+		if deliveredAt.Valid {
+			delivery.DeliveredAt = deliveredAt.Time.Unix()
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, rows.Err()
+}
@@ -0,0 +1,242 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BuildNotification", func() {
+	var (
+		pipeline            db.Pipeline
+		job                 db.Job
+		notificationFactory db.BuildNotificationFactory
+	)
+
+	BeforeEach(func() {
+		config := defaultPipelineConfig
+		config.Notifications = atc.NotificationConfigs{
+			{
+				Name:   "all-builds",
+				URL:    "https://example.com/all",
+				Secret: "some-secret",
+			},
+			{
+				Name:     "failures",
+				URL:      "https://example.com/failures",
+				Statuses: []atc.BuildStatus{atc.StatusFailed, atc.StatusErrored},
+			},
+		}
+
+		var err error
+		pipeline, _, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "notifying-pipeline"}, config, db.ConfigVersion(0), false)
+		Expect(err).NotTo(HaveOccurred())
+
+		var found bool
+		job, found, err = pipeline.Job("some-job")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found).To(BeTrue())
+
+		notificationFactory = db.NewBuildNotificationFactory(dbConn)
+	})
+
+	It("saves the notifications with the pipeline's config", func() {
+		Expect(pipeline.Notifications()).To(HaveLen(2))
+
+		config, err := pipeline.Config()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Notifications[0].Secret).To(Equal("some-secret"))
+	})
+
+	Context("when a build of the pipeline starts and fails", func() {
+		var build db.Build
+
+		BeforeEach(func() {
+			var err error
+			build, err = job.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).NotTo(HaveOccurred())
+
+			started, err := build.Start(atc.Plan{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(started).To(BeTrue())
+
+			err = build.Finish(db.BuildStatusFailed)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("queues a delivery for each interested notification", func() {
+			notifications, err := notificationFactory.DueNotifications(10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(notifications).To(HaveLen(3))
+
+			type queued struct {
+				name   string
+				status atc.BuildStatus
+			}
+
+			var all []queued
+			for _, n := range notifications {
+				Expect(n.BuildID()).To(Equal(build.ID()))
+				Expect(n.PipelineID()).To(Equal(pipeline.ID()))
+				all = append(all, queued{n.Notification(), n.Status()})
+			}
+
+			Expect(all).To(ConsistOf(
+				queued{"all-builds", atc.StatusStarted},
+				queued{"all-builds", atc.StatusFailed},
+				queued{"failures", atc.StatusFailed},
+			))
+		})
+
+		It("logs the deliveries of the pipeline", func() {
+			notifications, err := notificationFactory.DueNotifications(10)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = notifications[0].Delivered(200)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = notifications[1].Retry(502, "bad gateway", time.Now().Add(time.Hour))
+			Expect(err).NotTo(HaveOccurred())
+
+			err = notifications[2].Fail(0, "no such host")
+			Expect(err).NotTo(HaveOccurred())
+
+			due, err := notificationFactory.DueNotifications(10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(due).To(BeEmpty())
+
+			deliveries, err := pipeline.NotificationDeliveries(10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deliveries).To(HaveLen(3))
+
+			Expect(deliveries[2].ID).To(Equal(notifications[0].ID()))
+			Expect(deliveries[2].State).To(Equal(atc.NotificationDeliveryDelivered))
+			Expect(deliveries[2].ResponseCode).To(Equal(200))
+			Expect(deliveries[2].Attempts).To(Equal(1))
+			Expect(deliveries[2].DeliveredAt).NotTo(BeZero())
+			Expect(deliveries[2].JobName).To(Equal("some-job"))
+			Expect(deliveries[2].BuildName).To(Equal(build.Name()))
+
+			Expect(deliveries[1].State).To(Equal(atc.NotificationDeliveryPending))
+			Expect(deliveries[1].ResponseCode).To(Equal(502))
+			Expect(deliveries[1].Error).To(Equal("bad gateway"))
+
+			Expect(deliveries[0].State).To(Equal(atc.NotificationDeliveryFailed))
+			Expect(deliveries[0].Error).To(Equal("no such host"))
+		})
+	})
+
+	Context("when the team has notifications of its own", func() {
+		BeforeEach(func() {
+			err := defaultTeam.UpdateNotifications(atc.NotificationConfigs{
+				{
+					Name:     "team-wide",
+					URL:      "https://example.com/team",
+					Statuses: []atc.BuildStatus{atc.StatusSucceeded},
+				},
+				{
+					Name: "failures",
+					URL:  "https://example.com/team-failures",
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("saves them with the team", func() {
+			notifications, err := defaultTeam.Notifications()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(notifications).To(HaveLen(2))
+		})
+
+		It("queues deliveries for them too, unless the pipeline overrides them", func() {
+			build, err := job.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = build.Start(atc.Plan{})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.Finish(db.BuildStatusSucceeded)
+			Expect(err).NotTo(HaveOccurred())
+
+			notifications, err := notificationFactory.DueNotifications(10)
+			Expect(err).NotTo(HaveOccurred())
+
+			var names []string
+			for _, n := range notifications {
+				names = append(names, n.Notification())
+			}
+
+			Expect(names).To(ConsistOf("all-builds", "all-builds", "team-wide"))
+		})
+
+		It("looks up the pipeline's notification before the team's", func() {
+			notification, found, err := pipeline.LookupNotification("failures")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(notification.URL).To(Equal("https://example.com/failures"))
+
+			notification, found, err = pipeline.LookupNotification("team-wide")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(notification.URL).To(Equal("https://example.com/team"))
+		})
+	})
+
+	Context("when the build's events are reaped", func() {
+		var build db.Build
+
+		BeforeEach(func() {
+			var err error
+			build, err = job.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = build.Start(atc.Plan{})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.Finish(db.BuildStatusSucceeded)
+			Expect(err).NotTo(HaveOccurred())
+
+			notifications, err := notificationFactory.DueNotifications(10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(notifications).To(HaveLen(2))
+
+			err = notifications[0].Delivered(200)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = pipeline.DeleteBuildEventsByBuildIDs([]int{build.ID()})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("deletes the build's finished deliveries but keeps the pending ones", func() {
+			deliveries, err := pipeline.NotificationDeliveries(10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deliveries).To(HaveLen(1))
+			Expect(deliveries[0].State).To(Equal(atc.NotificationDeliveryPending))
+		})
+	})
+
+	Context("when the notifications are removed from the pipeline", func() {
+		BeforeEach(func() {
+			var err error
+			pipeline, _, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "notifying-pipeline"}, defaultPipelineConfig, pipeline.ConfigVersion(), false)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("no longer queues deliveries", func() {
+			Expect(pipeline.Notifications()).To(BeEmpty())
+
+			build, err := job.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = build.Start(atc.Plan{})
+			Expect(err).NotTo(HaveOccurred())
+
+			notifications, err := notificationFactory.DueNotifications(10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(notifications).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,141 @@
+package db
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+// buildSecretAccessRecorder records the secrets looked up by a build. Only
+// the paths of the secrets are recorded, never their values.
+//
+// Each distinct access is recorded once. Accesses made before the build has
+// an id are held onto until the recorder is flushed.
+type buildSecretAccessRecorder struct {
+	logger lager.Logger
+	conn   Conn
+
+	lock     sync.Mutex
+	buildID  int
+	recorded map[atc.SecretAccess]bool
+	pending  []atc.SecretAccess
+}
+
+func newBuildSecretAccessRecorder(logger lager.Logger, conn Conn, buildID int) *buildSecretAccessRecorder {
+	return &buildSecretAccessRecorder{
+		logger:   logger.Session("secret-accesses", lager.Data{"build": buildID}),
+		conn:     conn,
+		buildID:  buildID,
+		recorded: map[atc.SecretAccess]bool{},
+	}
+}
+
+func (b *build) secretAccessRecorder(logger lager.Logger) *buildSecretAccessRecorder {
+	return newBuildSecretAccessRecorder(logger, b.conn, b.id)
+}
+
+// RecordSecretAccess saves the access, including failed lookups. Failing to
+// save it only gets logged, as it must not fail the build.
+func (r *buildSecretAccessRecorder) RecordSecretAccess(access atc.SecretAccess) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.recorded[access] {
+		return
+	}
+
+	r.recorded[access] = true
+
+	if r.buildID == 0 {
+		r.pending = append(r.pending, access)
+		return
+	}
+
+	err := saveSecretAccesses(r.conn, r.buildID, []atc.SecretAccess{access})
+	if err != nil {
+		r.logger.Error("failed-to-save-secret-access", err)
+	}
+}
+
+// flush saves the accesses held onto so far against the build's newly
+// assigned id.
+func (r *buildSecretAccessRecorder) flush(tx Tx, buildID int) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.buildID = buildID
+
+	err := saveSecretAccesses(tx, buildID, r.pending)
+	if err != nil {
+		return err
+	}
+
+	r.pending = nil
+
+	return nil
+}
+
+func saveSecretAccesses(runner sq.BaseRunner, buildID int, accesses []atc.SecretAccess) error {
+	if len(accesses) == 0 {
+		return nil
+	}
+
+	insert := psql.Insert("build_secret_accesses").
+		Columns("build_id", "source", "path", "credential_manager", "found", "cached", "error")
+
+	for _, access := range accesses {
+		insert = insert.Values(
+			buildID,
+			access.Source,
+			access.Path,
+			access.CredentialManager,
+			access.Found,
+			access.Cached,
+			access.Error,
+		)
+	}
+
+	_, err := insert.
+		Suffix("ON CONFLICT DO NOTHING").
+		RunWith(runner).
+		Exec()
+	return err
+}
+
+func (b *build) SecretAccesses() ([]atc.SecretAccess, error) {
+	return secretAccesses(b.conn, b.id)
+}
+
+func (b *inMemoryCheckBuildForApi) SecretAccesses() ([]atc.SecretAccess, error) {
+	return secretAccesses(b.conn, b.id)
+}
+
+func secretAccesses(conn Conn, buildID int) ([]atc.SecretAccess, error) {
+	rows, err := psql.Select("source", "path", "credential_manager", "found", "cached", "error").
+		From("build_secret_accesses").
+		Where(sq.Eq{"build_id": buildID}).
+		OrderBy("source", "path", "credential_manager", "cached").
+		RunWith(conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	accesses := []atc.SecretAccess{}
+	for rows.Next() {
+		var access atc.SecretAccess
+
+		err = rows.Scan(&access.Source, &access.Path, &access.CredentialManager, &access.Found, &access.Cached, &access.Error)
+		if err != nil {
+			return nil, err
+		}
+
+		accesses = append(accesses, access)
+	}
+
+	return accesses, rows.Err()
+}
@@ -0,0 +1,154 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+// BuildStepCheckpoint records what a successful step of a build produced, so
+// that the step can be skipped when the build is resumed from a later, failed
+// step.
+type BuildStepCheckpoint struct {
+	PlanID atc.PlanID
+
+	// Artifacts maps the names of the artifacts registered by the step to the
+	// handles of their volumes.
+	Artifacts map[string]string
+
+	// Version is the version produced by a put step.
+	Version atc.Version
+}
+
+// SaveStepCheckpoint records the outputs of a successful step.
+func (b *build) SaveStepCheckpoint(checkpoint BuildStepCheckpoint) error {
+	artifacts := checkpoint.Artifacts
+	if artifacts == nil {
+		artifacts = map[string]string{}
+	}
+
+	artifactsJSON, err := json.Marshal(artifacts)
+	if err != nil {
+		return err
+	}
+
+	var versionJSON interface{}
+	if checkpoint.Version != nil {
+		payload, err := json.Marshal(checkpoint.Version)
+		if err != nil {
+			return err
+		}
+
+		versionJSON = string(payload)
+	}
+
+	_, err = psql.Insert("build_step_checkpoints").
+		Columns("build_id", "plan_id", "artifacts", "version").
+		Values(b.id, string(checkpoint.PlanID), string(artifactsJSON), versionJSON).
+		Suffix("ON CONFLICT (build_id, plan_id) DO UPDATE SET artifacts = EXCLUDED.artifacts, version = EXCLUDED.version").
+		RunWith(b.conn).
+		Exec()
+	return err
+}
+
+// ResumedStepCheckpoint returns the checkpoint of the given step in the build
+// that this build was resumed from, if any.
+func (b *build) ResumedStepCheckpoint(planID atc.PlanID) (BuildStepCheckpoint, bool, error) {
+	if b.resumedFrom == 0 {
+		return BuildStepCheckpoint{}, false, nil
+	}
+
+	var artifactsJSON []byte
+	var versionJSON sql.NullString
+	err := psql.Select("artifacts", "version").
+		From("build_step_checkpoints").
+		Where(sq.Eq{
+			"build_id": b.resumedFrom,
+			"plan_id":  string(planID),
+		}).
+		RunWith(b.conn).
+		QueryRow().
+		Scan(&artifactsJSON, &versionJSON)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return BuildStepCheckpoint{}, false, nil
+		}
+
+		return BuildStepCheckpoint{}, false, err
+	}
+
+	checkpoint := BuildStepCheckpoint{PlanID: planID}
+
+	err = json.Unmarshal(artifactsJSON, &checkpoint.Artifacts)
+	if err != nil {
+		return BuildStepCheckpoint{}, false, err
+	}
+
+	if versionJSON.Valid {
+		err = json.Unmarshal([]byte(versionJSON.String), &checkpoint.Version)
+		if err != nil {
+			return BuildStepCheckpoint{}, false, err
+		}
+	}
+
+	return checkpoint, true, nil
+}
+
+// retainStepCheckpoints keeps the volumes referenced by the build's
+// checkpoints around as worker artifacts, so that they outlive the containers
+// which created them for as long as the build may be resumed.
+func (b *build) retainStepCheckpoints(tx Tx) error {
+	rows, err := psql.Select("a.key", "a.value").
+		From("build_step_checkpoints c, jsonb_each_text(c.artifacts) a").
+		Where(sq.Eq{"c.build_id": b.id}).
+		RunWith(tx).
+		Query()
+	if err != nil {
+		return err
+	}
+
+	// artifact names keyed by volume handle
+	names := map[string]string{}
+	for rows.Next() {
+		var name, handle string
+		err = rows.Scan(&name, &handle)
+		if err != nil {
+			Close(rows)
+			return err
+		}
+
+		names[handle] = name
+	}
+
+	Close(rows)
+
+	return b.retainVolumes(tx, names)
+}
+
+// retainVolumes creates a worker artifact for each of the given volumes, keyed
+// by handle, so that they are not garbage collected until the artifacts
+// expire.
+func (b *build) retainVolumes(tx Tx, names map[string]string) error {
+	for handle, name := range names {
+		artifact, err := saveWorkerArtifact(tx, b.conn, atc.WorkerArtifact{
+			Name:    name,
+			BuildID: b.id,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = psql.Update("volumes").
+			Set("worker_artifact_id", artifact.ID()).
+			Where(sq.Eq{"handle": handle}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -959,6 +959,33 @@ var _ = Describe("Build", func() {
 				Expect(found).To(BeTrue())
 				Expect(val).To(Equal("caz"))
 			})
+
+			It("records the secrets looked up, without their values", func() {
+				v, err := build.Variables(logger, globalSecrets, varSourcePool)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, _, err = v.Get(vars.Reference{Path: "foo"})
+				Expect(err).ToNot(HaveOccurred())
+
+				_, _, err = v.Get(vars.Reference{Source: "some-source", Path: "baz"})
+				Expect(err).ToNot(HaveOccurred())
+
+				accesses, err := build.SecretAccesses()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(accesses).To(ContainElements(
+					atc.SecretAccess{Path: "foo", Found: true},
+					atc.SecretAccess{Source: "some-source", Path: "baz", CredentialManager: "dummy", Found: true},
+				))
+				Expect(accesses).To(ContainElement(atc.SecretAccess{Path: "default-team/foo"}))
+			})
+		})
+	})
+
+	Describe("SecretAccesses", func() {
+		It("returns no accesses for a build without any", func() {
+			accesses, err := build.SecretAccesses()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(accesses).To(BeEmpty())
 		})
 	})
 
@@ -1016,6 +1043,194 @@ var _ = Describe("Build", func() {
 		})
 	})
 
+	Describe("Approvals", func() {
+		BeforeEach(func() {
+			err := build.RequestApproval("some-plan-id", "deploy", "member")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("records the approval request as pending", func() {
+			approval, found, err := build.Approval("some-plan-id")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(approval.Name).To(Equal("deploy"))
+			Expect(approval.Role).To(Equal("member"))
+			Expect(approval.Decided).To(BeFalse())
+
+			pending, err := build.PendingApprovals()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pending).To(HaveLen(1))
+			Expect(pending[0].PlanID).To(Equal(atc.PlanID("some-plan-id")))
+		})
+
+		Context("when the approval is decided", func() {
+			var notifier db.Notifier
+
+			BeforeEach(func() {
+				var err error
+				notifier, err = build.ApprovalNotifier("some-plan-id")
+				Expect(err).NotTo(HaveOccurred())
+
+				decided, err := build.DecideApproval("some-plan-id", true, "some-user")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decided).To(BeTrue())
+			})
+
+			AfterEach(func() {
+				db.Close(notifier)
+			})
+
+			It("notifies and records the decision", func() {
+				Eventually(notifier.Notify()).Should(Receive())
+
+				approval, found, err := build.Approval("some-plan-id")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(approval.Decided).To(BeTrue())
+				Expect(approval.Approved).To(BeTrue())
+				Expect(approval.DecidedBy).To(Equal("some-user"))
+
+				pending, err := build.PendingApprovals()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pending).To(BeEmpty())
+			})
+
+			It("cannot be decided again", func() {
+				decided, err := build.DecideApproval("some-plan-id", false, "other-user")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decided).To(BeFalse())
+			})
+
+			It("keeps the decision when approval is requested again", func() {
+				err := build.RequestApproval("some-plan-id", "deploy", "member")
+				Expect(err).NotTo(HaveOccurred())
+
+				approval, _, err := build.Approval("some-plan-id")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(approval.Approved).To(BeTrue())
+			})
+		})
+
+		Context("when the approval is withdrawn", func() {
+			BeforeEach(func() {
+				err := build.WithdrawApproval("some-plan-id")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("removes the request", func() {
+				_, found, err := build.Approval("some-plan-id")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("TaskResults", func() {
+		var (
+			jobBuild db.Build
+			volume   db.CreatedVolume
+		)
+
+		BeforeEach(func() {
+			var err error
+			jobBuild, err = defaultJob.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).NotTo(HaveOccurred())
+
+			creatingVolume, err := volumeRepository.CreateVolume(defaultTeam.ID(), defaultWorker.Name(), db.VolumeTypeContainer)
+			Expect(err).NotTo(HaveOccurred())
+
+			volume, err = creatingVolume.Created()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("does not find a result that was never saved", func() {
+			_, found, err := jobBuild.TaskResult("some-task", "some-key")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		Context("when a result is saved", func() {
+			BeforeEach(func() {
+				err := jobBuild.SaveTaskResult("some-task", "some-key", map[string]string{
+					"some-output": volume.Handle(),
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("is found by later builds of the job", func() {
+				laterBuild, err := defaultJob.CreateBuild(defaultBuildCreatedBy)
+				Expect(err).NotTo(HaveOccurred())
+
+				outputs, found, err := laterBuild.TaskResult("some-task", "some-key")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(outputs).To(Equal(map[string]string{"some-output": volume.Handle()}))
+			})
+
+			It("is not found under another key", func() {
+				_, found, err := jobBuild.TaskResult("some-task", "other-key")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+
+			It("retains the output volumes as artifacts", func() {
+				reloaded, found, err := volumeRepository.FindVolume(volume.Handle())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(reloaded.WorkerArtifactID()).NotTo(BeZero())
+			})
+		})
+	})
+
+	Describe("TestResults", func() {
+		It("returns no results for a build without any", func() {
+			results, err := build.TestResults()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results).To(BeEmpty())
+		})
+
+		Context("when results are saved", func() {
+			BeforeEach(func() {
+				err := build.SaveTestResults("unit", []atc.TestResult{
+					{Suite: "some-suite", Name: "passes", Status: atc.TestStatusPassed, Duration: 1.5},
+					{Suite: "some-suite", Name: "fails", Status: atc.TestStatusFailed, Message: "boom"},
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns them along with the step name", func() {
+				results, err := build.TestResults()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(results).To(Equal([]atc.TestResult{
+					{Step: "unit", Suite: "some-suite", Name: "fails", Status: atc.TestStatusFailed, Message: "boom"},
+					{Step: "unit", Suite: "some-suite", Name: "passes", Status: atc.TestStatusPassed, Duration: 1.5},
+				}))
+			})
+		})
+
+		Context("when more results are saved than fit in a single statement", func() {
+			BeforeEach(func() {
+				results := make([]atc.TestResult, 10000)
+				for i := range results {
+					results[i] = atc.TestResult{
+						Suite:  "some-suite",
+						Name:   fmt.Sprintf("test-%d", i),
+						Status: atc.TestStatusPassed,
+					}
+				}
+
+				err := build.SaveTestResults("unit", results)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("saves all of them", func() {
+				results, err := build.TestResults()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(results).To(HaveLen(10000))
+			})
+		})
+	})
+
 	Describe("Events", func() {
 		It("saves and emits status events", func() {
 			By("allowing you to subscribe when no events have yet occurred")
@@ -1079,6 +1294,40 @@ var _ = Describe("Build", func() {
 		})
 	})
 
+	Describe("MarkEventsOffloaded", func() {
+		BeforeEach(func() {
+			err := build.SaveEvent(event.Log{
+				Payload: "some-payload",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.Finish(db.BuildStatusSucceeded)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.MarkEventsOffloaded()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("marks the events as offloaded", func() {
+			Expect(build.EventsOffloaded()).To(BeTrue())
+
+			found, err := build.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(build.EventsOffloaded()).To(BeTrue())
+		})
+
+		It("deletes the events from the database", func() {
+			events, err := build.Events(0)
+			Expect(err).NotTo(HaveOccurred())
+
+			defer db.Close(events)
+
+			_, err = events.Next()
+			Expect(err).To(Equal(db.ErrEndOfBuildEventStream))
+		})
+	})
+
 	Describe("SaveEvent", func() {
 		It("saves and propagates events correctly", func() {
 			By("allowing you to subscribe when no events have yet occurred")
@@ -0,0 +1,142 @@
+package db
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+// testResultsBatchSize is the number of test results inserted per statement,
+// keeping large reports well under Postgres's limit of 65535 parameters.
+const testResultsBatchSize = 1000
+
+// SaveTestResults records the results parsed from the test reports of the
+// given step of the build.
+func (b *build) SaveTestResults(stepName string, results []atc.TestResult) error {
+	if len(results) == 0 {
+		return nil
+	}
+
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	for start := 0; start < len(results); start += testResultsBatchSize {
+		end := start + testResultsBatchSize
+		if end > len(results) {
+			end = len(results)
+		}
+
+		insert := psql.Insert("build_test_results").
+			Columns("build_id", "step_name", "suite", "name", "status", "duration", "message")
+
+		for _, result := range results[start:end] {
+			insert = insert.Values(
+				b.id,
+				stepName,
+				result.Suite,
+				result.Name,
+				string(result.Status),
+				result.Duration,
+				result.Message,
+			)
+		}
+
+		_, err = insert.RunWith(tx).Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (b *build) TestResults() ([]atc.TestResult, error) {
+	rows, err := psql.Select("step_name", "suite", "name", "status", "duration", "message").
+		From("build_test_results").
+		Where(sq.Eq{"build_id": b.id}).
+		OrderBy("step_name", "suite", "name").
+		RunWith(b.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	results := []atc.TestResult{}
+	for rows.Next() {
+		var result atc.TestResult
+		var status string
+
+		err = rows.Scan(&result.Step, &result.Suite, &result.Name, &status, &result.Duration, &result.Message)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Status = atc.TestStatus(status)
+
+		results = append(results, result)
+	}
+
+	return results, rows.Err()
+}
+
+// TestSummaries aggregates the test results of the job's most recent builds
+// which recorded any, listing the tests that failed most often first.
+func (j *job) TestSummaries(limit int) ([]atc.TestSummary, error) {
+	rows, err := j.conn.Query(`
+		WITH recent AS (
+			SELECT b.id, b.name
+			FROM builds b
+			WHERE b.job_id = $1
+			AND EXISTS (SELECT 1 FROM build_test_results r WHERE r.build_id = b.id)
+			ORDER BY b.id DESC
+			LIMIT $2
+		)
+		SELECT r.suite, r.name,
+			count(*),
+			count(*) FILTER (WHERE r.status = 'passed'),
+			count(*) FILTER (WHERE r.status IN ('failed', 'errored')),
+			count(*) FILTER (WHERE r.status = 'skipped'),
+			(array_agg(b.name ORDER BY b.id DESC) FILTER (WHERE r.status IN ('failed', 'errored')))[1]
+		FROM build_test_results r
+		JOIN recent b ON b.id = r.build_id
+		GROUP BY r.suite, r.name
+		ORDER BY 5 DESC, r.suite, r.name
+	`, j.id, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	summaries := []atc.TestSummary{}
+	for rows.Next() {
+		var summary atc.TestSummary
+		var lastFailedBuild sql.NullString
+
+		err = rows.Scan(
+			&summary.Suite,
+			&summary.Name,
+			&summary.Runs,
+			&summary.Passed,
+			&summary.Failed,
+			&summary.Skipped,
+			&lastFailedBuild,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		summary.LastFailedBuild = lastFailedBuild.String
+
+		summaries = append(summaries, summary)
+	}
+
+	return summaries, rows.Err()
+}
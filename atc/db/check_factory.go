@@ -92,17 +92,7 @@ func (c *checkFactory) TryCreateCheck(ctx context.Context, checkable Checkable,
 		}
 	}
 
-	interval := atc.CheckEvery{
-		Interval: atc.DefaultCheckInterval,
-	}
-
-	if checkable.HasWebhook() {
-		interval.Interval = atc.DefaultWebhookInterval
-	}
-
-	if checkable.CheckEvery() != nil {
-		interval = *checkable.CheckEvery()
-	}
+	interval := ConfiguredCheckInterval(checkable)
 
 	skipInterval := manuallyTriggered
 	if !skipInterval && time.Now().Before(checkable.LastCheckEndTime().Add(interval.Interval)) {
@@ -139,6 +129,24 @@ func (c *checkFactory) TryCreateCheck(ctx context.Context, checkable Checkable,
 	}
 }
 
+// ConfiguredCheckInterval returns the interval on which the checkable is
+// configured to be checked, falling back on the defaults.
+func ConfiguredCheckInterval(checkable Checkable) atc.CheckEvery {
+	interval := atc.CheckEvery{
+		Interval: atc.DefaultCheckInterval,
+	}
+
+	if checkable.HasWebhook() {
+		interval.Interval = atc.DefaultWebhookInterval
+	}
+
+	if checkable.CheckEvery() != nil {
+		interval = *checkable.CheckEvery()
+	}
+
+	return interval
+}
+
 func (c *checkFactory) Resources() ([]Resource, error) {
 	var resources []Resource
 
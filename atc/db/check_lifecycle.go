@@ -46,6 +46,8 @@ func (cl *checkLifecycle) DeleteCompletedChecks(logger lager.Logger) error {
       RETURNING builds.id
       ), deleted_events AS (
         DELETE FROM check_build_events USING deleted_builds WHERE build_id = deleted_builds.id
+      ), deleted_secret_accesses AS (
+        DELETE FROM build_secret_accesses USING deleted_builds WHERE build_id = deleted_builds.id
       )
       SELECT COUNT(*) FROM deleted_builds
     `, CheckDeleteBatchSize).Scan(&numChecksDeleted)
@@ -71,6 +73,14 @@ func (cl *checkLifecycle) DeleteCompletedChecks(logger lager.Logger) error {
           WHERE cbe2.build_id = expired_imb_ids.build_id;
     `)
 
+	// the secret accesses of in-memory check builds have no build to be
+	// removed along with
+	_, err3 := cl.conn.Exec(`
+      DELETE FROM build_secret_accesses bsa
+          WHERE NOT EXISTS (SELECT 1 FROM builds WHERE id = bsa.build_id)
+            AND NOT EXISTS (SELECT 1 FROM check_build_events WHERE build_id = bsa.build_id)
+    `)
+
 	if err1 != nil {
 		return err1
 	}
@@ -79,5 +89,9 @@ func (cl *checkLifecycle) DeleteCompletedChecks(logger lager.Logger) error {
 		return err2
 	}
 
+	if err3 != nil {
+		return err3
+	}
+
 	return nil
 }
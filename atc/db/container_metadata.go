@@ -29,6 +29,8 @@ const (
 	ContainerTypePut   ContainerType = "put"
 	ContainerTypeTask  ContainerType = "task"
 	ContainerTypeRun   ContainerType = "run"
+
+	ContainerTypeService ContainerType = "service"
 )
 
 func ContainerTypeFromString(containerType string) (ContainerType, error) {
@@ -43,6 +45,8 @@ func ContainerTypeFromString(containerType string) (ContainerType, error) {
 		return ContainerTypeTask, nil
 	case "run":
 		return ContainerTypeRun, nil
+	case "service":
+		return ContainerTypeService, nil
 	default:
 		return "", fmt.Errorf("unrecognized containerType: %s", containerType)
 	}
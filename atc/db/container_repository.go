@@ -13,6 +13,7 @@ import (
 type ContainerRepository interface {
 	FindOrphanedContainers() ([]CreatingContainer, []CreatedContainer, []DestroyingContainer, error)
 	DestroyFailedContainers() (int, error)
+	DestroyCompletedBuildServiceContainers() (int, error)
 	FindDestroyingContainers(workerName string) ([]string, error)
 	RemoveDestroyingContainers(workerName string, currentHandles []string) (int, error)
 	UpdateContainersMissingSince(workerName string, handles []string) error
@@ -378,6 +379,35 @@ func (repository *containerRepository) DestroyFailedContainers() (int, error) {
 	return int(affected), nil
 }
 
+// DestroyCompletedBuildServiceContainers marks the service containers of
+// completed builds as destroying. Services are normally torn down as soon as
+// their task finishes, but they may be left behind if the ATC goes away
+// mid-build. Unlike other build containers, they are not kept around for
+// intercepting once the build has completed.
+func (repository *containerRepository) DestroyCompletedBuildServiceContainers() (int, error) {
+	result, err := psql.Update("containers").
+		Set("state", atc.ContainerStateDestroying).
+		Where(sq.And{
+			sq.Eq{
+				"state":     atc.ContainerStateCreated,
+				"meta_type": string(ContainerTypeService),
+			},
+			sq.Expr("build_id IN (SELECT id FROM builds WHERE completed)"),
+		}).
+		RunWith(repository.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(affected), nil
+}
+
 func (repository *containerRepository) DestroyUnknownContainers(workerName string, reportedHandles []string) (int, error) {
 	tx, err := repository.conn.Begin()
 	if err != nil {
@@ -413,6 +413,76 @@ var _ = Describe("ContainerRepository", func() {
 		})
 	})
 
+	Describe("DestroyCompletedBuildServiceContainers", func() {
+		var (
+			build            db.Build
+			serviceContainer db.CreatedContainer
+			taskContainer    db.CreatedContainer
+
+			destroyed  int
+			destroyErr error
+		)
+
+		BeforeEach(func() {
+			var err error
+			build, err = defaultJob.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SetInterceptible(true)
+			Expect(err).NotTo(HaveOccurred())
+
+			creatingContainer, err := defaultWorker.CreateContainer(
+				db.NewBuildStepContainerOwner(build.ID(), "some-plan/services/postgres", defaultTeam.ID()),
+				db.ContainerMetadata{Type: db.ContainerTypeService},
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			serviceContainer, err = creatingContainer.Created()
+			Expect(err).NotTo(HaveOccurred())
+
+			creatingContainer, err = defaultWorker.CreateContainer(
+				db.NewBuildStepContainerOwner(build.ID(), "some-plan", defaultTeam.ID()),
+				db.ContainerMetadata{Type: db.ContainerTypeTask},
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			taskContainer, err = creatingContainer.Created()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		JustBeforeEach(func() {
+			destroyed, destroyErr = containerRepository.DestroyCompletedBuildServiceContainers()
+		})
+
+		Context("when the build is running", func() {
+			It("does not destroy any containers", func() {
+				Expect(destroyErr).NotTo(HaveOccurred())
+				Expect(destroyed).To(Equal(0))
+
+				handles, err := containerRepository.FindDestroyingContainers(defaultWorker.Name())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(handles).To(BeEmpty())
+			})
+		})
+
+		Context("when the build has completed", func() {
+			BeforeEach(func() {
+				err := build.Finish(db.BuildStatusSucceeded)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("destroys only the service containers", func() {
+				Expect(destroyErr).NotTo(HaveOccurred())
+				Expect(destroyed).To(Equal(1))
+
+				handles, err := containerRepository.FindDestroyingContainers(defaultWorker.Name())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(handles).To(ConsistOf(serviceContainer.Handle()))
+				Expect(handles).NotTo(ContainElement(taskContainer.Handle()))
+			})
+		})
+	})
+
 	Describe("FindDestroyingContainers", func() {
 		var failedErr error
 		var destroyingContainers []string
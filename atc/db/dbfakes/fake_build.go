@@ -30,6 +30,16 @@ type FakeBuild struct {
 		result1 db.Notifier
 		result2 error
 	}
+	AbortReasonStub        func() string
+	abortReasonMutex       sync.RWMutex
+	abortReasonArgsForCall []struct {
+	}
+	abortReasonReturns struct {
+		result1 string
+	}
+	abortReasonReturnsOnCall map[int]struct {
+		result1 string
+	}
 	AcquireTrackingLockStub        func(lager.Logger, time.Duration) (lock.Lock, bool, error)
 	acquireTrackingLockMutex       sync.RWMutex
 	acquireTrackingLockArgsForCall []struct {
@@ -84,6 +94,34 @@ type FakeBuild struct {
 	allAssociatedTeamNamesReturnsOnCall map[int]struct {
 		result1 []string
 	}
+	ApprovalStub        func(atc.PlanID) (db.BuildApproval, bool, error)
+	approvalMutex       sync.RWMutex
+	approvalArgsForCall []struct {
+		arg1 atc.PlanID
+	}
+	approvalReturns struct {
+		result1 db.BuildApproval
+		result2 bool
+		result3 error
+	}
+	approvalReturnsOnCall map[int]struct {
+		result1 db.BuildApproval
+		result2 bool
+		result3 error
+	}
+	ApprovalNotifierStub        func(atc.PlanID) (db.Notifier, error)
+	approvalNotifierMutex       sync.RWMutex
+	approvalNotifierArgsForCall []struct {
+		arg1 atc.PlanID
+	}
+	approvalNotifierReturns struct {
+		result1 db.Notifier
+		result2 error
+	}
+	approvalNotifierReturnsOnCall map[int]struct {
+		result1 db.Notifier
+		result2 error
+	}
 	ArtifactStub        func(int) (db.WorkerArtifact, error)
 	artifactMutex       sync.RWMutex
 	artifactArgsForCall []struct {
@@ -150,6 +188,21 @@ type FakeBuild struct {
 	createdByReturnsOnCall map[int]struct {
 		result1 *string
 	}
+	DecideApprovalStub        func(atc.PlanID, bool, string) (bool, error)
+	decideApprovalMutex       sync.RWMutex
+	decideApprovalArgsForCall []struct {
+		arg1 atc.PlanID
+		arg2 bool
+		arg3 string
+	}
+	decideApprovalReturns struct {
+		result1 bool
+		result2 error
+	}
+	decideApprovalReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	DeleteStub        func() (bool, error)
 	deleteMutex       sync.RWMutex
 	deleteArgsForCall []struct {
@@ -185,6 +238,16 @@ type FakeBuild struct {
 		result1 db.EventSource
 		result2 error
 	}
+	EventsOffloadedStub        func() bool
+	eventsOffloadedMutex       sync.RWMutex
+	eventsOffloadedArgsForCall []struct {
+	}
+	eventsOffloadedReturns struct {
+		result1 bool
+	}
+	eventsOffloadedReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	FinishStub        func(db.BuildStatus) error
 	finishMutex       sync.RWMutex
 	finishArgsForCall []struct {
@@ -352,6 +415,16 @@ type FakeBuild struct {
 	markAsAbortedReturnsOnCall map[int]struct {
 		result1 error
 	}
+	MarkEventsOffloadedStub        func() error
+	markEventsOffloadedMutex       sync.RWMutex
+	markEventsOffloadedArgsForCall []struct {
+	}
+	markEventsOffloadedReturns struct {
+		result1 error
+	}
+	markEventsOffloadedReturnsOnCall map[int]struct {
+		result1 error
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -372,6 +445,28 @@ type FakeBuild struct {
 	onCheckBuildStartReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ParamsStub        func() map[string]interface{}
+	paramsMutex       sync.RWMutex
+	paramsArgsForCall []struct {
+	}
+	paramsReturns struct {
+		result1 map[string]interface{}
+	}
+	paramsReturnsOnCall map[int]struct {
+		result1 map[string]interface{}
+	}
+	PendingApprovalsStub        func() ([]db.BuildApproval, error)
+	pendingApprovalsMutex       sync.RWMutex
+	pendingApprovalsArgsForCall []struct {
+	}
+	pendingApprovalsReturns struct {
+		result1 []db.BuildApproval
+		result2 error
+	}
+	pendingApprovalsReturnsOnCall map[int]struct {
+		result1 []db.BuildApproval
+		result2 error
+	}
 	PipelineStub        func() (db.Pipeline, bool, error)
 	pipelineMutex       sync.RWMutex
 	pipelineArgsForCall []struct {
@@ -440,6 +535,16 @@ type FakeBuild struct {
 		result2 bool
 		result3 error
 	}
+	PriorityStub        func() int
+	priorityMutex       sync.RWMutex
+	priorityArgsForCall []struct {
+	}
+	priorityReturns struct {
+		result1 int
+	}
+	priorityReturnsOnCall map[int]struct {
+		result1 int
+	}
 	PrivatePlanStub        func() atc.Plan
 	privatePlanMutex       sync.RWMutex
 	privatePlanArgsForCall []struct {
@@ -482,6 +587,19 @@ type FakeBuild struct {
 		result1 bool
 		result2 error
 	}
+	RequestApprovalStub        func(atc.PlanID, string, string) error
+	requestApprovalMutex       sync.RWMutex
+	requestApprovalArgsForCall []struct {
+		arg1 atc.PlanID
+		arg2 string
+		arg3 string
+	}
+	requestApprovalReturns struct {
+		result1 error
+	}
+	requestApprovalReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RerunNumberStub        func() int
 	rerunNumberMutex       sync.RWMutex
 	rerunNumberArgsForCall []struct {
@@ -578,6 +696,31 @@ type FakeBuild struct {
 		result1 bool
 		result2 error
 	}
+	ResumedFromStub        func() int
+	resumedFromMutex       sync.RWMutex
+	resumedFromArgsForCall []struct {
+	}
+	resumedFromReturns struct {
+		result1 int
+	}
+	resumedFromReturnsOnCall map[int]struct {
+		result1 int
+	}
+	ResumedStepCheckpointStub        func(atc.PlanID) (db.BuildStepCheckpoint, bool, error)
+	resumedStepCheckpointMutex       sync.RWMutex
+	resumedStepCheckpointArgsForCall []struct {
+		arg1 atc.PlanID
+	}
+	resumedStepCheckpointReturns struct {
+		result1 db.BuildStepCheckpoint
+		result2 bool
+		result3 error
+	}
+	resumedStepCheckpointReturnsOnCall map[int]struct {
+		result1 db.BuildStepCheckpoint
+		result2 bool
+		result3 error
+	}
 	RunStateIDStub        func() string
 	runStateIDMutex       sync.RWMutex
 	runStateIDArgsForCall []struct {
@@ -646,6 +789,42 @@ type FakeBuild struct {
 		result2 bool
 		result3 error
 	}
+	SaveStepCheckpointStub        func(db.BuildStepCheckpoint) error
+	saveStepCheckpointMutex       sync.RWMutex
+	saveStepCheckpointArgsForCall []struct {
+		arg1 db.BuildStepCheckpoint
+	}
+	saveStepCheckpointReturns struct {
+		result1 error
+	}
+	saveStepCheckpointReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SaveTaskResultStub        func(string, string, map[string]string) error
+	saveTaskResultMutex       sync.RWMutex
+	saveTaskResultArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]string
+	}
+	saveTaskResultReturns struct {
+		result1 error
+	}
+	saveTaskResultReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SaveTestResultsStub        func(string, []atc.TestResult) error
+	saveTestResultsMutex       sync.RWMutex
+	saveTestResultsArgsForCall []struct {
+		arg1 string
+		arg2 []atc.TestResult
+	}
+	saveTestResultsReturns struct {
+		result1 error
+	}
+	saveTestResultsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SchemaStub        func() string
 	schemaMutex       sync.RWMutex
 	schemaArgsForCall []struct {
@@ -656,6 +835,18 @@ type FakeBuild struct {
 	schemaReturnsOnCall map[int]struct {
 		result1 string
 	}
+	SecretAccessesStub        func() ([]atc.SecretAccess, error)
+	secretAccessesMutex       sync.RWMutex
+	secretAccessesArgsForCall []struct {
+	}
+	secretAccessesReturns struct {
+		result1 []atc.SecretAccess
+		result2 error
+	}
+	secretAccessesReturnsOnCall map[int]struct {
+		result1 []atc.SecretAccess
+		result2 error
+	}
 	SetCommentStub        func(string) error
 	setCommentMutex       sync.RWMutex
 	setCommentArgsForCall []struct {
@@ -743,6 +934,22 @@ type FakeBuild struct {
 	syslogTagReturnsOnCall map[int]struct {
 		result1 string
 	}
+	TaskResultStub        func(string, string) (map[string]string, bool, error)
+	taskResultMutex       sync.RWMutex
+	taskResultArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	taskResultReturns struct {
+		result1 map[string]string
+		result2 bool
+		result3 error
+	}
+	taskResultReturnsOnCall map[int]struct {
+		result1 map[string]string
+		result2 bool
+		result3 error
+	}
 	TeamIDStub        func() int
 	teamIDMutex       sync.RWMutex
 	teamIDArgsForCall []struct {
@@ -763,6 +970,18 @@ type FakeBuild struct {
 	teamNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	TestResultsStub        func() ([]atc.TestResult, error)
+	testResultsMutex       sync.RWMutex
+	testResultsArgsForCall []struct {
+	}
+	testResultsReturns struct {
+		result1 []atc.TestResult
+		result2 error
+	}
+	testResultsReturnsOnCall map[int]struct {
+		result1 []atc.TestResult
+		result2 error
+	}
 	TracingAttrsStub        func() tracing.Attrs
 	tracingAttrsMutex       sync.RWMutex
 	tracingAttrsArgsForCall []struct {
@@ -773,6 +992,16 @@ type FakeBuild struct {
 	tracingAttrsReturnsOnCall map[int]struct {
 		result1 tracing.Attrs
 	}
+	TriggerReasonStub        func() string
+	triggerReasonMutex       sync.RWMutex
+	triggerReasonArgsForCall []struct {
+	}
+	triggerReasonReturns struct {
+		result1 string
+	}
+	triggerReasonReturnsOnCall map[int]struct {
+		result1 string
+	}
 	VariablesStub        func(lager.Logger, creds.Secrets, creds.VarSourcePool) (vars.Variables, error)
 	variablesMutex       sync.RWMutex
 	variablesArgsForCall []struct {
@@ -788,6 +1017,17 @@ type FakeBuild struct {
 		result1 vars.Variables
 		result2 error
 	}
+	WithdrawApprovalStub        func(atc.PlanID) error
+	withdrawApprovalMutex       sync.RWMutex
+	withdrawApprovalArgsForCall []struct {
+		arg1 atc.PlanID
+	}
+	withdrawApprovalReturns struct {
+		result1 error
+	}
+	withdrawApprovalReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -848,6 +1088,59 @@ func (fake *FakeBuild) AbortNotifierReturnsOnCall(i int, result1 db.Notifier, re
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) AbortReason() string {
+	fake.abortReasonMutex.Lock()
+	ret, specificReturn := fake.abortReasonReturnsOnCall[len(fake.abortReasonArgsForCall)]
+	fake.abortReasonArgsForCall = append(fake.abortReasonArgsForCall, struct {
+	}{})
+	stub := fake.AbortReasonStub
+	fakeReturns := fake.abortReasonReturns
+	fake.recordInvocation("AbortReason", []interface{}{})
+	fake.abortReasonMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) AbortReasonCallCount() int {
+	fake.abortReasonMutex.RLock()
+	defer fake.abortReasonMutex.RUnlock()
+	return len(fake.abortReasonArgsForCall)
+}
+
+func (fake *FakeBuild) AbortReasonCalls(stub func() string) {
+	fake.abortReasonMutex.Lock()
+	defer fake.abortReasonMutex.Unlock()
+	fake.AbortReasonStub = stub
+}
+
+func (fake *FakeBuild) AbortReasonReturns(result1 string) {
+	fake.abortReasonMutex.Lock()
+	defer fake.abortReasonMutex.Unlock()
+	fake.AbortReasonStub = nil
+	fake.abortReasonReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeBuild) AbortReasonReturnsOnCall(i int, result1 string) {
+	fake.abortReasonMutex.Lock()
+	defer fake.abortReasonMutex.Unlock()
+	fake.AbortReasonStub = nil
+	if fake.abortReasonReturnsOnCall == nil {
+		fake.abortReasonReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.abortReasonReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeBuild) AcquireTrackingLock(arg1 lager.Logger, arg2 time.Duration) (lock.Lock, bool, error) {
 	fake.acquireTrackingLockMutex.Lock()
 	ret, specificReturn := fake.acquireTrackingLockReturnsOnCall[len(fake.acquireTrackingLockArgsForCall)]
@@ -1087,6 +1380,137 @@ func (fake *FakeBuild) AllAssociatedTeamNamesReturnsOnCall(i int, result1 []stri
 	}{result1}
 }
 
+func (fake *FakeBuild) Approval(arg1 atc.PlanID) (db.BuildApproval, bool, error) {
+	fake.approvalMutex.Lock()
+	ret, specificReturn := fake.approvalReturnsOnCall[len(fake.approvalArgsForCall)]
+	fake.approvalArgsForCall = append(fake.approvalArgsForCall, struct {
+		arg1 atc.PlanID
+	}{arg1})
+	stub := fake.ApprovalStub
+	fakeReturns := fake.approvalReturns
+	fake.recordInvocation("Approval", []interface{}{arg1})
+	fake.approvalMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeBuild) ApprovalCallCount() int {
+	fake.approvalMutex.RLock()
+	defer fake.approvalMutex.RUnlock()
+	return len(fake.approvalArgsForCall)
+}
+
+func (fake *FakeBuild) ApprovalCalls(stub func(atc.PlanID) (db.BuildApproval, bool, error)) {
+	fake.approvalMutex.Lock()
+	defer fake.approvalMutex.Unlock()
+	fake.ApprovalStub = stub
+}
+
+func (fake *FakeBuild) ApprovalArgsForCall(i int) atc.PlanID {
+	fake.approvalMutex.RLock()
+	defer fake.approvalMutex.RUnlock()
+	argsForCall := fake.approvalArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) ApprovalReturns(result1 db.BuildApproval, result2 bool, result3 error) {
+	fake.approvalMutex.Lock()
+	defer fake.approvalMutex.Unlock()
+	fake.ApprovalStub = nil
+	fake.approvalReturns = struct {
+		result1 db.BuildApproval
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuild) ApprovalReturnsOnCall(i int, result1 db.BuildApproval, result2 bool, result3 error) {
+	fake.approvalMutex.Lock()
+	defer fake.approvalMutex.Unlock()
+	fake.ApprovalStub = nil
+	if fake.approvalReturnsOnCall == nil {
+		fake.approvalReturnsOnCall = make(map[int]struct {
+			result1 db.BuildApproval
+			result2 bool
+			result3 error
+		})
+	}
+	fake.approvalReturnsOnCall[i] = struct {
+		result1 db.BuildApproval
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuild) ApprovalNotifier(arg1 atc.PlanID) (db.Notifier, error) {
+	fake.approvalNotifierMutex.Lock()
+	ret, specificReturn := fake.approvalNotifierReturnsOnCall[len(fake.approvalNotifierArgsForCall)]
+	fake.approvalNotifierArgsForCall = append(fake.approvalNotifierArgsForCall, struct {
+		arg1 atc.PlanID
+	}{arg1})
+	stub := fake.ApprovalNotifierStub
+	fakeReturns := fake.approvalNotifierReturns
+	fake.recordInvocation("ApprovalNotifier", []interface{}{arg1})
+	fake.approvalNotifierMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) ApprovalNotifierCallCount() int {
+	fake.approvalNotifierMutex.RLock()
+	defer fake.approvalNotifierMutex.RUnlock()
+	return len(fake.approvalNotifierArgsForCall)
+}
+
+func (fake *FakeBuild) ApprovalNotifierCalls(stub func(atc.PlanID) (db.Notifier, error)) {
+	fake.approvalNotifierMutex.Lock()
+	defer fake.approvalNotifierMutex.Unlock()
+	fake.ApprovalNotifierStub = stub
+}
+
+func (fake *FakeBuild) ApprovalNotifierArgsForCall(i int) atc.PlanID {
+	fake.approvalNotifierMutex.RLock()
+	defer fake.approvalNotifierMutex.RUnlock()
+	argsForCall := fake.approvalNotifierArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) ApprovalNotifierReturns(result1 db.Notifier, result2 error) {
+	fake.approvalNotifierMutex.Lock()
+	defer fake.approvalNotifierMutex.Unlock()
+	fake.ApprovalNotifierStub = nil
+	fake.approvalNotifierReturns = struct {
+		result1 db.Notifier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) ApprovalNotifierReturnsOnCall(i int, result1 db.Notifier, result2 error) {
+	fake.approvalNotifierMutex.Lock()
+	defer fake.approvalNotifierMutex.Unlock()
+	fake.ApprovalNotifierStub = nil
+	if fake.approvalNotifierReturnsOnCall == nil {
+		fake.approvalNotifierReturnsOnCall = make(map[int]struct {
+			result1 db.Notifier
+			result2 error
+		})
+	}
+	fake.approvalNotifierReturnsOnCall[i] = struct {
+		result1 db.Notifier
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) Artifact(arg1 int) (db.WorkerArtifact, error) {
 	fake.artifactMutex.Lock()
 	ret, specificReturn := fake.artifactReturnsOnCall[len(fake.artifactArgsForCall)]
@@ -1427,6 +1851,72 @@ func (fake *FakeBuild) CreatedByReturnsOnCall(i int, result1 *string) {
 	}{result1}
 }
 
+func (fake *FakeBuild) DecideApproval(arg1 atc.PlanID, arg2 bool, arg3 string) (bool, error) {
+	fake.decideApprovalMutex.Lock()
+	ret, specificReturn := fake.decideApprovalReturnsOnCall[len(fake.decideApprovalArgsForCall)]
+	fake.decideApprovalArgsForCall = append(fake.decideApprovalArgsForCall, struct {
+		arg1 atc.PlanID
+		arg2 bool
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DecideApprovalStub
+	fakeReturns := fake.decideApprovalReturns
+	fake.recordInvocation("DecideApproval", []interface{}{arg1, arg2, arg3})
+	fake.decideApprovalMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) DecideApprovalCallCount() int {
+	fake.decideApprovalMutex.RLock()
+	defer fake.decideApprovalMutex.RUnlock()
+	return len(fake.decideApprovalArgsForCall)
+}
+
+func (fake *FakeBuild) DecideApprovalCalls(stub func(atc.PlanID, bool, string) (bool, error)) {
+	fake.decideApprovalMutex.Lock()
+	defer fake.decideApprovalMutex.Unlock()
+	fake.DecideApprovalStub = stub
+}
+
+func (fake *FakeBuild) DecideApprovalArgsForCall(i int) (atc.PlanID, bool, string) {
+	fake.decideApprovalMutex.RLock()
+	defer fake.decideApprovalMutex.RUnlock()
+	argsForCall := fake.decideApprovalArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBuild) DecideApprovalReturns(result1 bool, result2 error) {
+	fake.decideApprovalMutex.Lock()
+	defer fake.decideApprovalMutex.Unlock()
+	fake.DecideApprovalStub = nil
+	fake.decideApprovalReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) DecideApprovalReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.decideApprovalMutex.Lock()
+	defer fake.decideApprovalMutex.Unlock()
+	fake.DecideApprovalStub = nil
+	if fake.decideApprovalReturnsOnCall == nil {
+		fake.decideApprovalReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.decideApprovalReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) Delete() (bool, error) {
 	fake.deleteMutex.Lock()
 	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
@@ -1600,18 +2090,17 @@ func (fake *FakeBuild) EventsReturnsOnCall(i int, result1 db.EventSource, result
 	}{result1, result2}
 }
 
-func (fake *FakeBuild) Finish(arg1 db.BuildStatus) error {
-	fake.finishMutex.Lock()
-	ret, specificReturn := fake.finishReturnsOnCall[len(fake.finishArgsForCall)]
-	fake.finishArgsForCall = append(fake.finishArgsForCall, struct {
-		arg1 db.BuildStatus
-	}{arg1})
-	stub := fake.FinishStub
-	fakeReturns := fake.finishReturns
-	fake.recordInvocation("Finish", []interface{}{arg1})
-	fake.finishMutex.Unlock()
+func (fake *FakeBuild) EventsOffloaded() bool {
+	fake.eventsOffloadedMutex.Lock()
+	ret, specificReturn := fake.eventsOffloadedReturnsOnCall[len(fake.eventsOffloadedArgsForCall)]
+	fake.eventsOffloadedArgsForCall = append(fake.eventsOffloadedArgsForCall, struct {
+	}{})
+	stub := fake.EventsOffloadedStub
+	fakeReturns := fake.eventsOffloadedReturns
+	fake.recordInvocation("EventsOffloaded", []interface{}{})
+	fake.eventsOffloadedMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
@@ -1619,13 +2108,67 @@ func (fake *FakeBuild) Finish(arg1 db.BuildStatus) error {
 	return fakeReturns.result1
 }
 
-func (fake *FakeBuild) FinishCallCount() int {
-	fake.finishMutex.RLock()
-	defer fake.finishMutex.RUnlock()
-	return len(fake.finishArgsForCall)
+func (fake *FakeBuild) EventsOffloadedCallCount() int {
+	fake.eventsOffloadedMutex.RLock()
+	defer fake.eventsOffloadedMutex.RUnlock()
+	return len(fake.eventsOffloadedArgsForCall)
 }
 
-func (fake *FakeBuild) FinishCalls(stub func(db.BuildStatus) error) {
+func (fake *FakeBuild) EventsOffloadedCalls(stub func() bool) {
+	fake.eventsOffloadedMutex.Lock()
+	defer fake.eventsOffloadedMutex.Unlock()
+	fake.EventsOffloadedStub = stub
+}
+
+func (fake *FakeBuild) EventsOffloadedReturns(result1 bool) {
+	fake.eventsOffloadedMutex.Lock()
+	defer fake.eventsOffloadedMutex.Unlock()
+	fake.EventsOffloadedStub = nil
+	fake.eventsOffloadedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeBuild) EventsOffloadedReturnsOnCall(i int, result1 bool) {
+	fake.eventsOffloadedMutex.Lock()
+	defer fake.eventsOffloadedMutex.Unlock()
+	fake.EventsOffloadedStub = nil
+	if fake.eventsOffloadedReturnsOnCall == nil {
+		fake.eventsOffloadedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.eventsOffloadedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeBuild) Finish(arg1 db.BuildStatus) error {
+	fake.finishMutex.Lock()
+	ret, specificReturn := fake.finishReturnsOnCall[len(fake.finishArgsForCall)]
+	fake.finishArgsForCall = append(fake.finishArgsForCall, struct {
+		arg1 db.BuildStatus
+	}{arg1})
+	stub := fake.FinishStub
+	fakeReturns := fake.finishReturns
+	fake.recordInvocation("Finish", []interface{}{arg1})
+	fake.finishMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) FinishCallCount() int {
+	fake.finishMutex.RLock()
+	defer fake.finishMutex.RUnlock()
+	return len(fake.finishArgsForCall)
+}
+
+func (fake *FakeBuild) FinishCalls(stub func(db.BuildStatus) error) {
 	fake.finishMutex.Lock()
 	defer fake.finishMutex.Unlock()
 	fake.FinishStub = stub
@@ -2465,6 +3008,59 @@ func (fake *FakeBuild) MarkAsAbortedReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeBuild) MarkEventsOffloaded() error {
+	fake.markEventsOffloadedMutex.Lock()
+	ret, specificReturn := fake.markEventsOffloadedReturnsOnCall[len(fake.markEventsOffloadedArgsForCall)]
+	fake.markEventsOffloadedArgsForCall = append(fake.markEventsOffloadedArgsForCall, struct {
+	}{})
+	stub := fake.MarkEventsOffloadedStub
+	fakeReturns := fake.markEventsOffloadedReturns
+	fake.recordInvocation("MarkEventsOffloaded", []interface{}{})
+	fake.markEventsOffloadedMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) MarkEventsOffloadedCallCount() int {
+	fake.markEventsOffloadedMutex.RLock()
+	defer fake.markEventsOffloadedMutex.RUnlock()
+	return len(fake.markEventsOffloadedArgsForCall)
+}
+
+func (fake *FakeBuild) MarkEventsOffloadedCalls(stub func() error) {
+	fake.markEventsOffloadedMutex.Lock()
+	defer fake.markEventsOffloadedMutex.Unlock()
+	fake.MarkEventsOffloadedStub = stub
+}
+
+func (fake *FakeBuild) MarkEventsOffloadedReturns(result1 error) {
+	fake.markEventsOffloadedMutex.Lock()
+	defer fake.markEventsOffloadedMutex.Unlock()
+	fake.MarkEventsOffloadedStub = nil
+	fake.markEventsOffloadedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) MarkEventsOffloadedReturnsOnCall(i int, result1 error) {
+	fake.markEventsOffloadedMutex.Lock()
+	defer fake.markEventsOffloadedMutex.Unlock()
+	fake.MarkEventsOffloadedStub = nil
+	if fake.markEventsOffloadedReturnsOnCall == nil {
+		fake.markEventsOffloadedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.markEventsOffloadedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -2571,6 +3167,115 @@ func (fake *FakeBuild) OnCheckBuildStartReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeBuild) Params() map[string]interface{} {
+	fake.paramsMutex.Lock()
+	ret, specificReturn := fake.paramsReturnsOnCall[len(fake.paramsArgsForCall)]
+	fake.paramsArgsForCall = append(fake.paramsArgsForCall, struct {
+	}{})
+	stub := fake.ParamsStub
+	fakeReturns := fake.paramsReturns
+	fake.recordInvocation("Params", []interface{}{})
+	fake.paramsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) ParamsCallCount() int {
+	fake.paramsMutex.RLock()
+	defer fake.paramsMutex.RUnlock()
+	return len(fake.paramsArgsForCall)
+}
+
+func (fake *FakeBuild) ParamsCalls(stub func() map[string]interface{}) {
+	fake.paramsMutex.Lock()
+	defer fake.paramsMutex.Unlock()
+	fake.ParamsStub = stub
+}
+
+func (fake *FakeBuild) ParamsReturns(result1 map[string]interface{}) {
+	fake.paramsMutex.Lock()
+	defer fake.paramsMutex.Unlock()
+	fake.ParamsStub = nil
+	fake.paramsReturns = struct {
+		result1 map[string]interface{}
+	}{result1}
+}
+
+func (fake *FakeBuild) ParamsReturnsOnCall(i int, result1 map[string]interface{}) {
+	fake.paramsMutex.Lock()
+	defer fake.paramsMutex.Unlock()
+	fake.ParamsStub = nil
+	if fake.paramsReturnsOnCall == nil {
+		fake.paramsReturnsOnCall = make(map[int]struct {
+			result1 map[string]interface{}
+		})
+	}
+	fake.paramsReturnsOnCall[i] = struct {
+		result1 map[string]interface{}
+	}{result1}
+}
+
+func (fake *FakeBuild) PendingApprovals() ([]db.BuildApproval, error) {
+	fake.pendingApprovalsMutex.Lock()
+	ret, specificReturn := fake.pendingApprovalsReturnsOnCall[len(fake.pendingApprovalsArgsForCall)]
+	fake.pendingApprovalsArgsForCall = append(fake.pendingApprovalsArgsForCall, struct {
+	}{})
+	stub := fake.PendingApprovalsStub
+	fakeReturns := fake.pendingApprovalsReturns
+	fake.recordInvocation("PendingApprovals", []interface{}{})
+	fake.pendingApprovalsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) PendingApprovalsCallCount() int {
+	fake.pendingApprovalsMutex.RLock()
+	defer fake.pendingApprovalsMutex.RUnlock()
+	return len(fake.pendingApprovalsArgsForCall)
+}
+
+func (fake *FakeBuild) PendingApprovalsCalls(stub func() ([]db.BuildApproval, error)) {
+	fake.pendingApprovalsMutex.Lock()
+	defer fake.pendingApprovalsMutex.Unlock()
+	fake.PendingApprovalsStub = stub
+}
+
+func (fake *FakeBuild) PendingApprovalsReturns(result1 []db.BuildApproval, result2 error) {
+	fake.pendingApprovalsMutex.Lock()
+	defer fake.pendingApprovalsMutex.Unlock()
+	fake.PendingApprovalsStub = nil
+	fake.pendingApprovalsReturns = struct {
+		result1 []db.BuildApproval
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) PendingApprovalsReturnsOnCall(i int, result1 []db.BuildApproval, result2 error) {
+	fake.pendingApprovalsMutex.Lock()
+	defer fake.pendingApprovalsMutex.Unlock()
+	fake.PendingApprovalsStub = nil
+	if fake.pendingApprovalsReturnsOnCall == nil {
+		fake.pendingApprovalsReturnsOnCall = make(map[int]struct {
+			result1 []db.BuildApproval
+			result2 error
+		})
+	}
+	fake.pendingApprovalsReturnsOnCall[i] = struct {
+		result1 []db.BuildApproval
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) Pipeline() (db.Pipeline, bool, error) {
 	fake.pipelineMutex.Lock()
 	ret, specificReturn := fake.pipelineReturnsOnCall[len(fake.pipelineArgsForCall)]
@@ -2901,6 +3606,59 @@ func (fake *FakeBuild) PreparationReturnsOnCall(i int, result1 db.BuildPreparati
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuild) Priority() int {
+	fake.priorityMutex.Lock()
+	ret, specificReturn := fake.priorityReturnsOnCall[len(fake.priorityArgsForCall)]
+	fake.priorityArgsForCall = append(fake.priorityArgsForCall, struct {
+	}{})
+	stub := fake.PriorityStub
+	fakeReturns := fake.priorityReturns
+	fake.recordInvocation("Priority", []interface{}{})
+	fake.priorityMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) PriorityCallCount() int {
+	fake.priorityMutex.RLock()
+	defer fake.priorityMutex.RUnlock()
+	return len(fake.priorityArgsForCall)
+}
+
+func (fake *FakeBuild) PriorityCalls(stub func() int) {
+	fake.priorityMutex.Lock()
+	defer fake.priorityMutex.Unlock()
+	fake.PriorityStub = stub
+}
+
+func (fake *FakeBuild) PriorityReturns(result1 int) {
+	fake.priorityMutex.Lock()
+	defer fake.priorityMutex.Unlock()
+	fake.PriorityStub = nil
+	fake.priorityReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeBuild) PriorityReturnsOnCall(i int, result1 int) {
+	fake.priorityMutex.Lock()
+	defer fake.priorityMutex.Unlock()
+	fake.PriorityStub = nil
+	if fake.priorityReturnsOnCall == nil {
+		fake.priorityReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.priorityReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeBuild) PrivatePlan() atc.Plan {
 	fake.privatePlanMutex.Lock()
 	ret, specificReturn := fake.privatePlanReturnsOnCall[len(fake.privatePlanArgsForCall)]
@@ -3116,6 +3874,69 @@ func (fake *FakeBuild) ReloadReturnsOnCall(i int, result1 bool, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) RequestApproval(arg1 atc.PlanID, arg2 string, arg3 string) error {
+	fake.requestApprovalMutex.Lock()
+	ret, specificReturn := fake.requestApprovalReturnsOnCall[len(fake.requestApprovalArgsForCall)]
+	fake.requestApprovalArgsForCall = append(fake.requestApprovalArgsForCall, struct {
+		arg1 atc.PlanID
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RequestApprovalStub
+	fakeReturns := fake.requestApprovalReturns
+	fake.recordInvocation("RequestApproval", []interface{}{arg1, arg2, arg3})
+	fake.requestApprovalMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) RequestApprovalCallCount() int {
+	fake.requestApprovalMutex.RLock()
+	defer fake.requestApprovalMutex.RUnlock()
+	return len(fake.requestApprovalArgsForCall)
+}
+
+func (fake *FakeBuild) RequestApprovalCalls(stub func(atc.PlanID, string, string) error) {
+	fake.requestApprovalMutex.Lock()
+	defer fake.requestApprovalMutex.Unlock()
+	fake.RequestApprovalStub = stub
+}
+
+func (fake *FakeBuild) RequestApprovalArgsForCall(i int) (atc.PlanID, string, string) {
+	fake.requestApprovalMutex.RLock()
+	defer fake.requestApprovalMutex.RUnlock()
+	argsForCall := fake.requestApprovalArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBuild) RequestApprovalReturns(result1 error) {
+	fake.requestApprovalMutex.Lock()
+	defer fake.requestApprovalMutex.Unlock()
+	fake.RequestApprovalStub = nil
+	fake.requestApprovalReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) RequestApprovalReturnsOnCall(i int, result1 error) {
+	fake.requestApprovalMutex.Lock()
+	defer fake.requestApprovalMutex.Unlock()
+	fake.RequestApprovalStub = nil
+	if fake.requestApprovalReturnsOnCall == nil {
+		fake.requestApprovalReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.requestApprovalReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) RerunNumber() int {
 	fake.rerunNumberMutex.Lock()
 	ret, specificReturn := fake.rerunNumberReturnsOnCall[len(fake.rerunNumberArgsForCall)]
@@ -3602,6 +4423,126 @@ func (fake *FakeBuild) ResourcesCheckedReturnsOnCall(i int, result1 bool, result
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) ResumedFrom() int {
+	fake.resumedFromMutex.Lock()
+	ret, specificReturn := fake.resumedFromReturnsOnCall[len(fake.resumedFromArgsForCall)]
+	fake.resumedFromArgsForCall = append(fake.resumedFromArgsForCall, struct {
+	}{})
+	stub := fake.ResumedFromStub
+	fakeReturns := fake.resumedFromReturns
+	fake.recordInvocation("ResumedFrom", []interface{}{})
+	fake.resumedFromMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) ResumedFromCallCount() int {
+	fake.resumedFromMutex.RLock()
+	defer fake.resumedFromMutex.RUnlock()
+	return len(fake.resumedFromArgsForCall)
+}
+
+func (fake *FakeBuild) ResumedFromCalls(stub func() int) {
+	fake.resumedFromMutex.Lock()
+	defer fake.resumedFromMutex.Unlock()
+	fake.ResumedFromStub = stub
+}
+
+func (fake *FakeBuild) ResumedFromReturns(result1 int) {
+	fake.resumedFromMutex.Lock()
+	defer fake.resumedFromMutex.Unlock()
+	fake.ResumedFromStub = nil
+	fake.resumedFromReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeBuild) ResumedFromReturnsOnCall(i int, result1 int) {
+	fake.resumedFromMutex.Lock()
+	defer fake.resumedFromMutex.Unlock()
+	fake.ResumedFromStub = nil
+	if fake.resumedFromReturnsOnCall == nil {
+		fake.resumedFromReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.resumedFromReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeBuild) ResumedStepCheckpoint(arg1 atc.PlanID) (db.BuildStepCheckpoint, bool, error) {
+	fake.resumedStepCheckpointMutex.Lock()
+	ret, specificReturn := fake.resumedStepCheckpointReturnsOnCall[len(fake.resumedStepCheckpointArgsForCall)]
+	fake.resumedStepCheckpointArgsForCall = append(fake.resumedStepCheckpointArgsForCall, struct {
+		arg1 atc.PlanID
+	}{arg1})
+	stub := fake.ResumedStepCheckpointStub
+	fakeReturns := fake.resumedStepCheckpointReturns
+	fake.recordInvocation("ResumedStepCheckpoint", []interface{}{arg1})
+	fake.resumedStepCheckpointMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeBuild) ResumedStepCheckpointCallCount() int {
+	fake.resumedStepCheckpointMutex.RLock()
+	defer fake.resumedStepCheckpointMutex.RUnlock()
+	return len(fake.resumedStepCheckpointArgsForCall)
+}
+
+func (fake *FakeBuild) ResumedStepCheckpointCalls(stub func(atc.PlanID) (db.BuildStepCheckpoint, bool, error)) {
+	fake.resumedStepCheckpointMutex.Lock()
+	defer fake.resumedStepCheckpointMutex.Unlock()
+	fake.ResumedStepCheckpointStub = stub
+}
+
+func (fake *FakeBuild) ResumedStepCheckpointArgsForCall(i int) atc.PlanID {
+	fake.resumedStepCheckpointMutex.RLock()
+	defer fake.resumedStepCheckpointMutex.RUnlock()
+	argsForCall := fake.resumedStepCheckpointArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) ResumedStepCheckpointReturns(result1 db.BuildStepCheckpoint, result2 bool, result3 error) {
+	fake.resumedStepCheckpointMutex.Lock()
+	defer fake.resumedStepCheckpointMutex.Unlock()
+	fake.ResumedStepCheckpointStub = nil
+	fake.resumedStepCheckpointReturns = struct {
+		result1 db.BuildStepCheckpoint
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuild) ResumedStepCheckpointReturnsOnCall(i int, result1 db.BuildStepCheckpoint, result2 bool, result3 error) {
+	fake.resumedStepCheckpointMutex.Lock()
+	defer fake.resumedStepCheckpointMutex.Unlock()
+	fake.ResumedStepCheckpointStub = nil
+	if fake.resumedStepCheckpointReturnsOnCall == nil {
+		fake.resumedStepCheckpointReturnsOnCall = make(map[int]struct {
+			result1 db.BuildStepCheckpoint
+			result2 bool
+			result3 error
+		})
+	}
+	fake.resumedStepCheckpointReturnsOnCall[i] = struct {
+		result1 db.BuildStepCheckpoint
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeBuild) RunStateID() string {
 	fake.runStateIDMutex.Lock()
 	ret, specificReturn := fake.runStateIDReturnsOnCall[len(fake.runStateIDArgsForCall)]
@@ -3915,6 +4856,197 @@ func (fake *FakeBuild) SavePipelineReturnsOnCall(i int, result1 db.Pipeline, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuild) SaveStepCheckpoint(arg1 db.BuildStepCheckpoint) error {
+	fake.saveStepCheckpointMutex.Lock()
+	ret, specificReturn := fake.saveStepCheckpointReturnsOnCall[len(fake.saveStepCheckpointArgsForCall)]
+	fake.saveStepCheckpointArgsForCall = append(fake.saveStepCheckpointArgsForCall, struct {
+		arg1 db.BuildStepCheckpoint
+	}{arg1})
+	stub := fake.SaveStepCheckpointStub
+	fakeReturns := fake.saveStepCheckpointReturns
+	fake.recordInvocation("SaveStepCheckpoint", []interface{}{arg1})
+	fake.saveStepCheckpointMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SaveStepCheckpointCallCount() int {
+	fake.saveStepCheckpointMutex.RLock()
+	defer fake.saveStepCheckpointMutex.RUnlock()
+	return len(fake.saveStepCheckpointArgsForCall)
+}
+
+func (fake *FakeBuild) SaveStepCheckpointCalls(stub func(db.BuildStepCheckpoint) error) {
+	fake.saveStepCheckpointMutex.Lock()
+	defer fake.saveStepCheckpointMutex.Unlock()
+	fake.SaveStepCheckpointStub = stub
+}
+
+func (fake *FakeBuild) SaveStepCheckpointArgsForCall(i int) db.BuildStepCheckpoint {
+	fake.saveStepCheckpointMutex.RLock()
+	defer fake.saveStepCheckpointMutex.RUnlock()
+	argsForCall := fake.saveStepCheckpointArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) SaveStepCheckpointReturns(result1 error) {
+	fake.saveStepCheckpointMutex.Lock()
+	defer fake.saveStepCheckpointMutex.Unlock()
+	fake.SaveStepCheckpointStub = nil
+	fake.saveStepCheckpointReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SaveStepCheckpointReturnsOnCall(i int, result1 error) {
+	fake.saveStepCheckpointMutex.Lock()
+	defer fake.saveStepCheckpointMutex.Unlock()
+	fake.SaveStepCheckpointStub = nil
+	if fake.saveStepCheckpointReturnsOnCall == nil {
+		fake.saveStepCheckpointReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveStepCheckpointReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SaveTaskResult(arg1 string, arg2 string, arg3 map[string]string) error {
+	fake.saveTaskResultMutex.Lock()
+	ret, specificReturn := fake.saveTaskResultReturnsOnCall[len(fake.saveTaskResultArgsForCall)]
+	fake.saveTaskResultArgsForCall = append(fake.saveTaskResultArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]string
+	}{arg1, arg2, arg3})
+	stub := fake.SaveTaskResultStub
+	fakeReturns := fake.saveTaskResultReturns
+	fake.recordInvocation("SaveTaskResult", []interface{}{arg1, arg2, arg3})
+	fake.saveTaskResultMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SaveTaskResultCallCount() int {
+	fake.saveTaskResultMutex.RLock()
+	defer fake.saveTaskResultMutex.RUnlock()
+	return len(fake.saveTaskResultArgsForCall)
+}
+
+func (fake *FakeBuild) SaveTaskResultCalls(stub func(string, string, map[string]string) error) {
+	fake.saveTaskResultMutex.Lock()
+	defer fake.saveTaskResultMutex.Unlock()
+	fake.SaveTaskResultStub = stub
+}
+
+func (fake *FakeBuild) SaveTaskResultArgsForCall(i int) (string, string, map[string]string) {
+	fake.saveTaskResultMutex.RLock()
+	defer fake.saveTaskResultMutex.RUnlock()
+	argsForCall := fake.saveTaskResultArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBuild) SaveTaskResultReturns(result1 error) {
+	fake.saveTaskResultMutex.Lock()
+	defer fake.saveTaskResultMutex.Unlock()
+	fake.SaveTaskResultStub = nil
+	fake.saveTaskResultReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SaveTaskResultReturnsOnCall(i int, result1 error) {
+	fake.saveTaskResultMutex.Lock()
+	defer fake.saveTaskResultMutex.Unlock()
+	fake.SaveTaskResultStub = nil
+	if fake.saveTaskResultReturnsOnCall == nil {
+		fake.saveTaskResultReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveTaskResultReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SaveTestResults(arg1 string, arg2 []atc.TestResult) error {
+	var arg2Copy []atc.TestResult
+	if arg2 != nil {
+		arg2Copy = make([]atc.TestResult, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.saveTestResultsMutex.Lock()
+	ret, specificReturn := fake.saveTestResultsReturnsOnCall[len(fake.saveTestResultsArgsForCall)]
+	fake.saveTestResultsArgsForCall = append(fake.saveTestResultsArgsForCall, struct {
+		arg1 string
+		arg2 []atc.TestResult
+	}{arg1, arg2Copy})
+	stub := fake.SaveTestResultsStub
+	fakeReturns := fake.saveTestResultsReturns
+	fake.recordInvocation("SaveTestResults", []interface{}{arg1, arg2Copy})
+	fake.saveTestResultsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SaveTestResultsCallCount() int {
+	fake.saveTestResultsMutex.RLock()
+	defer fake.saveTestResultsMutex.RUnlock()
+	return len(fake.saveTestResultsArgsForCall)
+}
+
+func (fake *FakeBuild) SaveTestResultsCalls(stub func(string, []atc.TestResult) error) {
+	fake.saveTestResultsMutex.Lock()
+	defer fake.saveTestResultsMutex.Unlock()
+	fake.SaveTestResultsStub = stub
+}
+
+func (fake *FakeBuild) SaveTestResultsArgsForCall(i int) (string, []atc.TestResult) {
+	fake.saveTestResultsMutex.RLock()
+	defer fake.saveTestResultsMutex.RUnlock()
+	argsForCall := fake.saveTestResultsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBuild) SaveTestResultsReturns(result1 error) {
+	fake.saveTestResultsMutex.Lock()
+	defer fake.saveTestResultsMutex.Unlock()
+	fake.SaveTestResultsStub = nil
+	fake.saveTestResultsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SaveTestResultsReturnsOnCall(i int, result1 error) {
+	fake.saveTestResultsMutex.Lock()
+	defer fake.saveTestResultsMutex.Unlock()
+	fake.SaveTestResultsStub = nil
+	if fake.saveTestResultsReturnsOnCall == nil {
+		fake.saveTestResultsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveTestResultsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) Schema() string {
 	fake.schemaMutex.Lock()
 	ret, specificReturn := fake.schemaReturnsOnCall[len(fake.schemaArgsForCall)]
@@ -3968,6 +5100,62 @@ func (fake *FakeBuild) SchemaReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeBuild) SecretAccesses() ([]atc.SecretAccess, error) {
+	fake.secretAccessesMutex.Lock()
+	ret, specificReturn := fake.secretAccessesReturnsOnCall[len(fake.secretAccessesArgsForCall)]
+	fake.secretAccessesArgsForCall = append(fake.secretAccessesArgsForCall, struct {
+	}{})
+	stub := fake.SecretAccessesStub
+	fakeReturns := fake.secretAccessesReturns
+	fake.recordInvocation("SecretAccesses", []interface{}{})
+	fake.secretAccessesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) SecretAccessesCallCount() int {
+	fake.secretAccessesMutex.RLock()
+	defer fake.secretAccessesMutex.RUnlock()
+	return len(fake.secretAccessesArgsForCall)
+}
+
+func (fake *FakeBuild) SecretAccessesCalls(stub func() ([]atc.SecretAccess, error)) {
+	fake.secretAccessesMutex.Lock()
+	defer fake.secretAccessesMutex.Unlock()
+	fake.SecretAccessesStub = stub
+}
+
+func (fake *FakeBuild) SecretAccessesReturns(result1 []atc.SecretAccess, result2 error) {
+	fake.secretAccessesMutex.Lock()
+	defer fake.secretAccessesMutex.Unlock()
+	fake.SecretAccessesStub = nil
+	fake.secretAccessesReturns = struct {
+		result1 []atc.SecretAccess
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) SecretAccessesReturnsOnCall(i int, result1 []atc.SecretAccess, result2 error) {
+	fake.secretAccessesMutex.Lock()
+	defer fake.secretAccessesMutex.Unlock()
+	fake.SecretAccessesStub = nil
+	if fake.secretAccessesReturnsOnCall == nil {
+		fake.secretAccessesReturnsOnCall = make(map[int]struct {
+			result1 []atc.SecretAccess
+			result2 error
+		})
+	}
+	fake.secretAccessesReturnsOnCall[i] = struct {
+		result1 []atc.SecretAccess
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) SetComment(arg1 string) error {
 	fake.setCommentMutex.Lock()
 	ret, specificReturn := fake.setCommentReturnsOnCall[len(fake.setCommentArgsForCall)]
@@ -4435,6 +5623,74 @@ func (fake *FakeBuild) SyslogTagReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeBuild) TaskResult(arg1 string, arg2 string) (map[string]string, bool, error) {
+	fake.taskResultMutex.Lock()
+	ret, specificReturn := fake.taskResultReturnsOnCall[len(fake.taskResultArgsForCall)]
+	fake.taskResultArgsForCall = append(fake.taskResultArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.TaskResultStub
+	fakeReturns := fake.taskResultReturns
+	fake.recordInvocation("TaskResult", []interface{}{arg1, arg2})
+	fake.taskResultMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeBuild) TaskResultCallCount() int {
+	fake.taskResultMutex.RLock()
+	defer fake.taskResultMutex.RUnlock()
+	return len(fake.taskResultArgsForCall)
+}
+
+func (fake *FakeBuild) TaskResultCalls(stub func(string, string) (map[string]string, bool, error)) {
+	fake.taskResultMutex.Lock()
+	defer fake.taskResultMutex.Unlock()
+	fake.TaskResultStub = stub
+}
+
+func (fake *FakeBuild) TaskResultArgsForCall(i int) (string, string) {
+	fake.taskResultMutex.RLock()
+	defer fake.taskResultMutex.RUnlock()
+	argsForCall := fake.taskResultArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBuild) TaskResultReturns(result1 map[string]string, result2 bool, result3 error) {
+	fake.taskResultMutex.Lock()
+	defer fake.taskResultMutex.Unlock()
+	fake.TaskResultStub = nil
+	fake.taskResultReturns = struct {
+		result1 map[string]string
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuild) TaskResultReturnsOnCall(i int, result1 map[string]string, result2 bool, result3 error) {
+	fake.taskResultMutex.Lock()
+	defer fake.taskResultMutex.Unlock()
+	fake.TaskResultStub = nil
+	if fake.taskResultReturnsOnCall == nil {
+		fake.taskResultReturnsOnCall = make(map[int]struct {
+			result1 map[string]string
+			result2 bool
+			result3 error
+		})
+	}
+	fake.taskResultReturnsOnCall[i] = struct {
+		result1 map[string]string
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeBuild) TeamID() int {
 	fake.teamIDMutex.Lock()
 	ret, specificReturn := fake.teamIDReturnsOnCall[len(fake.teamIDArgsForCall)]
@@ -4541,6 +5797,62 @@ func (fake *FakeBuild) TeamNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeBuild) TestResults() ([]atc.TestResult, error) {
+	fake.testResultsMutex.Lock()
+	ret, specificReturn := fake.testResultsReturnsOnCall[len(fake.testResultsArgsForCall)]
+	fake.testResultsArgsForCall = append(fake.testResultsArgsForCall, struct {
+	}{})
+	stub := fake.TestResultsStub
+	fakeReturns := fake.testResultsReturns
+	fake.recordInvocation("TestResults", []interface{}{})
+	fake.testResultsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) TestResultsCallCount() int {
+	fake.testResultsMutex.RLock()
+	defer fake.testResultsMutex.RUnlock()
+	return len(fake.testResultsArgsForCall)
+}
+
+func (fake *FakeBuild) TestResultsCalls(stub func() ([]atc.TestResult, error)) {
+	fake.testResultsMutex.Lock()
+	defer fake.testResultsMutex.Unlock()
+	fake.TestResultsStub = stub
+}
+
+func (fake *FakeBuild) TestResultsReturns(result1 []atc.TestResult, result2 error) {
+	fake.testResultsMutex.Lock()
+	defer fake.testResultsMutex.Unlock()
+	fake.TestResultsStub = nil
+	fake.testResultsReturns = struct {
+		result1 []atc.TestResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) TestResultsReturnsOnCall(i int, result1 []atc.TestResult, result2 error) {
+	fake.testResultsMutex.Lock()
+	defer fake.testResultsMutex.Unlock()
+	fake.TestResultsStub = nil
+	if fake.testResultsReturnsOnCall == nil {
+		fake.testResultsReturnsOnCall = make(map[int]struct {
+			result1 []atc.TestResult
+			result2 error
+		})
+	}
+	fake.testResultsReturnsOnCall[i] = struct {
+		result1 []atc.TestResult
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) TracingAttrs() tracing.Attrs {
 	fake.tracingAttrsMutex.Lock()
 	ret, specificReturn := fake.tracingAttrsReturnsOnCall[len(fake.tracingAttrsArgsForCall)]
@@ -4594,6 +5906,59 @@ func (fake *FakeBuild) TracingAttrsReturnsOnCall(i int, result1 tracing.Attrs) {
 	}{result1}
 }
 
+func (fake *FakeBuild) TriggerReason() string {
+	fake.triggerReasonMutex.Lock()
+	ret, specificReturn := fake.triggerReasonReturnsOnCall[len(fake.triggerReasonArgsForCall)]
+	fake.triggerReasonArgsForCall = append(fake.triggerReasonArgsForCall, struct {
+	}{})
+	stub := fake.TriggerReasonStub
+	fakeReturns := fake.triggerReasonReturns
+	fake.recordInvocation("TriggerReason", []interface{}{})
+	fake.triggerReasonMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) TriggerReasonCallCount() int {
+	fake.triggerReasonMutex.RLock()
+	defer fake.triggerReasonMutex.RUnlock()
+	return len(fake.triggerReasonArgsForCall)
+}
+
+func (fake *FakeBuild) TriggerReasonCalls(stub func() string) {
+	fake.triggerReasonMutex.Lock()
+	defer fake.triggerReasonMutex.Unlock()
+	fake.TriggerReasonStub = stub
+}
+
+func (fake *FakeBuild) TriggerReasonReturns(result1 string) {
+	fake.triggerReasonMutex.Lock()
+	defer fake.triggerReasonMutex.Unlock()
+	fake.TriggerReasonStub = nil
+	fake.triggerReasonReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeBuild) TriggerReasonReturnsOnCall(i int, result1 string) {
+	fake.triggerReasonMutex.Lock()
+	defer fake.triggerReasonMutex.Unlock()
+	fake.TriggerReasonStub = nil
+	if fake.triggerReasonReturnsOnCall == nil {
+		fake.triggerReasonReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.triggerReasonReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeBuild) Variables(arg1 lager.Logger, arg2 creds.Secrets, arg3 creds.VarSourcePool) (vars.Variables, error) {
 	fake.variablesMutex.Lock()
 	ret, specificReturn := fake.variablesReturnsOnCall[len(fake.variablesArgsForCall)]
@@ -4660,11 +6025,74 @@ func (fake *FakeBuild) VariablesReturnsOnCall(i int, result1 vars.Variables, res
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) WithdrawApproval(arg1 atc.PlanID) error {
+	fake.withdrawApprovalMutex.Lock()
+	ret, specificReturn := fake.withdrawApprovalReturnsOnCall[len(fake.withdrawApprovalArgsForCall)]
+	fake.withdrawApprovalArgsForCall = append(fake.withdrawApprovalArgsForCall, struct {
+		arg1 atc.PlanID
+	}{arg1})
+	stub := fake.WithdrawApprovalStub
+	fakeReturns := fake.withdrawApprovalReturns
+	fake.recordInvocation("WithdrawApproval", []interface{}{arg1})
+	fake.withdrawApprovalMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) WithdrawApprovalCallCount() int {
+	fake.withdrawApprovalMutex.RLock()
+	defer fake.withdrawApprovalMutex.RUnlock()
+	return len(fake.withdrawApprovalArgsForCall)
+}
+
+func (fake *FakeBuild) WithdrawApprovalCalls(stub func(atc.PlanID) error) {
+	fake.withdrawApprovalMutex.Lock()
+	defer fake.withdrawApprovalMutex.Unlock()
+	fake.WithdrawApprovalStub = stub
+}
+
+func (fake *FakeBuild) WithdrawApprovalArgsForCall(i int) atc.PlanID {
+	fake.withdrawApprovalMutex.RLock()
+	defer fake.withdrawApprovalMutex.RUnlock()
+	argsForCall := fake.withdrawApprovalArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) WithdrawApprovalReturns(result1 error) {
+	fake.withdrawApprovalMutex.Lock()
+	defer fake.withdrawApprovalMutex.Unlock()
+	fake.WithdrawApprovalStub = nil
+	fake.withdrawApprovalReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) WithdrawApprovalReturnsOnCall(i int, result1 error) {
+	fake.withdrawApprovalMutex.Lock()
+	defer fake.withdrawApprovalMutex.Unlock()
+	fake.WithdrawApprovalStub = nil
+	if fake.withdrawApprovalReturnsOnCall == nil {
+		fake.withdrawApprovalReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.withdrawApprovalReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.abortNotifierMutex.RLock()
 	defer fake.abortNotifierMutex.RUnlock()
+	fake.abortReasonMutex.RLock()
+	defer fake.abortReasonMutex.RUnlock()
 	fake.acquireTrackingLockMutex.RLock()
 	defer fake.acquireTrackingLockMutex.RUnlock()
 	fake.adoptInputsAndPipesMutex.RLock()
@@ -4673,6 +6101,10 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.adoptRerunInputsAndPipesMutex.RUnlock()
 	fake.allAssociatedTeamNamesMutex.RLock()
 	defer fake.allAssociatedTeamNamesMutex.RUnlock()
+	fake.approvalMutex.RLock()
+	defer fake.approvalMutex.RUnlock()
+	fake.approvalNotifierMutex.RLock()
+	defer fake.approvalNotifierMutex.RUnlock()
 	fake.artifactMutex.RLock()
 	defer fake.artifactMutex.RUnlock()
 	fake.artifactsMutex.RLock()
@@ -4685,12 +6117,16 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.createTimeMutex.RUnlock()
 	fake.createdByMutex.RLock()
 	defer fake.createdByMutex.RUnlock()
+	fake.decideApprovalMutex.RLock()
+	defer fake.decideApprovalMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
 	fake.endTimeMutex.RLock()
 	defer fake.endTimeMutex.RUnlock()
 	fake.eventsMutex.RLock()
 	defer fake.eventsMutex.RUnlock()
+	fake.eventsOffloadedMutex.RLock()
+	defer fake.eventsOffloadedMutex.RUnlock()
 	fake.finishMutex.RLock()
 	defer fake.finishMutex.RUnlock()
 	fake.hasPlanMutex.RLock()
@@ -4723,10 +6159,16 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.lagerDataMutex.RUnlock()
 	fake.markAsAbortedMutex.RLock()
 	defer fake.markAsAbortedMutex.RUnlock()
+	fake.markEventsOffloadedMutex.RLock()
+	defer fake.markEventsOffloadedMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.onCheckBuildStartMutex.RLock()
 	defer fake.onCheckBuildStartMutex.RUnlock()
+	fake.paramsMutex.RLock()
+	defer fake.paramsMutex.RUnlock()
+	fake.pendingApprovalsMutex.RLock()
+	defer fake.pendingApprovalsMutex.RUnlock()
 	fake.pipelineMutex.RLock()
 	defer fake.pipelineMutex.RUnlock()
 	fake.pipelineIDMutex.RLock()
@@ -4739,6 +6181,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.pipelineRefMutex.RUnlock()
 	fake.preparationMutex.RLock()
 	defer fake.preparationMutex.RUnlock()
+	fake.priorityMutex.RLock()
+	defer fake.priorityMutex.RUnlock()
 	fake.privatePlanMutex.RLock()
 	defer fake.privatePlanMutex.RUnlock()
 	fake.publicPlanMutex.RLock()
@@ -4747,6 +6191,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.reapTimeMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
+	fake.requestApprovalMutex.RLock()
+	defer fake.requestApprovalMutex.RUnlock()
 	fake.rerunNumberMutex.RLock()
 	defer fake.rerunNumberMutex.RUnlock()
 	fake.rerunOfMutex.RLock()
@@ -4765,6 +6211,10 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.resourcesMutex.RUnlock()
 	fake.resourcesCheckedMutex.RLock()
 	defer fake.resourcesCheckedMutex.RUnlock()
+	fake.resumedFromMutex.RLock()
+	defer fake.resumedFromMutex.RUnlock()
+	fake.resumedStepCheckpointMutex.RLock()
+	defer fake.resumedStepCheckpointMutex.RUnlock()
 	fake.runStateIDMutex.RLock()
 	defer fake.runStateIDMutex.RUnlock()
 	fake.saveEventMutex.RLock()
@@ -4775,8 +6225,16 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.saveOutputMutex.RUnlock()
 	fake.savePipelineMutex.RLock()
 	defer fake.savePipelineMutex.RUnlock()
+	fake.saveStepCheckpointMutex.RLock()
+	defer fake.saveStepCheckpointMutex.RUnlock()
+	fake.saveTaskResultMutex.RLock()
+	defer fake.saveTaskResultMutex.RUnlock()
+	fake.saveTestResultsMutex.RLock()
+	defer fake.saveTestResultsMutex.RUnlock()
 	fake.schemaMutex.RLock()
 	defer fake.schemaMutex.RUnlock()
+	fake.secretAccessesMutex.RLock()
+	defer fake.secretAccessesMutex.RUnlock()
 	fake.setCommentMutex.RLock()
 	defer fake.setCommentMutex.RUnlock()
 	fake.setDrainedMutex.RLock()
@@ -4793,14 +6251,22 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.statusMutex.RUnlock()
 	fake.syslogTagMutex.RLock()
 	defer fake.syslogTagMutex.RUnlock()
+	fake.taskResultMutex.RLock()
+	defer fake.taskResultMutex.RUnlock()
 	fake.teamIDMutex.RLock()
 	defer fake.teamIDMutex.RUnlock()
 	fake.teamNameMutex.RLock()
 	defer fake.teamNameMutex.RUnlock()
+	fake.testResultsMutex.RLock()
+	defer fake.testResultsMutex.RUnlock()
 	fake.tracingAttrsMutex.RLock()
 	defer fake.tracingAttrsMutex.RUnlock()
+	fake.triggerReasonMutex.RLock()
+	defer fake.triggerReasonMutex.RUnlock()
 	fake.variablesMutex.RLock()
 	defer fake.variablesMutex.RUnlock()
+	fake.withdrawApprovalMutex.RLock()
+	defer fake.withdrawApprovalMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -3,6 +3,7 @@ package dbfakes
 
 import (
 	"sync"
+	"time"
 
 	"github.com/concourse/concourse/atc/db"
 )
@@ -77,6 +78,21 @@ type FakeBuildFactory struct {
 		result1 []db.Build
 		result2 error
 	}
+	GetOffloadableBuildsStub        func(time.Duration, int, bool) ([]db.Build, error)
+	getOffloadableBuildsMutex       sync.RWMutex
+	getOffloadableBuildsArgsForCall []struct {
+		arg1 time.Duration
+		arg2 int
+		arg3 bool
+	}
+	getOffloadableBuildsReturns struct {
+		result1 []db.Build
+		result2 error
+	}
+	getOffloadableBuildsReturnsOnCall map[int]struct {
+		result1 []db.Build
+		result2 error
+	}
 	MarkNonInterceptibleBuildsStub        func() error
 	markNonInterceptibleBuildsMutex       sync.RWMutex
 	markNonInterceptibleBuildsArgsForCall []struct {
@@ -102,6 +118,19 @@ type FakeBuildFactory struct {
 		result2 db.Pagination
 		result3 error
 	}
+	SearchBuildLogsStub        func(db.BuildLogSearch) ([]db.BuildLogMatch, error)
+	searchBuildLogsMutex       sync.RWMutex
+	searchBuildLogsArgsForCall []struct {
+		arg1 db.BuildLogSearch
+	}
+	searchBuildLogsReturns struct {
+		result1 []db.BuildLogMatch
+		result2 error
+	}
+	searchBuildLogsReturnsOnCall map[int]struct {
+		result1 []db.BuildLogMatch
+		result2 error
+	}
 	VisibleBuildsStub        func([]string, db.Page) ([]db.BuildForAPI, db.Pagination, error)
 	visibleBuildsMutex       sync.RWMutex
 	visibleBuildsArgsForCall []struct {
@@ -435,6 +464,72 @@ func (fake *FakeBuildFactory) GetDrainableBuildsReturnsOnCall(i int, result1 []d
 	}{result1, result2}
 }
 
+func (fake *FakeBuildFactory) GetOffloadableBuilds(arg1 time.Duration, arg2 int, arg3 bool) ([]db.Build, error) {
+	fake.getOffloadableBuildsMutex.Lock()
+	ret, specificReturn := fake.getOffloadableBuildsReturnsOnCall[len(fake.getOffloadableBuildsArgsForCall)]
+	fake.getOffloadableBuildsArgsForCall = append(fake.getOffloadableBuildsArgsForCall, struct {
+		arg1 time.Duration
+		arg2 int
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.GetOffloadableBuildsStub
+	fakeReturns := fake.getOffloadableBuildsReturns
+	fake.recordInvocation("GetOffloadableBuilds", []interface{}{arg1, arg2, arg3})
+	fake.getOffloadableBuildsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuildFactory) GetOffloadableBuildsCallCount() int {
+	fake.getOffloadableBuildsMutex.RLock()
+	defer fake.getOffloadableBuildsMutex.RUnlock()
+	return len(fake.getOffloadableBuildsArgsForCall)
+}
+
+func (fake *FakeBuildFactory) GetOffloadableBuildsCalls(stub func(time.Duration, int, bool) ([]db.Build, error)) {
+	fake.getOffloadableBuildsMutex.Lock()
+	defer fake.getOffloadableBuildsMutex.Unlock()
+	fake.GetOffloadableBuildsStub = stub
+}
+
+func (fake *FakeBuildFactory) GetOffloadableBuildsArgsForCall(i int) (time.Duration, int, bool) {
+	fake.getOffloadableBuildsMutex.RLock()
+	defer fake.getOffloadableBuildsMutex.RUnlock()
+	argsForCall := fake.getOffloadableBuildsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBuildFactory) GetOffloadableBuildsReturns(result1 []db.Build, result2 error) {
+	fake.getOffloadableBuildsMutex.Lock()
+	defer fake.getOffloadableBuildsMutex.Unlock()
+	fake.GetOffloadableBuildsStub = nil
+	fake.getOffloadableBuildsReturns = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildFactory) GetOffloadableBuildsReturnsOnCall(i int, result1 []db.Build, result2 error) {
+	fake.getOffloadableBuildsMutex.Lock()
+	defer fake.getOffloadableBuildsMutex.Unlock()
+	fake.GetOffloadableBuildsStub = nil
+	if fake.getOffloadableBuildsReturnsOnCall == nil {
+		fake.getOffloadableBuildsReturnsOnCall = make(map[int]struct {
+			result1 []db.Build
+			result2 error
+		})
+	}
+	fake.getOffloadableBuildsReturnsOnCall[i] = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuildFactory) MarkNonInterceptibleBuilds() error {
 	fake.markNonInterceptibleBuildsMutex.Lock()
 	ret, specificReturn := fake.markNonInterceptibleBuildsReturnsOnCall[len(fake.markNonInterceptibleBuildsArgsForCall)]
@@ -555,6 +650,70 @@ func (fake *FakeBuildFactory) PublicBuildsReturnsOnCall(i int, result1 []db.Buil
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuildFactory) SearchBuildLogs(arg1 db.BuildLogSearch) ([]db.BuildLogMatch, error) {
+	fake.searchBuildLogsMutex.Lock()
+	ret, specificReturn := fake.searchBuildLogsReturnsOnCall[len(fake.searchBuildLogsArgsForCall)]
+	fake.searchBuildLogsArgsForCall = append(fake.searchBuildLogsArgsForCall, struct {
+		arg1 db.BuildLogSearch
+	}{arg1})
+	stub := fake.SearchBuildLogsStub
+	fakeReturns := fake.searchBuildLogsReturns
+	fake.recordInvocation("SearchBuildLogs", []interface{}{arg1})
+	fake.searchBuildLogsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuildFactory) SearchBuildLogsCallCount() int {
+	fake.searchBuildLogsMutex.RLock()
+	defer fake.searchBuildLogsMutex.RUnlock()
+	return len(fake.searchBuildLogsArgsForCall)
+}
+
+func (fake *FakeBuildFactory) SearchBuildLogsCalls(stub func(db.BuildLogSearch) ([]db.BuildLogMatch, error)) {
+	fake.searchBuildLogsMutex.Lock()
+	defer fake.searchBuildLogsMutex.Unlock()
+	fake.SearchBuildLogsStub = stub
+}
+
+func (fake *FakeBuildFactory) SearchBuildLogsArgsForCall(i int) db.BuildLogSearch {
+	fake.searchBuildLogsMutex.RLock()
+	defer fake.searchBuildLogsMutex.RUnlock()
+	argsForCall := fake.searchBuildLogsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildFactory) SearchBuildLogsReturns(result1 []db.BuildLogMatch, result2 error) {
+	fake.searchBuildLogsMutex.Lock()
+	defer fake.searchBuildLogsMutex.Unlock()
+	fake.SearchBuildLogsStub = nil
+	fake.searchBuildLogsReturns = struct {
+		result1 []db.BuildLogMatch
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildFactory) SearchBuildLogsReturnsOnCall(i int, result1 []db.BuildLogMatch, result2 error) {
+	fake.searchBuildLogsMutex.Lock()
+	defer fake.searchBuildLogsMutex.Unlock()
+	fake.SearchBuildLogsStub = nil
+	if fake.searchBuildLogsReturnsOnCall == nil {
+		fake.searchBuildLogsReturnsOnCall = make(map[int]struct {
+			result1 []db.BuildLogMatch
+			result2 error
+		})
+	}
+	fake.searchBuildLogsReturnsOnCall[i] = struct {
+		result1 []db.BuildLogMatch
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuildFactory) VisibleBuilds(arg1 []string, arg2 db.Page) ([]db.BuildForAPI, db.Pagination, error) {
 	var arg1Copy []string
 	if arg1 != nil {
@@ -641,10 +800,14 @@ func (fake *FakeBuildFactory) Invocations() map[string][][]interface{} {
 	defer fake.getAllStartedBuildsMutex.RUnlock()
 	fake.getDrainableBuildsMutex.RLock()
 	defer fake.getDrainableBuildsMutex.RUnlock()
+	fake.getOffloadableBuildsMutex.RLock()
+	defer fake.getOffloadableBuildsMutex.RUnlock()
 	fake.markNonInterceptibleBuildsMutex.RLock()
 	defer fake.markNonInterceptibleBuildsMutex.RUnlock()
 	fake.publicBuildsMutex.RLock()
 	defer fake.publicBuildsMutex.RUnlock()
+	fake.searchBuildLogsMutex.RLock()
+	defer fake.searchBuildLogsMutex.RUnlock()
 	fake.visibleBuildsMutex.RLock()
 	defer fake.visibleBuildsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -12,6 +12,16 @@ import (
 )
 
 type FakeBuildForAPI struct {
+	AbortReasonStub        func() string
+	abortReasonMutex       sync.RWMutex
+	abortReasonArgsForCall []struct {
+	}
+	abortReasonReturns struct {
+		result1 string
+	}
+	abortReasonReturnsOnCall map[int]struct {
+		result1 string
+	}
 	AllAssociatedTeamNamesStub        func() []string
 	allAssociatedTeamNamesMutex       sync.RWMutex
 	allAssociatedTeamNamesArgsForCall []struct {
@@ -54,6 +64,21 @@ type FakeBuildForAPI struct {
 	createdByReturnsOnCall map[int]struct {
 		result1 *string
 	}
+	DecideApprovalStub        func(atc.PlanID, bool, string) (bool, error)
+	decideApprovalMutex       sync.RWMutex
+	decideApprovalArgsForCall []struct {
+		arg1 atc.PlanID
+		arg2 bool
+		arg3 string
+	}
+	decideApprovalReturns struct {
+		result1 bool
+		result2 error
+	}
+	decideApprovalReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	EndTimeStub        func() time.Time
 	endTimeMutex       sync.RWMutex
 	endTimeArgsForCall []struct {
@@ -77,6 +102,16 @@ type FakeBuildForAPI struct {
 		result1 db.EventSource
 		result2 error
 	}
+	EventsOffloadedStub        func() bool
+	eventsOffloadedMutex       sync.RWMutex
+	eventsOffloadedArgsForCall []struct {
+	}
+	eventsOffloadedReturns struct {
+		result1 bool
+	}
+	eventsOffloadedReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	HasPlanStub        func() bool
 	hasPlanMutex       sync.RWMutex
 	hasPlanArgsForCall []struct {
@@ -181,6 +216,28 @@ type FakeBuildForAPI struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ParamsStub        func() map[string]interface{}
+	paramsMutex       sync.RWMutex
+	paramsArgsForCall []struct {
+	}
+	paramsReturns struct {
+		result1 map[string]interface{}
+	}
+	paramsReturnsOnCall map[int]struct {
+		result1 map[string]interface{}
+	}
+	PendingApprovalsStub        func() ([]db.BuildApproval, error)
+	pendingApprovalsMutex       sync.RWMutex
+	pendingApprovalsArgsForCall []struct {
+	}
+	pendingApprovalsReturns struct {
+		result1 []db.BuildApproval
+		result2 error
+	}
+	pendingApprovalsReturnsOnCall map[int]struct {
+		result1 []db.BuildApproval
+		result2 error
+	}
 	PipelineStub        func() (db.Pipeline, bool, error)
 	pipelineMutex       sync.RWMutex
 	pipelineArgsForCall []struct {
@@ -249,6 +306,16 @@ type FakeBuildForAPI struct {
 		result2 bool
 		result3 error
 	}
+	PriorityStub        func() int
+	priorityMutex       sync.RWMutex
+	priorityArgsForCall []struct {
+	}
+	priorityReturns struct {
+		result1 int
+	}
+	priorityReturnsOnCall map[int]struct {
+		result1 int
+	}
 	PublicPlanStub        func() *json.RawMessage
 	publicPlanMutex       sync.RWMutex
 	publicPlanArgsForCall []struct {
@@ -343,6 +410,18 @@ type FakeBuildForAPI struct {
 	schemaReturnsOnCall map[int]struct {
 		result1 string
 	}
+	SecretAccessesStub        func() ([]atc.SecretAccess, error)
+	secretAccessesMutex       sync.RWMutex
+	secretAccessesArgsForCall []struct {
+	}
+	secretAccessesReturns struct {
+		result1 []atc.SecretAccess
+		result2 error
+	}
+	secretAccessesReturnsOnCall map[int]struct {
+		result1 []atc.SecretAccess
+		result2 error
+	}
 	SetCommentStub        func(string) error
 	setCommentMutex       sync.RWMutex
 	setCommentArgsForCall []struct {
@@ -394,10 +473,85 @@ type FakeBuildForAPI struct {
 	teamNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	TestResultsStub        func() ([]atc.TestResult, error)
+	testResultsMutex       sync.RWMutex
+	testResultsArgsForCall []struct {
+	}
+	testResultsReturns struct {
+		result1 []atc.TestResult
+		result2 error
+	}
+	testResultsReturnsOnCall map[int]struct {
+		result1 []atc.TestResult
+		result2 error
+	}
+	TriggerReasonStub        func() string
+	triggerReasonMutex       sync.RWMutex
+	triggerReasonArgsForCall []struct {
+	}
+	triggerReasonReturns struct {
+		result1 string
+	}
+	triggerReasonReturnsOnCall map[int]struct {
+		result1 string
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeBuildForAPI) AbortReason() string {
+	fake.abortReasonMutex.Lock()
+	ret, specificReturn := fake.abortReasonReturnsOnCall[len(fake.abortReasonArgsForCall)]
+	fake.abortReasonArgsForCall = append(fake.abortReasonArgsForCall, struct {
+	}{})
+	stub := fake.AbortReasonStub
+	fakeReturns := fake.abortReasonReturns
+	fake.recordInvocation("AbortReason", []interface{}{})
+	fake.abortReasonMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuildForAPI) AbortReasonCallCount() int {
+	fake.abortReasonMutex.RLock()
+	defer fake.abortReasonMutex.RUnlock()
+	return len(fake.abortReasonArgsForCall)
+}
+
+func (fake *FakeBuildForAPI) AbortReasonCalls(stub func() string) {
+	fake.abortReasonMutex.Lock()
+	defer fake.abortReasonMutex.Unlock()
+	fake.AbortReasonStub = stub
+}
+
+func (fake *FakeBuildForAPI) AbortReasonReturns(result1 string) {
+	fake.abortReasonMutex.Lock()
+	defer fake.abortReasonMutex.Unlock()
+	fake.AbortReasonStub = nil
+	fake.abortReasonReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeBuildForAPI) AbortReasonReturnsOnCall(i int, result1 string) {
+	fake.abortReasonMutex.Lock()
+	defer fake.abortReasonMutex.Unlock()
+	fake.AbortReasonStub = nil
+	if fake.abortReasonReturnsOnCall == nil {
+		fake.abortReasonReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.abortReasonReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeBuildForAPI) AllAssociatedTeamNames() []string {
 	fake.allAssociatedTeamNamesMutex.Lock()
 	ret, specificReturn := fake.allAssociatedTeamNamesReturnsOnCall[len(fake.allAssociatedTeamNamesArgsForCall)]
@@ -613,6 +767,72 @@ func (fake *FakeBuildForAPI) CreatedByReturnsOnCall(i int, result1 *string) {
 	}{result1}
 }
 
+func (fake *FakeBuildForAPI) DecideApproval(arg1 atc.PlanID, arg2 bool, arg3 string) (bool, error) {
+	fake.decideApprovalMutex.Lock()
+	ret, specificReturn := fake.decideApprovalReturnsOnCall[len(fake.decideApprovalArgsForCall)]
+	fake.decideApprovalArgsForCall = append(fake.decideApprovalArgsForCall, struct {
+		arg1 atc.PlanID
+		arg2 bool
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DecideApprovalStub
+	fakeReturns := fake.decideApprovalReturns
+	fake.recordInvocation("DecideApproval", []interface{}{arg1, arg2, arg3})
+	fake.decideApprovalMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuildForAPI) DecideApprovalCallCount() int {
+	fake.decideApprovalMutex.RLock()
+	defer fake.decideApprovalMutex.RUnlock()
+	return len(fake.decideApprovalArgsForCall)
+}
+
+func (fake *FakeBuildForAPI) DecideApprovalCalls(stub func(atc.PlanID, bool, string) (bool, error)) {
+	fake.decideApprovalMutex.Lock()
+	defer fake.decideApprovalMutex.Unlock()
+	fake.DecideApprovalStub = stub
+}
+
+func (fake *FakeBuildForAPI) DecideApprovalArgsForCall(i int) (atc.PlanID, bool, string) {
+	fake.decideApprovalMutex.RLock()
+	defer fake.decideApprovalMutex.RUnlock()
+	argsForCall := fake.decideApprovalArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBuildForAPI) DecideApprovalReturns(result1 bool, result2 error) {
+	fake.decideApprovalMutex.Lock()
+	defer fake.decideApprovalMutex.Unlock()
+	fake.DecideApprovalStub = nil
+	fake.decideApprovalReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildForAPI) DecideApprovalReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.decideApprovalMutex.Lock()
+	defer fake.decideApprovalMutex.Unlock()
+	fake.DecideApprovalStub = nil
+	if fake.decideApprovalReturnsOnCall == nil {
+		fake.decideApprovalReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.decideApprovalReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuildForAPI) EndTime() time.Time {
 	fake.endTimeMutex.Lock()
 	ret, specificReturn := fake.endTimeReturnsOnCall[len(fake.endTimeArgsForCall)]
@@ -730,6 +950,59 @@ func (fake *FakeBuildForAPI) EventsReturnsOnCall(i int, result1 db.EventSource,
 	}{result1, result2}
 }
 
+func (fake *FakeBuildForAPI) EventsOffloaded() bool {
+	fake.eventsOffloadedMutex.Lock()
+	ret, specificReturn := fake.eventsOffloadedReturnsOnCall[len(fake.eventsOffloadedArgsForCall)]
+	fake.eventsOffloadedArgsForCall = append(fake.eventsOffloadedArgsForCall, struct {
+	}{})
+	stub := fake.EventsOffloadedStub
+	fakeReturns := fake.eventsOffloadedReturns
+	fake.recordInvocation("EventsOffloaded", []interface{}{})
+	fake.eventsOffloadedMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuildForAPI) EventsOffloadedCallCount() int {
+	fake.eventsOffloadedMutex.RLock()
+	defer fake.eventsOffloadedMutex.RUnlock()
+	return len(fake.eventsOffloadedArgsForCall)
+}
+
+func (fake *FakeBuildForAPI) EventsOffloadedCalls(stub func() bool) {
+	fake.eventsOffloadedMutex.Lock()
+	defer fake.eventsOffloadedMutex.Unlock()
+	fake.EventsOffloadedStub = stub
+}
+
+func (fake *FakeBuildForAPI) EventsOffloadedReturns(result1 bool) {
+	fake.eventsOffloadedMutex.Lock()
+	defer fake.eventsOffloadedMutex.Unlock()
+	fake.EventsOffloadedStub = nil
+	fake.eventsOffloadedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeBuildForAPI) EventsOffloadedReturnsOnCall(i int, result1 bool) {
+	fake.eventsOffloadedMutex.Lock()
+	defer fake.eventsOffloadedMutex.Unlock()
+	fake.EventsOffloadedStub = nil
+	if fake.eventsOffloadedReturnsOnCall == nil {
+		fake.eventsOffloadedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.eventsOffloadedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeBuildForAPI) HasPlan() bool {
 	fake.hasPlanMutex.Lock()
 	ret, specificReturn := fake.hasPlanReturnsOnCall[len(fake.hasPlanArgsForCall)]
@@ -1266,6 +1539,115 @@ func (fake *FakeBuildForAPI) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeBuildForAPI) Params() map[string]interface{} {
+	fake.paramsMutex.Lock()
+	ret, specificReturn := fake.paramsReturnsOnCall[len(fake.paramsArgsForCall)]
+	fake.paramsArgsForCall = append(fake.paramsArgsForCall, struct {
+	}{})
+	stub := fake.ParamsStub
+	fakeReturns := fake.paramsReturns
+	fake.recordInvocation("Params", []interface{}{})
+	fake.paramsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuildForAPI) ParamsCallCount() int {
+	fake.paramsMutex.RLock()
+	defer fake.paramsMutex.RUnlock()
+	return len(fake.paramsArgsForCall)
+}
+
+func (fake *FakeBuildForAPI) ParamsCalls(stub func() map[string]interface{}) {
+	fake.paramsMutex.Lock()
+	defer fake.paramsMutex.Unlock()
+	fake.ParamsStub = stub
+}
+
+func (fake *FakeBuildForAPI) ParamsReturns(result1 map[string]interface{}) {
+	fake.paramsMutex.Lock()
+	defer fake.paramsMutex.Unlock()
+	fake.ParamsStub = nil
+	fake.paramsReturns = struct {
+		result1 map[string]interface{}
+	}{result1}
+}
+
+func (fake *FakeBuildForAPI) ParamsReturnsOnCall(i int, result1 map[string]interface{}) {
+	fake.paramsMutex.Lock()
+	defer fake.paramsMutex.Unlock()
+	fake.ParamsStub = nil
+	if fake.paramsReturnsOnCall == nil {
+		fake.paramsReturnsOnCall = make(map[int]struct {
+			result1 map[string]interface{}
+		})
+	}
+	fake.paramsReturnsOnCall[i] = struct {
+		result1 map[string]interface{}
+	}{result1}
+}
+
+func (fake *FakeBuildForAPI) PendingApprovals() ([]db.BuildApproval, error) {
+	fake.pendingApprovalsMutex.Lock()
+	ret, specificReturn := fake.pendingApprovalsReturnsOnCall[len(fake.pendingApprovalsArgsForCall)]
+	fake.pendingApprovalsArgsForCall = append(fake.pendingApprovalsArgsForCall, struct {
+	}{})
+	stub := fake.PendingApprovalsStub
+	fakeReturns := fake.pendingApprovalsReturns
+	fake.recordInvocation("PendingApprovals", []interface{}{})
+	fake.pendingApprovalsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuildForAPI) PendingApprovalsCallCount() int {
+	fake.pendingApprovalsMutex.RLock()
+	defer fake.pendingApprovalsMutex.RUnlock()
+	return len(fake.pendingApprovalsArgsForCall)
+}
+
+func (fake *FakeBuildForAPI) PendingApprovalsCalls(stub func() ([]db.BuildApproval, error)) {
+	fake.pendingApprovalsMutex.Lock()
+	defer fake.pendingApprovalsMutex.Unlock()
+	fake.PendingApprovalsStub = stub
+}
+
+func (fake *FakeBuildForAPI) PendingApprovalsReturns(result1 []db.BuildApproval, result2 error) {
+	fake.pendingApprovalsMutex.Lock()
+	defer fake.pendingApprovalsMutex.Unlock()
+	fake.PendingApprovalsStub = nil
+	fake.pendingApprovalsReturns = struct {
+		result1 []db.BuildApproval
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildForAPI) PendingApprovalsReturnsOnCall(i int, result1 []db.BuildApproval, result2 error) {
+	fake.pendingApprovalsMutex.Lock()
+	defer fake.pendingApprovalsMutex.Unlock()
+	fake.PendingApprovalsStub = nil
+	if fake.pendingApprovalsReturnsOnCall == nil {
+		fake.pendingApprovalsReturnsOnCall = make(map[int]struct {
+			result1 []db.BuildApproval
+			result2 error
+		})
+	}
+	fake.pendingApprovalsReturnsOnCall[i] = struct {
+		result1 []db.BuildApproval
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuildForAPI) Pipeline() (db.Pipeline, bool, error) {
 	fake.pipelineMutex.Lock()
 	ret, specificReturn := fake.pipelineReturnsOnCall[len(fake.pipelineArgsForCall)]
@@ -1596,6 +1978,59 @@ func (fake *FakeBuildForAPI) PreparationReturnsOnCall(i int, result1 db.BuildPre
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuildForAPI) Priority() int {
+	fake.priorityMutex.Lock()
+	ret, specificReturn := fake.priorityReturnsOnCall[len(fake.priorityArgsForCall)]
+	fake.priorityArgsForCall = append(fake.priorityArgsForCall, struct {
+	}{})
+	stub := fake.PriorityStub
+	fakeReturns := fake.priorityReturns
+	fake.recordInvocation("Priority", []interface{}{})
+	fake.priorityMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuildForAPI) PriorityCallCount() int {
+	fake.priorityMutex.RLock()
+	defer fake.priorityMutex.RUnlock()
+	return len(fake.priorityArgsForCall)
+}
+
+func (fake *FakeBuildForAPI) PriorityCalls(stub func() int) {
+	fake.priorityMutex.Lock()
+	defer fake.priorityMutex.Unlock()
+	fake.PriorityStub = stub
+}
+
+func (fake *FakeBuildForAPI) PriorityReturns(result1 int) {
+	fake.priorityMutex.Lock()
+	defer fake.priorityMutex.Unlock()
+	fake.PriorityStub = nil
+	fake.priorityReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeBuildForAPI) PriorityReturnsOnCall(i int, result1 int) {
+	fake.priorityMutex.Lock()
+	defer fake.priorityMutex.Unlock()
+	fake.PriorityStub = nil
+	if fake.priorityReturnsOnCall == nil {
+		fake.priorityReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.priorityReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeBuildForAPI) PublicPlan() *json.RawMessage {
 	fake.publicPlanMutex.Lock()
 	ret, specificReturn := fake.publicPlanReturnsOnCall[len(fake.publicPlanArgsForCall)]
@@ -2079,6 +2514,62 @@ func (fake *FakeBuildForAPI) SchemaReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeBuildForAPI) SecretAccesses() ([]atc.SecretAccess, error) {
+	fake.secretAccessesMutex.Lock()
+	ret, specificReturn := fake.secretAccessesReturnsOnCall[len(fake.secretAccessesArgsForCall)]
+	fake.secretAccessesArgsForCall = append(fake.secretAccessesArgsForCall, struct {
+	}{})
+	stub := fake.SecretAccessesStub
+	fakeReturns := fake.secretAccessesReturns
+	fake.recordInvocation("SecretAccesses", []interface{}{})
+	fake.secretAccessesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuildForAPI) SecretAccessesCallCount() int {
+	fake.secretAccessesMutex.RLock()
+	defer fake.secretAccessesMutex.RUnlock()
+	return len(fake.secretAccessesArgsForCall)
+}
+
+func (fake *FakeBuildForAPI) SecretAccessesCalls(stub func() ([]atc.SecretAccess, error)) {
+	fake.secretAccessesMutex.Lock()
+	defer fake.secretAccessesMutex.Unlock()
+	fake.SecretAccessesStub = stub
+}
+
+func (fake *FakeBuildForAPI) SecretAccessesReturns(result1 []atc.SecretAccess, result2 error) {
+	fake.secretAccessesMutex.Lock()
+	defer fake.secretAccessesMutex.Unlock()
+	fake.SecretAccessesStub = nil
+	fake.secretAccessesReturns = struct {
+		result1 []atc.SecretAccess
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildForAPI) SecretAccessesReturnsOnCall(i int, result1 []atc.SecretAccess, result2 error) {
+	fake.secretAccessesMutex.Lock()
+	defer fake.secretAccessesMutex.Unlock()
+	fake.SecretAccessesStub = nil
+	if fake.secretAccessesReturnsOnCall == nil {
+		fake.secretAccessesReturnsOnCall = make(map[int]struct {
+			result1 []atc.SecretAccess
+			result2 error
+		})
+	}
+	fake.secretAccessesReturnsOnCall[i] = struct {
+		result1 []atc.SecretAccess
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuildForAPI) SetComment(arg1 string) error {
 	fake.setCommentMutex.Lock()
 	ret, specificReturn := fake.setCommentReturnsOnCall[len(fake.setCommentArgsForCall)]
@@ -2352,9 +2843,120 @@ func (fake *FakeBuildForAPI) TeamNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeBuildForAPI) TestResults() ([]atc.TestResult, error) {
+	fake.testResultsMutex.Lock()
+	ret, specificReturn := fake.testResultsReturnsOnCall[len(fake.testResultsArgsForCall)]
+	fake.testResultsArgsForCall = append(fake.testResultsArgsForCall, struct {
+	}{})
+	stub := fake.TestResultsStub
+	fakeReturns := fake.testResultsReturns
+	fake.recordInvocation("TestResults", []interface{}{})
+	fake.testResultsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuildForAPI) TestResultsCallCount() int {
+	fake.testResultsMutex.RLock()
+	defer fake.testResultsMutex.RUnlock()
+	return len(fake.testResultsArgsForCall)
+}
+
+func (fake *FakeBuildForAPI) TestResultsCalls(stub func() ([]atc.TestResult, error)) {
+	fake.testResultsMutex.Lock()
+	defer fake.testResultsMutex.Unlock()
+	fake.TestResultsStub = stub
+}
+
+func (fake *FakeBuildForAPI) TestResultsReturns(result1 []atc.TestResult, result2 error) {
+	fake.testResultsMutex.Lock()
+	defer fake.testResultsMutex.Unlock()
+	fake.TestResultsStub = nil
+	fake.testResultsReturns = struct {
+		result1 []atc.TestResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildForAPI) TestResultsReturnsOnCall(i int, result1 []atc.TestResult, result2 error) {
+	fake.testResultsMutex.Lock()
+	defer fake.testResultsMutex.Unlock()
+	fake.TestResultsStub = nil
+	if fake.testResultsReturnsOnCall == nil {
+		fake.testResultsReturnsOnCall = make(map[int]struct {
+			result1 []atc.TestResult
+			result2 error
+		})
+	}
+	fake.testResultsReturnsOnCall[i] = struct {
+		result1 []atc.TestResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildForAPI) TriggerReason() string {
+	fake.triggerReasonMutex.Lock()
+	ret, specificReturn := fake.triggerReasonReturnsOnCall[len(fake.triggerReasonArgsForCall)]
+	fake.triggerReasonArgsForCall = append(fake.triggerReasonArgsForCall, struct {
+	}{})
+	stub := fake.TriggerReasonStub
+	fakeReturns := fake.triggerReasonReturns
+	fake.recordInvocation("TriggerReason", []interface{}{})
+	fake.triggerReasonMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuildForAPI) TriggerReasonCallCount() int {
+	fake.triggerReasonMutex.RLock()
+	defer fake.triggerReasonMutex.RUnlock()
+	return len(fake.triggerReasonArgsForCall)
+}
+
+func (fake *FakeBuildForAPI) TriggerReasonCalls(stub func() string) {
+	fake.triggerReasonMutex.Lock()
+	defer fake.triggerReasonMutex.Unlock()
+	fake.TriggerReasonStub = stub
+}
+
+func (fake *FakeBuildForAPI) TriggerReasonReturns(result1 string) {
+	fake.triggerReasonMutex.Lock()
+	defer fake.triggerReasonMutex.Unlock()
+	fake.TriggerReasonStub = nil
+	fake.triggerReasonReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeBuildForAPI) TriggerReasonReturnsOnCall(i int, result1 string) {
+	fake.triggerReasonMutex.Lock()
+	defer fake.triggerReasonMutex.Unlock()
+	fake.TriggerReasonStub = nil
+	if fake.triggerReasonReturnsOnCall == nil {
+		fake.triggerReasonReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.triggerReasonReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeBuildForAPI) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.abortReasonMutex.RLock()
+	defer fake.abortReasonMutex.RUnlock()
 	fake.allAssociatedTeamNamesMutex.RLock()
 	defer fake.allAssociatedTeamNamesMutex.RUnlock()
 	fake.artifactsMutex.RLock()
@@ -2363,10 +2965,14 @@ func (fake *FakeBuildForAPI) Invocations() map[string][][]interface{} {
 	defer fake.commentMutex.RUnlock()
 	fake.createdByMutex.RLock()
 	defer fake.createdByMutex.RUnlock()
+	fake.decideApprovalMutex.RLock()
+	defer fake.decideApprovalMutex.RUnlock()
 	fake.endTimeMutex.RLock()
 	defer fake.endTimeMutex.RUnlock()
 	fake.eventsMutex.RLock()
 	defer fake.eventsMutex.RUnlock()
+	fake.eventsOffloadedMutex.RLock()
+	defer fake.eventsOffloadedMutex.RUnlock()
 	fake.hasPlanMutex.RLock()
 	defer fake.hasPlanMutex.RUnlock()
 	fake.iDMutex.RLock()
@@ -2387,6 +2993,10 @@ func (fake *FakeBuildForAPI) Invocations() map[string][][]interface{} {
 	defer fake.markAsAbortedMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.paramsMutex.RLock()
+	defer fake.paramsMutex.RUnlock()
+	fake.pendingApprovalsMutex.RLock()
+	defer fake.pendingApprovalsMutex.RUnlock()
 	fake.pipelineMutex.RLock()
 	defer fake.pipelineMutex.RUnlock()
 	fake.pipelineIDMutex.RLock()
@@ -2399,6 +3009,8 @@ func (fake *FakeBuildForAPI) Invocations() map[string][][]interface{} {
 	defer fake.pipelineRefMutex.RUnlock()
 	fake.preparationMutex.RLock()
 	defer fake.preparationMutex.RUnlock()
+	fake.priorityMutex.RLock()
+	defer fake.priorityMutex.RUnlock()
 	fake.publicPlanMutex.RLock()
 	defer fake.publicPlanMutex.RUnlock()
 	fake.reapTimeMutex.RLock()
@@ -2417,6 +3029,8 @@ func (fake *FakeBuildForAPI) Invocations() map[string][][]interface{} {
 	defer fake.resourcesMutex.RUnlock()
 	fake.schemaMutex.RLock()
 	defer fake.schemaMutex.RUnlock()
+	fake.secretAccessesMutex.RLock()
+	defer fake.secretAccessesMutex.RUnlock()
 	fake.setCommentMutex.RLock()
 	defer fake.setCommentMutex.RUnlock()
 	fake.startTimeMutex.RLock()
@@ -2427,6 +3041,10 @@ func (fake *FakeBuildForAPI) Invocations() map[string][][]interface{} {
 	defer fake.teamIDMutex.RUnlock()
 	fake.teamNameMutex.RLock()
 	defer fake.teamNameMutex.RUnlock()
+	fake.testResultsMutex.RLock()
+	defer fake.testResultsMutex.RUnlock()
+	fake.triggerReasonMutex.RLock()
+	defer fake.triggerReasonMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
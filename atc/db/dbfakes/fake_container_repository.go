@@ -9,6 +9,18 @@ import (
 )
 
 type FakeContainerRepository struct {
+	DestroyCompletedBuildServiceContainersStub        func() (int, error)
+	destroyCompletedBuildServiceContainersMutex       sync.RWMutex
+	destroyCompletedBuildServiceContainersArgsForCall []struct {
+	}
+	destroyCompletedBuildServiceContainersReturns struct {
+		result1 int
+		result2 error
+	}
+	destroyCompletedBuildServiceContainersReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
 	DestroyDirtyInMemoryBuildContainersStub        func() (int, error)
 	destroyDirtyInMemoryBuildContainersMutex       sync.RWMutex
 	destroyDirtyInMemoryBuildContainersArgsForCall []struct {
@@ -119,6 +131,62 @@ type FakeContainerRepository struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeContainerRepository) DestroyCompletedBuildServiceContainers() (int, error) {
+	fake.destroyCompletedBuildServiceContainersMutex.Lock()
+	ret, specificReturn := fake.destroyCompletedBuildServiceContainersReturnsOnCall[len(fake.destroyCompletedBuildServiceContainersArgsForCall)]
+	fake.destroyCompletedBuildServiceContainersArgsForCall = append(fake.destroyCompletedBuildServiceContainersArgsForCall, struct {
+	}{})
+	stub := fake.DestroyCompletedBuildServiceContainersStub
+	fakeReturns := fake.destroyCompletedBuildServiceContainersReturns
+	fake.recordInvocation("DestroyCompletedBuildServiceContainers", []interface{}{})
+	fake.destroyCompletedBuildServiceContainersMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContainerRepository) DestroyCompletedBuildServiceContainersCallCount() int {
+	fake.destroyCompletedBuildServiceContainersMutex.RLock()
+	defer fake.destroyCompletedBuildServiceContainersMutex.RUnlock()
+	return len(fake.destroyCompletedBuildServiceContainersArgsForCall)
+}
+
+func (fake *FakeContainerRepository) DestroyCompletedBuildServiceContainersCalls(stub func() (int, error)) {
+	fake.destroyCompletedBuildServiceContainersMutex.Lock()
+	defer fake.destroyCompletedBuildServiceContainersMutex.Unlock()
+	fake.DestroyCompletedBuildServiceContainersStub = stub
+}
+
+func (fake *FakeContainerRepository) DestroyCompletedBuildServiceContainersReturns(result1 int, result2 error) {
+	fake.destroyCompletedBuildServiceContainersMutex.Lock()
+	defer fake.destroyCompletedBuildServiceContainersMutex.Unlock()
+	fake.DestroyCompletedBuildServiceContainersStub = nil
+	fake.destroyCompletedBuildServiceContainersReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContainerRepository) DestroyCompletedBuildServiceContainersReturnsOnCall(i int, result1 int, result2 error) {
+	fake.destroyCompletedBuildServiceContainersMutex.Lock()
+	defer fake.destroyCompletedBuildServiceContainersMutex.Unlock()
+	fake.DestroyCompletedBuildServiceContainersStub = nil
+	if fake.destroyCompletedBuildServiceContainersReturnsOnCall == nil {
+		fake.destroyCompletedBuildServiceContainersReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.destroyCompletedBuildServiceContainersReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeContainerRepository) DestroyDirtyInMemoryBuildContainers() (int, error) {
 	fake.destroyDirtyInMemoryBuildContainersMutex.Lock()
 	ret, specificReturn := fake.destroyDirtyInMemoryBuildContainersReturnsOnCall[len(fake.destroyDirtyInMemoryBuildContainersArgsForCall)]
@@ -631,6 +699,8 @@ func (fake *FakeContainerRepository) UpdateContainersMissingSinceReturnsOnCall(i
 func (fake *FakeContainerRepository) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.destroyCompletedBuildServiceContainersMutex.RLock()
+	defer fake.destroyCompletedBuildServiceContainersMutex.RUnlock()
 	fake.destroyDirtyInMemoryBuildContainersMutex.RLock()
 	defer fake.destroyDirtyInMemoryBuildContainersMutex.RUnlock()
 	fake.destroyFailedContainersMutex.RLock()
@@ -139,6 +139,37 @@ type FakeJob struct {
 		result1 db.Build
 		result2 error
 	}
+	CreateBuildWithParamsStub        func(string, map[string]interface{}, *int) (db.Build, error)
+	createBuildWithParamsMutex       sync.RWMutex
+	createBuildWithParamsArgsForCall []struct {
+		arg1 string
+		arg2 map[string]interface{}
+		arg3 *int
+	}
+	createBuildWithParamsReturns struct {
+		result1 db.Build
+		result2 error
+	}
+	createBuildWithParamsReturnsOnCall map[int]struct {
+		result1 db.Build
+		result2 error
+	}
+	CreateScheduledBuildStub        func(time.Time, time.Time) (db.Build, bool, error)
+	createScheduledBuildMutex       sync.RWMutex
+	createScheduledBuildArgsForCall []struct {
+		arg1 time.Time
+		arg2 time.Time
+	}
+	createScheduledBuildReturns struct {
+		result1 db.Build
+		result2 bool
+		result3 error
+	}
+	createScheduledBuildReturnsOnCall map[int]struct {
+		result1 db.Build
+		result2 bool
+		result3 error
+	}
 	DisableManualTriggerStub        func() bool
 	disableManualTriggerMutex       sync.RWMutex
 	disableManualTriggerArgsForCall []struct {
@@ -427,6 +458,20 @@ type FakeJob struct {
 		result1 db.Build
 		result2 error
 	}
+	ResumeBuildStub        func(db.Build, string) (db.Build, error)
+	resumeBuildMutex       sync.RWMutex
+	resumeBuildArgsForCall []struct {
+		arg1 db.Build
+		arg2 string
+	}
+	resumeBuildReturns struct {
+		result1 db.Build
+		result2 error
+	}
+	resumeBuildReturnsOnCall map[int]struct {
+		result1 db.Build
+		result2 error
+	}
 	SaveNextInputMappingStub        func(db.InputMapping, bool) error
 	saveNextInputMappingMutex       sync.RWMutex
 	saveNextInputMappingArgsForCall []struct {
@@ -452,6 +497,16 @@ type FakeJob struct {
 		result1 bool
 		result2 error
 	}
+	ScheduleNextTriggerStub        func() time.Time
+	scheduleNextTriggerMutex       sync.RWMutex
+	scheduleNextTriggerArgsForCall []struct {
+	}
+	scheduleNextTriggerReturns struct {
+		result1 time.Time
+	}
+	scheduleNextTriggerReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	ScheduleRequestedTimeStub        func() time.Time
 	scheduleRequestedTimeMutex       sync.RWMutex
 	scheduleRequestedTimeArgsForCall []struct {
@@ -473,6 +528,20 @@ type FakeJob struct {
 	setHasNewInputsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	StatsStub        func(time.Time, time.Time) (atc.JobStats, error)
+	statsMutex       sync.RWMutex
+	statsArgsForCall []struct {
+		arg1 time.Time
+		arg2 time.Time
+	}
+	statsReturns struct {
+		result1 atc.JobStats
+		result2 error
+	}
+	statsReturnsOnCall map[int]struct {
+		result1 atc.JobStats
+		result2 error
+	}
 	TagsStub        func() []string
 	tagsMutex       sync.RWMutex
 	tagsArgsForCall []struct {
@@ -503,6 +572,19 @@ type FakeJob struct {
 	teamNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	TestSummariesStub        func(int) ([]atc.TestSummary, error)
+	testSummariesMutex       sync.RWMutex
+	testSummariesArgsForCall []struct {
+		arg1 int
+	}
+	testSummariesReturns struct {
+		result1 []atc.TestSummary
+		result2 error
+	}
+	testSummariesReturnsOnCall map[int]struct {
+		result1 []atc.TestSummary
+		result2 error
+	}
 	UnpauseStub        func() error
 	unpauseMutex       sync.RWMutex
 	unpauseArgsForCall []struct {
@@ -1115,6 +1197,140 @@ func (fake *FakeJob) CreateBuildReturnsOnCall(i int, result1 db.Build, result2 e
 	}{result1, result2}
 }
 
+func (fake *FakeJob) CreateBuildWithParams(arg1 string, arg2 map[string]interface{}, arg3 *int) (db.Build, error) {
+	fake.createBuildWithParamsMutex.Lock()
+	ret, specificReturn := fake.createBuildWithParamsReturnsOnCall[len(fake.createBuildWithParamsArgsForCall)]
+	fake.createBuildWithParamsArgsForCall = append(fake.createBuildWithParamsArgsForCall, struct {
+		arg1 string
+		arg2 map[string]interface{}
+		arg3 *int
+	}{arg1, arg2, arg3})
+	stub := fake.CreateBuildWithParamsStub
+	fakeReturns := fake.createBuildWithParamsReturns
+	fake.recordInvocation("CreateBuildWithParams", []interface{}{arg1, arg2, arg3})
+	fake.createBuildWithParamsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) CreateBuildWithParamsCallCount() int {
+	fake.createBuildWithParamsMutex.RLock()
+	defer fake.createBuildWithParamsMutex.RUnlock()
+	return len(fake.createBuildWithParamsArgsForCall)
+}
+
+func (fake *FakeJob) CreateBuildWithParamsCalls(stub func(string, map[string]interface{}, *int) (db.Build, error)) {
+	fake.createBuildWithParamsMutex.Lock()
+	defer fake.createBuildWithParamsMutex.Unlock()
+	fake.CreateBuildWithParamsStub = stub
+}
+
+func (fake *FakeJob) CreateBuildWithParamsArgsForCall(i int) (string, map[string]interface{}, *int) {
+	fake.createBuildWithParamsMutex.RLock()
+	defer fake.createBuildWithParamsMutex.RUnlock()
+	argsForCall := fake.createBuildWithParamsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeJob) CreateBuildWithParamsReturns(result1 db.Build, result2 error) {
+	fake.createBuildWithParamsMutex.Lock()
+	defer fake.createBuildWithParamsMutex.Unlock()
+	fake.CreateBuildWithParamsStub = nil
+	fake.createBuildWithParamsReturns = struct {
+		result1 db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) CreateBuildWithParamsReturnsOnCall(i int, result1 db.Build, result2 error) {
+	fake.createBuildWithParamsMutex.Lock()
+	defer fake.createBuildWithParamsMutex.Unlock()
+	fake.CreateBuildWithParamsStub = nil
+	if fake.createBuildWithParamsReturnsOnCall == nil {
+		fake.createBuildWithParamsReturnsOnCall = make(map[int]struct {
+			result1 db.Build
+			result2 error
+		})
+	}
+	fake.createBuildWithParamsReturnsOnCall[i] = struct {
+		result1 db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) CreateScheduledBuild(arg1 time.Time, arg2 time.Time) (db.Build, bool, error) {
+	fake.createScheduledBuildMutex.Lock()
+	ret, specificReturn := fake.createScheduledBuildReturnsOnCall[len(fake.createScheduledBuildArgsForCall)]
+	fake.createScheduledBuildArgsForCall = append(fake.createScheduledBuildArgsForCall, struct {
+		arg1 time.Time
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.CreateScheduledBuildStub
+	fakeReturns := fake.createScheduledBuildReturns
+	fake.recordInvocation("CreateScheduledBuild", []interface{}{arg1, arg2})
+	fake.createScheduledBuildMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeJob) CreateScheduledBuildCallCount() int {
+	fake.createScheduledBuildMutex.RLock()
+	defer fake.createScheduledBuildMutex.RUnlock()
+	return len(fake.createScheduledBuildArgsForCall)
+}
+
+func (fake *FakeJob) CreateScheduledBuildCalls(stub func(time.Time, time.Time) (db.Build, bool, error)) {
+	fake.createScheduledBuildMutex.Lock()
+	defer fake.createScheduledBuildMutex.Unlock()
+	fake.CreateScheduledBuildStub = stub
+}
+
+func (fake *FakeJob) CreateScheduledBuildArgsForCall(i int) (time.Time, time.Time) {
+	fake.createScheduledBuildMutex.RLock()
+	defer fake.createScheduledBuildMutex.RUnlock()
+	argsForCall := fake.createScheduledBuildArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeJob) CreateScheduledBuildReturns(result1 db.Build, result2 bool, result3 error) {
+	fake.createScheduledBuildMutex.Lock()
+	defer fake.createScheduledBuildMutex.Unlock()
+	fake.CreateScheduledBuildStub = nil
+	fake.createScheduledBuildReturns = struct {
+		result1 db.Build
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeJob) CreateScheduledBuildReturnsOnCall(i int, result1 db.Build, result2 bool, result3 error) {
+	fake.createScheduledBuildMutex.Lock()
+	defer fake.createScheduledBuildMutex.Unlock()
+	fake.CreateScheduledBuildStub = nil
+	if fake.createScheduledBuildReturnsOnCall == nil {
+		fake.createScheduledBuildReturnsOnCall = make(map[int]struct {
+			result1 db.Build
+			result2 bool
+			result3 error
+		})
+	}
+	fake.createScheduledBuildReturnsOnCall[i] = struct {
+		result1 db.Build
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeJob) DisableManualTrigger() bool {
 	fake.disableManualTriggerMutex.Lock()
 	ret, specificReturn := fake.disableManualTriggerReturnsOnCall[len(fake.disableManualTriggerArgsForCall)]
@@ -2554,6 +2770,71 @@ func (fake *FakeJob) RerunBuildReturnsOnCall(i int, result1 db.Build, result2 er
 	}{result1, result2}
 }
 
+func (fake *FakeJob) ResumeBuild(arg1 db.Build, arg2 string) (db.Build, error) {
+	fake.resumeBuildMutex.Lock()
+	ret, specificReturn := fake.resumeBuildReturnsOnCall[len(fake.resumeBuildArgsForCall)]
+	fake.resumeBuildArgsForCall = append(fake.resumeBuildArgsForCall, struct {
+		arg1 db.Build
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ResumeBuildStub
+	fakeReturns := fake.resumeBuildReturns
+	fake.recordInvocation("ResumeBuild", []interface{}{arg1, arg2})
+	fake.resumeBuildMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) ResumeBuildCallCount() int {
+	fake.resumeBuildMutex.RLock()
+	defer fake.resumeBuildMutex.RUnlock()
+	return len(fake.resumeBuildArgsForCall)
+}
+
+func (fake *FakeJob) ResumeBuildCalls(stub func(db.Build, string) (db.Build, error)) {
+	fake.resumeBuildMutex.Lock()
+	defer fake.resumeBuildMutex.Unlock()
+	fake.ResumeBuildStub = stub
+}
+
+func (fake *FakeJob) ResumeBuildArgsForCall(i int) (db.Build, string) {
+	fake.resumeBuildMutex.RLock()
+	defer fake.resumeBuildMutex.RUnlock()
+	argsForCall := fake.resumeBuildArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeJob) ResumeBuildReturns(result1 db.Build, result2 error) {
+	fake.resumeBuildMutex.Lock()
+	defer fake.resumeBuildMutex.Unlock()
+	fake.ResumeBuildStub = nil
+	fake.resumeBuildReturns = struct {
+		result1 db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) ResumeBuildReturnsOnCall(i int, result1 db.Build, result2 error) {
+	fake.resumeBuildMutex.Lock()
+	defer fake.resumeBuildMutex.Unlock()
+	fake.ResumeBuildStub = nil
+	if fake.resumeBuildReturnsOnCall == nil {
+		fake.resumeBuildReturnsOnCall = make(map[int]struct {
+			result1 db.Build
+			result2 error
+		})
+	}
+	fake.resumeBuildReturnsOnCall[i] = struct {
+		result1 db.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) SaveNextInputMapping(arg1 db.InputMapping, arg2 bool) error {
 	fake.saveNextInputMappingMutex.Lock()
 	ret, specificReturn := fake.saveNextInputMappingReturnsOnCall[len(fake.saveNextInputMappingArgsForCall)]
@@ -2680,6 +2961,59 @@ func (fake *FakeJob) ScheduleBuildReturnsOnCall(i int, result1 bool, result2 err
 	}{result1, result2}
 }
 
+func (fake *FakeJob) ScheduleNextTrigger() time.Time {
+	fake.scheduleNextTriggerMutex.Lock()
+	ret, specificReturn := fake.scheduleNextTriggerReturnsOnCall[len(fake.scheduleNextTriggerArgsForCall)]
+	fake.scheduleNextTriggerArgsForCall = append(fake.scheduleNextTriggerArgsForCall, struct {
+	}{})
+	stub := fake.ScheduleNextTriggerStub
+	fakeReturns := fake.scheduleNextTriggerReturns
+	fake.recordInvocation("ScheduleNextTrigger", []interface{}{})
+	fake.scheduleNextTriggerMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) ScheduleNextTriggerCallCount() int {
+	fake.scheduleNextTriggerMutex.RLock()
+	defer fake.scheduleNextTriggerMutex.RUnlock()
+	return len(fake.scheduleNextTriggerArgsForCall)
+}
+
+func (fake *FakeJob) ScheduleNextTriggerCalls(stub func() time.Time) {
+	fake.scheduleNextTriggerMutex.Lock()
+	defer fake.scheduleNextTriggerMutex.Unlock()
+	fake.ScheduleNextTriggerStub = stub
+}
+
+func (fake *FakeJob) ScheduleNextTriggerReturns(result1 time.Time) {
+	fake.scheduleNextTriggerMutex.Lock()
+	defer fake.scheduleNextTriggerMutex.Unlock()
+	fake.ScheduleNextTriggerStub = nil
+	fake.scheduleNextTriggerReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeJob) ScheduleNextTriggerReturnsOnCall(i int, result1 time.Time) {
+	fake.scheduleNextTriggerMutex.Lock()
+	defer fake.scheduleNextTriggerMutex.Unlock()
+	fake.ScheduleNextTriggerStub = nil
+	if fake.scheduleNextTriggerReturnsOnCall == nil {
+		fake.scheduleNextTriggerReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.scheduleNextTriggerReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeJob) ScheduleRequestedTime() time.Time {
 	fake.scheduleRequestedTimeMutex.Lock()
 	ret, specificReturn := fake.scheduleRequestedTimeReturnsOnCall[len(fake.scheduleRequestedTimeArgsForCall)]
@@ -2794,6 +3128,71 @@ func (fake *FakeJob) SetHasNewInputsReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeJob) Stats(arg1 time.Time, arg2 time.Time) (atc.JobStats, error) {
+	fake.statsMutex.Lock()
+	ret, specificReturn := fake.statsReturnsOnCall[len(fake.statsArgsForCall)]
+	fake.statsArgsForCall = append(fake.statsArgsForCall, struct {
+		arg1 time.Time
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.StatsStub
+	fakeReturns := fake.statsReturns
+	fake.recordInvocation("Stats", []interface{}{arg1, arg2})
+	fake.statsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) StatsCallCount() int {
+	fake.statsMutex.RLock()
+	defer fake.statsMutex.RUnlock()
+	return len(fake.statsArgsForCall)
+}
+
+func (fake *FakeJob) StatsCalls(stub func(time.Time, time.Time) (atc.JobStats, error)) {
+	fake.statsMutex.Lock()
+	defer fake.statsMutex.Unlock()
+	fake.StatsStub = stub
+}
+
+func (fake *FakeJob) StatsArgsForCall(i int) (time.Time, time.Time) {
+	fake.statsMutex.RLock()
+	defer fake.statsMutex.RUnlock()
+	argsForCall := fake.statsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeJob) StatsReturns(result1 atc.JobStats, result2 error) {
+	fake.statsMutex.Lock()
+	defer fake.statsMutex.Unlock()
+	fake.StatsStub = nil
+	fake.statsReturns = struct {
+		result1 atc.JobStats
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) StatsReturnsOnCall(i int, result1 atc.JobStats, result2 error) {
+	fake.statsMutex.Lock()
+	defer fake.statsMutex.Unlock()
+	fake.StatsStub = nil
+	if fake.statsReturnsOnCall == nil {
+		fake.statsReturnsOnCall = make(map[int]struct {
+			result1 atc.JobStats
+			result2 error
+		})
+	}
+	fake.statsReturnsOnCall[i] = struct {
+		result1 atc.JobStats
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) Tags() []string {
 	fake.tagsMutex.Lock()
 	ret, specificReturn := fake.tagsReturnsOnCall[len(fake.tagsArgsForCall)]
@@ -2953,6 +3352,70 @@ func (fake *FakeJob) TeamNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeJob) TestSummaries(arg1 int) ([]atc.TestSummary, error) {
+	fake.testSummariesMutex.Lock()
+	ret, specificReturn := fake.testSummariesReturnsOnCall[len(fake.testSummariesArgsForCall)]
+	fake.testSummariesArgsForCall = append(fake.testSummariesArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.TestSummariesStub
+	fakeReturns := fake.testSummariesReturns
+	fake.recordInvocation("TestSummaries", []interface{}{arg1})
+	fake.testSummariesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) TestSummariesCallCount() int {
+	fake.testSummariesMutex.RLock()
+	defer fake.testSummariesMutex.RUnlock()
+	return len(fake.testSummariesArgsForCall)
+}
+
+func (fake *FakeJob) TestSummariesCalls(stub func(int) ([]atc.TestSummary, error)) {
+	fake.testSummariesMutex.Lock()
+	defer fake.testSummariesMutex.Unlock()
+	fake.TestSummariesStub = stub
+}
+
+func (fake *FakeJob) TestSummariesArgsForCall(i int) int {
+	fake.testSummariesMutex.RLock()
+	defer fake.testSummariesMutex.RUnlock()
+	argsForCall := fake.testSummariesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) TestSummariesReturns(result1 []atc.TestSummary, result2 error) {
+	fake.testSummariesMutex.Lock()
+	defer fake.testSummariesMutex.Unlock()
+	fake.TestSummariesStub = nil
+	fake.testSummariesReturns = struct {
+		result1 []atc.TestSummary
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) TestSummariesReturnsOnCall(i int, result1 []atc.TestSummary, result2 error) {
+	fake.testSummariesMutex.Lock()
+	defer fake.testSummariesMutex.Unlock()
+	fake.TestSummariesStub = nil
+	if fake.testSummariesReturnsOnCall == nil {
+		fake.testSummariesReturnsOnCall = make(map[int]struct {
+			result1 []atc.TestSummary
+			result2 error
+		})
+	}
+	fake.testSummariesReturnsOnCall[i] = struct {
+		result1 []atc.TestSummary
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) Unpause() error {
 	fake.unpauseMutex.Lock()
 	ret, specificReturn := fake.unpauseReturnsOnCall[len(fake.unpauseArgsForCall)]
@@ -3149,6 +3612,10 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.configMutex.RUnlock()
 	fake.createBuildMutex.RLock()
 	defer fake.createBuildMutex.RUnlock()
+	fake.createBuildWithParamsMutex.RLock()
+	defer fake.createBuildWithParamsMutex.RUnlock()
+	fake.createScheduledBuildMutex.RLock()
+	defer fake.createScheduledBuildMutex.RUnlock()
 	fake.disableManualTriggerMutex.RLock()
 	defer fake.disableManualTriggerMutex.RUnlock()
 	fake.ensurePendingBuildExistsMutex.RLock()
@@ -3201,20 +3668,28 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.requestScheduleMutex.RUnlock()
 	fake.rerunBuildMutex.RLock()
 	defer fake.rerunBuildMutex.RUnlock()
+	fake.resumeBuildMutex.RLock()
+	defer fake.resumeBuildMutex.RUnlock()
 	fake.saveNextInputMappingMutex.RLock()
 	defer fake.saveNextInputMappingMutex.RUnlock()
 	fake.scheduleBuildMutex.RLock()
 	defer fake.scheduleBuildMutex.RUnlock()
+	fake.scheduleNextTriggerMutex.RLock()
+	defer fake.scheduleNextTriggerMutex.RUnlock()
 	fake.scheduleRequestedTimeMutex.RLock()
 	defer fake.scheduleRequestedTimeMutex.RUnlock()
 	fake.setHasNewInputsMutex.RLock()
 	defer fake.setHasNewInputsMutex.RUnlock()
+	fake.statsMutex.RLock()
+	defer fake.statsMutex.RUnlock()
 	fake.tagsMutex.RLock()
 	defer fake.tagsMutex.RUnlock()
 	fake.teamIDMutex.RLock()
 	defer fake.teamIDMutex.RUnlock()
 	fake.teamNameMutex.RLock()
 	defer fake.teamNameMutex.RUnlock()
+	fake.testSummariesMutex.RLock()
+	defer fake.testSummariesMutex.RUnlock()
 	fake.unpauseMutex.RLock()
 	defer fake.unpauseMutex.RUnlock()
 	fake.updateFirstLoggedBuildIDMutex.RLock()
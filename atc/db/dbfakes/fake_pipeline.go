@@ -292,6 +292,21 @@ type FakePipeline struct {
 		result1 *atc.DebugVersionsDB
 		result2 error
 	}
+	LookupNotificationStub        func(string) (atc.NotificationConfig, bool, error)
+	lookupNotificationMutex       sync.RWMutex
+	lookupNotificationArgsForCall []struct {
+		arg1 string
+	}
+	lookupNotificationReturns struct {
+		result1 atc.NotificationConfig
+		result2 bool
+		result3 error
+	}
+	lookupNotificationReturnsOnCall map[int]struct {
+		result1 atc.NotificationConfig
+		result2 bool
+		result3 error
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -302,6 +317,29 @@ type FakePipeline struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	NotificationDeliveriesStub        func(int) ([]atc.NotificationDelivery, error)
+	notificationDeliveriesMutex       sync.RWMutex
+	notificationDeliveriesArgsForCall []struct {
+		arg1 int
+	}
+	notificationDeliveriesReturns struct {
+		result1 []atc.NotificationDelivery
+		result2 error
+	}
+	notificationDeliveriesReturnsOnCall map[int]struct {
+		result1 []atc.NotificationDelivery
+		result2 error
+	}
+	NotificationsStub        func() atc.NotificationConfigs
+	notificationsMutex       sync.RWMutex
+	notificationsArgsForCall []struct {
+	}
+	notificationsReturns struct {
+		result1 atc.NotificationConfigs
+	}
+	notificationsReturnsOnCall map[int]struct {
+		result1 atc.NotificationConfigs
+	}
 	ParentBuildIDStub        func() int
 	parentBuildIDMutex       sync.RWMutex
 	parentBuildIDArgsForCall []struct {
@@ -1998,6 +2036,73 @@ func (fake *FakePipeline) LoadDebugVersionsDBReturnsOnCall(i int, result1 *atc.D
 	}{result1, result2}
 }
 
+func (fake *FakePipeline) LookupNotification(arg1 string) (atc.NotificationConfig, bool, error) {
+	fake.lookupNotificationMutex.Lock()
+	ret, specificReturn := fake.lookupNotificationReturnsOnCall[len(fake.lookupNotificationArgsForCall)]
+	fake.lookupNotificationArgsForCall = append(fake.lookupNotificationArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.LookupNotificationStub
+	fakeReturns := fake.lookupNotificationReturns
+	fake.recordInvocation("LookupNotification", []interface{}{arg1})
+	fake.lookupNotificationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakePipeline) LookupNotificationCallCount() int {
+	fake.lookupNotificationMutex.RLock()
+	defer fake.lookupNotificationMutex.RUnlock()
+	return len(fake.lookupNotificationArgsForCall)
+}
+
+func (fake *FakePipeline) LookupNotificationCalls(stub func(string) (atc.NotificationConfig, bool, error)) {
+	fake.lookupNotificationMutex.Lock()
+	defer fake.lookupNotificationMutex.Unlock()
+	fake.LookupNotificationStub = stub
+}
+
+func (fake *FakePipeline) LookupNotificationArgsForCall(i int) string {
+	fake.lookupNotificationMutex.RLock()
+	defer fake.lookupNotificationMutex.RUnlock()
+	argsForCall := fake.lookupNotificationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePipeline) LookupNotificationReturns(result1 atc.NotificationConfig, result2 bool, result3 error) {
+	fake.lookupNotificationMutex.Lock()
+	defer fake.lookupNotificationMutex.Unlock()
+	fake.LookupNotificationStub = nil
+	fake.lookupNotificationReturns = struct {
+		result1 atc.NotificationConfig
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePipeline) LookupNotificationReturnsOnCall(i int, result1 atc.NotificationConfig, result2 bool, result3 error) {
+	fake.lookupNotificationMutex.Lock()
+	defer fake.lookupNotificationMutex.Unlock()
+	fake.LookupNotificationStub = nil
+	if fake.lookupNotificationReturnsOnCall == nil {
+		fake.lookupNotificationReturnsOnCall = make(map[int]struct {
+			result1 atc.NotificationConfig
+			result2 bool
+			result3 error
+		})
+	}
+	fake.lookupNotificationReturnsOnCall[i] = struct {
+		result1 atc.NotificationConfig
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakePipeline) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -2051,6 +2156,123 @@ func (fake *FakePipeline) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakePipeline) NotificationDeliveries(arg1 int) ([]atc.NotificationDelivery, error) {
+	fake.notificationDeliveriesMutex.Lock()
+	ret, specificReturn := fake.notificationDeliveriesReturnsOnCall[len(fake.notificationDeliveriesArgsForCall)]
+	fake.notificationDeliveriesArgsForCall = append(fake.notificationDeliveriesArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.NotificationDeliveriesStub
+	fakeReturns := fake.notificationDeliveriesReturns
+	fake.recordInvocation("NotificationDeliveries", []interface{}{arg1})
+	fake.notificationDeliveriesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePipeline) NotificationDeliveriesCallCount() int {
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
+	return len(fake.notificationDeliveriesArgsForCall)
+}
+
+func (fake *FakePipeline) NotificationDeliveriesCalls(stub func(int) ([]atc.NotificationDelivery, error)) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = stub
+}
+
+func (fake *FakePipeline) NotificationDeliveriesArgsForCall(i int) int {
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
+	argsForCall := fake.notificationDeliveriesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePipeline) NotificationDeliveriesReturns(result1 []atc.NotificationDelivery, result2 error) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = nil
+	fake.notificationDeliveriesReturns = struct {
+		result1 []atc.NotificationDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) NotificationDeliveriesReturnsOnCall(i int, result1 []atc.NotificationDelivery, result2 error) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = nil
+	if fake.notificationDeliveriesReturnsOnCall == nil {
+		fake.notificationDeliveriesReturnsOnCall = make(map[int]struct {
+			result1 []atc.NotificationDelivery
+			result2 error
+		})
+	}
+	fake.notificationDeliveriesReturnsOnCall[i] = struct {
+		result1 []atc.NotificationDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) Notifications() atc.NotificationConfigs {
+	fake.notificationsMutex.Lock()
+	ret, specificReturn := fake.notificationsReturnsOnCall[len(fake.notificationsArgsForCall)]
+	fake.notificationsArgsForCall = append(fake.notificationsArgsForCall, struct {
+	}{})
+	stub := fake.NotificationsStub
+	fakeReturns := fake.notificationsReturns
+	fake.recordInvocation("Notifications", []interface{}{})
+	fake.notificationsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) NotificationsCallCount() int {
+	fake.notificationsMutex.RLock()
+	defer fake.notificationsMutex.RUnlock()
+	return len(fake.notificationsArgsForCall)
+}
+
+func (fake *FakePipeline) NotificationsCalls(stub func() atc.NotificationConfigs) {
+	fake.notificationsMutex.Lock()
+	defer fake.notificationsMutex.Unlock()
+	fake.NotificationsStub = stub
+}
+
+func (fake *FakePipeline) NotificationsReturns(result1 atc.NotificationConfigs) {
+	fake.notificationsMutex.Lock()
+	defer fake.notificationsMutex.Unlock()
+	fake.NotificationsStub = nil
+	fake.notificationsReturns = struct {
+		result1 atc.NotificationConfigs
+	}{result1}
+}
+
+func (fake *FakePipeline) NotificationsReturnsOnCall(i int, result1 atc.NotificationConfigs) {
+	fake.notificationsMutex.Lock()
+	defer fake.notificationsMutex.Unlock()
+	fake.NotificationsStub = nil
+	if fake.notificationsReturnsOnCall == nil {
+		fake.notificationsReturnsOnCall = make(map[int]struct {
+			result1 atc.NotificationConfigs
+		})
+	}
+	fake.notificationsReturnsOnCall[i] = struct {
+		result1 atc.NotificationConfigs
+	}{result1}
+}
+
 func (fake *FakePipeline) ParentBuildID() int {
 	fake.parentBuildIDMutex.Lock()
 	ret, specificReturn := fake.parentBuildIDReturnsOnCall[len(fake.parentBuildIDArgsForCall)]
@@ -3633,8 +3855,14 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.lastUpdatedMutex.RUnlock()
 	fake.loadDebugVersionsDBMutex.RLock()
 	defer fake.loadDebugVersionsDBMutex.RUnlock()
+	fake.lookupNotificationMutex.RLock()
+	defer fake.lookupNotificationMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
+	fake.notificationsMutex.RLock()
+	defer fake.notificationsMutex.RUnlock()
 	fake.parentBuildIDMutex.RLock()
 	defer fake.parentBuildIDMutex.RUnlock()
 	fake.parentJobIDMutex.RLock()
@@ -18,15 +18,28 @@ type FakePipelineLifecycle struct {
 	archiveAbandonedPipelinesReturnsOnCall map[int]struct {
 		result1 error
 	}
-	RemoveBuildEventsForDeletedPipelinesStub        func() error
+	ForgetDeletedPipelinesStub        func([]int) error
+	forgetDeletedPipelinesMutex       sync.RWMutex
+	forgetDeletedPipelinesArgsForCall []struct {
+		arg1 []int
+	}
+	forgetDeletedPipelinesReturns struct {
+		result1 error
+	}
+	forgetDeletedPipelinesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveBuildEventsForDeletedPipelinesStub        func() ([]int, error)
 	removeBuildEventsForDeletedPipelinesMutex       sync.RWMutex
 	removeBuildEventsForDeletedPipelinesArgsForCall []struct {
 	}
 	removeBuildEventsForDeletedPipelinesReturns struct {
-		result1 error
+		result1 []int
+		result2 error
 	}
 	removeBuildEventsForDeletedPipelinesReturnsOnCall map[int]struct {
-		result1 error
+		result1 []int
+		result2 error
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
@@ -85,7 +98,73 @@ func (fake *FakePipelineLifecycle) ArchiveAbandonedPipelinesReturnsOnCall(i int,
 	}{result1}
 }
 
-func (fake *FakePipelineLifecycle) RemoveBuildEventsForDeletedPipelines() error {
+func (fake *FakePipelineLifecycle) ForgetDeletedPipelines(arg1 []int) error {
+	var arg1Copy []int
+	if arg1 != nil {
+		arg1Copy = make([]int, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.forgetDeletedPipelinesMutex.Lock()
+	ret, specificReturn := fake.forgetDeletedPipelinesReturnsOnCall[len(fake.forgetDeletedPipelinesArgsForCall)]
+	fake.forgetDeletedPipelinesArgsForCall = append(fake.forgetDeletedPipelinesArgsForCall, struct {
+		arg1 []int
+	}{arg1Copy})
+	stub := fake.ForgetDeletedPipelinesStub
+	fakeReturns := fake.forgetDeletedPipelinesReturns
+	fake.recordInvocation("ForgetDeletedPipelines", []interface{}{arg1Copy})
+	fake.forgetDeletedPipelinesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePipelineLifecycle) ForgetDeletedPipelinesCallCount() int {
+	fake.forgetDeletedPipelinesMutex.RLock()
+	defer fake.forgetDeletedPipelinesMutex.RUnlock()
+	return len(fake.forgetDeletedPipelinesArgsForCall)
+}
+
+func (fake *FakePipelineLifecycle) ForgetDeletedPipelinesCalls(stub func([]int) error) {
+	fake.forgetDeletedPipelinesMutex.Lock()
+	defer fake.forgetDeletedPipelinesMutex.Unlock()
+	fake.ForgetDeletedPipelinesStub = stub
+}
+
+func (fake *FakePipelineLifecycle) ForgetDeletedPipelinesArgsForCall(i int) []int {
+	fake.forgetDeletedPipelinesMutex.RLock()
+	defer fake.forgetDeletedPipelinesMutex.RUnlock()
+	argsForCall := fake.forgetDeletedPipelinesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePipelineLifecycle) ForgetDeletedPipelinesReturns(result1 error) {
+	fake.forgetDeletedPipelinesMutex.Lock()
+	defer fake.forgetDeletedPipelinesMutex.Unlock()
+	fake.ForgetDeletedPipelinesStub = nil
+	fake.forgetDeletedPipelinesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePipelineLifecycle) ForgetDeletedPipelinesReturnsOnCall(i int, result1 error) {
+	fake.forgetDeletedPipelinesMutex.Lock()
+	defer fake.forgetDeletedPipelinesMutex.Unlock()
+	fake.ForgetDeletedPipelinesStub = nil
+	if fake.forgetDeletedPipelinesReturnsOnCall == nil {
+		fake.forgetDeletedPipelinesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.forgetDeletedPipelinesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePipelineLifecycle) RemoveBuildEventsForDeletedPipelines() ([]int, error) {
 	fake.removeBuildEventsForDeletedPipelinesMutex.Lock()
 	ret, specificReturn := fake.removeBuildEventsForDeletedPipelinesReturnsOnCall[len(fake.removeBuildEventsForDeletedPipelinesArgsForCall)]
 	fake.removeBuildEventsForDeletedPipelinesArgsForCall = append(fake.removeBuildEventsForDeletedPipelinesArgsForCall, struct {
@@ -98,9 +177,9 @@ func (fake *FakePipelineLifecycle) RemoveBuildEventsForDeletedPipelines() error
 		return stub()
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
 func (fake *FakePipelineLifecycle) RemoveBuildEventsForDeletedPipelinesCallCount() int {
@@ -109,33 +188,36 @@ func (fake *FakePipelineLifecycle) RemoveBuildEventsForDeletedPipelinesCallCount
 	return len(fake.removeBuildEventsForDeletedPipelinesArgsForCall)
 }
 
-func (fake *FakePipelineLifecycle) RemoveBuildEventsForDeletedPipelinesCalls(stub func() error) {
+func (fake *FakePipelineLifecycle) RemoveBuildEventsForDeletedPipelinesCalls(stub func() ([]int, error)) {
 	fake.removeBuildEventsForDeletedPipelinesMutex.Lock()
 	defer fake.removeBuildEventsForDeletedPipelinesMutex.Unlock()
 	fake.RemoveBuildEventsForDeletedPipelinesStub = stub
 }
 
-func (fake *FakePipelineLifecycle) RemoveBuildEventsForDeletedPipelinesReturns(result1 error) {
+func (fake *FakePipelineLifecycle) RemoveBuildEventsForDeletedPipelinesReturns(result1 []int, result2 error) {
 	fake.removeBuildEventsForDeletedPipelinesMutex.Lock()
 	defer fake.removeBuildEventsForDeletedPipelinesMutex.Unlock()
 	fake.RemoveBuildEventsForDeletedPipelinesStub = nil
 	fake.removeBuildEventsForDeletedPipelinesReturns = struct {
-		result1 error
-	}{result1}
+		result1 []int
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakePipelineLifecycle) RemoveBuildEventsForDeletedPipelinesReturnsOnCall(i int, result1 error) {
+func (fake *FakePipelineLifecycle) RemoveBuildEventsForDeletedPipelinesReturnsOnCall(i int, result1 []int, result2 error) {
 	fake.removeBuildEventsForDeletedPipelinesMutex.Lock()
 	defer fake.removeBuildEventsForDeletedPipelinesMutex.Unlock()
 	fake.RemoveBuildEventsForDeletedPipelinesStub = nil
 	if fake.removeBuildEventsForDeletedPipelinesReturnsOnCall == nil {
 		fake.removeBuildEventsForDeletedPipelinesReturnsOnCall = make(map[int]struct {
-			result1 error
+			result1 []int
+			result2 error
 		})
 	}
 	fake.removeBuildEventsForDeletedPipelinesReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
+		result1 []int
+		result2 error
+	}{result1, result2}
 }
 
 func (fake *FakePipelineLifecycle) Invocations() map[string][][]interface{} {
@@ -143,6 +225,8 @@ func (fake *FakePipelineLifecycle) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.archiveAbandonedPipelinesMutex.RLock()
 	defer fake.archiveAbandonedPipelinesMutex.RUnlock()
+	fake.forgetDeletedPipelinesMutex.RLock()
+	defer fake.forgetDeletedPipelinesMutex.RUnlock()
 	fake.removeBuildEventsForDeletedPipelinesMutex.RLock()
 	defer fake.removeBuildEventsForDeletedPipelinesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -58,6 +58,36 @@ type FakeResource struct {
 	checkEveryReturnsOnCall map[int]struct {
 		result1 *atc.CheckEvery
 	}
+	CheckFailuresStub        func() int
+	checkFailuresMutex       sync.RWMutex
+	checkFailuresArgsForCall []struct {
+	}
+	checkFailuresReturns struct {
+		result1 int
+	}
+	checkFailuresReturnsOnCall map[int]struct {
+		result1 int
+	}
+	CheckIntervalStub        func() time.Duration
+	checkIntervalMutex       sync.RWMutex
+	checkIntervalArgsForCall []struct {
+	}
+	checkIntervalReturns struct {
+		result1 time.Duration
+	}
+	checkIntervalReturnsOnCall map[int]struct {
+		result1 time.Duration
+	}
+	CheckIntervalReasonStub        func() atc.CheckIntervalReason
+	checkIntervalReasonMutex       sync.RWMutex
+	checkIntervalReasonArgsForCall []struct {
+	}
+	checkIntervalReasonReturns struct {
+		result1 atc.CheckIntervalReason
+	}
+	checkIntervalReasonReturnsOnCall map[int]struct {
+		result1 atc.CheckIntervalReason
+	}
 	CheckPlanStub        func(atc.PlanFactory, atc.ImagePlanner, atc.Version, atc.CheckEvery, atc.Source, bool, bool) atc.Plan
 	checkPlanMutex       sync.RWMutex
 	checkPlanArgsForCall []struct {
@@ -259,6 +289,16 @@ type FakeResource struct {
 	lastCheckStartTimeReturnsOnCall map[int]struct {
 		result1 time.Time
 	}
+	LastVersionTimeStub        func() time.Time
+	lastVersionTimeMutex       sync.RWMutex
+	lastVersionTimeArgsForCall []struct {
+	}
+	lastVersionTimeReturns struct {
+		result1 time.Time
+	}
+	lastVersionTimeReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -398,6 +438,18 @@ type FakeResource struct {
 	resourceConfigScopeIDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	SetCheckIntervalStub        func(time.Duration, atc.CheckIntervalReason) error
+	setCheckIntervalMutex       sync.RWMutex
+	setCheckIntervalArgsForCall []struct {
+		arg1 time.Duration
+		arg2 atc.CheckIntervalReason
+	}
+	setCheckIntervalReturns struct {
+		result1 error
+	}
+	setCheckIntervalReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SetPinCommentStub        func(string) error
 	setPinCommentMutex       sync.RWMutex
 	setPinCommentArgsForCall []struct {
@@ -524,6 +576,16 @@ type FakeResource struct {
 		result3 bool
 		result4 error
 	}
+	WebhookStub        func() *atc.WebhookConfig
+	webhookMutex       sync.RWMutex
+	webhookArgsForCall []struct {
+	}
+	webhookReturns struct {
+		result1 *atc.WebhookConfig
+	}
+	webhookReturnsOnCall map[int]struct {
+		result1 *atc.WebhookConfig
+	}
 	WebhookTokenStub        func() string
 	webhookTokenMutex       sync.RWMutex
 	webhookTokenArgsForCall []struct {
@@ -765,6 +827,165 @@ func (fake *FakeResource) CheckEveryReturnsOnCall(i int, result1 *atc.CheckEvery
 	}{result1}
 }
 
+func (fake *FakeResource) CheckFailures() int {
+	fake.checkFailuresMutex.Lock()
+	ret, specificReturn := fake.checkFailuresReturnsOnCall[len(fake.checkFailuresArgsForCall)]
+	fake.checkFailuresArgsForCall = append(fake.checkFailuresArgsForCall, struct {
+	}{})
+	stub := fake.CheckFailuresStub
+	fakeReturns := fake.checkFailuresReturns
+	fake.recordInvocation("CheckFailures", []interface{}{})
+	fake.checkFailuresMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) CheckFailuresCallCount() int {
+	fake.checkFailuresMutex.RLock()
+	defer fake.checkFailuresMutex.RUnlock()
+	return len(fake.checkFailuresArgsForCall)
+}
+
+func (fake *FakeResource) CheckFailuresCalls(stub func() int) {
+	fake.checkFailuresMutex.Lock()
+	defer fake.checkFailuresMutex.Unlock()
+	fake.CheckFailuresStub = stub
+}
+
+func (fake *FakeResource) CheckFailuresReturns(result1 int) {
+	fake.checkFailuresMutex.Lock()
+	defer fake.checkFailuresMutex.Unlock()
+	fake.CheckFailuresStub = nil
+	fake.checkFailuresReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeResource) CheckFailuresReturnsOnCall(i int, result1 int) {
+	fake.checkFailuresMutex.Lock()
+	defer fake.checkFailuresMutex.Unlock()
+	fake.CheckFailuresStub = nil
+	if fake.checkFailuresReturnsOnCall == nil {
+		fake.checkFailuresReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.checkFailuresReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeResource) CheckInterval() time.Duration {
+	fake.checkIntervalMutex.Lock()
+	ret, specificReturn := fake.checkIntervalReturnsOnCall[len(fake.checkIntervalArgsForCall)]
+	fake.checkIntervalArgsForCall = append(fake.checkIntervalArgsForCall, struct {
+	}{})
+	stub := fake.CheckIntervalStub
+	fakeReturns := fake.checkIntervalReturns
+	fake.recordInvocation("CheckInterval", []interface{}{})
+	fake.checkIntervalMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) CheckIntervalCallCount() int {
+	fake.checkIntervalMutex.RLock()
+	defer fake.checkIntervalMutex.RUnlock()
+	return len(fake.checkIntervalArgsForCall)
+}
+
+func (fake *FakeResource) CheckIntervalCalls(stub func() time.Duration) {
+	fake.checkIntervalMutex.Lock()
+	defer fake.checkIntervalMutex.Unlock()
+	fake.CheckIntervalStub = stub
+}
+
+func (fake *FakeResource) CheckIntervalReturns(result1 time.Duration) {
+	fake.checkIntervalMutex.Lock()
+	defer fake.checkIntervalMutex.Unlock()
+	fake.CheckIntervalStub = nil
+	fake.checkIntervalReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeResource) CheckIntervalReturnsOnCall(i int, result1 time.Duration) {
+	fake.checkIntervalMutex.Lock()
+	defer fake.checkIntervalMutex.Unlock()
+	fake.CheckIntervalStub = nil
+	if fake.checkIntervalReturnsOnCall == nil {
+		fake.checkIntervalReturnsOnCall = make(map[int]struct {
+			result1 time.Duration
+		})
+	}
+	fake.checkIntervalReturnsOnCall[i] = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeResource) CheckIntervalReason() atc.CheckIntervalReason {
+	fake.checkIntervalReasonMutex.Lock()
+	ret, specificReturn := fake.checkIntervalReasonReturnsOnCall[len(fake.checkIntervalReasonArgsForCall)]
+	fake.checkIntervalReasonArgsForCall = append(fake.checkIntervalReasonArgsForCall, struct {
+	}{})
+	stub := fake.CheckIntervalReasonStub
+	fakeReturns := fake.checkIntervalReasonReturns
+	fake.recordInvocation("CheckIntervalReason", []interface{}{})
+	fake.checkIntervalReasonMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) CheckIntervalReasonCallCount() int {
+	fake.checkIntervalReasonMutex.RLock()
+	defer fake.checkIntervalReasonMutex.RUnlock()
+	return len(fake.checkIntervalReasonArgsForCall)
+}
+
+func (fake *FakeResource) CheckIntervalReasonCalls(stub func() atc.CheckIntervalReason) {
+	fake.checkIntervalReasonMutex.Lock()
+	defer fake.checkIntervalReasonMutex.Unlock()
+	fake.CheckIntervalReasonStub = stub
+}
+
+func (fake *FakeResource) CheckIntervalReasonReturns(result1 atc.CheckIntervalReason) {
+	fake.checkIntervalReasonMutex.Lock()
+	defer fake.checkIntervalReasonMutex.Unlock()
+	fake.CheckIntervalReasonStub = nil
+	fake.checkIntervalReasonReturns = struct {
+		result1 atc.CheckIntervalReason
+	}{result1}
+}
+
+func (fake *FakeResource) CheckIntervalReasonReturnsOnCall(i int, result1 atc.CheckIntervalReason) {
+	fake.checkIntervalReasonMutex.Lock()
+	defer fake.checkIntervalReasonMutex.Unlock()
+	fake.CheckIntervalReasonStub = nil
+	if fake.checkIntervalReasonReturnsOnCall == nil {
+		fake.checkIntervalReasonReturnsOnCall = make(map[int]struct {
+			result1 atc.CheckIntervalReason
+		})
+	}
+	fake.checkIntervalReasonReturnsOnCall[i] = struct {
+		result1 atc.CheckIntervalReason
+	}{result1}
+}
+
 func (fake *FakeResource) CheckPlan(arg1 atc.PlanFactory, arg2 atc.ImagePlanner, arg3 atc.Version, arg4 atc.CheckEvery, arg5 atc.Source, arg6 bool, arg7 bool) atc.Plan {
 	fake.checkPlanMutex.Lock()
 	ret, specificReturn := fake.checkPlanReturnsOnCall[len(fake.checkPlanArgsForCall)]
@@ -1753,6 +1974,59 @@ func (fake *FakeResource) LastCheckStartTimeReturnsOnCall(i int, result1 time.Ti
 	}{result1}
 }
 
+func (fake *FakeResource) LastVersionTime() time.Time {
+	fake.lastVersionTimeMutex.Lock()
+	ret, specificReturn := fake.lastVersionTimeReturnsOnCall[len(fake.lastVersionTimeArgsForCall)]
+	fake.lastVersionTimeArgsForCall = append(fake.lastVersionTimeArgsForCall, struct {
+	}{})
+	stub := fake.LastVersionTimeStub
+	fakeReturns := fake.lastVersionTimeReturns
+	fake.recordInvocation("LastVersionTime", []interface{}{})
+	fake.lastVersionTimeMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) LastVersionTimeCallCount() int {
+	fake.lastVersionTimeMutex.RLock()
+	defer fake.lastVersionTimeMutex.RUnlock()
+	return len(fake.lastVersionTimeArgsForCall)
+}
+
+func (fake *FakeResource) LastVersionTimeCalls(stub func() time.Time) {
+	fake.lastVersionTimeMutex.Lock()
+	defer fake.lastVersionTimeMutex.Unlock()
+	fake.LastVersionTimeStub = stub
+}
+
+func (fake *FakeResource) LastVersionTimeReturns(result1 time.Time) {
+	fake.lastVersionTimeMutex.Lock()
+	defer fake.lastVersionTimeMutex.Unlock()
+	fake.LastVersionTimeStub = nil
+	fake.lastVersionTimeReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeResource) LastVersionTimeReturnsOnCall(i int, result1 time.Time) {
+	fake.lastVersionTimeMutex.Lock()
+	defer fake.lastVersionTimeMutex.Unlock()
+	fake.LastVersionTimeStub = nil
+	if fake.lastVersionTimeReturnsOnCall == nil {
+		fake.lastVersionTimeReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.lastVersionTimeReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeResource) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -2462,6 +2736,68 @@ func (fake *FakeResource) ResourceConfigScopeIDReturnsOnCall(i int, result1 int)
 	}{result1}
 }
 
+func (fake *FakeResource) SetCheckInterval(arg1 time.Duration, arg2 atc.CheckIntervalReason) error {
+	fake.setCheckIntervalMutex.Lock()
+	ret, specificReturn := fake.setCheckIntervalReturnsOnCall[len(fake.setCheckIntervalArgsForCall)]
+	fake.setCheckIntervalArgsForCall = append(fake.setCheckIntervalArgsForCall, struct {
+		arg1 time.Duration
+		arg2 atc.CheckIntervalReason
+	}{arg1, arg2})
+	stub := fake.SetCheckIntervalStub
+	fakeReturns := fake.setCheckIntervalReturns
+	fake.recordInvocation("SetCheckInterval", []interface{}{arg1, arg2})
+	fake.setCheckIntervalMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) SetCheckIntervalCallCount() int {
+	fake.setCheckIntervalMutex.RLock()
+	defer fake.setCheckIntervalMutex.RUnlock()
+	return len(fake.setCheckIntervalArgsForCall)
+}
+
+func (fake *FakeResource) SetCheckIntervalCalls(stub func(time.Duration, atc.CheckIntervalReason) error) {
+	fake.setCheckIntervalMutex.Lock()
+	defer fake.setCheckIntervalMutex.Unlock()
+	fake.SetCheckIntervalStub = stub
+}
+
+func (fake *FakeResource) SetCheckIntervalArgsForCall(i int) (time.Duration, atc.CheckIntervalReason) {
+	fake.setCheckIntervalMutex.RLock()
+	defer fake.setCheckIntervalMutex.RUnlock()
+	argsForCall := fake.setCheckIntervalArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeResource) SetCheckIntervalReturns(result1 error) {
+	fake.setCheckIntervalMutex.Lock()
+	defer fake.setCheckIntervalMutex.Unlock()
+	fake.SetCheckIntervalStub = nil
+	fake.setCheckIntervalReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResource) SetCheckIntervalReturnsOnCall(i int, result1 error) {
+	fake.setCheckIntervalMutex.Lock()
+	defer fake.setCheckIntervalMutex.Unlock()
+	fake.SetCheckIntervalStub = nil
+	if fake.setCheckIntervalReturnsOnCall == nil {
+		fake.setCheckIntervalReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setCheckIntervalReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeResource) SetPinComment(arg1 string) error {
 	fake.setPinCommentMutex.Lock()
 	ret, specificReturn := fake.setPinCommentReturnsOnCall[len(fake.setPinCommentArgsForCall)]
@@ -3094,6 +3430,59 @@ func (fake *FakeResource) VersionsReturnsOnCall(i int, result1 []atc.ResourceVer
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeResource) Webhook() *atc.WebhookConfig {
+	fake.webhookMutex.Lock()
+	ret, specificReturn := fake.webhookReturnsOnCall[len(fake.webhookArgsForCall)]
+	fake.webhookArgsForCall = append(fake.webhookArgsForCall, struct {
+	}{})
+	stub := fake.WebhookStub
+	fakeReturns := fake.webhookReturns
+	fake.recordInvocation("Webhook", []interface{}{})
+	fake.webhookMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) WebhookCallCount() int {
+	fake.webhookMutex.RLock()
+	defer fake.webhookMutex.RUnlock()
+	return len(fake.webhookArgsForCall)
+}
+
+func (fake *FakeResource) WebhookCalls(stub func() *atc.WebhookConfig) {
+	fake.webhookMutex.Lock()
+	defer fake.webhookMutex.Unlock()
+	fake.WebhookStub = stub
+}
+
+func (fake *FakeResource) WebhookReturns(result1 *atc.WebhookConfig) {
+	fake.webhookMutex.Lock()
+	defer fake.webhookMutex.Unlock()
+	fake.WebhookStub = nil
+	fake.webhookReturns = struct {
+		result1 *atc.WebhookConfig
+	}{result1}
+}
+
+func (fake *FakeResource) WebhookReturnsOnCall(i int, result1 *atc.WebhookConfig) {
+	fake.webhookMutex.Lock()
+	defer fake.webhookMutex.Unlock()
+	fake.WebhookStub = nil
+	if fake.webhookReturnsOnCall == nil {
+		fake.webhookReturnsOnCall = make(map[int]struct {
+			result1 *atc.WebhookConfig
+		})
+	}
+	fake.webhookReturnsOnCall[i] = struct {
+		result1 *atc.WebhookConfig
+	}{result1}
+}
+
 func (fake *FakeResource) WebhookToken() string {
 	fake.webhookTokenMutex.Lock()
 	ret, specificReturn := fake.webhookTokenReturnsOnCall[len(fake.webhookTokenArgsForCall)]
@@ -3158,6 +3547,12 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.causalityMutex.RUnlock()
 	fake.checkEveryMutex.RLock()
 	defer fake.checkEveryMutex.RUnlock()
+	fake.checkFailuresMutex.RLock()
+	defer fake.checkFailuresMutex.RUnlock()
+	fake.checkIntervalMutex.RLock()
+	defer fake.checkIntervalMutex.RUnlock()
+	fake.checkIntervalReasonMutex.RLock()
+	defer fake.checkIntervalReasonMutex.RUnlock()
 	fake.checkPlanMutex.RLock()
 	defer fake.checkPlanMutex.RUnlock()
 	fake.checkTimeoutMutex.RLock()
@@ -3192,6 +3587,8 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.lastCheckEndTimeMutex.RUnlock()
 	fake.lastCheckStartTimeMutex.RLock()
 	defer fake.lastCheckStartTimeMutex.RUnlock()
+	fake.lastVersionTimeMutex.RLock()
+	defer fake.lastVersionTimeMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.notifyScanMutex.RLock()
@@ -3218,6 +3615,8 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.resourceConfigIDMutex.RUnlock()
 	fake.resourceConfigScopeIDMutex.RLock()
 	defer fake.resourceConfigScopeIDMutex.RUnlock()
+	fake.setCheckIntervalMutex.RLock()
+	defer fake.setCheckIntervalMutex.RUnlock()
 	fake.setPinCommentMutex.RLock()
 	defer fake.setPinCommentMutex.RUnlock()
 	fake.setResourceConfigScopeMutex.RLock()
@@ -3240,6 +3639,8 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.updateMetadataMutex.RUnlock()
 	fake.versionsMutex.RLock()
 	defer fake.versionsMutex.RUnlock()
+	fake.webhookMutex.RLock()
+	defer fake.webhookMutex.RUnlock()
 	fake.webhookTokenMutex.RLock()
 	defer fake.webhookTokenMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
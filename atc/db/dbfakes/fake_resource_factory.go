@@ -35,6 +35,20 @@ type FakeResourceFactory struct {
 		result2 bool
 		result3 error
 	}
+	TeamWebhookResourcesStub        func(int, string) ([]db.Resource, error)
+	teamWebhookResourcesMutex       sync.RWMutex
+	teamWebhookResourcesArgsForCall []struct {
+		arg1 int
+		arg2 string
+	}
+	teamWebhookResourcesReturns struct {
+		result1 []db.Resource
+		result2 error
+	}
+	teamWebhookResourcesReturnsOnCall map[int]struct {
+		result1 []db.Resource
+		result2 error
+	}
 	VisibleResourcesStub        func([]string) ([]db.Resource, error)
 	visibleResourcesMutex       sync.RWMutex
 	visibleResourcesArgsForCall []struct {
@@ -175,6 +189,71 @@ func (fake *FakeResourceFactory) ResourceReturnsOnCall(i int, result1 db.Resourc
 	}{result1, result2, result3}
 }
 
+func (fake *FakeResourceFactory) TeamWebhookResources(arg1 int, arg2 string) ([]db.Resource, error) {
+	fake.teamWebhookResourcesMutex.Lock()
+	ret, specificReturn := fake.teamWebhookResourcesReturnsOnCall[len(fake.teamWebhookResourcesArgsForCall)]
+	fake.teamWebhookResourcesArgsForCall = append(fake.teamWebhookResourcesArgsForCall, struct {
+		arg1 int
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.TeamWebhookResourcesStub
+	fakeReturns := fake.teamWebhookResourcesReturns
+	fake.recordInvocation("TeamWebhookResources", []interface{}{arg1, arg2})
+	fake.teamWebhookResourcesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResourceFactory) TeamWebhookResourcesCallCount() int {
+	fake.teamWebhookResourcesMutex.RLock()
+	defer fake.teamWebhookResourcesMutex.RUnlock()
+	return len(fake.teamWebhookResourcesArgsForCall)
+}
+
+func (fake *FakeResourceFactory) TeamWebhookResourcesCalls(stub func(int, string) ([]db.Resource, error)) {
+	fake.teamWebhookResourcesMutex.Lock()
+	defer fake.teamWebhookResourcesMutex.Unlock()
+	fake.TeamWebhookResourcesStub = stub
+}
+
+func (fake *FakeResourceFactory) TeamWebhookResourcesArgsForCall(i int) (int, string) {
+	fake.teamWebhookResourcesMutex.RLock()
+	defer fake.teamWebhookResourcesMutex.RUnlock()
+	argsForCall := fake.teamWebhookResourcesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeResourceFactory) TeamWebhookResourcesReturns(result1 []db.Resource, result2 error) {
+	fake.teamWebhookResourcesMutex.Lock()
+	defer fake.teamWebhookResourcesMutex.Unlock()
+	fake.TeamWebhookResourcesStub = nil
+	fake.teamWebhookResourcesReturns = struct {
+		result1 []db.Resource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResourceFactory) TeamWebhookResourcesReturnsOnCall(i int, result1 []db.Resource, result2 error) {
+	fake.teamWebhookResourcesMutex.Lock()
+	defer fake.teamWebhookResourcesMutex.Unlock()
+	fake.TeamWebhookResourcesStub = nil
+	if fake.teamWebhookResourcesReturnsOnCall == nil {
+		fake.teamWebhookResourcesReturnsOnCall = make(map[int]struct {
+			result1 []db.Resource
+			result2 error
+		})
+	}
+	fake.teamWebhookResourcesReturnsOnCall[i] = struct {
+		result1 []db.Resource
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeResourceFactory) VisibleResources(arg1 []string) ([]db.Resource, error) {
 	var arg1Copy []string
 	if arg1 != nil {
@@ -251,6 +330,8 @@ func (fake *FakeResourceFactory) Invocations() map[string][][]interface{} {
 	defer fake.allResourcesMutex.RUnlock()
 	fake.resourceMutex.RLock()
 	defer fake.resourceMutex.RUnlock()
+	fake.teamWebhookResourcesMutex.RLock()
+	defer fake.teamWebhookResourcesMutex.RUnlock()
 	fake.visibleResourcesMutex.RLock()
 	defer fake.visibleResourcesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -274,6 +274,18 @@ type FakeTeam struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	NotificationsStub        func() (atc.NotificationConfigs, error)
+	notificationsMutex       sync.RWMutex
+	notificationsArgsForCall []struct {
+	}
+	notificationsReturns struct {
+		result1 atc.NotificationConfigs
+		result2 error
+	}
+	notificationsReturnsOnCall map[int]struct {
+		result1 atc.NotificationConfigs
+		result2 error
+	}
 	OrderPipelinesStub        func([]string) error
 	orderPipelinesMutex       sync.RWMutex
 	orderPipelinesArgsForCall []struct {
@@ -408,6 +420,17 @@ type FakeTeam struct {
 		result1 db.Worker
 		result2 error
 	}
+	UpdateNotificationsStub        func(atc.NotificationConfigs) error
+	updateNotificationsMutex       sync.RWMutex
+	updateNotificationsArgsForCall []struct {
+		arg1 atc.NotificationConfigs
+	}
+	updateNotificationsReturns struct {
+		result1 error
+	}
+	updateNotificationsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpdateProviderAuthStub        func(atc.TeamAuth) error
 	updateProviderAuthMutex       sync.RWMutex
 	updateProviderAuthArgsForCall []struct {
@@ -419,6 +442,29 @@ type FakeTeam struct {
 	updateProviderAuthReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UpdateVarSourcesStub        func(atc.VarSourceConfigs) error
+	updateVarSourcesMutex       sync.RWMutex
+	updateVarSourcesArgsForCall []struct {
+		arg1 atc.VarSourceConfigs
+	}
+	updateVarSourcesReturns struct {
+		result1 error
+	}
+	updateVarSourcesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	VarSourcesStub        func() (atc.VarSourceConfigs, error)
+	varSourcesMutex       sync.RWMutex
+	varSourcesArgsForCall []struct {
+	}
+	varSourcesReturns struct {
+		result1 atc.VarSourceConfigs
+		result2 error
+	}
+	varSourcesReturnsOnCall map[int]struct {
+		result1 atc.VarSourceConfigs
+		result2 error
+	}
 	WorkersStub        func() ([]db.Worker, error)
 	workersMutex       sync.RWMutex
 	workersArgsForCall []struct {
@@ -1672,6 +1718,62 @@ func (fake *FakeTeam) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeTeam) Notifications() (atc.NotificationConfigs, error) {
+	fake.notificationsMutex.Lock()
+	ret, specificReturn := fake.notificationsReturnsOnCall[len(fake.notificationsArgsForCall)]
+	fake.notificationsArgsForCall = append(fake.notificationsArgsForCall, struct {
+	}{})
+	stub := fake.NotificationsStub
+	fakeReturns := fake.notificationsReturns
+	fake.recordInvocation("Notifications", []interface{}{})
+	fake.notificationsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) NotificationsCallCount() int {
+	fake.notificationsMutex.RLock()
+	defer fake.notificationsMutex.RUnlock()
+	return len(fake.notificationsArgsForCall)
+}
+
+func (fake *FakeTeam) NotificationsCalls(stub func() (atc.NotificationConfigs, error)) {
+	fake.notificationsMutex.Lock()
+	defer fake.notificationsMutex.Unlock()
+	fake.NotificationsStub = stub
+}
+
+func (fake *FakeTeam) NotificationsReturns(result1 atc.NotificationConfigs, result2 error) {
+	fake.notificationsMutex.Lock()
+	defer fake.notificationsMutex.Unlock()
+	fake.NotificationsStub = nil
+	fake.notificationsReturns = struct {
+		result1 atc.NotificationConfigs
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) NotificationsReturnsOnCall(i int, result1 atc.NotificationConfigs, result2 error) {
+	fake.notificationsMutex.Lock()
+	defer fake.notificationsMutex.Unlock()
+	fake.NotificationsStub = nil
+	if fake.notificationsReturnsOnCall == nil {
+		fake.notificationsReturnsOnCall = make(map[int]struct {
+			result1 atc.NotificationConfigs
+			result2 error
+		})
+	}
+	fake.notificationsReturnsOnCall[i] = struct {
+		result1 atc.NotificationConfigs
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) OrderPipelines(arg1 []string) error {
 	var arg1Copy []string
 	if arg1 != nil {
@@ -2312,6 +2414,67 @@ func (fake *FakeTeam) SaveWorkerReturnsOnCall(i int, result1 db.Worker, result2
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) UpdateNotifications(arg1 atc.NotificationConfigs) error {
+	fake.updateNotificationsMutex.Lock()
+	ret, specificReturn := fake.updateNotificationsReturnsOnCall[len(fake.updateNotificationsArgsForCall)]
+	fake.updateNotificationsArgsForCall = append(fake.updateNotificationsArgsForCall, struct {
+		arg1 atc.NotificationConfigs
+	}{arg1})
+	stub := fake.UpdateNotificationsStub
+	fakeReturns := fake.updateNotificationsReturns
+	fake.recordInvocation("UpdateNotifications", []interface{}{arg1})
+	fake.updateNotificationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) UpdateNotificationsCallCount() int {
+	fake.updateNotificationsMutex.RLock()
+	defer fake.updateNotificationsMutex.RUnlock()
+	return len(fake.updateNotificationsArgsForCall)
+}
+
+func (fake *FakeTeam) UpdateNotificationsCalls(stub func(atc.NotificationConfigs) error) {
+	fake.updateNotificationsMutex.Lock()
+	defer fake.updateNotificationsMutex.Unlock()
+	fake.UpdateNotificationsStub = stub
+}
+
+func (fake *FakeTeam) UpdateNotificationsArgsForCall(i int) atc.NotificationConfigs {
+	fake.updateNotificationsMutex.RLock()
+	defer fake.updateNotificationsMutex.RUnlock()
+	argsForCall := fake.updateNotificationsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) UpdateNotificationsReturns(result1 error) {
+	fake.updateNotificationsMutex.Lock()
+	defer fake.updateNotificationsMutex.Unlock()
+	fake.UpdateNotificationsStub = nil
+	fake.updateNotificationsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdateNotificationsReturnsOnCall(i int, result1 error) {
+	fake.updateNotificationsMutex.Lock()
+	defer fake.updateNotificationsMutex.Unlock()
+	fake.UpdateNotificationsStub = nil
+	if fake.updateNotificationsReturnsOnCall == nil {
+		fake.updateNotificationsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateNotificationsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTeam) UpdateProviderAuth(arg1 atc.TeamAuth) error {
 	fake.updateProviderAuthMutex.Lock()
 	ret, specificReturn := fake.updateProviderAuthReturnsOnCall[len(fake.updateProviderAuthArgsForCall)]
@@ -2373,6 +2536,123 @@ func (fake *FakeTeam) UpdateProviderAuthReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTeam) UpdateVarSources(arg1 atc.VarSourceConfigs) error {
+	fake.updateVarSourcesMutex.Lock()
+	ret, specificReturn := fake.updateVarSourcesReturnsOnCall[len(fake.updateVarSourcesArgsForCall)]
+	fake.updateVarSourcesArgsForCall = append(fake.updateVarSourcesArgsForCall, struct {
+		arg1 atc.VarSourceConfigs
+	}{arg1})
+	stub := fake.UpdateVarSourcesStub
+	fakeReturns := fake.updateVarSourcesReturns
+	fake.recordInvocation("UpdateVarSources", []interface{}{arg1})
+	fake.updateVarSourcesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) UpdateVarSourcesCallCount() int {
+	fake.updateVarSourcesMutex.RLock()
+	defer fake.updateVarSourcesMutex.RUnlock()
+	return len(fake.updateVarSourcesArgsForCall)
+}
+
+func (fake *FakeTeam) UpdateVarSourcesCalls(stub func(atc.VarSourceConfigs) error) {
+	fake.updateVarSourcesMutex.Lock()
+	defer fake.updateVarSourcesMutex.Unlock()
+	fake.UpdateVarSourcesStub = stub
+}
+
+func (fake *FakeTeam) UpdateVarSourcesArgsForCall(i int) atc.VarSourceConfigs {
+	fake.updateVarSourcesMutex.RLock()
+	defer fake.updateVarSourcesMutex.RUnlock()
+	argsForCall := fake.updateVarSourcesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) UpdateVarSourcesReturns(result1 error) {
+	fake.updateVarSourcesMutex.Lock()
+	defer fake.updateVarSourcesMutex.Unlock()
+	fake.UpdateVarSourcesStub = nil
+	fake.updateVarSourcesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdateVarSourcesReturnsOnCall(i int, result1 error) {
+	fake.updateVarSourcesMutex.Lock()
+	defer fake.updateVarSourcesMutex.Unlock()
+	fake.UpdateVarSourcesStub = nil
+	if fake.updateVarSourcesReturnsOnCall == nil {
+		fake.updateVarSourcesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateVarSourcesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) VarSources() (atc.VarSourceConfigs, error) {
+	fake.varSourcesMutex.Lock()
+	ret, specificReturn := fake.varSourcesReturnsOnCall[len(fake.varSourcesArgsForCall)]
+	fake.varSourcesArgsForCall = append(fake.varSourcesArgsForCall, struct {
+	}{})
+	stub := fake.VarSourcesStub
+	fakeReturns := fake.varSourcesReturns
+	fake.recordInvocation("VarSources", []interface{}{})
+	fake.varSourcesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) VarSourcesCallCount() int {
+	fake.varSourcesMutex.RLock()
+	defer fake.varSourcesMutex.RUnlock()
+	return len(fake.varSourcesArgsForCall)
+}
+
+func (fake *FakeTeam) VarSourcesCalls(stub func() (atc.VarSourceConfigs, error)) {
+	fake.varSourcesMutex.Lock()
+	defer fake.varSourcesMutex.Unlock()
+	fake.VarSourcesStub = stub
+}
+
+func (fake *FakeTeam) VarSourcesReturns(result1 atc.VarSourceConfigs, result2 error) {
+	fake.varSourcesMutex.Lock()
+	defer fake.varSourcesMutex.Unlock()
+	fake.VarSourcesStub = nil
+	fake.varSourcesReturns = struct {
+		result1 atc.VarSourceConfigs
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) VarSourcesReturnsOnCall(i int, result1 atc.VarSourceConfigs, result2 error) {
+	fake.varSourcesMutex.Lock()
+	defer fake.varSourcesMutex.Unlock()
+	fake.VarSourcesStub = nil
+	if fake.varSourcesReturnsOnCall == nil {
+		fake.varSourcesReturnsOnCall = make(map[int]struct {
+			result1 atc.VarSourceConfigs
+			result2 error
+		})
+	}
+	fake.varSourcesReturnsOnCall[i] = struct {
+		result1 atc.VarSourceConfigs
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) Workers() ([]db.Worker, error) {
 	fake.workersMutex.Lock()
 	ret, specificReturn := fake.workersReturnsOnCall[len(fake.workersArgsForCall)]
@@ -2472,6 +2752,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.isContainerWithinTeamMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.notificationsMutex.RLock()
+	defer fake.notificationsMutex.RUnlock()
 	fake.orderPipelinesMutex.RLock()
 	defer fake.orderPipelinesMutex.RUnlock()
 	fake.orderPipelinesWithinGroupMutex.RLock()
@@ -2492,8 +2774,14 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.savePipelineMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
+	fake.updateNotificationsMutex.RLock()
+	defer fake.updateNotificationsMutex.RUnlock()
 	fake.updateProviderAuthMutex.RLock()
 	defer fake.updateProviderAuthMutex.RUnlock()
+	fake.updateVarSourcesMutex.RLock()
+	defer fake.updateVarSourcesMutex.RUnlock()
+	fake.varSourcesMutex.RLock()
+	defer fake.varSourcesMutex.RUnlock()
 	fake.workersMutex.RLock()
 	defer fake.workersMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
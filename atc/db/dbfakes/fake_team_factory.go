@@ -49,6 +49,17 @@ type FakeTeamFactory struct {
 		result2 bool
 		result3 error
 	}
+	ForgetDeletedTeamsStub        func([]int) error
+	forgetDeletedTeamsMutex       sync.RWMutex
+	forgetDeletedTeamsArgsForCall []struct {
+		arg1 []int
+	}
+	forgetDeletedTeamsReturns struct {
+		result1 error
+	}
+	forgetDeletedTeamsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetByIDStub        func(int) db.Team
 	getByIDMutex       sync.RWMutex
 	getByIDArgsForCall []struct {
@@ -92,6 +103,18 @@ type FakeTeamFactory struct {
 	notifyResourceScannerReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DeletedTeamsStub        func() ([]int, error)
+	deletedTeamsMutex       sync.RWMutex
+	deletedTeamsArgsForCall []struct {
+	}
+	deletedTeamsReturns struct {
+		result1 []int
+		result2 error
+	}
+	deletedTeamsReturnsOnCall map[int]struct {
+		result1 []int
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -283,6 +306,72 @@ func (fake *FakeTeamFactory) FindTeamReturnsOnCall(i int, result1 db.Team, resul
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeamFactory) ForgetDeletedTeams(arg1 []int) error {
+	var arg1Copy []int
+	if arg1 != nil {
+		arg1Copy = make([]int, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.forgetDeletedTeamsMutex.Lock()
+	ret, specificReturn := fake.forgetDeletedTeamsReturnsOnCall[len(fake.forgetDeletedTeamsArgsForCall)]
+	fake.forgetDeletedTeamsArgsForCall = append(fake.forgetDeletedTeamsArgsForCall, struct {
+		arg1 []int
+	}{arg1Copy})
+	stub := fake.ForgetDeletedTeamsStub
+	fakeReturns := fake.forgetDeletedTeamsReturns
+	fake.recordInvocation("ForgetDeletedTeams", []interface{}{arg1Copy})
+	fake.forgetDeletedTeamsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeamFactory) ForgetDeletedTeamsCallCount() int {
+	fake.forgetDeletedTeamsMutex.RLock()
+	defer fake.forgetDeletedTeamsMutex.RUnlock()
+	return len(fake.forgetDeletedTeamsArgsForCall)
+}
+
+func (fake *FakeTeamFactory) ForgetDeletedTeamsCalls(stub func([]int) error) {
+	fake.forgetDeletedTeamsMutex.Lock()
+	defer fake.forgetDeletedTeamsMutex.Unlock()
+	fake.ForgetDeletedTeamsStub = stub
+}
+
+func (fake *FakeTeamFactory) ForgetDeletedTeamsArgsForCall(i int) []int {
+	fake.forgetDeletedTeamsMutex.RLock()
+	defer fake.forgetDeletedTeamsMutex.RUnlock()
+	argsForCall := fake.forgetDeletedTeamsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeamFactory) ForgetDeletedTeamsReturns(result1 error) {
+	fake.forgetDeletedTeamsMutex.Lock()
+	defer fake.forgetDeletedTeamsMutex.Unlock()
+	fake.ForgetDeletedTeamsStub = nil
+	fake.forgetDeletedTeamsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeamFactory) ForgetDeletedTeamsReturnsOnCall(i int, result1 error) {
+	fake.forgetDeletedTeamsMutex.Lock()
+	defer fake.forgetDeletedTeamsMutex.Unlock()
+	fake.ForgetDeletedTeamsStub = nil
+	if fake.forgetDeletedTeamsReturnsOnCall == nil {
+		fake.forgetDeletedTeamsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.forgetDeletedTeamsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTeamFactory) GetByID(arg1 int) db.Team {
 	fake.getByIDMutex.Lock()
 	ret, specificReturn := fake.getByIDReturnsOnCall[len(fake.getByIDArgsForCall)]
@@ -506,6 +595,62 @@ func (fake *FakeTeamFactory) NotifyResourceScannerReturnsOnCall(i int, result1 e
 	}{result1}
 }
 
+func (fake *FakeTeamFactory) DeletedTeams() ([]int, error) {
+	fake.deletedTeamsMutex.Lock()
+	ret, specificReturn := fake.deletedTeamsReturnsOnCall[len(fake.deletedTeamsArgsForCall)]
+	fake.deletedTeamsArgsForCall = append(fake.deletedTeamsArgsForCall, struct {
+	}{})
+	stub := fake.DeletedTeamsStub
+	fakeReturns := fake.deletedTeamsReturns
+	fake.recordInvocation("DeletedTeams", []interface{}{})
+	fake.deletedTeamsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeamFactory) DeletedTeamsCallCount() int {
+	fake.deletedTeamsMutex.RLock()
+	defer fake.deletedTeamsMutex.RUnlock()
+	return len(fake.deletedTeamsArgsForCall)
+}
+
+func (fake *FakeTeamFactory) DeletedTeamsCalls(stub func() ([]int, error)) {
+	fake.deletedTeamsMutex.Lock()
+	defer fake.deletedTeamsMutex.Unlock()
+	fake.DeletedTeamsStub = stub
+}
+
+func (fake *FakeTeamFactory) DeletedTeamsReturns(result1 []int, result2 error) {
+	fake.deletedTeamsMutex.Lock()
+	defer fake.deletedTeamsMutex.Unlock()
+	fake.DeletedTeamsStub = nil
+	fake.deletedTeamsReturns = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeamFactory) DeletedTeamsReturnsOnCall(i int, result1 []int, result2 error) {
+	fake.deletedTeamsMutex.Lock()
+	defer fake.deletedTeamsMutex.Unlock()
+	fake.DeletedTeamsStub = nil
+	if fake.deletedTeamsReturnsOnCall == nil {
+		fake.deletedTeamsReturnsOnCall = make(map[int]struct {
+			result1 []int
+			result2 error
+		})
+	}
+	fake.deletedTeamsReturnsOnCall[i] = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeamFactory) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -515,6 +660,8 @@ func (fake *FakeTeamFactory) Invocations() map[string][][]interface{} {
 	defer fake.createTeamMutex.RUnlock()
 	fake.findTeamMutex.RLock()
 	defer fake.findTeamMutex.RUnlock()
+	fake.forgetDeletedTeamsMutex.RLock()
+	defer fake.forgetDeletedTeamsMutex.RUnlock()
 	fake.getByIDMutex.RLock()
 	defer fake.getByIDMutex.RUnlock()
 	fake.getTeamsMutex.RLock()
@@ -523,6 +670,8 @@ func (fake *FakeTeamFactory) Invocations() map[string][][]interface{} {
 	defer fake.notifyCacherMutex.RUnlock()
 	fake.notifyResourceScannerMutex.RLock()
 	defer fake.notifyResourceScannerMutex.RUnlock()
+	fake.deletedTeamsMutex.RLock()
+	defer fake.deletedTeamsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -13,6 +13,7 @@ import (
 	"code.cloudfoundry.org/lager"
 	sq "github.com/Masterminds/squirrel"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/encryption"
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/tracing"
 	"github.com/lib/pq"
@@ -63,6 +64,7 @@ type Job interface {
 	Tags() []string
 	Public() bool
 	ScheduleRequestedTime() time.Time
+	ScheduleNextTrigger() time.Time
 	MaxInFlight() int
 	DisableManualTrigger() bool
 
@@ -81,7 +83,10 @@ type Job interface {
 
 	ScheduleBuild(Build) (bool, error)
 	CreateBuild(createdBy string) (Build, error)
+	CreateBuildWithParams(createdBy string, params map[string]interface{}, priority *int) (Build, error)
+	CreateScheduledBuild(trigger time.Time, nextTrigger time.Time) (Build, bool, error)
 	RerunBuild(build Build, createdBy string) (Build, error)
+	ResumeBuild(build Build, createdBy string) (Build, error)
 
 	RequestSchedule() error
 	UpdateLastScheduled(time.Time) error
@@ -101,6 +106,9 @@ type Job interface {
 
 	ClearTaskCache(string, string) (int64, error)
 
+	TestSummaries(limit int) ([]atc.TestSummary, error)
+	Stats(since time.Time, until time.Time) (atc.JobStats, error)
+
 	AcquireSchedulingLock(lager.Logger) (lock.Lock, bool, error)
 
 	SetHasNewInputs(bool) error
@@ -126,7 +134,8 @@ var jobsQuery = psql.Select(
 	"j.max_in_flight",
 	"j.disable_manual_trigger",
 	"j.paused_by",
-	"j.paused_at").
+	"j.paused_at",
+	"j.schedule_next_trigger").
 	From("jobs j").
 	LeftJoin("pipelines p ON j.pipeline_id = p.id").
 	LeftJoin("teams t ON p.team_id = t.id")
@@ -156,6 +165,7 @@ type job struct {
 	tags                  []string
 	hasNewInputs          bool
 	scheduleRequestedTime time.Time
+	scheduleNextTrigger   time.Time
 	maxInFlight           int
 	disableManualTrigger  bool
 
@@ -219,6 +229,7 @@ func (j *job) TeamName() string                 { return j.teamName }
 func (j *job) Tags() []string                   { return j.tags }
 func (j *job) HasNewInputs() bool               { return j.hasNewInputs }
 func (j *job) ScheduleRequestedTime() time.Time { return j.scheduleRequestedTime }
+func (j *job) ScheduleNextTrigger() time.Time   { return j.scheduleNextTrigger }
 func (j *job) MaxInFlight() int                 { return j.maxInFlight }
 func (j *job) DisableManualTrigger() bool       { return j.disableManualTrigger }
 
@@ -445,12 +456,19 @@ func (j *job) Pause(pausedBy string) error {
 }
 
 func (j *job) Unpause() error {
+	tx, err := j.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
 	result, err := psql.Update("jobs").
 		Set("paused", false).
 		Set("paused_by", nil).
 		Set("paused_at", nil).
 		Where(sq.Eq{"id": j.id, "paused": true}).
-		RunWith(j.conn).
+		RunWith(tx).
 		Exec()
 	if err != nil {
 		return err
@@ -462,13 +480,18 @@ func (j *job) Unpause() error {
 	}
 
 	if rowsAffected == 1 {
-		err = j.RequestSchedule()
+		err = skipMissedScheduleTriggers(tx, j.conn.EncryptionStrategy(), sq.Eq{"id": j.id})
+		if err != nil {
+			return err
+		}
+
+		err = requestSchedule(tx, j.id)
 		if err != nil {
 			return err
 		}
 	}
 
-	return nil
+	return tx.Commit()
 }
 
 func (j *job) FinishedAndNextBuild() (Build, Build, error) {
@@ -679,6 +702,167 @@ func (j *job) ScheduleBuild(build Build) (bool, error) {
 	return scheduled, nil
 }
 
+// abortSupersededBuilds marks the older pending or running builds of the
+// job's concurrency group as aborted, if the job is configured to cancel
+// them, in the same way as MarkAsAborted. It is called as soon as a new build
+// of the job is created, so that superseded builds do not wait to be
+// scheduled behind it. The group spans every pipeline of the team. The caller
+// is responsible for notifying the returned builds with notifyAbortedBuilds
+// once the transaction is committed.
+func (j *job) abortSupersededBuilds(tx Tx, buildID int, buildName string) ([]int, error) {
+	var (
+		concurrencyGroup sql.NullString
+		cancelInProgress bool
+	)
+	err := psql.Select("concurrency_group", "cancel_in_progress").
+		From("jobs").
+		Where(sq.Eq{"id": j.id}).
+		RunWith(tx).
+		QueryRow().
+		Scan(&concurrencyGroup, &cancelInProgress)
+	if err != nil {
+		return nil, err
+	}
+
+	if !concurrencyGroup.Valid || !cancelInProgress {
+		return nil, nil
+	}
+
+	rows, err := psql.Select("id", "job_id", "status").
+		From("builds").
+		Where(sq.And{
+			sq.Expr(`job_id IN (
+				SELECT j.id FROM jobs j
+				JOIN pipelines p ON j.pipeline_id = p.id
+				WHERE p.team_id = ? AND j.concurrency_group = ?
+			)`, j.teamID, concurrencyGroup.String),
+			sq.Eq{
+				"status":  []string{string(BuildStatusPending), string(BuildStatusStarted)},
+				"aborted": false,
+			},
+			sq.Lt{"id": buildID},
+		}).
+		Suffix("FOR UPDATE").
+		RunWith(tx).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	type supersededBuild struct {
+		id     int
+		jobID  int
+		status BuildStatus
+	}
+
+	var superseded []supersededBuild
+	for rows.Next() {
+		var b supersededBuild
+		err = rows.Scan(&b.id, &b.jobID, &b.status)
+		if err != nil {
+			Close(rows)
+			return nil, err
+		}
+
+		superseded = append(superseded, b)
+	}
+
+	Close(rows)
+
+	reason := fmt.Sprintf("superseded by %s/%s #%s", j.PipelineRef(), j.name, buildName)
+
+	var buildIDs []int
+	for _, b := range superseded {
+		err = markBuildAsAborted(tx, b.id, b.jobID, b.status, reason)
+		if err != nil {
+			return nil, err
+		}
+
+		buildIDs = append(buildIDs, b.id)
+	}
+
+	return buildIDs, nil
+}
+
+func (j *job) notifyAbortedBuilds(buildIDs []int) error {
+	for _, id := range buildIDs {
+		err := j.conn.Bus().Notify(buildAbortChannel(id))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// skipMissedScheduleTriggers moves the next trigger of the matching jobs'
+// schedules past now if it has already passed. Schedules are paused along
+// with their jobs, so triggers missed in the meantime are not fired once the
+// jobs are unpaused.
+func skipMissedScheduleTriggers(tx Tx, es encryption.Strategy, jobs sq.Sqlizer) error {
+	rows, err := psql.Select("id", "config", "nonce").
+		From("jobs").
+		Where(jobs).
+		Where(sq.Expr("schedule_next_trigger <= now()")).
+		RunWith(tx).
+		Query()
+	if err != nil {
+		return err
+	}
+
+	nextTriggers := map[int]time.Time{}
+	for rows.Next() {
+		var (
+			id        int
+			rawConfig string
+			nonce     *string
+		)
+		err = rows.Scan(&id, &rawConfig, &nonce)
+		if err != nil {
+			Close(rows)
+			return err
+		}
+
+		decryptedConfig, err := es.Decrypt(rawConfig, nonce)
+		if err != nil {
+			Close(rows)
+			return err
+		}
+
+		var config atc.JobConfig
+		err = json.Unmarshal(decryptedConfig, &config)
+		if err != nil {
+			Close(rows)
+			return err
+		}
+
+		if config.Schedule == nil {
+			continue
+		}
+
+		nextTriggers[id], err = config.Schedule.Next(time.Now())
+		if err != nil {
+			Close(rows)
+			return err
+		}
+	}
+
+	Close(rows)
+
+	for id, nextTrigger := range nextTriggers {
+		_, err = psql.Update("jobs").
+			Set("schedule_next_trigger", nextTrigger).
+			Where(sq.Eq{"id": id}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (j *job) GetFullNextBuildInputs() ([]BuildInput, bool, error) {
 	tx, err := j.conn.Begin()
 	if err != nil {
@@ -782,6 +966,11 @@ func (j *job) EnsurePendingBuildExists(ctx context.Context) error {
 			return err
 		}
 
+		abortedBuildIDs, err := j.abortSupersededBuilds(tx, buildID, buildName)
+		if err != nil {
+			return err
+		}
+
 		latestNonRerunID, err := latestCompletedNonRerunBuild(tx, j.id)
 		if err != nil {
 			return err
@@ -792,7 +981,12 @@ func (j *job) EnsurePendingBuildExists(ctx context.Context) error {
 			return err
 		}
 
-		return tx.Commit()
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+
+		return j.notifyAbortedBuilds(abortedBuildIDs)
 	}
 
 	return nil
@@ -841,6 +1035,14 @@ func (j *job) GetPendingBuilds() ([]Build, error) {
 }
 
 func (j *job) CreateBuild(createdBy string) (Build, error) {
+	return j.CreateBuildWithParams(createdBy, nil, nil)
+}
+
+// CreateBuildWithParams creates a manually triggered build with the given
+// param values, which are made available to the build as local vars. The
+// values are expected to have already been resolved against the job's params.
+// If priority is given, it overrides the job's priority for the build.
+func (j *job) CreateBuildWithParams(createdBy string, params map[string]interface{}, priority *int) (Build, error) {
 	tx, err := j.conn.Begin()
 	if err != nil {
 		return nil, err
@@ -853,8 +1055,7 @@ func (j *job) CreateBuild(createdBy string) (Build, error) {
 		return nil, err
 	}
 
-	build := newEmptyBuild(j.conn, j.lockFactory)
-	err = createBuild(tx, build, map[string]interface{}{
+	values := map[string]interface{}{
 		"name":               buildName,
 		"job_id":             j.id,
 		"pipeline_id":        j.pipelineID,
@@ -862,7 +1063,28 @@ func (j *job) CreateBuild(createdBy string) (Build, error) {
 		"status":             BuildStatusPending,
 		"manually_triggered": true,
 		"created_by":         createdBy,
-	})
+	}
+
+	if params != nil {
+		payload, err := json.Marshal(params)
+		if err != nil {
+			return nil, err
+		}
+
+		values["params"] = string(payload)
+	}
+
+	if priority != nil {
+		values["priority"] = *priority
+	}
+
+	build := newEmptyBuild(j.conn, j.lockFactory)
+	err = createBuild(tx, build, values)
+	if err != nil {
+		return nil, err
+	}
+
+	abortedBuildIDs, err := j.abortSupersededBuilds(tx, build.ID(), build.Name())
 	if err != nil {
 		return nil, err
 	}
@@ -887,12 +1109,110 @@ func (j *job) CreateBuild(createdBy string) (Build, error) {
 		return nil, err
 	}
 
+	err = j.notifyAbortedBuilds(abortedBuildIDs)
+	if err != nil {
+		return nil, err
+	}
+
 	return build, nil
 }
 
+// CreateScheduledBuild creates a build for the job's schedule firing at the
+// given trigger time, and moves the job's next trigger to nextTrigger. No
+// build is created if the trigger has already been handled, e.g. by another
+// ATC.
+func (j *job) CreateScheduledBuild(trigger time.Time, nextTrigger time.Time) (Build, bool, error) {
+	tx, err := j.conn.Begin()
+	if err != nil {
+		return nil, false, err
+	}
+
+	defer Rollback(tx)
+
+	result, err := psql.Update("jobs").
+		Set("schedule_next_trigger", nextTrigger).
+		Where(sq.Eq{
+			"id":                    j.id,
+			"schedule_next_trigger": trigger,
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return nil, false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, false, err
+	}
+
+	if rowsAffected == 0 {
+		return nil, false, nil
+	}
+
+	buildName, err := j.getNewBuildName(tx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	build := newEmptyBuild(j.conn, j.lockFactory)
+	err = createBuild(tx, build, map[string]interface{}{
+		"name":           buildName,
+		"job_id":         j.id,
+		"pipeline_id":    j.pipelineID,
+		"team_id":        j.teamID,
+		"status":         BuildStatusPending,
+		"trigger_reason": atc.BuildTriggerReasonScheduled,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	abortedBuildIDs, err := j.abortSupersededBuilds(tx, build.ID(), build.Name())
+	if err != nil {
+		return nil, false, err
+	}
+
+	latestNonRerunID, err := latestCompletedNonRerunBuild(tx, j.id)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = updateNextBuildForJob(tx, j.id, latestNonRerunID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = j.notifyAbortedBuilds(abortedBuildIDs)
+	if err != nil {
+		return nil, false, err
+	}
+
+	j.scheduleNextTrigger = nextTrigger
+
+	return build, true, nil
+}
+
 func (j *job) RerunBuild(buildToRerun Build, createdBy string) (Build, error) {
+	return j.rerunBuild(buildToRerun, createdBy, false)
+}
+
+// ResumeBuild creates a rerun of a failed or errored build which runs the
+// same plan, skipping the steps which succeeded in the original build and
+// reusing their artifacts instead. It returns ErrBuildCannotBeResumed if the
+// build did not fail or is too old to be resumed.
+func (j *job) ResumeBuild(buildToResume Build, createdBy string) (Build, error) {
+	return j.rerunBuild(buildToResume, createdBy, true)
+}
+
+func (j *job) rerunBuild(buildToRerun Build, createdBy string, resume bool) (Build, error) {
 	for {
-		rerunBuild, err := j.tryRerunBuild(buildToRerun, createdBy)
+		rerunBuild, err := j.tryRerunBuild(buildToRerun, createdBy, resume)
 		if err != nil {
 			if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == pqUniqueViolationErrCode {
 				continue
@@ -905,7 +1225,7 @@ func (j *job) RerunBuild(buildToRerun Build, createdBy string) (Build, error) {
 	}
 }
 
-func (j *job) tryRerunBuild(buildToRerun Build, createdBy string) (Build, error) {
+func (j *job) tryRerunBuild(buildToRerun Build, createdBy string, resume bool) (Build, error) {
 	tx, err := j.conn.Begin()
 	if err != nil {
 		return nil, err
@@ -913,6 +1233,26 @@ func (j *job) tryRerunBuild(buildToRerun Build, createdBy string) (Build, error)
 
 	defer Rollback(tx)
 
+	if resume {
+		var resumable bool
+		err = psql.Select("private_plan IS NOT NULL").
+			From("builds").
+			Where(sq.Eq{
+				"id":     buildToRerun.ID(),
+				"status": []BuildStatus{BuildStatusFailed, BuildStatusErrored},
+			}).
+			RunWith(tx).
+			QueryRow().
+			Scan(&resumable)
+		if err != nil && err != sql.ErrNoRows {
+			return nil, err
+		}
+
+		if !resumable {
+			return nil, ErrBuildCannotBeResumed
+		}
+	}
+
 	buildToRerunID := buildToRerun.ID()
 	if buildToRerun.RerunOf() != 0 {
 		buildToRerunID = buildToRerun.RerunOf()
@@ -923,8 +1263,7 @@ func (j *job) tryRerunBuild(buildToRerun Build, createdBy string) (Build, error)
 		return nil, err
 	}
 
-	rerunBuild := newEmptyBuild(j.conn, j.lockFactory)
-	err = createBuild(tx, rerunBuild, map[string]interface{}{
+	values := map[string]interface{}{
 		"name":         rerunBuildName,
 		"job_id":       j.id,
 		"pipeline_id":  j.pipelineID,
@@ -933,7 +1272,33 @@ func (j *job) tryRerunBuild(buildToRerun Build, createdBy string) (Build, error)
 		"rerun_of":     buildToRerunID,
 		"rerun_number": rerunNumber,
 		"created_by":   createdBy,
-	})
+		"priority":     sq.Expr("(SELECT priority FROM builds WHERE id = ?)", buildToRerun.ID()),
+	}
+
+	if resume {
+		// the same plan is run so that the steps can be matched up with the
+		// checkpoints of the original build
+		values["resumed_from"] = buildToRerun.ID()
+		values["private_plan"] = sq.Expr("(SELECT private_plan FROM builds WHERE id = ?)", buildToRerun.ID())
+		values["nonce"] = sq.Expr("(SELECT nonce FROM builds WHERE id = ?)", buildToRerun.ID())
+	}
+
+	if buildToRerun.Params() != nil {
+		payload, err := json.Marshal(buildToRerun.Params())
+		if err != nil {
+			return nil, err
+		}
+
+		values["params"] = string(payload)
+	}
+
+	rerunBuild := newEmptyBuild(j.conn, j.lockFactory)
+	err = createBuild(tx, rerunBuild, values)
+	if err != nil {
+		return nil, err
+	}
+
+	abortedBuildIDs, err := j.abortSupersededBuilds(tx, rerunBuild.ID(), rerunBuild.Name())
 	if err != nil {
 		return nil, err
 	}
@@ -958,6 +1323,11 @@ func (j *job) tryRerunBuild(buildToRerun Build, createdBy string) (Build, error)
 		return nil, err
 	}
 
+	err = j.notifyAbortedBuilds(abortedBuildIDs)
+	if err != nil {
+		return nil, err
+	}
+
 	return rerunBuild, nil
 }
 
@@ -1420,9 +1790,10 @@ func scanJob(j *job, row scannable) error {
 		pipelineInstanceVars sql.NullString
 		pausedBy             sql.NullString
 		pausedAt             sql.NullTime
+		scheduleNextTrigger  sql.NullTime
 	)
 
-	err := row.Scan(&j.id, &j.name, &config, &j.paused, &j.public, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &pipelineInstanceVars, &j.teamID, &j.teamName, &nonce, pq.Array(&j.tags), &j.hasNewInputs, &j.scheduleRequestedTime, &j.maxInFlight, &j.disableManualTrigger, &pausedBy, &pausedAt)
+	err := row.Scan(&j.id, &j.name, &config, &j.paused, &j.public, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &pipelineInstanceVars, &j.teamID, &j.teamName, &nonce, pq.Array(&j.tags), &j.hasNewInputs, &j.scheduleRequestedTime, &j.maxInFlight, &j.disableManualTrigger, &pausedBy, &pausedAt, &scheduleNextTrigger)
 	if err != nil {
 		return err
 	}
@@ -1450,6 +1821,10 @@ func scanJob(j *job, row scannable) error {
 		j.pausedAt = pausedAt.Time
 	}
 
+	if scheduleNextTrigger.Valid {
+		j.scheduleNextTrigger = scheduleNextTrigger.Time
+	}
+
 	return nil
 }
 
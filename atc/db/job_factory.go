@@ -84,7 +84,10 @@ func (j *jobFactory) JobsToSchedule() (SchedulerJobs, error) {
 	defer tx.Rollback()
 
 	rows, err := jobsQuery.
-		Where(sq.Expr("j.schedule_requested > j.last_scheduled")).
+		Where(sq.Or{
+			sq.Expr("j.schedule_requested > j.last_scheduled"),
+			sq.Expr("j.schedule_next_trigger <= now()"),
+		}).
 		Where(sq.Eq{
 			"j.active": true,
 			"j.paused": false,
@@ -212,7 +215,7 @@ func (j *jobFactory) JobsToSchedule() (SchedulerJobs, error) {
 			Job:           job,
 			Resources:     schedulerResources,
 			ResourceTypes: resourceTypes.Deserialize(),
-			Prototypes:    prototypes.Configs(),
+			Prototypes:    prototypes.Deserialize(resourceTypes),
 		})
 	}
 
@@ -384,6 +384,45 @@ var _ = Describe("JobFactory", func() {
 			})
 		})
 
+		Context("when the job's schedule is due", func() {
+			BeforeEach(func() {
+				pipeline1, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
+					Jobs: atc.JobConfigs{
+						{Name: "job-name", Schedule: &atc.JobSchedule{Cron: "0 3 * * *"}},
+					},
+				}, db.ConfigVersion(1), false)
+				Expect(err).ToNot(HaveOccurred())
+
+				var found bool
+				job1, found, err = pipeline1.Job("job-name")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				_, err = dbConn.Exec("UPDATE jobs SET last_scheduled = now(), schedule_next_trigger = now() - interval '1 minute' WHERE id = $1;", job1.ID())
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("fetches that job", func() {
+				jobs, err := jobFactory.JobsToSchedule()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(len(jobs)).To(Equal(1))
+				Expect(jobs[0].Name()).To(Equal(job1.Name()))
+			})
+
+			Context("when the job is paused", func() {
+				BeforeEach(func() {
+					err := job1.Pause("some-user")
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("does not fetch that job", func() {
+					jobs, err := jobFactory.JobsToSchedule()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(len(jobs)).To(Equal(0))
+				})
+			})
+		})
+
 		Context("when the job has a requested schedule time earlier than the last scheduled", func() {
 			BeforeEach(func() {
 				pipeline1, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
@@ -0,0 +1,220 @@
+package db
+
+import (
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+)
+
+// insertStepTimings registers the steps of the build's plan, so that their
+// durations can be recorded as they run. The timings are kept separately
+// from the build's events, which may be offloaded or reaped long before the
+// job's statistics stop covering the build.
+func insertStepTimings(tx Tx, buildID int, plan atc.Plan) error {
+	insert := psql.Insert("build_step_timings").
+		Columns("build_id", "plan_id", "name", "type").
+		Suffix("ON CONFLICT (build_id, plan_id) DO NOTHING")
+
+	var steps int
+	plan.Each(func(p *atc.Plan) {
+		name, stepType := stepNameAndType(p)
+		if stepType == "" {
+			return
+		}
+
+		insert = insert.Values(buildID, string(p.ID), name, stepType)
+		steps++
+	})
+
+	if steps == 0 {
+		return nil
+	}
+
+	_, err := insert.RunWith(tx).Exec()
+	return err
+}
+
+func stepNameAndType(plan *atc.Plan) (string, string) {
+	switch {
+	case plan.Get != nil:
+		return plan.Get.Name, "get"
+	case plan.Put != nil:
+		return plan.Put.Name, "put"
+	case plan.Task != nil:
+		return plan.Task.Name, "task"
+	case plan.Run != nil:
+		return plan.Run.Message, "run"
+	case plan.SetPipeline != nil:
+		return plan.SetPipeline.Name, "set_pipeline"
+	case plan.LoadVar != nil:
+		return plan.LoadVar.Name, "load_var"
+	case plan.Approve != nil:
+		return plan.Approve.Name, "approve"
+	default:
+		return "", ""
+	}
+}
+
+// recordStepTiming notes the time at which a step was initialized or
+// finished, based on the event being saved.
+func recordStepTiming(tx Tx, buildID int, ev atc.Event) error {
+	var column string
+	var origin event.Origin
+	var at int64
+
+	switch e := ev.(type) {
+	case event.Initialize:
+		column, origin, at = "start_time", e.Origin, e.Time
+	case event.InitializeGet:
+		column, origin, at = "start_time", e.Origin, e.Time
+	case event.InitializePut:
+		column, origin, at = "start_time", e.Origin, e.Time
+	case event.InitializeTask:
+		column, origin, at = "start_time", e.Origin, e.Time
+	case event.Finish:
+		column, origin, at = "end_time", e.Origin, e.Time
+	case event.FinishGet:
+		column, origin, at = "end_time", e.Origin, e.Time
+	case event.FinishPut:
+		column, origin, at = "end_time", e.Origin, e.Time
+	case event.FinishTask:
+		column, origin, at = "end_time", e.Origin, e.Time
+	default:
+		return nil
+	}
+
+	if at == 0 {
+		return nil
+	}
+
+	_, err := psql.Update("build_step_timings").
+		Set(column, time.Unix(at, 0)).
+		Where(sq.Eq{
+			"build_id": buildID,
+			"plan_id":  string(origin.ID),
+		}).
+		RunWith(tx).
+		Exec()
+	return err
+}
+
+// Stats summarizes the job's completed builds which started within the given
+// window of time.
+func (j *job) Stats(since time.Time, until time.Time) (atc.JobStats, error) {
+	stats := atc.JobStats{
+		Since: since.Unix(),
+		Until: until.Unix(),
+		Steps: []atc.StepStats{},
+	}
+
+	var successRate, mttr, medianDuration, medianQueueTime sql.NullFloat64
+
+	err := j.conn.QueryRow(`
+		WITH window_builds AS (
+			SELECT id, status, create_time, start_time, end_time, rerun_of
+			FROM builds
+			WHERE job_id = $1
+			AND completed
+			AND start_time >= $2
+			AND start_time < $3
+		), outcomes AS (
+			SELECT status, end_time, lag(status) OVER (ORDER BY end_time, id) AS prev_status
+			FROM window_builds
+			WHERE status IN ('succeeded', 'failed', 'errored')
+		), breakages AS (
+			SELECT o.end_time AS broken_at, (
+				SELECT min(f.end_time)
+				FROM outcomes f
+				WHERE f.status = 'succeeded'
+				AND f.end_time > o.end_time
+			) AS fixed_at
+			FROM outcomes o
+			WHERE o.status IN ('failed', 'errored')
+			AND (o.prev_status IS NULL OR o.prev_status = 'succeeded')
+		)
+		SELECT
+			count(*),
+			count(*) FILTER (WHERE status = 'succeeded'),
+			count(*) FILTER (WHERE status = 'failed'),
+			count(*) FILTER (WHERE status = 'errored'),
+			count(*) FILTER (WHERE status = 'aborted'),
+			count(*) FILTER (WHERE status = 'succeeded')::float / NULLIF(count(*) FILTER (WHERE status != 'aborted'), 0),
+			(SELECT avg(extract(epoch FROM fixed_at - broken_at)) FROM breakages WHERE fixed_at IS NOT NULL),
+			percentile_cont(0.5) WITHIN GROUP (ORDER BY extract(epoch FROM end_time - start_time)),
+			percentile_cont(0.5) WITHIN GROUP (ORDER BY extract(epoch FROM start_time - create_time)),
+			(
+				SELECT count(*)
+				FROM window_builds r
+				JOIN builds o ON o.id = r.rerun_of
+				WHERE r.status = 'succeeded'
+				AND o.status IN ('failed', 'errored')
+			),
+			(
+				SELECT count(*) FROM (
+					SELECT 1
+					FROM build_test_results t
+					JOIN window_builds b ON b.id = t.build_id
+					GROUP BY t.suite, t.name
+					HAVING bool_or(t.status = 'passed') AND bool_or(t.status IN ('failed', 'errored'))
+				) flaky
+			)
+		FROM window_builds
+	`, j.id, since, until).Scan(
+		&stats.Builds,
+		&stats.Succeeded,
+		&stats.Failed,
+		&stats.Errored,
+		&stats.Aborted,
+		&successRate,
+		&mttr,
+		&medianDuration,
+		&medianQueueTime,
+		&stats.FlakyRetries,
+		&stats.FlakyTests,
+	)
+	if err != nil {
+		return atc.JobStats{}, err
+	}
+
+	stats.SuccessRate = successRate.Float64
+	stats.MeanTimeToRecovery = mttr.Float64
+	stats.MedianDuration = medianDuration.Float64
+	stats.MedianQueueTime = medianQueueTime.Float64
+
+	rows, err := j.conn.Query(`
+		SELECT s.name, s.type, count(*),
+			percentile_cont(0.5) WITHIN GROUP (ORDER BY extract(epoch FROM s.end_time - s.start_time)),
+			percentile_cont(0.9) WITHIN GROUP (ORDER BY extract(epoch FROM s.end_time - s.start_time)),
+			percentile_cont(0.95) WITHIN GROUP (ORDER BY extract(epoch FROM s.end_time - s.start_time))
+		FROM build_step_timings s
+		JOIN builds b ON b.id = s.build_id
+		WHERE b.job_id = $1
+		AND b.completed
+		AND b.start_time >= $2
+		AND b.start_time < $3
+		AND s.start_time IS NOT NULL
+		AND s.end_time IS NOT NULL
+		GROUP BY s.name, s.type
+		ORDER BY s.name, s.type
+	`, j.id, since, until)
+	if err != nil {
+		return atc.JobStats{}, err
+	}
+
+	defer Close(rows)
+
+	for rows.Next() {
+		var step atc.StepStats
+		err = rows.Scan(&step.Name, &step.Type, &step.Runs, &step.P50, &step.P90, &step.P95)
+		if err != nil {
+			return atc.JobStats{}, err
+		}
+
+		stats.Steps = append(stats.Steps, step)
+	}
+
+	return stats, rows.Err()
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbtest"
+	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/tracing"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -647,6 +648,168 @@ var _ = Describe("Job", func() {
 		})
 	})
 
+	Describe("CreateBuildWithParams", func() {
+		It("stores the params on the build", func() {
+			build, err := job.CreateBuildWithParams(defaultBuildCreatedBy, map[string]interface{}{
+				"target":  "prod",
+				"dry-run": true,
+			}, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.Params()).To(Equal(map[string]interface{}{
+				"target":  "prod",
+				"dry-run": true,
+			}))
+
+			reloaded, found, err := job.Build(build.Name())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(reloaded.Params()).To(Equal(build.Params()))
+			Expect(reloaded.IsManuallyTriggered()).To(BeTrue())
+		})
+
+		It("does not store params when none are given", func() {
+			build, err := job.CreateBuildWithParams(defaultBuildCreatedBy, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.Params()).To(BeNil())
+		})
+
+		Describe("priority", func() {
+			BeforeEach(func() {
+				pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "priority-pipeline"}, atc.Config{
+					Jobs: atc.JobConfigs{
+						{Name: "release", Priority: 5},
+					},
+				}, db.ConfigVersion(0), false)
+				Expect(err).ToNot(HaveOccurred())
+
+				var found bool
+				job, found, err = pipeline.Job("release")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+
+			It("defaults to the job's priority", func() {
+				build, err := job.CreateBuildWithParams(defaultBuildCreatedBy, nil, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(build.Priority()).To(Equal(5))
+			})
+
+			It("can be overridden for the build", func() {
+				priority := 10
+				build, err := job.CreateBuildWithParams(defaultBuildCreatedBy, nil, &priority)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(build.Priority()).To(Equal(10))
+
+				rerun, err := job.RerunBuild(build, defaultBuildCreatedBy)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(rerun.Priority()).To(Equal(10))
+			})
+		})
+	})
+
+	Describe("CreateScheduledBuild", func() {
+		var scheduledJob db.Job
+
+		BeforeEach(func() {
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "scheduled-pipeline"}, atc.Config{
+				Jobs: atc.JobConfigs{
+					{Name: "nightly", Schedule: &atc.JobSchedule{Cron: "0 3 * * *"}},
+				},
+			}, db.ConfigVersion(0), false)
+			Expect(err).ToNot(HaveOccurred())
+
+			var found bool
+			scheduledJob, found, err = pipeline.Job("nightly")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+
+		It("computes the next trigger when the pipeline is saved", func() {
+			Expect(scheduledJob.ScheduleNextTrigger()).To(BeTemporally(">", time.Now()))
+			Expect(scheduledJob.ScheduleNextTrigger().UTC().Hour()).To(Equal(3))
+		})
+
+		It("creates a scheduled build and moves the trigger", func() {
+			trigger := scheduledJob.ScheduleNextTrigger()
+			nextTrigger := trigger.Add(24 * time.Hour)
+
+			build, created, err := scheduledJob.CreateScheduledBuild(trigger, nextTrigger)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeTrue())
+			Expect(build.TriggerReason()).To(Equal(atc.BuildTriggerReasonScheduled))
+			Expect(build.IsManuallyTriggered()).To(BeFalse())
+			Expect(build.Status()).To(Equal(db.BuildStatusPending))
+
+			found, err := scheduledJob.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(scheduledJob.ScheduleNextTrigger()).To(BeTemporally("~", nextTrigger, time.Second))
+		})
+
+		It("does not create a build for a trigger which was already handled", func() {
+			trigger := scheduledJob.ScheduleNextTrigger()
+
+			_, created, err := scheduledJob.CreateScheduledBuild(trigger, trigger.Add(24*time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeTrue())
+
+			_, created, err = scheduledJob.CreateScheduledBuild(trigger, trigger.Add(24*time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeFalse())
+		})
+
+		Context("when a trigger is missed while the job is paused", func() {
+			var missedTrigger time.Time
+
+			BeforeEach(func() {
+				missedTrigger = time.Now().Add(-time.Hour)
+
+				_, created, err := scheduledJob.CreateScheduledBuild(scheduledJob.ScheduleNextTrigger(), missedTrigger)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeTrue())
+
+				err = scheduledJob.Pause("some-user")
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("skips the trigger once the job is unpaused", func() {
+				err := scheduledJob.Unpause()
+				Expect(err).ToNot(HaveOccurred())
+
+				found, err := scheduledJob.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(scheduledJob.ScheduleNextTrigger()).To(BeTemporally(">", time.Now()))
+				Expect(scheduledJob.ScheduleNextTrigger().UTC().Hour()).To(Equal(3))
+			})
+		})
+
+		Context("when a trigger is missed while the pipeline is paused", func() {
+			BeforeEach(func() {
+				_, created, err := scheduledJob.CreateScheduledBuild(scheduledJob.ScheduleNextTrigger(), time.Now().Add(-time.Hour))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeTrue())
+			})
+
+			It("skips the trigger once the pipeline is unpaused", func() {
+				pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: "scheduled-pipeline"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				err = pipeline.Pause("some-user")
+				Expect(err).ToNot(HaveOccurred())
+
+				err = pipeline.Unpause()
+				Expect(err).ToNot(HaveOccurred())
+
+				found, err = scheduledJob.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(scheduledJob.ScheduleNextTrigger()).To(BeTemporally(">", time.Now()))
+			})
+		})
+	})
+
 	Describe("RerunBuild", func() {
 		var firstBuild db.Build
 		var rerunErr error
@@ -691,6 +854,23 @@ var _ = Describe("Job", func() {
 				Expect(job.ScheduleRequestedTime()).Should(BeTemporally(">", requestedSchedule))
 			})
 
+			Context("when the build has params", func() {
+				BeforeEach(func() {
+					var err error
+					buildToRerun, err = job.CreateBuildWithParams(defaultBuildCreatedBy, map[string]interface{}{
+						"target": "prod",
+					}, nil)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("replays the params", func() {
+					Expect(rerunErr).ToNot(HaveOccurred())
+					Expect(rerunBuild.Params()).To(Equal(map[string]interface{}{
+						"target": "prod",
+					}))
+				})
+			})
+
 			Context("when there is an existing rerun build", func() {
 				var rerun1 db.Build
 
@@ -731,6 +911,115 @@ var _ = Describe("Job", func() {
 		})
 	})
 
+	Describe("ResumeBuild", func() {
+		var buildToResume db.Build
+		var resumedBuild db.Build
+		var resumeErr error
+
+		plan := atc.Plan{
+			ID: "some-plan",
+			Task: &atc.TaskPlan{
+				Name: "some-task",
+			},
+		}
+
+		BeforeEach(func() {
+			var err error
+			buildToResume, err = job.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).NotTo(HaveOccurred())
+
+			started, err := buildToResume.Start(plan)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(started).To(BeTrue())
+		})
+
+		JustBeforeEach(func() {
+			resumedBuild, resumeErr = job.ResumeBuild(buildToResume, defaultBuildCreatedBy)
+		})
+
+		Context("when the build failed", func() {
+			BeforeEach(func() {
+				err := buildToResume.SaveStepCheckpoint(db.BuildStepCheckpoint{
+					PlanID:    "some-plan",
+					Artifacts: map[string]string{"some-output": "some-handle"},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				err = buildToResume.Finish(db.BuildStatusFailed)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("creates a rerun of the build which resumes it", func() {
+				Expect(resumeErr).ToNot(HaveOccurred())
+				Expect(resumedBuild.Name()).To(Equal(fmt.Sprintf("%s.1", buildToResume.Name())))
+				Expect(resumedBuild.RerunOf()).To(Equal(buildToResume.ID()))
+				Expect(resumedBuild.ResumedFrom()).To(Equal(buildToResume.ID()))
+			})
+
+			It("runs the same plan as the build", func() {
+				Expect(resumeErr).ToNot(HaveOccurred())
+				Expect(resumedBuild.PrivatePlan()).To(Equal(plan))
+			})
+
+			It("finds the checkpoints of the build", func() {
+				Expect(resumeErr).ToNot(HaveOccurred())
+
+				checkpoint, found, err := resumedBuild.ResumedStepCheckpoint("some-plan")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(checkpoint).To(Equal(db.BuildStepCheckpoint{
+					PlanID:    "some-plan",
+					Artifacts: map[string]string{"some-output": "some-handle"},
+				}))
+			})
+		})
+
+		Context("when the build succeeded", func() {
+			BeforeEach(func() {
+				err := buildToResume.Finish(db.BuildStatusSucceeded)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("cannot be resumed", func() {
+				Expect(resumeErr).To(Equal(db.ErrBuildCannotBeResumed))
+			})
+		})
+
+		Context("when resuming builds is disabled", func() {
+			BeforeEach(func() {
+				atc.BuildResumeWindow = 0
+
+				err := buildToResume.Finish(db.BuildStatusFailed)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				atc.BuildResumeWindow = 12 * time.Hour
+			})
+
+			It("cannot be resumed", func() {
+				Expect(resumeErr).To(Equal(db.ErrBuildCannotBeResumed))
+			})
+		})
+
+		Context("when the plan of the failed build has expired", func() {
+			BeforeEach(func() {
+				err := buildToResume.Finish(db.BuildStatusFailed)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = dbConn.Exec(`UPDATE builds SET end_time = now() - interval '13 hours' WHERE id = $1`, buildToResume.ID())
+				Expect(err).NotTo(HaveOccurred())
+
+				err = db.NewArtifactLifecycle(dbConn, 12*time.Hour).RemoveExpiredArtifacts()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("cannot be resumed", func() {
+				Expect(resumeErr).To(Equal(db.ErrBuildCannotBeResumed))
+			})
+		})
+	})
+
 	Describe("ScheduleBuild", func() {
 		var (
 			schedulingBuild            db.Build
@@ -1340,6 +1629,160 @@ var _ = Describe("Job", func() {
 		})
 	})
 
+	Describe("creating a build with a concurrency group", func() {
+		var (
+			prJob, otherPRJob, unrelatedJob db.Job
+
+			runningBuild, pendingBuild, unrelatedBuild db.Build
+
+			cancelInProgress bool
+		)
+
+		savePRPipeline := func(branch string, cancel bool) db.Job {
+			prPipeline, _, err := team.SavePipeline(atc.PipelineRef{
+				Name:         "prs",
+				InstanceVars: atc.InstanceVars{"branch": branch},
+			}, atc.Config{
+				Jobs: atc.JobConfigs{
+					{
+						Name: "test",
+						ConcurrencyGroup: &atc.ConcurrencyGroupConfig{
+							Name:             "pr-tests",
+							CancelInProgress: cancel,
+						},
+					},
+				},
+			}, db.ConfigVersion(0), false)
+			Expect(err).ToNot(HaveOccurred())
+
+			job, found, err := prPipeline.Job("test")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			return job
+		}
+
+		reload := func() {
+			for _, build := range []db.Build{runningBuild, pendingBuild, unrelatedBuild} {
+				found, err := build.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+			}
+		}
+
+		BeforeEach(func() {
+			cancelInProgress = true
+
+			// the builds are created before the group cancels builds in
+			// progress, so that they are not superseded by each other
+			prJob = savePRPipeline("feature", false)
+			otherPRJob = savePRPipeline("other-feature", false)
+
+			var found bool
+			var err error
+			unrelatedJob, found, err = pipeline.Job("some-other-job")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			runningBuild, err = otherPRJob.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).ToNot(HaveOccurred())
+
+			started, err := runningBuild.Start(atc.Plan{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(started).To(BeTrue())
+
+			pendingBuild, err = prJob.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).ToNot(HaveOccurred())
+
+			unrelatedBuild, err = unrelatedJob.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		JustBeforeEach(func() {
+			prJob = savePRPipeline("feature", cancelInProgress)
+		})
+
+		Context("when a build is triggered", func() {
+			JustBeforeEach(func() {
+				_, err := prJob.CreateBuild(defaultBuildCreatedBy)
+				Expect(err).ToNot(HaveOccurred())
+
+				reload()
+			})
+
+			It("aborts the older builds of the group with a reason without scheduling", func() {
+				Expect(runningBuild.IsAborted()).To(BeTrue())
+				Expect(runningBuild.AbortReason()).To(Equal("superseded by prs/branch:feature/test #2"))
+
+				Expect(pendingBuild.IsAborted()).To(BeTrue())
+				Expect(pendingBuild.AbortReason()).To(Equal("superseded by prs/branch:feature/test #2"))
+			})
+
+			It("does not abort builds outside of the group", func() {
+				Expect(unrelatedBuild.IsAborted()).To(BeFalse())
+				Expect(unrelatedBuild.AbortReason()).To(BeEmpty())
+			})
+
+			It("notifies the aborted builds", func() {
+				notifier, err := runningBuild.AbortNotifier()
+				Expect(err).ToNot(HaveOccurred())
+				defer notifier.Close()
+
+				Eventually(notifier.Notify()).Should(Receive())
+			})
+
+			Context("when the job does not cancel builds in progress", func() {
+				BeforeEach(func() {
+					cancelInProgress = false
+				})
+
+				It("does not abort any builds", func() {
+					Expect(runningBuild.IsAborted()).To(BeFalse())
+					Expect(pendingBuild.IsAborted()).To(BeFalse())
+				})
+			})
+		})
+
+		Context("when a pending build is created for new inputs", func() {
+			BeforeEach(func() {
+				// the job's own pending build would stop another being created
+				err := pendingBuild.Finish(db.BuildStatusSucceeded)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			JustBeforeEach(func() {
+				err := prJob.EnsurePendingBuildExists(context.TODO())
+				Expect(err).ToNot(HaveOccurred())
+
+				reload()
+			})
+
+			It("aborts the older builds of the group", func() {
+				Expect(runningBuild.IsAborted()).To(BeTrue())
+				Expect(runningBuild.AbortReason()).To(Equal("superseded by prs/branch:feature/test #2"))
+			})
+		})
+
+		Context("when a build is rerun", func() {
+			BeforeEach(func() {
+				err := pendingBuild.Finish(db.BuildStatusFailed)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			JustBeforeEach(func() {
+				_, err := prJob.RerunBuild(pendingBuild, defaultBuildCreatedBy)
+				Expect(err).ToNot(HaveOccurred())
+
+				reload()
+			})
+
+			It("aborts the older builds of the group", func() {
+				Expect(runningBuild.IsAborted()).To(BeTrue())
+				Expect(runningBuild.AbortReason()).To(Equal("superseded by prs/branch:feature/test #1.1"))
+			})
+		})
+	})
+
 	Describe("GetNextBuildInputs", func() {
 		var (
 			versions    []atc.ResourceVersion
@@ -2042,6 +2485,144 @@ var _ = Describe("Job", func() {
 		})
 	})
 
+	Describe("TestSummaries", func() {
+		saveResults := func(status atc.TestStatus) db.Build {
+			build, err := job.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SaveTestResults("unit", []atc.TestResult{
+				{Suite: "some-suite", Name: "stable", Status: atc.TestStatusPassed},
+				{Suite: "some-suite", Name: "flaky", Status: status},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			return build
+		}
+
+		It("aggregates the results of the job's recent builds", func() {
+			saveResults(atc.TestStatusPassed)
+			failedBuild := saveResults(atc.TestStatusFailed)
+			saveResults(atc.TestStatusPassed)
+
+			// builds without results do not count towards the limit
+			_, err := job.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).NotTo(HaveOccurred())
+
+			summaries, err := job.TestSummaries(10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(summaries).To(Equal([]atc.TestSummary{
+				{Suite: "some-suite", Name: "flaky", Runs: 3, Passed: 2, Failed: 1, LastFailedBuild: failedBuild.Name()},
+				{Suite: "some-suite", Name: "stable", Runs: 3, Passed: 3},
+			}))
+		})
+
+		It("only considers the given number of builds", func() {
+			saveResults(atc.TestStatusFailed)
+			saveResults(atc.TestStatusPassed)
+
+			summaries, err := job.TestSummaries(1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(summaries).To(ConsistOf(
+				atc.TestSummary{Suite: "some-suite", Name: "flaky", Runs: 1, Passed: 1},
+				atc.TestSummary{Suite: "some-suite", Name: "stable", Runs: 1, Passed: 1},
+			))
+		})
+	})
+
+	Describe("Stats", func() {
+		var since, until time.Time
+
+		BeforeEach(func() {
+			since = time.Now().Add(-time.Hour)
+			until = time.Now().Add(time.Hour)
+		})
+
+		runBuild := func(status db.BuildStatus, taskSeconds int64) db.Build {
+			build, err := job.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).NotTo(HaveOccurred())
+
+			started, err := build.Start(atc.Plan{
+				ID:   "1",
+				Task: &atc.TaskPlan{Name: "unit"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(started).To(BeTrue())
+
+			err = build.SaveEvent(event.InitializeTask{Origin: event.Origin{ID: "1"}, Time: 1000})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SaveEvent(event.FinishTask{Origin: event.Origin{ID: "1"}, Time: 1000 + taskSeconds})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.Finish(status)
+			Expect(err).NotTo(HaveOccurred())
+
+			return build
+		}
+
+		It("returns empty stats when there are no builds", func() {
+			stats, err := job.Stats(since, until)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stats).To(Equal(atc.JobStats{
+				Since: since.Unix(),
+				Until: until.Unix(),
+				Steps: []atc.StepStats{},
+			}))
+		})
+
+		Context("when builds have run", func() {
+			BeforeEach(func() {
+				runBuild(db.BuildStatusSucceeded, 10)
+				failed := runBuild(db.BuildStatusFailed, 20)
+				runBuild(db.BuildStatusAborted, 30)
+
+				rerun, err := job.RerunBuild(failed, defaultBuildCreatedBy)
+				Expect(err).NotTo(HaveOccurred())
+
+				started, err := rerun.Start(atc.Plan{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(started).To(BeTrue())
+
+				err = rerun.Finish(db.BuildStatusSucceeded)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("counts the builds by status", func() {
+				stats, err := job.Stats(since, until)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stats.Builds).To(Equal(4))
+				Expect(stats.Succeeded).To(Equal(2))
+				Expect(stats.Failed).To(Equal(1))
+				Expect(stats.Aborted).To(Equal(1))
+				Expect(stats.SuccessRate).To(BeNumerically("~", 2.0/3.0))
+			})
+
+			It("counts the failed builds which succeeded when rerun", func() {
+				stats, err := job.Stats(since, until)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stats.FlakyRetries).To(Equal(1))
+			})
+
+			It("returns the duration percentiles of each step", func() {
+				stats, err := job.Stats(since, until)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stats.Steps).To(HaveLen(1))
+				Expect(stats.Steps[0].Name).To(Equal("unit"))
+				Expect(stats.Steps[0].Type).To(Equal("task"))
+				Expect(stats.Steps[0].Runs).To(Equal(3))
+				Expect(stats.Steps[0].P50).To(BeNumerically("==", 20))
+				Expect(stats.Steps[0].P95).To(BeNumerically("~", 29))
+			})
+
+			It("ignores builds outside of the window", func() {
+				stats, err := job.Stats(until, until.Add(time.Hour))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stats.Builds).To(BeZero())
+				Expect(stats.Steps).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("Clear task cache", func() {
 		Context("when task cache exists", func() {
 			var (
@@ -17,6 +17,11 @@ var encryptedColumns = []encryptedColumn{
 	{"builds", "private_plan", "id"},
 	{"cert_cache", "cert", "domain"},
 	{"pipelines", "var_sources", "id"},
+	{"pipeline_notifications", "config", "pipeline_id"},
+	{"secrets", "value", "id"},
+	{"signing_keys", "jwk", "id"},
+	{"team_var_sources", "var_sources", "team_id"},
+	{"team_notifications", "config", "team_id"},
 }
 
 type encryptedColumn struct {
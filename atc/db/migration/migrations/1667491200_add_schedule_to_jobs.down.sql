@@ -0,0 +1,5 @@
+ALTER TABLE builds
+DROP COLUMN trigger_reason;
+
+ALTER TABLE jobs
+DROP COLUMN schedule_next_trigger;
@@ -0,0 +1,5 @@
+ALTER TABLE jobs
+  ADD COLUMN schedule_next_trigger timestamp with time zone;
+
+ALTER TABLE builds
+  ADD COLUMN trigger_reason text;
@@ -0,0 +1,8 @@
+ALTER TABLE builds
+DROP COLUMN abort_reason;
+
+DROP INDEX jobs_concurrency_group_idx;
+
+ALTER TABLE jobs
+DROP COLUMN cancel_in_progress,
+DROP COLUMN concurrency_group;
@@ -0,0 +1,8 @@
+ALTER TABLE jobs
+  ADD COLUMN concurrency_group text,
+  ADD COLUMN cancel_in_progress boolean NOT NULL DEFAULT false;
+
+CREATE INDEX jobs_concurrency_group_idx ON jobs (concurrency_group) WHERE concurrency_group IS NOT NULL;
+
+ALTER TABLE builds
+  ADD COLUMN abort_reason text;
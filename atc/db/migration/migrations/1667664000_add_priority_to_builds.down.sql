@@ -0,0 +1,5 @@
+ALTER TABLE builds
+DROP COLUMN priority;
+
+ALTER TABLE jobs
+DROP COLUMN priority;
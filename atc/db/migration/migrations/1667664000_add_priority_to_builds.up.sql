@@ -0,0 +1,5 @@
+ALTER TABLE jobs
+  ADD COLUMN priority integer NOT NULL DEFAULT 0;
+
+ALTER TABLE builds
+  ADD COLUMN priority integer;
@@ -0,0 +1,6 @@
+DROP TABLE build_step_checkpoints;
+
+DROP INDEX builds_retained_plan_idx;
+
+ALTER TABLE builds
+DROP COLUMN resumed_from;
@@ -0,0 +1,13 @@
+ALTER TABLE builds
+  ADD COLUMN resumed_from integer REFERENCES builds (id) ON DELETE SET NULL;
+
+CREATE INDEX builds_retained_plan_idx ON builds (end_time) WHERE completed AND private_plan IS NOT NULL;
+
+CREATE TABLE build_step_checkpoints (
+    build_id integer NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+    plan_id text NOT NULL,
+    artifacts jsonb NOT NULL DEFAULT '{}',
+    version jsonb,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    PRIMARY KEY (build_id, plan_id)
+);
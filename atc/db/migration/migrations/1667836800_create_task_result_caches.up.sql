@@ -0,0 +1,11 @@
+CREATE TABLE task_result_caches (
+    job_id integer NOT NULL REFERENCES jobs (id) ON DELETE CASCADE,
+    step_name text NOT NULL,
+    key text NOT NULL,
+    build_id integer REFERENCES builds (id) ON DELETE SET NULL,
+    outputs jsonb NOT NULL DEFAULT '{}',
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    PRIMARY KEY (job_id, step_name, key)
+);
+
+CREATE INDEX task_result_caches_created_at_idx ON task_result_caches (created_at);
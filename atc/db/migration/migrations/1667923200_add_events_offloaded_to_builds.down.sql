@@ -0,0 +1,4 @@
+DROP INDEX builds_events_not_offloaded_idx;
+
+ALTER TABLE builds
+DROP COLUMN events_offloaded;
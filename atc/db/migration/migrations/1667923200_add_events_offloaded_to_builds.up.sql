@@ -0,0 +1,4 @@
+ALTER TABLE builds
+ADD COLUMN events_offloaded boolean NOT NULL DEFAULT false;
+
+CREATE INDEX builds_events_not_offloaded_idx ON builds (end_time) WHERE completed AND NOT events_offloaded;
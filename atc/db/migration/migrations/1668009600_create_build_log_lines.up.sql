@@ -0,0 +1,9 @@
+CREATE TABLE build_log_lines (
+    build_id bigint NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+    event_id integer NOT NULL,
+    line text NOT NULL
+);
+
+CREATE INDEX build_log_lines_build_id_idx ON build_log_lines (build_id);
+
+CREATE INDEX build_log_lines_search_idx ON build_log_lines USING gin (to_tsvector('simple', line));
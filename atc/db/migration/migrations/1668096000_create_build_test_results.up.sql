@@ -0,0 +1,11 @@
+CREATE TABLE build_test_results (
+    build_id bigint NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+    step_name text NOT NULL,
+    suite text NOT NULL DEFAULT '',
+    name text NOT NULL,
+    status text NOT NULL,
+    duration double precision NOT NULL DEFAULT 0,
+    message text NOT NULL DEFAULT ''
+);
+
+CREATE INDEX build_test_results_build_id_idx ON build_test_results (build_id);
@@ -0,0 +1,9 @@
+CREATE TABLE build_step_timings (
+    build_id integer NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+    plan_id text NOT NULL,
+    name text NOT NULL,
+    type text NOT NULL,
+    start_time timestamp with time zone,
+    end_time timestamp with time zone,
+    PRIMARY KEY (build_id, plan_id)
+);
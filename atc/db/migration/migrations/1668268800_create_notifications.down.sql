@@ -0,0 +1,3 @@
+DROP TABLE build_notifications;
+
+DROP TABLE pipeline_notifications;
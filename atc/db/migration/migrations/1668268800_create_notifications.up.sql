@@ -0,0 +1,24 @@
+CREATE TABLE pipeline_notifications (
+    pipeline_id integer PRIMARY KEY REFERENCES pipelines (id) ON DELETE CASCADE,
+    config text NOT NULL,
+    nonce text
+);
+
+CREATE TABLE build_notifications (
+    id bigserial PRIMARY KEY,
+    build_id integer NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+    pipeline_id integer NOT NULL REFERENCES pipelines (id) ON DELETE CASCADE,
+    notification text NOT NULL,
+    status text NOT NULL,
+    state text NOT NULL DEFAULT 'pending',
+    attempts integer NOT NULL DEFAULT 0,
+    next_attempt_at timestamp with time zone NOT NULL DEFAULT now(),
+    response_code integer,
+    error text,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    delivered_at timestamp with time zone
+);
+
+CREATE INDEX build_notifications_pipeline_id_idx ON build_notifications (pipeline_id, id DESC);
+
+CREATE INDEX build_notifications_pending_idx ON build_notifications (next_attempt_at) WHERE state = 'pending';
@@ -0,0 +1,7 @@
+ALTER TABLE resources
+  DROP COLUMN check_interval,
+  DROP COLUMN check_interval_reason;
+
+ALTER TABLE resource_config_scopes
+  DROP COLUMN last_version_time,
+  DROP COLUMN check_failures;
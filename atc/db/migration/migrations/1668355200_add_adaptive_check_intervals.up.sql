@@ -0,0 +1,7 @@
+ALTER TABLE resource_config_scopes
+  ADD COLUMN last_version_time timestamp with time zone NOT NULL DEFAULT now(),
+  ADD COLUMN check_failures integer NOT NULL DEFAULT 0;
+
+ALTER TABLE resources
+  ADD COLUMN check_interval bigint,
+  ADD COLUMN check_interval_reason text;
@@ -0,0 +1,7 @@
+CREATE TABLE signing_keys (
+    id serial PRIMARY KEY,
+    kid text NOT NULL UNIQUE,
+    jwk text NOT NULL,
+    nonce text,
+    created_at timestamp with time zone NOT NULL DEFAULT now()
+);
@@ -0,0 +1 @@
+DROP TABLE team_var_sources;
@@ -0,0 +1,5 @@
+CREATE TABLE team_var_sources (
+    team_id integer PRIMARY KEY REFERENCES teams (id) ON DELETE CASCADE,
+    var_sources text NOT NULL,
+    nonce text
+);
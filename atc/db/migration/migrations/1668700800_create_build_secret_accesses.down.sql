@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS build_secret_accesses_delete_trigger ON builds;
+DROP FUNCTION IF EXISTS on_build_delete_remove_secret_accesses();
+DROP TABLE build_secret_accesses;
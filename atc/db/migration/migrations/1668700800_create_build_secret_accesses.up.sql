@@ -0,0 +1,23 @@
+-- no foreign key on build_id, as in-memory check builds have no row in builds;
+-- the accesses of any other build are removed along with it by a trigger
+CREATE TABLE build_secret_accesses (
+    build_id bigint NOT NULL,
+    source text NOT NULL DEFAULT '',
+    path text NOT NULL,
+    credential_manager text NOT NULL DEFAULT '',
+    found boolean NOT NULL,
+    cached boolean NOT NULL,
+    error text NOT NULL DEFAULT ''
+);
+
+CREATE UNIQUE INDEX build_secret_accesses_uniq
+    ON build_secret_accesses (build_id, source, path, credential_manager, found, cached, error);
+
+CREATE OR REPLACE FUNCTION on_build_delete_remove_secret_accesses() RETURNS TRIGGER AS $$
+BEGIN
+        DELETE FROM build_secret_accesses WHERE build_id = OLD.id;
+        RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER build_secret_accesses_delete_trigger AFTER DELETE on builds FOR EACH ROW EXECUTE PROCEDURE on_build_delete_remove_secret_accesses();
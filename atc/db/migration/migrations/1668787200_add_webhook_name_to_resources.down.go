@@ -0,0 +1,12 @@
+package migrations
+
+func (m *migrations) Down_1668787200() error {
+	tx := m.Tx
+
+	_, err := tx.Exec("ALTER TABLE resources DROP COLUMN webhook_name")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package migrations
+
+import (
+	"database/sql"
+	"encoding/json"
+)
+
+type V7ResourceWebhookConfig struct {
+	Webhook *struct {
+		Name string `json:"name"`
+	} `json:"webhook,omitempty"`
+}
+
+func (m *migrations) Up_1668787200() error {
+	tx := m.Tx
+
+	_, err := tx.Exec("ALTER TABLE resources ADD COLUMN webhook_name text")
+	if err != nil {
+		return err
+	}
+
+	webhookNames, err := m.resourceWebhookNames()
+	if err != nil {
+		return err
+	}
+
+	for resourceID, name := range webhookNames {
+		_, err = tx.Exec("UPDATE resources SET webhook_name = $1 WHERE id = $2", name, resourceID)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.Exec("CREATE INDEX resources_webhook_name_idx ON resources (webhook_name) WHERE webhook_name IS NOT NULL")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// resourceWebhookNames returns the names of the webhooks of the active
+// resources which have a named webhook, keyed by the resource's id.
+func (m *migrations) resourceWebhookNames() (map[int]string, error) {
+	rows, err := m.Tx.Query("SELECT id, config, nonce FROM resources WHERE active = true")
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	webhookNames := make(map[int]string)
+	for rows.Next() {
+		var configBlob []byte
+		var nonce sql.NullString
+		var resourceID int
+
+		err = rows.Scan(&resourceID, &configBlob, &nonce)
+		if err != nil {
+			return nil, err
+		}
+
+		var noncense *string
+		if nonce.Valid {
+			noncense = &nonce.String
+		}
+
+		decrypted, err := m.Strategy.Decrypt(string(configBlob), noncense)
+		if err != nil {
+			return nil, err
+		}
+
+		var config V7ResourceWebhookConfig
+		err = json.Unmarshal(decrypted, &config)
+		if err != nil {
+			return nil, err
+		}
+
+		if config.Webhook != nil && config.Webhook.Name != "" {
+			webhookNames[resourceID] = config.Webhook.Name
+		}
+	}
+
+	return webhookNames, rows.Err()
+}
@@ -0,0 +1,5 @@
+CREATE TABLE team_notifications (
+    team_id integer PRIMARY KEY REFERENCES teams (id) ON DELETE CASCADE,
+    config text NOT NULL,
+    nonce text
+);
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS deleted_teams_insert_trigger ON teams;
+DROP FUNCTION IF EXISTS on_team_delete_record();
+DROP TABLE IF EXISTS deleted_teams;
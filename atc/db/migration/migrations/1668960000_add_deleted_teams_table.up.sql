@@ -0,0 +1,13 @@
+CREATE TABLE deleted_teams (
+    id integer NOT NULL,
+    deleted_at timestamp without time zone DEFAULT now() NOT NULL
+);
+
+CREATE OR REPLACE FUNCTION on_team_delete_record() RETURNS TRIGGER AS $$
+BEGIN
+        EXECUTE format('INSERT INTO deleted_teams VALUES (%s)', OLD.id);
+        RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER deleted_teams_insert_trigger AFTER DELETE on teams FOR EACH ROW EXECUTE PROCEDURE on_team_delete_record();
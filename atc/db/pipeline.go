@@ -63,6 +63,8 @@ type Pipeline interface {
 	Groups() atc.GroupConfigs
 	VarSources() atc.VarSourceConfigs
 	Display() *atc.DisplayConfig
+	Notifications() atc.NotificationConfigs
+	LookupNotification(name string) (atc.NotificationConfig, bool, error)
 	ConfigVersion() ConfigVersion
 	Config() (atc.Config, error)
 	Public() bool
@@ -85,6 +87,8 @@ type Pipeline interface {
 
 	DeleteBuildEventsByBuildIDs(buildIDs []int) error
 
+	NotificationDeliveries(limit int) ([]atc.NotificationDelivery, error)
+
 	LoadDebugVersionsDB() (*atc.DebugVersionsDB, error)
 
 	Resource(name string) (Resource, bool, error)
@@ -139,6 +143,7 @@ type pipeline struct {
 	groups        atc.GroupConfigs
 	varSources    atc.VarSourceConfigs
 	display       *atc.DisplayConfig
+	notifications atc.NotificationConfigs
 	configVersion ConfigVersion
 	paused        bool
 	pausedBy      string
@@ -172,9 +177,12 @@ var pipelinesQuery = psql.Select(`
 		p.parent_build_id,
 		p.instance_vars,
 		p.paused_by,
-		p.paused_at`).
+		p.paused_at,
+		pn.config,
+		pn.nonce`).
 	From("pipelines p").
-	LeftJoin("teams t ON p.team_id = t.id")
+	LeftJoin("teams t ON p.team_id = t.id").
+	LeftJoin("pipeline_notifications pn ON pn.pipeline_id = p.id")
 
 func newPipeline(conn Conn, lockFactory lock.LockFactory) *pipeline {
 	return &pipeline{
@@ -183,23 +191,24 @@ func newPipeline(conn Conn, lockFactory lock.LockFactory) *pipeline {
 	}
 }
 
-func (p *pipeline) ID() int                          { return p.id }
-func (p *pipeline) Name() string                     { return p.name }
-func (p *pipeline) TeamID() int                      { return p.teamID }
-func (p *pipeline) TeamName() string                 { return p.teamName }
-func (p *pipeline) ParentJobID() int                 { return p.parentJobID }
-func (p *pipeline) ParentBuildID() int               { return p.parentBuildID }
-func (p *pipeline) InstanceVars() atc.InstanceVars   { return p.instanceVars }
-func (p *pipeline) Groups() atc.GroupConfigs         { return p.groups }
-func (p *pipeline) VarSources() atc.VarSourceConfigs { return p.varSources }
-func (p *pipeline) Display() *atc.DisplayConfig      { return p.display }
-func (p *pipeline) ConfigVersion() ConfigVersion     { return p.configVersion }
-func (p *pipeline) Public() bool                     { return p.public }
-func (p *pipeline) Paused() bool                     { return p.paused }
-func (p *pipeline) PausedAt() time.Time              { return p.pausedAt }
-func (p *pipeline) PausedBy() string                 { return p.pausedBy }
-func (p *pipeline) Archived() bool                   { return p.archived }
-func (p *pipeline) LastUpdated() time.Time           { return p.lastUpdated }
+func (p *pipeline) ID() int                                { return p.id }
+func (p *pipeline) Name() string                           { return p.name }
+func (p *pipeline) TeamID() int                            { return p.teamID }
+func (p *pipeline) TeamName() string                       { return p.teamName }
+func (p *pipeline) ParentJobID() int                       { return p.parentJobID }
+func (p *pipeline) ParentBuildID() int                     { return p.parentBuildID }
+func (p *pipeline) InstanceVars() atc.InstanceVars         { return p.instanceVars }
+func (p *pipeline) Groups() atc.GroupConfigs               { return p.groups }
+func (p *pipeline) VarSources() atc.VarSourceConfigs       { return p.varSources }
+func (p *pipeline) Display() *atc.DisplayConfig            { return p.display }
+func (p *pipeline) Notifications() atc.NotificationConfigs { return p.notifications }
+func (p *pipeline) ConfigVersion() ConfigVersion           { return p.configVersion }
+func (p *pipeline) Public() bool                           { return p.public }
+func (p *pipeline) Paused() bool                           { return p.paused }
+func (p *pipeline) PausedAt() time.Time                    { return p.pausedAt }
+func (p *pipeline) PausedBy() string                       { return p.pausedBy }
+func (p *pipeline) Archived() bool                         { return p.archived }
+func (p *pipeline) LastUpdated() time.Time                 { return p.lastUpdated }
 
 func (p *pipeline) CheckPaused() (bool, error) {
 	var paused bool
@@ -266,6 +275,7 @@ func (p *pipeline) Config() (atc.Config, error) {
 		ResourceTypes: resourceTypes.Configs(),
 		Prototypes:    prototypes.Configs(),
 		Jobs:          jobConfigs,
+		Notifications: p.Notifications(),
 		Display:       p.Display(),
 	}
 
@@ -694,6 +704,11 @@ func (p *pipeline) Unpause() error {
 		return err
 	}
 
+	err = skipMissedScheduleTriggers(tx, p.conn.EncryptionStrategy(), sq.Eq{"pipeline_id": p.id})
+	if err != nil {
+		return err
+	}
+
 	err = requestScheduleForJobsInPipeline(tx, p.id)
 	if err != nil {
 		return err
@@ -1009,6 +1024,41 @@ func (p *pipeline) DeleteBuildEventsByBuildIDs(buildIDs []int) error {
 		return err
 	}
 
+	_, err = tx.Exec(`
+		DELETE FROM build_log_lines
+		WHERE build_id = ANY($1)
+	`, a)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		DELETE FROM build_test_results
+		WHERE build_id = ANY($1)
+	`, a)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		DELETE FROM build_secret_accesses
+		WHERE build_id = ANY($1)
+	`, a)
+	if err != nil {
+		return err
+	}
+
+	// deliveries still being retried are left alone so that their outcome
+	// ends up in the delivery log
+	_, err = tx.Exec(`
+		DELETE FROM build_notifications
+		WHERE build_id = ANY($1)
+		AND state != 'pending'
+	`, a)
+	if err != nil {
+		return err
+	}
+
 	_, err = tx.Exec(`
 		UPDATE builds
 		SET reap_time = now()
@@ -1111,52 +1161,31 @@ func (p *pipeline) CreateStartedBuild(plan atc.Plan) (Build, error) {
 	return build, nil
 }
 
-// Variables creates variables for this pipeline. If this pipeline has its own
-// var_sources, a vars.MultiVars containing all pipeline specific var_sources
-// plug the global variables, otherwise just return the global variables.
+// Variables creates variables for this pipeline. If this pipeline or its team
+// has var_sources, a vars.MultiVars containing all of them plus the global
+// variables, otherwise just return the global variables. A pipeline var_source
+// overrides a team var_source of the same name.
 func (p *pipeline) Variables(logger lager.Logger, globalSecrets creds.Secrets, varSourcePool creds.VarSourcePool) (vars.Variables, error) {
-	globalVars := creds.NewVariables(globalSecrets, p.TeamName(), p.Name(), false)
-	namedVarsMap := vars.NamedVariables{}
-
-	// It's safe to add NamedVariables to allVars via an array here, because
-	// a map is passed by reference.
-	allVars := vars.NewMultiVars([]vars.Variables{namedVarsMap, globalVars})
+	return pipelineVariables(logger, p.conn, p, globalSecrets, varSourcePool, nil)
+}
 
-	orderedVarSources, err := p.varSources.OrderByDependency()
+// pipelineVariables is like Pipeline.Variables, but tells the recorder, if
+// any, about every secret looked up through the variables.
+func pipelineVariables(logger lager.Logger, conn Conn, p Pipeline, globalSecrets creds.Secrets, varSourcePool creds.VarSourcePool, recorder creds.SecretAccessRecorder) (vars.Variables, error) {
+	teamVarSources, err := teamVarSources(conn, p.TeamID())
 	if err != nil {
 		return nil, err
 	}
 
-	for _, cm := range orderedVarSources {
-		factory := creds.ManagerFactories()[cm.Type]
-		if factory == nil {
-			return nil, fmt.Errorf("unknown credential manager type: %s", cm.Type)
-		}
-
-		// Interpolate variables in pipeline credential manager's config
-		newConfig, err := creds.NewParams(allVars, atc.Params{"config": cm.Config}).Evaluate()
-		if err != nil {
-			return nil, errors.Wrapf(err, "evaluate var_source '%s' error", cm.Name)
-		}
-
-		config, ok := newConfig["config"].(map[string]interface{})
-		if !ok {
-			return nil, fmt.Errorf("var_source '%s' invalid config", cm.Name)
-		}
-		secrets, err := varSourcePool.FindOrCreate(logger, config, factory)
-		if err != nil {
-			return nil, errors.Wrapf(err, "create var_source '%s' error", cm.Name)
-		}
-		namedVarsMap[cm.Name] = creds.NewVariables(secrets, p.TeamName(), p.Name(), true)
-	}
-
-	// If there is no var_source from the pipeline, then just return the global
-	// vars.
-	if len(namedVarsMap) == 0 {
-		return globalVars, nil
-	}
-
-	return allVars, nil
+	return newVariables(
+		logger,
+		globalSecrets,
+		varSourcePool,
+		p.TeamName(),
+		p.Name(),
+		mergeVarSources(teamVarSources, p.VarSources()),
+		recorder,
+	)
 }
 
 func (p *pipeline) SetParentIDs(jobID, buildID int) error {
@@ -11,7 +11,8 @@ import (
 //counterfeiter:generate . PipelineLifecycle
 type PipelineLifecycle interface {
 	ArchiveAbandonedPipelines() error
-	RemoveBuildEventsForDeletedPipelines() error
+	RemoveBuildEventsForDeletedPipelines() ([]int, error)
+	ForgetDeletedPipelines(ids []int) error
 }
 
 func NewPipelineLifecycle(conn Conn, lockFactory lock.LockFactory) PipelineLifecycle {
@@ -108,44 +109,52 @@ func archivePipelines(tx Tx, conn Conn, lockFactory lock.LockFactory, rows *sql.
 	return nil
 }
 
-func (p *pipelineLifecycle) RemoveBuildEventsForDeletedPipelines() error {
+// RemoveBuildEventsForDeletedPipelines drops the build events tables of the
+// deleted pipelines and returns their IDs. The pipelines are remembered until
+// ForgetDeletedPipelines is called, so that anything kept outside of the
+// database for them can be cleaned up first.
+func (p *pipelineLifecycle) RemoveBuildEventsForDeletedPipelines() ([]int, error) {
 	rows, err := psql.Select("id").
 		From("deleted_pipelines").
 		RunWith(p.conn).
 		Query()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	defer Close(rows)
+
 	var idsToDelete []int
 	for rows.Next() {
 		var id int
 		if err := rows.Scan(&id); err != nil {
-			return err
+			return nil, err
 		}
 		idsToDelete = append(idsToDelete, id)
 	}
 
-	rows.Close()
-
-	if len(idsToDelete) == 0 {
-		return nil
+	if err := rows.Err(); err != nil {
+		return nil, err
 	}
 
 	for _, id := range idsToDelete {
 		_, err = p.conn.Exec(fmt.Sprintf("DROP TABLE IF EXISTS pipeline_build_events_%d", id))
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	_, err = psql.Delete("deleted_pipelines").
-		Where(sq.Eq{"id": idsToDelete}).
-		RunWith(p.conn).
-		Exec()
-	if err != nil {
-		return err
+	return idsToDelete, nil
+}
+
+func (p *pipelineLifecycle) ForgetDeletedPipelines(ids []int) error {
+	if len(ids) == 0 {
+		return nil
 	}
 
-	return nil
+	_, err := psql.Delete("deleted_pipelines").
+		Where(sq.Eq{"id": ids}).
+		RunWith(p.conn).
+		Exec()
+	return err
 }
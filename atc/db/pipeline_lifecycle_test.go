@@ -185,16 +185,39 @@ var _ = Describe("PipelineLifecycle", func() {
 			destroy(pipeline1)
 			destroy(pipeline2)
 
-			err := pl.RemoveBuildEventsForDeletedPipelines()
+			_, err := pl.RemoveBuildEventsForDeletedPipelines()
 			Expect(err).ToNot(HaveOccurred())
 
 			Expect(pipelineBuildEventsExists(pipeline1.ID())).To(BeFalse())
 			Expect(pipelineBuildEventsExists(pipeline2.ID())).To(BeFalse())
 		})
 
-		It("clears the deleted_pipelines table", func() {
+		It("returns the IDs of the deleted pipelines", func() {
 			destroy(pipeline1)
-			err := pl.RemoveBuildEventsForDeletedPipelines()
+			destroy(pipeline2)
+
+			ids, err := pl.RemoveBuildEventsForDeletedPipelines()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ids).To(ConsistOf(pipeline1.ID(), pipeline2.ID()))
+		})
+
+		It("keeps the deleted pipelines until they are forgotten", func() {
+			destroy(pipeline1)
+
+			_, err := pl.RemoveBuildEventsForDeletedPipelines()
+			Expect(err).ToNot(HaveOccurred())
+
+			ids, err := pl.RemoveBuildEventsForDeletedPipelines()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ids).To(ConsistOf(pipeline1.ID()))
+		})
+
+		It("clears the deleted_pipelines table once they are forgotten", func() {
+			destroy(pipeline1)
+			ids, err := pl.RemoveBuildEventsForDeletedPipelines()
+			Expect(err).ToNot(HaveOccurred())
+
+			err = pl.ForgetDeletedPipelines(ids)
 			Expect(err).ToNot(HaveOccurred())
 
 			var count int
@@ -208,7 +231,7 @@ var _ = Describe("PipelineLifecycle", func() {
 			_, err := dbConn.Exec(fmt.Sprintf("DROP TABLE pipeline_build_events_%d", pipeline1.ID()))
 			Expect(err).ToNot(HaveOccurred())
 
-			err = pl.RemoveBuildEventsForDeletedPipelines()
+			_, err = pl.RemoveBuildEventsForDeletedPipelines()
 			Expect(err).ToNot(HaveOccurred())
 		})
 	})
@@ -1198,6 +1198,25 @@ var _ = Describe("Pipeline", func() {
 			Expect(found).To(BeFalse())
 		})
 
+		It("removes the secret accesses of its builds", func() {
+			job, found, err := pipeline.Job("job-name")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			build, err := job.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = dbConn.Exec("INSERT INTO build_secret_accesses (build_id, path, found, cached) VALUES ($1, 'foo', true, false)", build.ID())
+			Expect(err).ToNot(HaveOccurred())
+
+			destroy(pipeline)
+
+			var count int
+			err = dbConn.QueryRow("SELECT COUNT(*) FROM build_secret_accesses WHERE build_id = $1", build.ID()).Scan(&count)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(BeZero())
+		})
+
 		It("marks the pipeline ID in the deleted_pipelines table", func() {
 			destroy(pipeline)
 
@@ -1453,6 +1472,32 @@ var _ = Describe("Pipeline", func() {
 			// Not required behavior, just a sanity check for what I think will happen
 			Expect(build4DB.ReapTime()).To(Equal(build1DB.ReapTime()))
 		})
+
+		It("deletes the test results of the given builds", func() {
+			build1DB, err := pipeline.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			build2DB, err := pipeline.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			for _, b := range []db.Build{build1DB, build2DB} {
+				err = b.SaveTestResults("unit", []atc.TestResult{
+					{Suite: "some-suite", Name: "passes", Status: atc.TestStatusPassed},
+				})
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			err = pipeline.DeleteBuildEventsByBuildIDs([]int{build1DB.ID()})
+			Expect(err).ToNot(HaveOccurred())
+
+			results, err := build1DB.TestResults()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(BeEmpty())
+
+			results, err = build2DB.TestResults()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(HaveLen(1))
+		})
 		It("deletes all build logs when there are more than 65_536", func() {
 			txn, err := dbConn.Begin()
 			Expect(err).ToNot(HaveOccurred())
@@ -2196,6 +2241,42 @@ var _ = Describe("Pipeline", func() {
 				Expect(v.(string)).To(Equal("pv"))
 			})
 		})
+
+		Context("when the team has var_sources", func() {
+			BeforeEach(func() {
+				err := team.UpdateVarSources(atc.VarSourceConfigs{
+					{
+						Name: "team-var-source",
+						Type: "dummy",
+						Config: map[string]interface{}{
+							"vars": map[string]interface{}{"tk": "tv"},
+						},
+					},
+					{
+						Name: "some-var-source",
+						Type: "dummy",
+						Config: map[string]interface{}{
+							"vars": map[string]interface{}{"pk": "team-pv"},
+						},
+					},
+				})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should get var from the team var source", func() {
+				v, found, err := pvars.Get(vars.Reference{Source: "team-var-source", Path: "tk"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(v.(string)).To(Equal("tv"))
+			})
+
+			It("should let the pipeline var source override the team var source", func() {
+				v, found, err := pvars.Get(vars.Reference{Source: "some-var-source", Path: "pk"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(v.(string)).To(Equal("pv"))
+			})
+		})
 	})
 
 	Describe("SetParentIDs", func() {
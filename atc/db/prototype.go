@@ -73,6 +73,38 @@ func (prototypes Prototypes) Configs() atc.Prototypes {
 	return configs
 }
 
+// Deserialize returns the configs of the prototypes with the source defaults
+// of their parent types applied, as used when fetching their images.
+func (prototypes Prototypes) Deserialize(resourceTypes ResourceTypes) atc.Prototypes {
+	var atcPrototypes atc.Prototypes
+
+	for _, p := range prototypes {
+		source := p.Source()
+		parentType, found := resourceTypes.Parent(p)
+		if found {
+			source = parentType.Defaults().Merge(source)
+		} else {
+			defaults, found := atc.FindBaseResourceTypeDefaults(p.Type())
+			if found {
+				source = defaults.Merge(source)
+			}
+		}
+
+		atcPrototypes = append(atcPrototypes, atc.Prototype{
+			Name:       p.Name(),
+			Type:       p.Type(),
+			Source:     source,
+			Defaults:   p.Defaults(),
+			Privileged: p.Privileged(),
+			CheckEvery: p.CheckEvery(),
+			Tags:       p.Tags(),
+			Params:     p.Params(),
+		})
+	}
+
+	return atcPrototypes
+}
+
 var prototypesQuery = psql.Select(
 	"pt.id",
 	"pt.pipeline_id",
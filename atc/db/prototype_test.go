@@ -136,6 +136,84 @@ var _ = Describe("Prototype", func() {
 		})
 	})
 
+	Describe("(Prototypes).Deserialize", func() {
+		var prototypes atc.Prototypes
+
+		BeforeEach(func() {
+			atc.LoadBaseResourceTypeDefaults(map[string]atc.Source{"s3": {"default-s3-key": "some-value"}})
+
+			var (
+				created bool
+				err     error
+			)
+
+			pipeline, created, err = defaultTeam.SavePipeline(
+				atc.PipelineRef{Name: "pipeline-with-parent-types"},
+				atc.Config{
+					ResourceTypes: atc.ResourceTypes{
+						{
+							Name:     "some-resource-type",
+							Type:     "registry-image",
+							Source:   atc.Source{"some": "repository"},
+							Defaults: atc.Source{"some-default-k1": "some-default-v1"},
+						},
+					},
+					Prototypes: atc.Prototypes{
+						{
+							Name:   "some-prototype",
+							Type:   "some-resource-type",
+							Source: atc.Source{"some": "other-repository"},
+						},
+						{
+							Name:   "some-s3-prototype",
+							Type:   "s3",
+							Source: atc.Source{"some": "repository"},
+						},
+					},
+				},
+				0,
+				false,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeTrue())
+		})
+
+		AfterEach(func() {
+			atc.LoadBaseResourceTypeDefaults(map[string]atc.Source{})
+		})
+
+		JustBeforeEach(func() {
+			dbPrototypes, err := pipeline.Prototypes()
+			Expect(err).ToNot(HaveOccurred())
+
+			resourceTypes, err := pipeline.ResourceTypes()
+			Expect(err).ToNot(HaveOccurred())
+
+			prototypes = dbPrototypes.Deserialize(resourceTypes)
+		})
+
+		It("applies the defaults of the parent types to the source", func() {
+			Expect(prototypes).To(ConsistOf(
+				atc.Prototype{
+					Name: "some-prototype",
+					Type: "some-resource-type",
+					Source: atc.Source{
+						"some-default-k1": "some-default-v1",
+						"some":            "other-repository",
+					},
+				},
+				atc.Prototype{
+					Name: "some-s3-prototype",
+					Type: "s3",
+					Source: atc.Source{
+						"default-s3-key": "some-value",
+						"some":           "repository",
+					},
+				},
+			))
+		})
+	})
+
 	Describe("Prototype version", func() {
 		var (
 			scenario *dbtest.Scenario
@@ -88,6 +88,7 @@ type Resource interface {
 	LastCheckEndTime() time.Time
 	Tags() atc.Tags
 	WebhookToken() string
+	Webhook() *atc.WebhookConfig
 	Config() atc.ResourceConfig
 	ConfigPinnedVersion() atc.Version
 	APIPinnedVersion() atc.Version
@@ -97,6 +98,12 @@ type Resource interface {
 	ResourceConfigScopeID() int
 	Icon() string
 
+	LastVersionTime() time.Time
+	CheckFailures() int
+	CheckInterval() time.Duration
+	CheckIntervalReason() atc.CheckIntervalReason
+	SetCheckInterval(time.Duration, atc.CheckIntervalReason) error
+
 	HasWebhook() bool
 
 	CurrentPinnedVersion() atc.Version
@@ -157,6 +164,10 @@ var (
 		"r.in_memory_build_start_time",
 		"r.in_memory_build_plan",
 		"r.in_memory_build_status",
+		"rs.last_version_time",
+		"rs.check_failures",
+		"r.check_interval",
+		"r.check_interval_reason",
 	).
 		From("resources r").
 		Join("pipelines p ON p.id = r.pipeline_id").
@@ -183,6 +194,10 @@ type resource struct {
 	resourceConfigID      int
 	resourceConfigScopeID int
 	buildSummary          *atc.BuildSummary
+	lastVersionTime       time.Time
+	checkFailures         int
+	checkInterval         time.Duration
+	checkIntervalReason   atc.CheckIntervalReason
 }
 
 func newEmptyResource(conn Conn, lockFactory lock.LockFactory) *resource {
@@ -230,6 +245,7 @@ func (r *resource) LastCheckStartTime() time.Time    { return r.lastCheckStartTi
 func (r *resource) LastCheckEndTime() time.Time      { return r.lastCheckEndTime }
 func (r *resource) Tags() atc.Tags                   { return r.config.Tags }
 func (r *resource) WebhookToken() string             { return r.config.WebhookToken }
+func (r *resource) Webhook() *atc.WebhookConfig      { return r.config.Webhook }
 func (r *resource) Config() atc.ResourceConfig       { return r.config }
 func (r *resource) ConfigPinnedVersion() atc.Version { return r.configPinnedVersion }
 func (r *resource) APIPinnedVersion() atc.Version    { return r.apiPinnedVersion }
@@ -238,7 +254,31 @@ func (r *resource) ResourceConfigID() int            { return r.resourceConfigID
 func (r *resource) ResourceConfigScopeID() int       { return r.resourceConfigScopeID }
 func (r *resource) Icon() string                     { return r.config.Icon }
 
-func (r *resource) HasWebhook() bool { return r.WebhookToken() != "" }
+func (r *resource) LastVersionTime() time.Time                   { return r.lastVersionTime }
+func (r *resource) CheckFailures() int                           { return r.checkFailures }
+func (r *resource) CheckInterval() time.Duration                 { return r.checkInterval }
+func (r *resource) CheckIntervalReason() atc.CheckIntervalReason { return r.checkIntervalReason }
+
+func (r *resource) HasWebhook() bool { return r.WebhookToken() != "" || r.Webhook() != nil }
+
+// SetCheckInterval records the interval on which the resource is currently
+// being checked and why.
+func (r *resource) SetCheckInterval(interval time.Duration, reason atc.CheckIntervalReason) error {
+	_, err := psql.Update("resources").
+		Set("check_interval", int64(interval)).
+		Set("check_interval_reason", string(reason)).
+		Where(sq.Eq{"id": r.id}).
+		RunWith(r.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	r.checkInterval = interval
+	r.checkIntervalReason = reason
+
+	return nil
+}
 
 func (r *resource) Reload() (bool, error) {
 	row := resourcesQuery.Where(sq.Eq{"r.id": r.id}).
@@ -936,6 +976,9 @@ func scanResource(r *resource, row scannable) error {
 		pinnedThroughConfig                               sql.NullBool
 		pipelineInstanceVars                              sql.NullString
 		buildData                                         buildData
+		lastVersionTime                                   pq.NullTime
+		checkFailures, checkInterval                      sql.NullInt64
+		checkIntervalReason                               sql.NullString
 	)
 
 	err := row.Scan(&r.id, &r.name, &r.type_, &configBlob, &buildData.lastCheckStartTime,
@@ -943,11 +986,17 @@ func scanResource(r *resource, row scannable) error {
 		&r.pipelineID, &nonce, &rcID, &rcScopeID,
 		&r.pipelineName, &pipelineInstanceVars, &r.teamID, &r.teamName,
 		&pinnedVersion, &pinComment, &pinnedThroughConfig,
-		&buildData.inMemoryBuildId, &buildData.inMemoryBuildStartTime, &buildData.inMemoryBuildPlan, &buildData.inMemoryBuildStatus)
+		&buildData.inMemoryBuildId, &buildData.inMemoryBuildStartTime, &buildData.inMemoryBuildPlan, &buildData.inMemoryBuildStatus,
+		&lastVersionTime, &checkFailures, &checkInterval, &checkIntervalReason)
 	if err != nil {
 		return err
 	}
 
+	r.lastVersionTime = lastVersionTime.Time
+	r.checkFailures = int(checkFailures.Int64)
+	r.checkInterval = time.Duration(checkInterval.Int64)
+	r.checkIntervalReason = atc.CheckIntervalReason(checkIntervalReason.String)
+
 	es := r.conn.EncryptionStrategy()
 
 	var noncense *string
@@ -125,6 +125,15 @@ func saveVersions(conn Conn, rcsID int, versions []atc.Version, spanContext Span
 		if err != nil {
 			return err
 		}
+
+		_, err = psql.Update("resource_config_scopes").
+			Set("last_version_time", sq.Expr("now()")).
+			Where(sq.Eq{"id": rcsID}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
 	}
 
 	err = tx.Commit()
@@ -239,7 +248,9 @@ func (r *resourceConfigScope) UpdateLastCheckEndTime(succeeded bool) (bool, erro
 
 	updated, err := checkIfRowsUpdated(tx, `
 		UPDATE resource_config_scopes
-		SET last_check_end_time = now(), last_check_succeeded = $1
+		SET last_check_end_time = now(),
+			last_check_succeeded = $1,
+			check_failures = CASE WHEN $1 THEN 0 ELSE check_failures + 1 END
 		WHERE id = $2
 	`, succeeded, r.id)
 	if err != nil {
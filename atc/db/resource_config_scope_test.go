@@ -106,6 +106,18 @@ var _ = Describe("Resource Config Scope", func() {
 			Expect(latestVR.CheckOrder()).To(Equal(4))
 		})
 
+		It("records when a new version was last found", func() {
+			err := scenario.Resource("some-resource").SetResourceConfigScope(resourceScope)
+			Expect(err).ToNot(HaveOccurred())
+
+			lastVersionTime := scenario.Resource("some-resource").LastVersionTime()
+
+			err = resourceScope.SaveVersions(nil, []atc.Version{{"ref": "brand-new"}})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(scenario.Resource("some-resource").LastVersionTime()).To(BeTemporally(">", lastVersionTime))
+		})
+
 		Context("when the versions already exists", func() {
 			var newVersionSlice []atc.Version
 
@@ -344,6 +356,18 @@ var _ = Describe("Resource Config Scope", func() {
 			Expect(updated).To(BeTrue())
 			Expect(scenario.Resource("some-resource").LastCheckEndTime()).To(BeTemporally(">", lastTime))
 		})
+
+		It("counts the checks which failed in a row", func() {
+			_, err := resourceScope.UpdateLastCheckEndTime(false)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = resourceScope.UpdateLastCheckEndTime(false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(scenario.Resource("some-resource").CheckFailures()).To(Equal(2))
+
+			_, err = resourceScope.UpdateLastCheckEndTime(true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(scenario.Resource("some-resource").CheckFailures()).To(BeZero())
+		})
 	})
 
 	Describe("AcquireResourceCheckingLock", func() {
@@ -12,6 +12,7 @@ type ResourceFactory interface {
 	Resource(int) (Resource, bool, error)
 	VisibleResources([]string) ([]Resource, error)
 	AllResources() ([]Resource, error)
+	TeamWebhookResources(teamID int, webhookName string) ([]Resource, error)
 }
 
 type resourceFactory struct {
@@ -63,6 +64,24 @@ func (r *resourceFactory) VisibleResources(teamNames []string) ([]Resource, erro
 	return scanResources(rows, r.conn, r.lockFactory)
 }
 
+// TeamWebhookResources returns the resources in the team's pipelines which
+// listen to the team webhook with the given name.
+func (r *resourceFactory) TeamWebhookResources(teamID int, webhookName string) ([]Resource, error) {
+	rows, err := resourcesQuery.
+		Where(sq.Eq{
+			"t.id":           teamID,
+			"r.webhook_name": webhookName,
+		}).
+		OrderBy("r.id ASC").
+		RunWith(r.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	return scanResources(rows, r.conn, r.lockFactory)
+}
+
 func (r *resourceFactory) AllResources() ([]Resource, error) {
 	rows, err := resourcesQuery.
 		OrderBy("r.id ASC").
@@ -76,5 +76,38 @@ var _ = Describe("Resource Factory", func() {
 				Expect(visibleResources[1].TeamName()).To(Equal("other-team"))
 			})
 		})
+
+		Context("TeamWebhookResources", func() {
+			var otherTeam db.Team
+
+			BeforeEach(func() {
+				var err error
+				otherTeam, _, err = teamFactory.FindTeam("other-team")
+				Expect(err).ToNot(HaveOccurred())
+
+				webhookConfig := atc.Config{
+					Resources: atc.ResourceConfigs{
+						{Name: "github-resource", Webhook: &atc.WebhookConfig{Name: "github", Secret: "((secret))"}},
+						{Name: "gitlab-resource", Webhook: &atc.WebhookConfig{Name: "gitlab", Secret: "((secret))"}},
+					},
+				}
+
+				_, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "webhook-pipeline"}, webhookConfig, db.ConfigVersion(0), false)
+				Expect(err).ToNot(HaveOccurred())
+
+				publicWebhookPipeline, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "webhook-pipeline"}, webhookConfig, db.ConfigVersion(0), false)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(publicWebhookPipeline.Expose()).To(Succeed())
+			})
+
+			It("returns only the team's resources listening to the webhook", func() {
+				resources, err := resourceFactory.TeamWebhookResources(otherTeam.ID(), "github")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(resources).To(HaveLen(1))
+				Expect(resources[0].Name()).To(Equal("github-resource"))
+				Expect(resources[0].TeamName()).To(Equal("other-team"))
+			})
+		})
 	})
 })
@@ -1210,6 +1210,35 @@ var _ = Describe("Resource", func() {
 		})
 	})
 
+	Describe("SetCheckInterval", func() {
+		var scenario *dbtest.Scenario
+
+		BeforeEach(func() {
+			scenario = dbtest.Setup(
+				builder.WithPipeline(atc.Config{
+					Resources: atc.ResourceConfigs{
+						{
+							Name:   "some-resource",
+							Type:   "some-base-resource-type",
+							Source: atc.Source{"some": "repository"},
+						},
+					},
+				}),
+			)
+		})
+
+		It("records the interval the resource is checked on and why", func() {
+			Expect(scenario.Resource("some-resource").CheckInterval()).To(BeZero())
+
+			err := scenario.Resource("some-resource").SetCheckInterval(30*time.Minute, atc.CheckIntervalIdle)
+			Expect(err).ToNot(HaveOccurred())
+
+			resource := scenario.Resource("some-resource")
+			Expect(resource.CheckInterval()).To(Equal(30 * time.Minute))
+			Expect(resource.CheckIntervalReason()).To(Equal(atc.CheckIntervalIdle))
+		})
+	})
+
 	Describe("PinVersion/UnpinVersion", func() {
 		var (
 			scenario *dbtest.Scenario
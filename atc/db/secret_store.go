@@ -0,0 +1,150 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+//counterfeiter:generate . SecretStore
+type SecretStore interface {
+	Get(teamName string, pipelineName string, name string) (interface{}, bool, error)
+
+	List(teamID int) ([]atc.Secret, error)
+	Set(teamID int, pipelineName string, name string, value interface{}) error
+	Delete(teamID int, pipelineName string, name string) (bool, error)
+}
+
+type secretStore struct {
+	conn Conn
+}
+
+// NewSecretStore returns the store holding the secrets of the built-in
+// credential manager. Secrets belong to a team and are optionally scoped to
+// the team's pipelines with a given name. Their values are encrypted.
+func NewSecretStore(conn Conn) SecretStore {
+	return &secretStore{
+		conn: conn,
+	}
+}
+
+func (s *secretStore) Get(teamName string, pipelineName string, name string) (interface{}, bool, error) {
+	var encryptedValue string
+	var nonce sql.NullString
+	err := psql.Select("s.value", "s.nonce").
+		From("secrets s").
+		Join("teams t ON t.id = s.team_id").
+		Where(sq.Eq{
+			"t.name":          teamName,
+			"s.pipeline_name": pipelineName,
+			"s.name":          name,
+		}).
+		RunWith(s.conn).
+		QueryRow().
+		Scan(&encryptedValue, &nonce)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	var noncense *string
+	if nonce.Valid {
+		noncense = &nonce.String
+	}
+
+	decrypted, err := s.conn.EncryptionStrategy().Decrypt(encryptedValue, noncense)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var value interface{}
+	err = json.Unmarshal(decrypted, &value)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return value, true, nil
+}
+
+func (s *secretStore) List(teamID int) ([]atc.Secret, error) {
+	rows, err := psql.Select("s.name", "t.name", "s.pipeline_name", "s.updated_at").
+		From("secrets s").
+		Join("teams t ON t.id = s.team_id").
+		Where(sq.Eq{"s.team_id": teamID}).
+		OrderBy("s.pipeline_name", "s.name").
+		RunWith(s.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	secrets := []atc.Secret{}
+	for rows.Next() {
+		var secret atc.Secret
+		var updatedAt time.Time
+
+		err = rows.Scan(&secret.Name, &secret.TeamName, &secret.PipelineName, &updatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		secret.UpdatedAt = updatedAt.Unix()
+
+		secrets = append(secrets, secret)
+	}
+
+	return secrets, rows.Err()
+}
+
+func (s *secretStore) Set(teamID int, pipelineName string, name string, value interface{}) error {
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	encryptedValue, nonce, err := s.conn.EncryptionStrategy().Encrypt(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("secrets").
+		Columns("team_id", "pipeline_name", "name", "value", "nonce").
+		Values(teamID, pipelineName, name, encryptedValue, nonce).
+		Suffix(`
+			ON CONFLICT (team_id, pipeline_name, name) DO UPDATE SET
+				value = EXCLUDED.value,
+				nonce = EXCLUDED.nonce,
+				updated_at = now()
+		`).
+		RunWith(s.conn).
+		Exec()
+	return err
+}
+
+func (s *secretStore) Delete(teamID int, pipelineName string, name string) (bool, error) {
+	result, err := psql.Delete("secrets").
+		Where(sq.Eq{
+			"team_id":       teamID,
+			"pipeline_name": pipelineName,
+			"name":          name,
+		}).
+		RunWith(s.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
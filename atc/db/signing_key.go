@@ -0,0 +1,150 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"gopkg.in/square/go-jose.v2"
+)
+
+//counterfeiter:generate . SigningKeyFactory
+type SigningKeyFactory interface {
+	CreateKey(jwk jose.JSONWebKey) error
+	GetAllKeys() ([]SigningKey, error)
+	GetNewestKey() (SigningKey, bool, error)
+}
+
+//counterfeiter:generate . SigningKey
+type SigningKey interface {
+	ID() int
+	KeyID() string
+	JWK() jose.JSONWebKey
+	CreatedAt() time.Time
+
+	Delete() error
+}
+
+var signingKeysQuery = psql.Select("k.id", "k.jwk", "k.nonce", "k.created_at").
+	From("signing_keys k")
+
+type signingKeyFactory struct {
+	conn Conn
+}
+
+// NewSigningKeyFactory returns the factory for the keys the ATC signs
+// identity tokens with. The keys are private, so they're stored encrypted.
+func NewSigningKeyFactory(conn Conn) SigningKeyFactory {
+	return &signingKeyFactory{
+		conn: conn,
+	}
+}
+
+func (f *signingKeyFactory) CreateKey(jwk jose.JSONWebKey) error {
+	payload, err := json.Marshal(jwk)
+	if err != nil {
+		return err
+	}
+
+	encryptedJWK, nonce, err := f.conn.EncryptionStrategy().Encrypt(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("signing_keys").
+		Columns("kid", "jwk", "nonce").
+		Values(jwk.KeyID, encryptedJWK, nonce).
+		RunWith(f.conn).
+		Exec()
+	return err
+}
+
+func (f *signingKeyFactory) GetAllKeys() ([]SigningKey, error) {
+	rows, err := signingKeysQuery.
+		OrderBy("k.created_at DESC", "k.id DESC").
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var keys []SigningKey
+	for rows.Next() {
+		key := &signingKey{conn: f.conn}
+
+		err = scanSigningKey(key, rows)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, rows.Err()
+}
+
+func (f *signingKeyFactory) GetNewestKey() (SigningKey, bool, error) {
+	row := signingKeysQuery.
+		OrderBy("k.created_at DESC", "k.id DESC").
+		Limit(1).
+		RunWith(f.conn).
+		QueryRow()
+
+	key := &signingKey{conn: f.conn}
+
+	err := scanSigningKey(key, row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	return key, true, nil
+}
+
+type signingKey struct {
+	conn Conn
+
+	id        int
+	jwk       jose.JSONWebKey
+	createdAt time.Time
+}
+
+func (k *signingKey) ID() int              { return k.id }
+func (k *signingKey) KeyID() string        { return k.jwk.KeyID }
+func (k *signingKey) JWK() jose.JSONWebKey { return k.jwk }
+func (k *signingKey) CreatedAt() time.Time { return k.createdAt }
+
+func (k *signingKey) Delete() error {
+	_, err := psql.Delete("signing_keys").
+		Where(sq.Eq{"id": k.id}).
+		RunWith(k.conn).
+		Exec()
+	return err
+}
+
+func scanSigningKey(key *signingKey, row scannable) error {
+	var encryptedJWK string
+	var nonce sql.NullString
+
+	err := row.Scan(&key.id, &encryptedJWK, &nonce, &key.createdAt)
+	if err != nil {
+		return err
+	}
+
+	var noncense *string
+	if nonce.Valid {
+		noncense = &nonce.String
+	}
+
+	decrypted, err := key.conn.EncryptionStrategy().Decrypt(encryptedJWK, noncense)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(decrypted, &key.jwk)
+}
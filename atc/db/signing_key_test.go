@@ -0,0 +1,89 @@
+package db_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+
+	"github.com/concourse/concourse/atc/db"
+	"gopkg.in/square/go-jose.v2"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SigningKeyFactory", func() {
+	var signingKeyFactory db.SigningKeyFactory
+
+	newKey := func(kid string) jose.JSONWebKey {
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).ToNot(HaveOccurred())
+
+		return jose.JSONWebKey{
+			Key:       privateKey,
+			KeyID:     kid,
+			Algorithm: string(jose.RS256),
+			Use:       "sig",
+		}
+	}
+
+	BeforeEach(func() {
+		signingKeyFactory = db.NewSigningKeyFactory(dbConn)
+	})
+
+	Context("when there are no keys", func() {
+		It("does not find the newest key", func() {
+			_, found, err := signingKeyFactory.GetNewestKey()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("returns no keys", func() {
+			keys, err := signingKeyFactory.GetAllKeys()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(keys).To(BeEmpty())
+		})
+	})
+
+	Context("when keys are created", func() {
+		var oldKey, newestKey jose.JSONWebKey
+
+		BeforeEach(func() {
+			oldKey = newKey("old-key")
+			newestKey = newKey("new-key")
+
+			Expect(signingKeyFactory.CreateKey(oldKey)).To(Succeed())
+			Expect(signingKeyFactory.CreateKey(newestKey)).To(Succeed())
+		})
+
+		It("returns the newest key", func() {
+			key, found, err := signingKeyFactory.GetNewestKey()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(key.KeyID()).To(Equal("new-key"))
+			Expect(key.JWK().Key).To(Equal(newestKey.Key))
+		})
+
+		It("returns every key, newest first", func() {
+			keys, err := signingKeyFactory.GetAllKeys()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(keys).To(HaveLen(2))
+			Expect(keys[0].KeyID()).To(Equal("new-key"))
+			Expect(keys[1].KeyID()).To(Equal("old-key"))
+		})
+
+		Context("when a key is deleted", func() {
+			BeforeEach(func() {
+				key, _, err := signingKeyFactory.GetNewestKey()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(key.Delete()).To(Succeed())
+			})
+
+			It("is no longer returned", func() {
+				keys, err := signingKeyFactory.GetAllKeys()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(keys).To(HaveLen(1))
+				Expect(keys[0].KeyID()).To(Equal("old-key"))
+			})
+		})
+	})
+})
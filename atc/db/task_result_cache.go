@@ -0,0 +1,84 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// SaveTaskResult records the outputs of a successful run of a task step with
+// cache_result enabled, keyed by a hash of everything that went into running
+// it. The output volumes are retained so that later builds of the job can
+// reuse them.
+func (b *build) SaveTaskResult(stepName string, key string, outputs map[string]string) error {
+	if outputs == nil {
+		outputs = map[string]string{}
+	}
+
+	outputsJSON, err := json.Marshal(outputs)
+	if err != nil {
+		return err
+	}
+
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Insert("task_result_caches").
+		Columns("job_id", "step_name", "key", "build_id", "outputs").
+		Values(b.jobID, stepName, key, b.id, string(outputsJSON)).
+		Suffix("ON CONFLICT (job_id, step_name, key) DO UPDATE SET build_id = EXCLUDED.build_id, outputs = EXCLUDED.outputs, created_at = now()").
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	// artifact names keyed by volume handle
+	names := map[string]string{}
+	for name, handle := range outputs {
+		names[handle] = name
+	}
+
+	err = b.retainVolumes(tx, names)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// TaskResult returns the outputs recorded for the given task step of the
+// build's job under the given key, if any.
+func (b *build) TaskResult(stepName string, key string) (map[string]string, bool, error) {
+	var outputsJSON []byte
+	err := psql.Select("outputs").
+		From("task_result_caches").
+		Where(sq.Eq{
+			"job_id":    b.jobID,
+			"step_name": stepName,
+			"key":       key,
+		}).
+		RunWith(b.conn).
+		QueryRow().
+		Scan(&outputsJSON)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	var outputs map[string]string
+	err = json.Unmarshal(outputsJSON, &outputs)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return outputs, true, nil
+}
@@ -78,6 +78,12 @@ type Team interface {
 	FindWorkersForResourceCache(rcId int, shouldBeValidBefore time.Time) ([]Worker, error)
 
 	UpdateProviderAuth(auth atc.TeamAuth) error
+
+	VarSources() (atc.VarSourceConfigs, error)
+	UpdateVarSources(varSources atc.VarSourceConfigs) error
+
+	Notifications() (atc.NotificationConfigs, error)
+	UpdateNotifications(notifications atc.NotificationConfigs) error
 }
 
 type team struct {
@@ -586,6 +592,11 @@ func savePipeline(
 		return 0, false, err
 	}
 
+	err = saveNotifications(tx, config.Notifications, pipelineID)
+	if err != nil {
+		return 0, false, err
+	}
+
 	err = updateJobsName(tx, config.Jobs, pipelineID)
 	if err != nil {
 		return 0, false, err
@@ -977,6 +988,47 @@ func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 	return tx.Commit()
 }
 
+// VarSources returns the var_sources available to every pipeline in the team.
+func (t *team) VarSources() (atc.VarSourceConfigs, error) {
+	return teamVarSources(t.conn, t.id)
+}
+
+func (t *team) UpdateVarSources(varSources atc.VarSourceConfigs) error {
+	tx, err := t.conn.Begin()
+	if err != nil {
+		return err
+	}
+	defer Rollback(tx)
+
+	err = saveTeamVarSources(tx, t.id, varSources)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Notifications returns the notifications sent for builds of every pipeline
+// in the team.
+func (t *team) Notifications() (atc.NotificationConfigs, error) {
+	return teamNotifications(t.conn, t.conn.EncryptionStrategy(), t.id)
+}
+
+func (t *team) UpdateNotifications(notifications atc.NotificationConfigs) error {
+	tx, err := t.conn.Begin()
+	if err != nil {
+		return err
+	}
+	defer Rollback(tx)
+
+	err = saveTeamNotifications(tx, t.id, notifications)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
 func (t *team) FindCheckContainers(logger lager.Logger, pipelineRef atc.PipelineRef, resourceName string) ([]Container, map[int]time.Time, error) {
 	pipeline, found, err := t.Pipeline(pipelineRef)
 	if err != nil {
@@ -1218,11 +1270,31 @@ func saveJob(tx Tx, job atc.JobConfig, pipelineID int, groups []string) (int, er
 		return 0, err
 	}
 
+	var nextTrigger *time.Time
+	if job.Schedule != nil {
+		next, err := job.Schedule.Next(time.Now())
+		if err != nil {
+			return 0, err
+		}
+
+		nextTrigger = &next
+	}
+
+	var concurrencyGroup *string
+	var cancelInProgress bool
+	if job.ConcurrencyGroup != nil {
+		concurrencyGroup = &job.ConcurrencyGroup.Name
+		cancelInProgress = job.ConcurrencyGroup.CancelInProgress
+	}
+
+	// an existing trigger which is earlier than the one computed from the new
+	// config is kept, so that re-saving the pipeline does not skip a trigger
+	// which is due but has not been handled yet
 	var jobID int
 	err = psql.Insert("jobs").
-		Columns("name", "pipeline_id", "config", "public", "max_in_flight", "disable_manual_trigger", "interruptible", "active", "nonce", "tags").
-		Values(job.Name, pipelineID, encryptedPayload, job.Public, job.MaxInFlight(), job.DisableManualTrigger, job.Interruptible, true, nonce, pq.Array(groups)).
-		Suffix("ON CONFLICT (name, pipeline_id) DO UPDATE SET config = EXCLUDED.config, public = EXCLUDED.public, max_in_flight = EXCLUDED.max_in_flight, disable_manual_trigger = EXCLUDED.disable_manual_trigger, interruptible = EXCLUDED.interruptible, active = EXCLUDED.active, nonce = EXCLUDED.nonce, tags = EXCLUDED.tags").
+		Columns("name", "pipeline_id", "config", "public", "max_in_flight", "disable_manual_trigger", "interruptible", "active", "nonce", "tags", "schedule_next_trigger", "concurrency_group", "cancel_in_progress", "priority").
+		Values(job.Name, pipelineID, encryptedPayload, job.Public, job.MaxInFlight(), job.DisableManualTrigger, job.Interruptible, true, nonce, pq.Array(groups), nextTrigger, concurrencyGroup, cancelInProgress, job.Priority).
+		Suffix("ON CONFLICT (name, pipeline_id) DO UPDATE SET config = EXCLUDED.config, public = EXCLUDED.public, max_in_flight = EXCLUDED.max_in_flight, disable_manual_trigger = EXCLUDED.disable_manual_trigger, interruptible = EXCLUDED.interruptible, active = EXCLUDED.active, nonce = EXCLUDED.nonce, tags = EXCLUDED.tags, schedule_next_trigger = CASE WHEN EXCLUDED.schedule_next_trigger IS NULL THEN NULL ELSE LEAST(jobs.schedule_next_trigger, EXCLUDED.schedule_next_trigger) END, concurrency_group = EXCLUDED.concurrency_group, cancel_in_progress = EXCLUDED.cancel_in_progress, priority = EXCLUDED.priority").
 		Suffix("RETURNING id").
 		RunWith(tx).
 		QueryRow().
@@ -1340,8 +1412,11 @@ func scanPipeline(p *pipeline, scan scannable) error {
 		instanceVars  sql.NullString
 		pausedBy      sql.NullString
 		pausedAt      sql.NullTime
+
+		notifications      sql.NullString
+		notificationsNonce sql.NullString
 	)
-	err := scan.Scan(&p.id, &p.name, &groups, &varSources, &display, &nonce, &p.configVersion, &p.teamID, &p.teamName, &p.paused, &p.public, &p.archived, &lastUpdated, &parentJobID, &parentBuildID, &instanceVars, &pausedBy, &pausedAt)
+	err := scan.Scan(&p.id, &p.name, &groups, &varSources, &display, &nonce, &p.configVersion, &p.teamID, &p.teamName, &p.paused, &p.public, &p.archived, &lastUpdated, &parentJobID, &parentBuildID, &instanceVars, &pausedBy, &pausedAt, &notifications, &notificationsNonce)
 	if err != nil {
 		return err
 	}
@@ -1388,6 +1463,24 @@ func scanPipeline(p *pipeline, scan scannable) error {
 		p.varSources = pipelineVarSources
 	}
 
+	p.notifications = nil
+	if notifications.Valid {
+		var notificationsNonceStr *string
+		if notificationsNonce.Valid {
+			notificationsNonceStr = &notificationsNonce.String
+		}
+
+		decryptedNotifications, err := p.conn.EncryptionStrategy().Decrypt(notifications.String, notificationsNonceStr)
+		if err != nil {
+			return err
+		}
+
+		err = json.Unmarshal(decryptedNotifications, &p.notifications)
+		if err != nil {
+			return err
+		}
+	}
+
 	if instanceVars.Valid {
 		err = json.Unmarshal([]byte(instanceVars.String), &p.instanceVars)
 		if err != nil {
@@ -1516,8 +1609,8 @@ func saveResources(tx Tx, resources atc.ResourceConfigs, pipelineID int) (map[st
 		return nil, nil
 	}
 	insertQuery := psql.Insert("resources").
-		Columns("name", "pipeline_id", "config", "active", "nonce", "type", "resource_config_id", "resource_config_scope_id").
-		Suffix("ON CONFLICT (name, pipeline_id) DO UPDATE SET config = EXCLUDED.config, active = EXCLUDED.active, nonce = EXCLUDED.nonce, type = EXCLUDED.type, resource_config_id = EXCLUDED.resource_config_id, resource_config_scope_id = EXCLUDED.resource_config_scope_id").
+		Columns("name", "pipeline_id", "config", "active", "nonce", "type", "webhook_name", "resource_config_id", "resource_config_scope_id").
+		Suffix("ON CONFLICT (name, pipeline_id) DO UPDATE SET config = EXCLUDED.config, active = EXCLUDED.active, nonce = EXCLUDED.nonce, type = EXCLUDED.type, webhook_name = EXCLUDED.webhook_name, resource_config_id = EXCLUDED.resource_config_id, resource_config_scope_id = EXCLUDED.resource_config_scope_id").
 		Suffix("RETURNING name, id")
 	resourcesToPin := map[string][]byte{}
 
@@ -1537,7 +1630,14 @@ func saveResources(tx Tx, resources atc.ResourceConfigs, pipelineID int) (map[st
 		if err != nil {
 			return nil, err
 		}
-		values := []interface{}{resource.Name, pipelineID, encryptedPayload, true, nonce, resource.Type}
+		// the webhook's name is kept outside of the encrypted config so that
+		// the resources listening to a team webhook can be looked up by it
+		var webhookName *string
+		if resource.Webhook != nil && resource.Webhook.Name != "" {
+			webhookName = &resource.Webhook.Name
+		}
+
+		values := []interface{}{resource.Name, pipelineID, encryptedPayload, true, nonce, resource.Type, webhookName}
 
 		existing, exists := existingResources[resource.Name]
 
@@ -20,6 +20,9 @@ type TeamFactory interface {
 	CreateDefaultTeamIfNotExists() (Team, error)
 	NotifyResourceScanner() error
 	NotifyCacher() error
+
+	DeletedTeams() ([]int, error)
+	ForgetDeletedTeams(ids []int) error
 }
 
 type teamFactory struct {
@@ -68,6 +71,20 @@ func (factory *teamFactory) createTeam(t atc.Team, admin bool) (Team, error) {
 		return nil, err
 	}
 
+	if t.VarSources != nil {
+		err = saveTeamVarSources(tx, team.id, *t.VarSources)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if t.Notifications != nil {
+		err = saveTeamNotifications(tx, team.id, *t.Notifications)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, err
@@ -193,3 +210,43 @@ func (factory *teamFactory) scanTeam(t *team, rows scannable) error {
 
 	return err
 }
+
+// DeletedTeams returns the IDs of the teams deleted since they were last
+// forgotten, so that anything kept outside of the database for them can be
+// cleaned up too. Their build events tables are dropped as they are deleted.
+func (factory *teamFactory) DeletedTeams() ([]int, error) {
+	rows, err := psql.Select("id").
+		From("deleted_teams").
+		RunWith(factory.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		err = rows.Scan(&id)
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+func (factory *teamFactory) ForgetDeletedTeams(ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err := psql.Delete("deleted_teams").
+		Where(sq.Eq{"id": ids}).
+		RunWith(factory.conn).
+		Exec()
+	return err
+}
@@ -156,4 +156,28 @@ var _ = Describe("Team Factory", func() {
 			})
 		})
 	})
+	Describe("DeletedTeams", func() {
+		It("returns the ids of deleted teams until they are forgotten", func() {
+			team, err := teamFactory.CreateTeam(atc.Team{Name: "some-deleted-team"})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = team.Delete()
+			Expect(err).ToNot(HaveOccurred())
+
+			ids, err := teamFactory.DeletedTeams()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ids).To(ContainElement(team.ID()))
+
+			ids, err = teamFactory.DeletedTeams()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ids).To(ContainElement(team.ID()))
+
+			err = teamFactory.ForgetDeletedTeams(ids)
+			Expect(err).ToNot(HaveOccurred())
+
+			ids, err = teamFactory.DeletedTeams()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ids).To(BeEmpty())
+		})
+	})
 })
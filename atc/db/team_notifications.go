@@ -0,0 +1,93 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/encryption"
+)
+
+// saveTeamNotifications replaces the notifications shared by the team's
+// pipelines. They are encrypted like those of pipelines, as the endpoints'
+// secrets may be given literally.
+func saveTeamNotifications(tx Tx, teamID int, notifications atc.NotificationConfigs) error {
+	_, err := psql.Delete("team_notifications").
+		Where(sq.Eq{"team_id": teamID}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(notifications)
+	if err != nil {
+		return err
+	}
+
+	encryptedPayload, nonce, err := tx.EncryptionStrategy().Encrypt(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("team_notifications").
+		Columns("team_id", "config", "nonce").
+		Values(teamID, encryptedPayload, nonce).
+		RunWith(tx).
+		Exec()
+	return err
+}
+
+func teamNotifications(runner sq.BaseRunner, es encryption.Strategy, teamID int) (atc.NotificationConfigs, error) {
+	var payload string
+	var nonce sql.NullString
+	err := psql.Select("config", "nonce").
+		From("team_notifications").
+		Where(sq.Eq{"team_id": teamID}).
+		RunWith(runner).
+		QueryRow().
+		Scan(&payload, &nonce)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var nonceStr *string
+	if nonce.Valid {
+		nonceStr = &nonce.String
+	}
+
+	decrypted, err := es.Decrypt(payload, nonceStr)
+	if err != nil {
+		return nil, err
+	}
+
+	var notifications atc.NotificationConfigs
+	err = json.Unmarshal(decrypted, &notifications)
+	if err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+// mergeNotifications returns the team's notifications followed by the
+// pipeline's, leaving out any team notification which the pipeline overrides
+// by name.
+func mergeNotifications(teamNotifications atc.NotificationConfigs, pipelineNotifications atc.NotificationConfigs) atc.NotificationConfigs {
+	var merged atc.NotificationConfigs
+	for _, notification := range teamNotifications {
+		if _, overridden := pipelineNotifications.Lookup(notification.Name); !overridden {
+			merged = append(merged, notification)
+		}
+	}
+
+	return append(merged, pipelineNotifications...)
+}
@@ -1096,6 +1096,45 @@ var _ = Describe("Team", func() {
 				})
 			})
 		})
+
+		Describe("UpdateVarSources", func() {
+			var varSources atc.VarSourceConfigs
+
+			BeforeEach(func() {
+				varSources = atc.VarSourceConfigs{
+					{
+						Name:   "some-var-source",
+						Type:   "dummy",
+						Config: map[string]interface{}{"vars": map[string]interface{}{"k": "v"}},
+					},
+				}
+			})
+
+			It("has no var sources to begin with", func() {
+				Expect(team.VarSources()).To(BeEmpty())
+			})
+
+			It("saves the team's var sources", func() {
+				err := team.UpdateVarSources(varSources)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(team.VarSources()).To(Equal(varSources))
+			})
+
+			Context("when the var sources are cleared", func() {
+				BeforeEach(func() {
+					err := team.UpdateVarSources(varSources)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("removes the team's var sources", func() {
+					err := team.UpdateVarSources(nil)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(team.VarSources()).To(BeEmpty())
+				})
+			})
+		})
 	})
 
 	Describe("Pipelines", func() {
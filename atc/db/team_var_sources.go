@@ -0,0 +1,153 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"code.cloudfoundry.org/lager"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/vars"
+	"github.com/pkg/errors"
+)
+
+// saveTeamVarSources replaces the var_sources shared by the team's pipelines.
+// They are encrypted like those of pipelines, as their config may contain
+// credentials.
+func saveTeamVarSources(tx Tx, teamID int, varSources atc.VarSourceConfigs) error {
+	_, err := psql.Delete("team_var_sources").
+		Where(sq.Eq{"team_id": teamID}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	if len(varSources) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(varSources)
+	if err != nil {
+		return err
+	}
+
+	encryptedPayload, nonce, err := tx.EncryptionStrategy().Encrypt(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("team_var_sources").
+		Columns("team_id", "var_sources", "nonce").
+		Values(teamID, encryptedPayload, nonce).
+		RunWith(tx).
+		Exec()
+	return err
+}
+
+func teamVarSources(conn Conn, teamID int) (atc.VarSourceConfigs, error) {
+	var payload string
+	var nonce sql.NullString
+	err := psql.Select("var_sources", "nonce").
+		From("team_var_sources").
+		Where(sq.Eq{"team_id": teamID}).
+		RunWith(conn).
+		QueryRow().
+		Scan(&payload, &nonce)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var nonceStr *string
+	if nonce.Valid {
+		nonceStr = &nonce.String
+	}
+
+	decrypted, err := conn.EncryptionStrategy().Decrypt(payload, nonceStr)
+	if err != nil {
+		return nil, err
+	}
+
+	var varSources atc.VarSourceConfigs
+	err = json.Unmarshal(decrypted, &varSources)
+	if err != nil {
+		return nil, err
+	}
+
+	return varSources, nil
+}
+
+// mergeVarSources returns the team's var_sources followed by the pipeline's,
+// leaving out any team var_source which the pipeline overrides by name.
+func mergeVarSources(teamVarSources atc.VarSourceConfigs, pipelineVarSources atc.VarSourceConfigs) atc.VarSourceConfigs {
+	var merged atc.VarSourceConfigs
+	for _, vs := range teamVarSources {
+		if _, overridden := pipelineVarSources.Lookup(vs.Name); !overridden {
+			merged = append(merged, vs)
+		}
+	}
+
+	return append(merged, pipelineVarSources...)
+}
+
+// newVariables creates variables for the given var_sources. If there are
+// any, a vars.MultiVars containing all the var_sources plus the global
+// variables is returned, otherwise just the global variables. If a recorder
+// is given, it is told about every secret looked up.
+func newVariables(
+	logger lager.Logger,
+	globalSecrets creds.Secrets,
+	varSourcePool creds.VarSourcePool,
+	teamName string,
+	pipelineName string,
+	varSources atc.VarSourceConfigs,
+	recorder creds.SecretAccessRecorder,
+) (vars.Variables, error) {
+	globalVars := creds.NewRecordedVariables(globalSecrets, teamName, pipelineName, false, "", recorder)
+	namedVarsMap := vars.NamedVariables{}
+
+	// It's safe to add NamedVariables to allVars via an array here, because
+	// a map is passed by reference.
+	allVars := vars.NewMultiVars([]vars.Variables{namedVarsMap, globalVars})
+
+	orderedVarSources, err := varSources.OrderByDependency()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cm := range orderedVarSources {
+		factory := creds.ManagerFactories()[cm.Type]
+		if factory == nil {
+			return nil, fmt.Errorf("unknown credential manager type: %s", cm.Type)
+		}
+
+		// Interpolate variables in the var_source's config
+		newConfig, err := creds.NewParams(allVars, atc.Params{"config": cm.Config}).Evaluate()
+		if err != nil {
+			return nil, errors.Wrapf(err, "evaluate var_source '%s' error", cm.Name)
+		}
+
+		config, ok := newConfig["config"].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("var_source '%s' invalid config", cm.Name)
+		}
+		secrets, err := varSourcePool.FindOrCreate(logger, config, factory)
+		if err != nil {
+			return nil, errors.Wrapf(err, "create var_source '%s' error", cm.Name)
+		}
+		namedSecrets := creds.NewNamedSecrets(cm.Type, secrets)
+		namedVarsMap[cm.Name] = creds.NewRecordedVariables(namedSecrets, teamName, pipelineName, true, cm.Name, recorder)
+	}
+
+	// If there is no var_source, then just return the global vars.
+	if len(namedVarsMap) == 0 {
+		return globalVars, nil
+	}
+
+	return allVars, nil
+}
@@ -1,6 +1,9 @@
 package db
 
 import (
+	"fmt"
+	"time"
+
 	sq "github.com/Masterminds/squirrel"
 )
 
@@ -10,21 +13,80 @@ type WorkerArtifactLifecycle interface {
 }
 
 type artifactLifecycle struct {
-	conn Conn
+	conn         Conn
+	resumeWindow time.Duration
 }
 
-func NewArtifactLifecycle(conn Conn) *artifactLifecycle {
+// NewArtifactLifecycle returns a lifecycle which expires worker artifacts
+// after 12 hours, except for the ones kept for resuming failed builds, which
+// expire along with the builds' plans once the resume window has passed.
+func NewArtifactLifecycle(conn Conn, resumeWindow time.Duration) *artifactLifecycle {
 	return &artifactLifecycle{
-		conn: conn,
+		conn:         conn,
+		resumeWindow: resumeWindow,
 	}
 }
 
+// checkpointArtifactIDs selects the worker artifacts holding on to the
+// volumes of build step checkpoints.
+const checkpointArtifactIDs = `
+	SELECT v.worker_artifact_id
+	FROM build_step_checkpoints c, jsonb_each_text(c.artifacts) a, volumes v
+	WHERE v.handle = a.value
+	AND v.worker_artifact_id IS NOT NULL`
+
 func (lifecycle *artifactLifecycle) RemoveExpiredArtifacts() error {
+	resumeExpiry := fmt.Sprintf("NOW() - '%d seconds'::interval", int(lifecycle.resumeWindow.Seconds()))
 
+	// failed builds can only be resumed for as long as the artifacts of their
+	// steps are around
 	_, err := psql.Delete("worker_artifacts").
+		Where(sq.Expr("id IN (" + checkpointArtifactIDs + " AND c.created_at < " + resumeExpiry + ")")).
+		RunWith(lifecycle.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Delete("build_step_checkpoints").
+		Where(sq.Expr("created_at < " + resumeExpiry)).
+		RunWith(lifecycle.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Delete("worker_artifacts").
+		Where(sq.And{
+			sq.Expr("created_at < NOW() - interval '12 hours'"),
+			sq.Expr("id NOT IN (" + checkpointArtifactIDs + ")"),
+		}).
+		RunWith(lifecycle.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	// cached task results can only be reused for as long as their outputs are
+	// around
+	_, err = psql.Delete("task_result_caches").
 		Where(sq.Expr("created_at < NOW() - interval '12 hours'")).
 		RunWith(lifecycle.conn).
 		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Update("builds").
+		Set("private_plan", nil).
+		Set("nonce", nil).
+		Where(sq.And{
+			sq.Expr("completed"),
+			sq.Expr("private_plan IS NOT NULL"),
+			sq.Expr("end_time < " + resumeExpiry),
+		}).
+		RunWith(lifecycle.conn).
+		Exec()
 
 	return err
 }
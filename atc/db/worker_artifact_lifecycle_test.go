@@ -1,6 +1,8 @@
 package db_test
 
 import (
+	"time"
+
 	"github.com/concourse/concourse/atc/db"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -10,7 +12,7 @@ var _ = Describe("WorkerArtifactLifecycle", func() {
 	var workerArtifactLifecycle db.WorkerArtifactLifecycle
 
 	BeforeEach(func() {
-		workerArtifactLifecycle = db.NewArtifactLifecycle(dbConn)
+		workerArtifactLifecycle = db.NewArtifactLifecycle(dbConn, 12*time.Hour)
 	})
 
 	Describe("RemoveExpiredArtifacts", func() {
@@ -51,5 +53,74 @@ var _ = Describe("WorkerArtifactLifecycle", func() {
 				Expect(count).To(Equal(1))
 			})
 		})
+
+		Context("when an artifact is kept for a build step checkpoint", func() {
+			var checkpointAge string
+
+			BeforeEach(func() {
+				workerArtifactLifecycle = db.NewArtifactLifecycle(dbConn, 24*time.Hour)
+			})
+
+			JustBeforeEach(func() {
+				build, err := defaultJob.CreateBuild(defaultBuildCreatedBy)
+				Expect(err).ToNot(HaveOccurred())
+
+				var artifactID int
+				err = dbConn.QueryRow("INSERT INTO worker_artifacts(name, build_id, created_at) VALUES('some-output', $1, NOW() - $2::interval) RETURNING id", build.ID(), checkpointAge).Scan(&artifactID)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = dbConn.Exec("INSERT INTO volumes (handle, team_id, worker_name, worker_artifact_id, state) VALUES ('some-handle', $1, $2, $3, $4)", defaultTeam.ID(), defaultWorker.Name(), artifactID, db.VolumeStateCreated)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = dbConn.Exec(`INSERT INTO build_step_checkpoints(build_id, plan_id, artifacts, created_at) VALUES($1, 'some-plan', '{"some-output":"some-handle"}', NOW() - $2::interval)`, build.ID(), checkpointAge)
+				Expect(err).ToNot(HaveOccurred())
+
+				err = workerArtifactLifecycle.RemoveExpiredArtifacts()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			countArtifacts := func() int {
+				var count int
+				err := dbConn.QueryRow("SELECT count(*) from worker_artifacts").Scan(&count)
+				Expect(err).ToNot(HaveOccurred())
+				return count
+			}
+
+			Context("within the resume window", func() {
+				BeforeEach(func() {
+					checkpointAge = "13 hours"
+				})
+
+				It("keeps the artifact past 12 hours", func() {
+					Expect(countArtifacts()).To(Equal(1))
+				})
+			})
+
+			Context("after the resume window", func() {
+				BeforeEach(func() {
+					checkpointAge = "25 hours"
+				})
+
+				It("removes the artifact and the checkpoint", func() {
+					Expect(countArtifacts()).To(Equal(0))
+
+					var count int
+					err := dbConn.QueryRow("SELECT count(*) from build_step_checkpoints").Scan(&count)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(count).To(Equal(0))
+				})
+			})
+
+			Context("when the resume window is shorter than 12 hours", func() {
+				BeforeEach(func() {
+					workerArtifactLifecycle = db.NewArtifactLifecycle(dbConn, time.Hour)
+					checkpointAge = "2 hours"
+				})
+
+				It("removes the artifact once the window has passed", func() {
+					Expect(countArtifacts()).To(Equal(0))
+				})
+			})
+		})
 	})
 })
@@ -0,0 +1,68 @@
+package engine
+
+import (
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/policy"
+)
+
+func NewApproveStepDelegate(
+	build db.Build,
+	planID atc.PlanID,
+	state exec.RunState,
+	clock clock.Clock,
+	policyChecker policy.Checker,
+) *approveStepDelegate {
+	return &approveStepDelegate{
+		buildStepDelegate{
+			build:         build,
+			planID:        planID,
+			clock:         clock,
+			state:         state,
+			stdout:        nil,
+			stderr:        nil,
+			policyChecker: policyChecker,
+		},
+	}
+}
+
+type approveStepDelegate struct {
+	buildStepDelegate
+}
+
+func (delegate *approveStepDelegate) WaitingForApproval(logger lager.Logger, role string) {
+	err := delegate.build.SaveEvent(event.WaitingForApproval{
+		Origin: event.Origin{
+			ID: event.OriginID(delegate.planID),
+		},
+		Time: delegate.clock.Now().Unix(),
+		Role: role,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-waiting-for-approval-event", err)
+		return
+	}
+
+	logger.Debug("waiting for approval")
+}
+
+func (delegate *approveStepDelegate) ApprovalDecided(logger lager.Logger, approved bool, decidedBy string) {
+	err := delegate.build.SaveEvent(event.ApprovalDecided{
+		Origin: event.Origin{
+			ID: event.OriginID(delegate.planID),
+		},
+		Time:      delegate.clock.Now().Unix(),
+		Approved:  approved,
+		DecidedBy: decidedBy,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-approval-decided-event", err)
+		return
+	}
+
+	logger.Debug("approval decided", lager.Data{"approved": approved, "decided-by": decidedBy})
+}
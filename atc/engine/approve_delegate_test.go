@@ -0,0 +1,70 @@
+package engine_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
+
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/engine"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/policy/policyfakes"
+	"github.com/concourse/concourse/vars"
+)
+
+var _ = Describe("ApproveStepDelegate", func() {
+	var (
+		logger    *lagertest.TestLogger
+		fakeBuild *dbfakes.FakeBuild
+		fakeClock *fakeclock.FakeClock
+
+		now      = time.Date(1991, 6, 3, 5, 30, 0, 0, time.UTC)
+		delegate exec.ApproveStepDelegate
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeClock = fakeclock.NewFakeClock(now)
+		state := exec.NewRunState(noopStepper, vars.StaticVariables{}, true)
+
+		delegate = engine.NewApproveStepDelegate(fakeBuild, "some-plan-id", state, fakeClock, new(policyfakes.FakeChecker))
+	})
+
+	Describe("WaitingForApproval", func() {
+		JustBeforeEach(func() {
+			delegate.WaitingForApproval(logger, "member")
+		})
+
+		It("saves an event", func() {
+			Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+			Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.WaitingForApproval{
+				Origin: event.Origin{ID: event.OriginID("some-plan-id")},
+				Time:   now.Unix(),
+				Role:   "member",
+			}))
+		})
+	})
+
+	Describe("ApprovalDecided", func() {
+		JustBeforeEach(func() {
+			delegate.ApprovalDecided(logger, false, "some-user")
+		})
+
+		It("saves an event", func() {
+			Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+			Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.ApprovalDecided{
+				Origin:    event.Origin{ID: event.OriginID("some-plan-id")},
+				Time:      now.Unix(),
+				Approved:  false,
+				DecidedBy: "some-user",
+			}))
+		})
+	})
+})
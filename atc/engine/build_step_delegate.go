@@ -188,12 +188,13 @@ func (delegate *buildStepDelegate) BeforeSelectWorker(logger lager.Logger) error
 	return nil
 }
 
-func (delegate *buildStepDelegate) WaitingForWorker(logger lager.Logger) {
+func (delegate *buildStepDelegate) WaitingForWorker(logger lager.Logger, queuePosition int) {
 	err := delegate.build.SaveEvent(event.WaitingForWorker{
 		Time: time.Now().Unix(),
 		Origin: event.Origin{
 			ID: event.OriginID(delegate.planID),
 		},
+		QueuePosition: queuePosition,
 	})
 	if err != nil {
 		logger.Error("failed-to-save-waiting-for-worker-event", err)
@@ -262,6 +263,40 @@ func (delegate *buildStepDelegate) Errored(logger lager.Logger, message string)
 	}
 }
 
+func (delegate *buildStepDelegate) Skipped(logger lager.Logger, condition string) {
+	err := delegate.build.SaveEvent(event.Skipped{
+		Origin: event.Origin{
+			ID: event.OriginID(delegate.planID),
+		},
+		Time:      delegate.clock.Now().Unix(),
+		Condition: condition,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-skipped-event", err)
+		return
+	}
+
+	logger.Info("skipped")
+}
+
+func (delegate *buildStepDelegate) Retrying(logger lager.Logger, attempt int, delay time.Duration, reason string) {
+	err := delegate.build.SaveEvent(event.Retrying{
+		Origin: event.Origin{
+			ID: event.OriginID(delegate.planID),
+		},
+		Time:    delegate.clock.Now().Unix(),
+		Attempt: attempt,
+		Delay:   delay.String(),
+		Reason:  reason,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-retrying-event", err)
+		return
+	}
+
+	logger.Info("retrying", lager.Data{"attempt": attempt, "delay": delay.String(), "reason": reason})
+}
+
 func (delegate *buildStepDelegate) FetchImage(
 	ctx context.Context,
 	getPlan atc.Plan,
@@ -1065,6 +1065,19 @@ var _ = Describe("BuildStepDelegate", func() {
 		})
 	})
 
+	Describe("WaitingForWorker", func() {
+		JustBeforeEach(func() {
+			delegate.WaitingForWorker(logger, 3)
+		})
+
+		It("saves an event with the queue position", func() {
+			Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+			e := fakeBuild.SaveEventArgsForCall(0)
+			Expect(e.EventType()).To(Equal(atc.EventType("waiting-for-worker")))
+			Expect(e.(event.WaitingForWorker).QueuePosition).To(Equal(3))
+		})
+	})
+
 	Describe("StreamingVolume", func() {
 		JustBeforeEach(func() {
 			delegate.StreamingVolume(logger, "some-volume", "src-worker", "dest-worker")
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 
+	"code.cloudfoundry.org/clock"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/lock"
@@ -24,6 +25,7 @@ type CoreStepFactory interface {
 	CheckStep(atc.Plan, exec.StepMetadata, db.ContainerMetadata, DelegateFactory) exec.Step
 	SetPipelineStep(atc.Plan, exec.StepMetadata, DelegateFactory) exec.Step
 	LoadVarStep(atc.Plan, exec.StepMetadata, DelegateFactory) exec.Step
+	ApproveStep(atc.Plan, exec.StepMetadata, DelegateFactory) exec.Step
 	ArtifactInputStep(atc.Plan, db.Build) exec.Step
 	ArtifactOutputStep(atc.Plan, db.Build) exec.Step
 }
@@ -102,6 +104,10 @@ func (factory *stepperFactory) buildStep(build db.Build, plan atc.Plan) exec.Ste
 		return factory.buildTryStep(build, plan)
 	}
 
+	if plan.If != nil {
+		return factory.buildIfStep(build, plan)
+	}
+
 	if plan.OnAbort != nil {
 		return factory.buildOnAbortStep(build, plan)
 	}
@@ -138,6 +144,10 @@ func (factory *stepperFactory) buildStep(build db.Build, plan atc.Plan) exec.Ste
 		return factory.buildLoadVarStep(build, plan)
 	}
 
+	if plan.Approve != nil {
+		return factory.buildApproveStep(build, plan)
+	}
+
 	if plan.Check != nil {
 		return factory.buildCheckStep(build, plan)
 	}
@@ -221,6 +231,18 @@ func (factory *stepperFactory) buildTryStep(build db.Build, plan atc.Plan) exec.
 	return exec.Try(step)
 }
 
+func (factory *stepperFactory) buildIfStep(build db.Build, plan atc.Plan) exec.Step {
+	innerPlan := plan.If.Step
+	innerPlan.Attempts = plan.Attempts
+	step := factory.buildStep(build, innerPlan)
+
+	// events are attributed to the wrapped step so that it can be shown as
+	// skipped
+	delegateFactory := factory.buildDelegateFactory(build, innerPlan)
+
+	return exec.LogError(exec.If(step, *plan.If, delegateFactory), delegateFactory)
+}
+
 func (factory *stepperFactory) buildOnAbortStep(build db.Build, plan atc.Plan) exec.Step {
 	plan.OnAbort.Step.Attempts = plan.Attempts
 	step := factory.buildStep(build, plan.OnAbort.Step)
@@ -271,7 +293,13 @@ func (factory *stepperFactory) buildRetryStep(build db.Build, plan atc.Plan) exe
 		steps = append(steps, step)
 	}
 
-	return exec.Retry(steps...)
+	return exec.RetryWithPolicy(
+		steps,
+		*plan.Retry,
+		plan.RetryPolicy,
+		factory.buildDelegateFactory(build, plan),
+		clock.NewClock(),
+	)
 }
 
 func (factory *stepperFactory) buildGetStep(build db.Build, plan atc.Plan) exec.Step {
@@ -417,6 +445,21 @@ func (factory *stepperFactory) buildLoadVarStep(build db.Build, plan atc.Plan) e
 	)
 }
 
+func (factory *stepperFactory) buildApproveStep(build db.Build, plan atc.Plan) exec.Step {
+
+	stepMetadata := factory.stepMetadata(
+		build,
+		factory.externalURL,
+		false,
+	)
+
+	return factory.coreFactory.ApproveStep(
+		plan,
+		stepMetadata,
+		factory.buildDelegateFactory(build, plan),
+	)
+}
+
 func (factory *stepperFactory) buildArtifactInputStep(build db.Build, plan atc.Plan) exec.Step {
 	return factory.coreFactory.ArtifactInputStep(
 		plan,
@@ -481,6 +524,7 @@ func (factory *stepperFactory) stepMetadata(
 		PipelineName:         build.PipelineName(),
 		PipelineInstanceVars: build.PipelineInstanceVars(),
 		ExternalURL:          externalURL,
+		Priority:             build.Priority(),
 	}
 	if exposeBuildCreatedBy && build.CreatedBy() != nil {
 		meta.CreatedBy = *build.CreatedBy()
@@ -76,6 +76,7 @@ var _ = Describe("Builder", func() {
 				fakeBuild.TeamIDReturns(1111)
 				someUser := "some-user"
 				fakeBuild.CreatedByReturns(&someUser)
+				fakeBuild.PriorityReturns(10)
 
 				expectedMetadataWithCreatedBy = exec.StepMetadata{
 					BuildID:              4444,
@@ -89,6 +90,7 @@ var _ = Describe("Builder", func() {
 					PipelineInstanceVars: atc.InstanceVars{"branch": "master"},
 					ExternalURL:          "http://example.com",
 					CreatedBy:            "some-user",
+					Priority:             10,
 				}
 
 				expectedMetadataWithoutCreatedBy = exec.StepMetadata{
@@ -102,6 +104,7 @@ var _ = Describe("Builder", func() {
 					PipelineName:         "some-pipeline",
 					PipelineInstanceVars: atc.InstanceVars{"branch": "master"},
 					ExternalURL:          "http://example.com",
+					Priority:             10,
 				}
 			})
 
@@ -598,6 +601,21 @@ var _ = Describe("Builder", func() {
 						})
 					})
 
+					Context("that contains an approve step", func() {
+						BeforeEach(func() {
+							expectedPlan = planFactory.NewPlan(atc.ApprovePlan{
+								Name: "deploy",
+								Role: "member",
+							})
+						})
+
+						It("constructs approve correctly", func() {
+							plan, stepMetadata, _ := fakeCoreStepFactory.ApproveStepArgsForCall(0)
+							Expect(plan).To(Equal(expectedPlan))
+							Expect(stepMetadata).To(Equal(expectedMetadataWithoutCreatedBy))
+						})
+					})
+
 					Context("that contains a check step", func() {
 						BeforeEach(func() {
 							expectedPlan = planFactory.NewPlan(atc.CheckPlan{
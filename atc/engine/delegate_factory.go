@@ -46,3 +46,7 @@ func (delegate DelegateFactory) BuildStepDelegate(state exec.RunState) exec.Buil
 func (delegate DelegateFactory) SetPipelineStepDelegate(state exec.RunState) exec.SetPipelineStepDelegate {
 	return NewSetPipelineStepDelegate(delegate.build, delegate.plan.ID, state, clock.NewClock(), delegate.policyChecker)
 }
+
+func (delegate DelegateFactory) ApproveStepDelegate(state exec.RunState) exec.ApproveStepDelegate {
+	return NewApproveStepDelegate(delegate.build, delegate.plan.ID, state, clock.NewClock(), delegate.policyChecker)
+}
@@ -309,7 +309,11 @@ func (b *engineBuild) runState(logger lager.Logger, stepper exec.Stepper) (exec.
 	if err != nil {
 		return nil, err
 	}
-	state, _ := b.trackedStates.LoadOrStore(id, exec.NewRunState(stepper, credVars, atc.EnableRedactSecrets))
+	newState := exec.NewRunState(stepper, credVars, atc.EnableRedactSecrets)
+	for name, value := range b.build.Params() {
+		newState.AddLocalVar(name, value, false)
+	}
+	state, _ := b.trackedStates.LoadOrStore(id, newState)
 	return state.(exec.RunState), nil
 }
 
@@ -191,6 +191,21 @@ var _ = Describe("Engine", func() {
 									Expect(val).To(Equal("bar"))
 								})
 
+								Context("when the build has params", func() {
+									BeforeEach(func() {
+										fakeBuild.ParamsReturns(map[string]interface{}{"target": "prod"})
+									})
+
+									It("runs the step with the params as local vars", func() {
+										state := <-invokedState
+
+										val, found, err := state.Get(vars.Reference{Source: ".", Path: "target"})
+										Expect(err).ToNot(HaveOccurred())
+										Expect(found).To(BeTrue())
+										Expect(val).To(Equal("prod"))
+									})
+								})
+
 								Context("when the build is released", func() {
 									BeforeEach(func() {
 										readyToRelease := make(chan bool)
@@ -17,6 +17,8 @@ import (
 type coreStepFactory struct {
 	pool                  worker.Pool
 	streamer              worker.Streamer
+	taskCacheStore        exec.TaskCacheStore
+	idTokenIssuer         exec.IDTokenIssuer
 	lockFactory           lock.LockFactory
 	teamFactory           db.TeamFactory
 	buildFactory          db.BuildFactory
@@ -35,6 +37,8 @@ type coreStepFactory struct {
 func NewCoreStepFactory(
 	pool worker.Pool,
 	streamer worker.Streamer,
+	taskCacheStore exec.TaskCacheStore,
+	idTokenIssuer exec.IDTokenIssuer,
 	lockFactory lock.LockFactory,
 	teamFactory db.TeamFactory,
 	buildFactory db.BuildFactory,
@@ -52,6 +56,8 @@ func NewCoreStepFactory(
 	return &coreStepFactory{
 		pool:                  pool,
 		streamer:              streamer,
+		taskCacheStore:        taskCacheStore,
+		idTokenIssuer:         idTokenIssuer,
 		lockFactory:           lockFactory,
 		teamFactory:           teamFactory,
 		buildFactory:          buildFactory,
@@ -119,6 +125,9 @@ func (factory *coreStepFactory) PutStep(
 	if atc.EnableBuildRerunWhenWorkerDisappears {
 		putStep = exec.RetryError(putStep, delegateFactory)
 	}
+	if stepMetadata.JobID != 0 {
+		putStep = exec.Checkpoint(putStep, plan, delegateFactory.build, factory.pool, delegateFactory)
+	}
 	return putStep
 }
 
@@ -161,7 +170,12 @@ func (factory *coreStepFactory) RunStep(
 	runStep := exec.NewRunStep(
 		plan.ID,
 		*plan.Run,
+		stepMetadata,
+		containerMetadata,
+		factory.strategy,
+		factory.pool,
 		delegateFactory,
+		factory.defaultTaskTimeout,
 	)
 
 	runStep = exec.LogError(runStep, delegateFactory)
@@ -189,6 +203,8 @@ func (factory *coreStepFactory) TaskStep(
 		factory.strategy,
 		factory.pool,
 		factory.streamer,
+		factory.taskCacheStore,
+		factory.idTokenIssuer,
 		delegateFactory,
 		factory.defaultTaskTimeout,
 	)
@@ -197,6 +213,9 @@ func (factory *coreStepFactory) TaskStep(
 	if atc.EnableBuildRerunWhenWorkerDisappears {
 		taskStep = exec.RetryError(taskStep, delegateFactory)
 	}
+	if stepMetadata.JobID != 0 {
+		taskStep = exec.Checkpoint(taskStep, plan, delegateFactory.build, factory.pool, delegateFactory)
+	}
 	return taskStep
 }
 
@@ -242,6 +261,22 @@ func (factory *coreStepFactory) LoadVarStep(
 	return loadVarStep
 }
 
+func (factory *coreStepFactory) ApproveStep(
+	plan atc.Plan,
+	stepMetadata exec.StepMetadata,
+	delegateFactory DelegateFactory,
+) exec.Step {
+	approveStep := exec.NewApproveStep(
+		plan.ID,
+		*plan.Approve,
+		stepMetadata,
+		delegateFactory,
+		factory.buildFactory,
+	)
+
+	return exec.LogError(approveStep, delegateFactory)
+}
+
 func (factory *coreStepFactory) ArtifactInputStep(
 	plan atc.Plan,
 	build db.Build,
@@ -2,6 +2,7 @@ package engine
 
 import (
 	"context"
+	"fmt"
 	"io"
 
 	"code.cloudfoundry.org/clock"
@@ -103,6 +104,32 @@ func (d *taskDelegate) Finished(
 	logger.Info("finished", lager.Data{"exit-status": exitStatus})
 }
 
+func (d *taskDelegate) FindTaskResult(stepName string, key string) (map[string]string, bool, error) {
+	return d.build.TaskResult(stepName, key)
+}
+
+func (d *taskDelegate) SaveTaskResult(stepName string, key string, outputs map[string]string) error {
+	return d.build.SaveTaskResult(stepName, key, outputs)
+}
+
+func (d *taskDelegate) SaveTestResults(stepName string, results []atc.TestResult) error {
+	return d.build.SaveTestResults(stepName, results)
+}
+
+func (d *taskDelegate) TaskCacheHit(logger lager.Logger, key string) {
+	err := d.build.SaveEvent(event.TaskCacheHit{
+		Origin: d.eventOrigin,
+		Time:   d.clock.Now().Unix(),
+		Key:    key,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-task-cache-hit-event", err)
+		return
+	}
+
+	logger.Info("cache-hit", lager.Data{"key": key})
+}
+
 func (d *taskDelegate) FetchImage(
 	ctx context.Context,
 	image atc.ImageResource,
@@ -110,10 +137,38 @@ func (d *taskDelegate) FetchImage(
 	privileged bool,
 	stepTags atc.Tags,
 	skipInterval bool,
+) (runtime.ImageSpec, error) {
+	return d.fetchImage(ctx, d.planID, image, types, privileged, stepTags, skipInterval)
+}
+
+// FetchServiceImage fetches the image for one of the task's services. The
+// image plans are namespaced by the service name so that they do not collide
+// with those of the task's own image.
+func (d *taskDelegate) FetchServiceImage(
+	ctx context.Context,
+	serviceName string,
+	image atc.ImageResource,
+	types atc.ResourceTypes,
+	privileged bool,
+	stepTags atc.Tags,
+	skipInterval bool,
+) (runtime.ImageSpec, error) {
+	planID := atc.PlanID(fmt.Sprintf("%s/services/%s", d.planID, serviceName))
+	return d.fetchImage(ctx, planID, image, types, privileged, stepTags, skipInterval)
+}
+
+func (d *taskDelegate) fetchImage(
+	ctx context.Context,
+	planID atc.PlanID,
+	image atc.ImageResource,
+	types atc.ResourceTypes,
+	privileged bool,
+	stepTags atc.Tags,
+	skipInterval bool,
 ) (runtime.ImageSpec, error) {
 	image.Name = "image"
 
-	getPlan, checkPlan := atc.FetchImagePlan(d.planID, image, types, stepTags, skipInterval, nil)
+	getPlan, checkPlan := atc.FetchImagePlan(planID, image, types, stepTags, skipInterval, nil)
 
 	if checkPlan != nil {
 		err := d.build.SaveEvent(event.ImageCheck{
@@ -146,6 +146,21 @@ var _ = Describe("TaskDelegate", func() {
 		})
 	})
 
+	Describe("TaskCacheHit", func() {
+		JustBeforeEach(func() {
+			delegate.TaskCacheHit(logger, "some-key")
+		})
+
+		It("saves an event", func() {
+			Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+			Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.TaskCacheHit{
+				Origin: event.Origin{ID: event.OriginID(planID)},
+				Time:   now.Unix(),
+				Key:    "some-key",
+			}))
+		})
+	})
+
 	Describe("FetchImage", func() {
 		var delegate exec.TaskDelegate
 
@@ -311,4 +326,74 @@ var _ = Describe("TaskDelegate", func() {
 			})
 		})
 	})
+
+	Describe("FetchServiceImage", func() {
+		var delegate exec.TaskDelegate
+
+		var volume *runtimetest.Volume
+		var runPlans []atc.Plan
+
+		var imageSpec runtime.ImageSpec
+		var fetchErr error
+
+		BeforeEach(func() {
+			volume = runtimetest.NewVolume("some-volume")
+
+			runPlans = nil
+			stepper := func(p atc.Plan) exec.Step {
+				runPlans = append(runPlans, p)
+
+				step := new(execfakes.FakeStep)
+				step.RunStub = func(_ context.Context, state exec.RunState) (bool, error) {
+					if p.Get != nil {
+						state.ArtifactRepository().RegisterArtifact("image", volume, false)
+						state.StoreResult(p.ID, exec.GetResult{
+							Name:          "image",
+							ResourceCache: new(dbfakes.FakeResourceCache),
+						})
+					}
+					return true, nil
+				}
+				return step
+			}
+
+			runState := exec.NewRunState(stepper, nil, false)
+			delegate = NewTaskDelegate(fakeBuild, planID, runState, fakeClock, fakePolicyChecker, fakeWorkerFactory, fakeLockFactory)
+		})
+
+		JustBeforeEach(func() {
+			imageSpec, fetchErr = delegate.FetchServiceImage(
+				context.TODO(),
+				"postgres",
+				atc.ImageResource{
+					Type:   "registry-image",
+					Source: atc.Source{"repository": "postgres"},
+				},
+				atc.ResourceTypes{},
+				false,
+				nil,
+				false,
+			)
+		})
+
+		It("returns an image spec containing the artifact", func() {
+			Expect(fetchErr).ToNot(HaveOccurred())
+			Expect(imageSpec).To(Equal(runtime.ImageSpec{
+				ImageArtifact: volume,
+				Privileged:    false,
+			}))
+		})
+
+		It("namespaces the image plans by the service name", func() {
+			Expect(runPlans).To(HaveLen(2))
+			Expect(runPlans[0].ID).To(Equal(planID + "/services/postgres/image-check"))
+			Expect(runPlans[1].ID).To(Equal(planID + "/services/postgres/image-get"))
+		})
+
+		It("attributes the image events to the task", func() {
+			Expect(fakeBuild.SaveEventCallCount()).To(Equal(2))
+			Expect(fakeBuild.SaveEventArgsForCall(0).EventType()).To(Equal(event.EventTypeImageCheck))
+			Expect(fakeBuild.SaveEventArgsForCall(1).EventType()).To(Equal(event.EventTypeImageGet))
+		})
+	})
 })
@@ -93,12 +93,13 @@ func (Status) EventType() atc.EventType  { return EventTypeStatus }
 func (Status) Version() atc.EventVersion { return "1.0" }
 
 type WaitingForWorker struct {
-	Time   int64  `json:"time"`
-	Origin Origin `json:"origin"`
+	Time          int64  `json:"time"`
+	Origin        Origin `json:"origin"`
+	QueuePosition int    `json:"queue_position,omitempty"`
 }
 
 func (WaitingForWorker) EventType() atc.EventType  { return EventTypeWaitingForWorker }
-func (WaitingForWorker) Version() atc.EventVersion { return "1.0" }
+func (WaitingForWorker) Version() atc.EventVersion { return "1.1" }
 
 type SelectedWorker struct {
 	Time       int64  `json:"time"`
@@ -253,6 +254,54 @@ type Finish struct {
 func (Finish) EventType() atc.EventType  { return EventTypeFinish }
 func (Finish) Version() atc.EventVersion { return "1.0" }
 
+type Retrying struct {
+	Origin  Origin `json:"origin"`
+	Time    int64  `json:"time"`
+	Attempt int    `json:"attempt"`
+	Delay   string `json:"delay"`
+	Reason  string `json:"reason"`
+}
+
+func (Retrying) EventType() atc.EventType  { return EventTypeRetrying }
+func (Retrying) Version() atc.EventVersion { return "1.0" }
+
+type Skipped struct {
+	Origin    Origin `json:"origin"`
+	Time      int64  `json:"time"`
+	Condition string `json:"condition"`
+}
+
+func (Skipped) EventType() atc.EventType  { return EventTypeSkipped }
+func (Skipped) Version() atc.EventVersion { return "1.0" }
+
+type WaitingForApproval struct {
+	Origin Origin `json:"origin"`
+	Time   int64  `json:"time"`
+	Role   string `json:"role"`
+}
+
+func (WaitingForApproval) EventType() atc.EventType  { return EventTypeWaitingForApproval }
+func (WaitingForApproval) Version() atc.EventVersion { return "1.0" }
+
+type ApprovalDecided struct {
+	Origin    Origin `json:"origin"`
+	Time      int64  `json:"time"`
+	Approved  bool   `json:"approved"`
+	DecidedBy string `json:"decided_by"`
+}
+
+func (ApprovalDecided) EventType() atc.EventType  { return EventTypeApprovalDecided }
+func (ApprovalDecided) Version() atc.EventVersion { return "1.0" }
+
+type TaskCacheHit struct {
+	Origin Origin `json:"origin"`
+	Time   int64  `json:"time"`
+	Key    string `json:"key"`
+}
+
+func (TaskCacheHit) EventType() atc.EventType  { return EventTypeTaskCacheHit }
+func (TaskCacheHit) Version() atc.EventVersion { return "1.0" }
+
 type ImageCheck struct {
 	Time       int64            `json:"time"`
 	Origin     Origin           `json:"origin"`
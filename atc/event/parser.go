@@ -55,6 +55,11 @@ func init() {
 	RegisterEvent(ImageCheck{})
 	RegisterEvent(ImageGet{})
 	RegisterEvent(AcrossSubsteps{})
+	RegisterEvent(Skipped{})
+	RegisterEvent(Retrying{})
+	RegisterEvent(WaitingForApproval{})
+	RegisterEvent(ApprovalDecided{})
+	RegisterEvent(TaskCacheHit{})
 
 	// deprecated:
 	RegisterEvent(InitializeV10{})
@@ -65,6 +65,21 @@ const (
 	// error occurred
 	EventTypeError atc.EventType = "error"
 
+	// a step is about to be retried
+	EventTypeRetrying atc.EventType = "retrying"
+
+	// step skipped because its `if:` condition was false
+	EventTypeSkipped atc.EventType = "skipped"
+
+	// approve step is waiting for a user to approve or reject the build
+	EventTypeWaitingForApproval atc.EventType = "waiting-for-approval"
+
+	// approve step was approved or rejected
+	EventTypeApprovalDecided atc.EventType = "approval-decided"
+
+	// task step reused the outputs of a previous run with the same inputs
+	EventTypeTaskCacheHit atc.EventType = "task-cache-hit"
+
 	// image check sub-plan
 	EventTypeImageCheck atc.EventType = "image-check"
 
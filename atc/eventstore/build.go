@@ -0,0 +1,30 @@
+package eventstore
+
+import (
+	"context"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+type offloadableBuild struct {
+	db.BuildForAPI
+
+	store *Store
+}
+
+// WithStore wraps the build so that its events are read from the store once
+// they have been offloaded from the database.
+func WithStore(build db.BuildForAPI, store *Store) db.BuildForAPI {
+	return offloadableBuild{
+		BuildForAPI: build,
+		store:       store,
+	}
+}
+
+func (build offloadableBuild) Events(from uint) (db.EventSource, error) {
+	if !build.EventsOffloaded() {
+		return build.BuildForAPI.Events(from)
+	}
+
+	return build.store.Events(context.Background(), build.BuildForAPI, from)
+}
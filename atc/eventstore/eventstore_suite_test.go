@@ -0,0 +1,13 @@
+package eventstore_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestEventStore(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Event Store Suite")
+}
@@ -0,0 +1,80 @@
+package eventstore
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type offloader struct {
+	buildFactory      db.BuildFactory
+	store             *Store
+	gracePeriod       time.Duration
+	batchSize         int
+	drainerConfigured bool
+}
+
+// NewOffloader constructs a component which moves the events of builds that
+// finished longer than the grace period ago into the store. The grace period
+// gives clients which are still streaming the events from the database time
+// to finish.
+func NewOffloader(
+	buildFactory db.BuildFactory,
+	store *Store,
+	gracePeriod time.Duration,
+	batchSize int,
+	drainerConfigured bool,
+) *offloader {
+	return &offloader{
+		buildFactory:      buildFactory,
+		store:             store,
+		gracePeriod:       gracePeriod,
+		batchSize:         batchSize,
+		drainerConfigured: drainerConfigured,
+	}
+}
+
+func (o *offloader) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("build-event-offloader")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	// the drainer reads the events from the database, so they have to stay
+	// there until it has drained them
+	builds, err := o.buildFactory.GetOffloadableBuilds(o.gracePeriod, o.batchSize, o.drainerConfigured)
+	if err != nil {
+		logger.Error("failed-to-get-offloadable-builds", err)
+		return err
+	}
+
+	for _, build := range builds {
+		err = o.offload(ctx, logger, build)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *offloader) offload(ctx context.Context, logger lager.Logger, build db.Build) error {
+	logger = logger.Session("offload", build.LagerData())
+
+	err := o.store.Save(ctx, build)
+	if err != nil {
+		logger.Error("failed-to-save-events", err)
+		return err
+	}
+
+	err = build.MarkEventsOffloaded()
+	if err != nil {
+		logger.Error("failed-to-mark-events-offloaded", err)
+		return err
+	}
+
+	return nil
+}
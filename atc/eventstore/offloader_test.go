@@ -0,0 +1,99 @@
+package eventstore_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc/blobstore/blobstorefakes"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/eventstore"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Offloader", func() {
+	var (
+		fakeBuildFactory  *dbfakes.FakeBuildFactory
+		fakeObjectStore   *blobstorefakes.FakeObjectStore
+		drainerConfigured bool
+
+		drainedBuild   *dbfakes.FakeBuild
+		undrainedBuild *dbfakes.FakeBuild
+
+		err error
+	)
+
+	BeforeEach(func() {
+		fakeBuildFactory = new(dbfakes.FakeBuildFactory)
+		fakeObjectStore = new(blobstorefakes.FakeObjectStore)
+		drainerConfigured = false
+
+		drainedBuild = new(dbfakes.FakeBuild)
+		drainedBuild.IDReturns(1)
+		drainedBuild.IsDrainedReturns(true)
+		drainedBuild.EventsReturns(fakeEventSource(envelope("0", "some-log")), nil)
+
+		undrainedBuild = new(dbfakes.FakeBuild)
+		undrainedBuild.IDReturns(2)
+		undrainedBuild.EventsReturns(fakeEventSource(envelope("0", "some-log")), nil)
+
+		fakeBuildFactory.GetOffloadableBuildsReturns([]db.Build{drainedBuild, undrainedBuild}, nil)
+	})
+
+	JustBeforeEach(func() {
+		offloader := eventstore.NewOffloader(
+			fakeBuildFactory,
+			eventstore.NewStore(fakeObjectStore),
+			time.Hour,
+			10,
+			drainerConfigured,
+		)
+
+		err = offloader.Run(context.TODO())
+	})
+
+	It("gets the builds which finished before the grace period", func() {
+		Expect(err).ToNot(HaveOccurred())
+
+		gracePeriod, limit, drainedOnly := fakeBuildFactory.GetOffloadableBuildsArgsForCall(0)
+		Expect(gracePeriod).To(Equal(time.Hour))
+		Expect(limit).To(Equal(10))
+		Expect(drainedOnly).To(BeFalse())
+	})
+
+	It("uploads the events of each build and marks them as offloaded", func() {
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(fakeObjectStore.PutCallCount()).To(Equal(2))
+		Expect(drainedBuild.MarkEventsOffloadedCallCount()).To(Equal(1))
+		Expect(undrainedBuild.MarkEventsOffloadedCallCount()).To(Equal(1))
+	})
+
+	Context("when a drainer is configured", func() {
+		BeforeEach(func() {
+			drainerConfigured = true
+		})
+
+		It("only gets the builds which have been drained", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			_, _, drainedOnly := fakeBuildFactory.GetOffloadableBuildsArgsForCall(0)
+			Expect(drainedOnly).To(BeTrue())
+		})
+	})
+
+	Context("when uploading the events fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeObjectStore.PutReturns(disaster)
+		})
+
+		It("does not mark the events as offloaded", func() {
+			Expect(err).To(Equal(disaster))
+			Expect(drainedBuild.MarkEventsOffloadedCallCount()).To(BeZero())
+		})
+	})
+})
@@ -0,0 +1,228 @@
+// Package eventstore moves the events of finished builds out of the database
+// and into a blobstore.ObjectStore.
+package eventstore
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/concourse/concourse/atc/blobstore"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+)
+
+const keySuffix = ".json.gz"
+
+// Store keeps the events of each build as a gzipped stream of JSON envelopes,
+// one per line, in the same form in which they are read from the database.
+type Store struct {
+	objects blobstore.ObjectStore
+}
+
+func NewStore(objects blobstore.ObjectStore) *Store {
+	return &Store{
+		objects: objects,
+	}
+}
+
+type eventsOwner interface {
+	ID() int
+	TeamID() int
+	PipelineID() int
+}
+
+func key(build eventsOwner) string {
+	if build.PipelineID() != 0 {
+		return fmt.Sprintf("%s%d%s", pipelinePrefix(build.PipelineID()), build.ID(), keySuffix)
+	}
+
+	return fmt.Sprintf("%s%d%s", teamPrefix(build.TeamID()), build.ID(), keySuffix)
+}
+
+func pipelinePrefix(pipelineID int) string {
+	return fmt.Sprintf("pipelines/%d/", pipelineID)
+}
+
+func teamPrefix(teamID int) string {
+	return fmt.Sprintf("teams/%d/", teamID)
+}
+
+// Save reads all of the build's events from the database and uploads them.
+func (store *Store) Save(ctx context.Context, build db.Build) error {
+	events, err := build.Events(0)
+	if err != nil {
+		return err
+	}
+
+	defer db.Close(events)
+
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(writeEvents(writer, events))
+	}()
+
+	// unblocks the writer if the upload stops reading early
+	defer reader.Close()
+
+	return store.objects.Put(ctx, key(build), reader)
+}
+
+func writeEvents(w io.Writer, events db.EventSource) error {
+	gz := gzip.NewWriter(w)
+	encoder := json.NewEncoder(gz)
+
+	for {
+		ev, err := events.Next()
+		if err != nil {
+			if err == db.ErrEndOfBuildEventStream {
+				break
+			}
+
+			return err
+		}
+
+		err = encoder.Encode(ev)
+		if err != nil {
+			return err
+		}
+	}
+
+	return gz.Close()
+}
+
+// Events returns the build's uploaded events, starting from the given event
+// ID. If nothing was uploaded for the build, the stream is empty.
+func (store *Store) Events(ctx context.Context, build db.BuildForAPI, from uint) (db.EventSource, error) {
+	content, found, err := store.objects.Get(ctx, key(build))
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return &blobEventSource{from: from}, nil
+	}
+
+	reader, err := gzip.NewReader(content)
+	if err != nil {
+		content.Close()
+		return nil, err
+	}
+
+	return &blobEventSource{
+		content: content,
+		reader:  reader,
+		decoder: json.NewDecoder(reader),
+		from:    from,
+	}, nil
+}
+
+// DeleteBuilds deletes the uploaded events of the given builds of a
+// pipeline. Builds whose events were never uploaded are ignored.
+func (store *Store) DeleteBuilds(ctx context.Context, pipelineID int, buildIDs []int) error {
+	for _, buildID := range buildIDs {
+		err := store.objects.Delete(ctx, fmt.Sprintf("%s%d%s", pipelinePrefix(pipelineID), buildID, keySuffix))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DeletePipelines deletes the uploaded events of all builds of the given
+// pipelines.
+func (store *Store) DeletePipelines(ctx context.Context, pipelineIDs []int) error {
+	for _, pipelineID := range pipelineIDs {
+		err := store.deletePrefix(ctx, pipelinePrefix(pipelineID))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DeleteTeams deletes the uploaded events of all one-off builds of the given
+// teams. The events of their pipelines' builds are deleted along with the
+// pipelines.
+func (store *Store) DeleteTeams(ctx context.Context, teamIDs []int) error {
+	for _, teamID := range teamIDs {
+		err := store.deletePrefix(ctx, teamPrefix(teamID))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (store *Store) deletePrefix(ctx context.Context, prefix string) error {
+	objects, err := store.objects.List(ctx, prefix)
+	if err != nil {
+		return err
+	}
+
+	for _, object := range objects {
+		if !strings.HasSuffix(object.Key, keySuffix) {
+			continue
+		}
+
+		err = store.objects.Delete(ctx, object.Key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type blobEventSource struct {
+	content io.ReadCloser
+	reader  *gzip.Reader
+	decoder *json.Decoder
+	from    uint
+}
+
+func (source *blobEventSource) Next() (event.Envelope, error) {
+	if source.decoder == nil {
+		return event.Envelope{}, db.ErrEndOfBuildEventStream
+	}
+
+	for {
+		var ev event.Envelope
+		err := source.decoder.Decode(&ev)
+		if err != nil {
+			if err == io.EOF {
+				return event.Envelope{}, db.ErrEndOfBuildEventStream
+			}
+
+			return event.Envelope{}, err
+		}
+
+		id, err := strconv.Atoi(ev.EventID)
+		if err != nil {
+			return event.Envelope{}, fmt.Errorf("invalid event id '%s': %w", ev.EventID, err)
+		}
+
+		if id < int(source.from) {
+			continue
+		}
+
+		return ev, nil
+	}
+}
+
+func (source *blobEventSource) Close() error {
+	if source.content == nil {
+		return nil
+	}
+
+	source.reader.Close()
+
+	return source.content.Close()
+}
@@ -0,0 +1,246 @@
+package eventstore_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/concourse/concourse/atc/blobstore"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/eventstore"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func fakeEventSource(envelopes ...event.Envelope) *dbfakes.FakeEventSource {
+	source := new(dbfakes.FakeEventSource)
+	for i, envelope := range envelopes {
+		source.NextReturnsOnCall(i, envelope, nil)
+	}
+	source.NextReturnsOnCall(len(envelopes), event.Envelope{}, db.ErrEndOfBuildEventStream)
+	return source
+}
+
+func envelope(id string, payload string) event.Envelope {
+	data := json.RawMessage(`{"payload":"` + payload + `"}`)
+	return event.Envelope{
+		Data:    &data,
+		Event:   event.EventTypeLog,
+		Version: "5.1",
+		EventID: id,
+	}
+}
+
+func readAll(source db.EventSource) []event.Envelope {
+	var envelopes []event.Envelope
+	for {
+		ev, err := source.Next()
+		if err == db.ErrEndOfBuildEventStream {
+			return envelopes
+		}
+
+		Expect(err).ToNot(HaveOccurred())
+		envelopes = append(envelopes, ev)
+	}
+}
+
+var _ = Describe("Store", func() {
+	var (
+		ctx     context.Context
+		objects blobstore.ObjectStore
+		store   *eventstore.Store
+
+		fakeBuild *dbfakes.FakeBuild
+		events    *dbfakes.FakeEventSource
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		objects = blobstore.NewFilesystemObjectStore(GinkgoT().TempDir())
+		store = eventstore.NewStore(objects)
+
+		events = fakeEventSource(
+			envelope("0", "first"),
+			envelope("1", "second"),
+			envelope("2", "third"),
+		)
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.IDReturns(5)
+		fakeBuild.TeamIDReturns(3)
+		fakeBuild.PipelineIDReturns(42)
+		fakeBuild.EventsReturns(events, nil)
+	})
+
+	Describe("Save", func() {
+		It("uploads the events of the build under its pipeline", func() {
+			err := store.Save(ctx, fakeBuild)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeBuild.EventsArgsForCall(0)).To(BeZero())
+			Expect(events.CloseCallCount()).To(Equal(1))
+
+			uploaded, err := objects.List(ctx, "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(uploaded).To(ConsistOf(HaveField("Key", "pipelines/42/5.json.gz")))
+		})
+
+		Context("when the build is a one-off build", func() {
+			BeforeEach(func() {
+				fakeBuild.PipelineIDReturns(0)
+			})
+
+			It("uploads the events of the build under its team", func() {
+				err := store.Save(ctx, fakeBuild)
+				Expect(err).ToNot(HaveOccurred())
+
+				uploaded, err := objects.List(ctx, "")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(uploaded).To(ConsistOf(HaveField("Key", "teams/3/5.json.gz")))
+			})
+		})
+	})
+
+	Describe("Events", func() {
+		BeforeEach(func() {
+			Expect(store.Save(ctx, fakeBuild)).To(Succeed())
+		})
+
+		It("returns the uploaded events", func() {
+			source, err := store.Events(ctx, fakeBuild, 0)
+			Expect(err).ToNot(HaveOccurred())
+			defer source.Close()
+
+			Expect(readAll(source)).To(Equal([]event.Envelope{
+				envelope("0", "first"),
+				envelope("1", "second"),
+				envelope("2", "third"),
+			}))
+		})
+
+		It("skips the events before the given event ID", func() {
+			source, err := store.Events(ctx, fakeBuild, 2)
+			Expect(err).ToNot(HaveOccurred())
+			defer source.Close()
+
+			Expect(readAll(source)).To(Equal([]event.Envelope{
+				envelope("2", "third"),
+			}))
+		})
+
+		Context("when nothing was uploaded for the build", func() {
+			BeforeEach(func() {
+				fakeBuild.IDReturns(6)
+			})
+
+			It("returns an empty stream", func() {
+				source, err := store.Events(ctx, fakeBuild, 0)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(readAll(source)).To(BeEmpty())
+				Expect(source.Close()).To(Succeed())
+			})
+		})
+	})
+
+	Describe("DeleteBuilds, DeletePipelines and DeleteTeams", func() {
+		BeforeEach(func() {
+			for _, buildID := range []int{5, 6} {
+				build := new(dbfakes.FakeBuild)
+				build.IDReturns(buildID)
+				build.PipelineIDReturns(42)
+				build.EventsReturns(fakeEventSource(envelope("0", "some-log")), nil)
+				Expect(store.Save(ctx, build)).To(Succeed())
+			}
+
+			otherBuild := new(dbfakes.FakeBuild)
+			otherBuild.IDReturns(7)
+			otherBuild.PipelineIDReturns(43)
+			otherBuild.EventsReturns(fakeEventSource(envelope("0", "some-log")), nil)
+			Expect(store.Save(ctx, otherBuild)).To(Succeed())
+
+			oneOffBuild := new(dbfakes.FakeBuild)
+			oneOffBuild.IDReturns(8)
+			oneOffBuild.TeamIDReturns(3)
+			oneOffBuild.EventsReturns(fakeEventSource(envelope("0", "some-log")), nil)
+			Expect(store.Save(ctx, oneOffBuild)).To(Succeed())
+		})
+
+		It("deletes the events of the given builds", func() {
+			err := store.DeleteBuilds(ctx, 42, []int{5, 8})
+			Expect(err).ToNot(HaveOccurred())
+
+			uploaded, err := objects.List(ctx, "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(uploaded).To(ConsistOf(
+				HaveField("Key", "pipelines/42/6.json.gz"),
+				HaveField("Key", "pipelines/43/7.json.gz"),
+				HaveField("Key", "teams/3/8.json.gz"),
+			))
+		})
+
+		It("deletes the events of all builds of the given pipelines", func() {
+			err := store.DeletePipelines(ctx, []int{42})
+			Expect(err).ToNot(HaveOccurred())
+
+			uploaded, err := objects.List(ctx, "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(uploaded).To(ConsistOf(
+				HaveField("Key", "pipelines/43/7.json.gz"),
+				HaveField("Key", "teams/3/8.json.gz"),
+			))
+		})
+
+		It("deletes the events of all one-off builds of the given teams", func() {
+			err := store.DeleteTeams(ctx, []int{3})
+			Expect(err).ToNot(HaveOccurred())
+
+			uploaded, err := objects.List(ctx, "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(uploaded).To(ConsistOf(
+				HaveField("Key", "pipelines/42/5.json.gz"),
+				HaveField("Key", "pipelines/42/6.json.gz"),
+				HaveField("Key", "pipelines/43/7.json.gz"),
+			))
+		})
+	})
+
+	Describe("WithStore", func() {
+		var dbEvents *dbfakes.FakeEventSource
+
+		BeforeEach(func() {
+			Expect(store.Save(ctx, fakeBuild)).To(Succeed())
+
+			dbEvents = new(dbfakes.FakeEventSource)
+			fakeBuild.EventsReturns(dbEvents, nil)
+		})
+
+		Context("when the events have not been offloaded", func() {
+			It("reads the events from the database", func() {
+				source, err := eventstore.WithStore(fakeBuild, store).Events(1)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(source).To(Equal(dbEvents))
+				Expect(fakeBuild.EventsArgsForCall(1)).To(Equal(uint(1)))
+			})
+		})
+
+		Context("when the events have been offloaded", func() {
+			BeforeEach(func() {
+				fakeBuild.EventsOffloadedReturns(true)
+			})
+
+			It("reads the events from the store", func() {
+				source, err := eventstore.WithStore(fakeBuild, store).Events(1)
+				Expect(err).ToNot(HaveOccurred())
+				defer source.Close()
+
+				Expect(readAll(source)).To(Equal([]event.Envelope{
+					envelope("1", "second"),
+					envelope("2", "third"),
+				}))
+				Expect(fakeBuild.EventsCallCount()).To(Equal(1))
+			})
+		})
+	})
+})
@@ -0,0 +1,117 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/tracing"
+)
+
+// ApproveStep pauses the build until a user approves or rejects it. The step
+// succeeds if the build is approved and fails if it is rejected.
+type ApproveStep struct {
+	planID          atc.PlanID
+	plan            atc.ApprovePlan
+	metadata        StepMetadata
+	delegateFactory ApproveStepDelegateFactory
+	buildFactory    db.BuildFactory
+}
+
+func NewApproveStep(
+	planID atc.PlanID,
+	plan atc.ApprovePlan,
+	metadata StepMetadata,
+	delegateFactory ApproveStepDelegateFactory,
+	buildFactory db.BuildFactory,
+) Step {
+	return &ApproveStep{
+		planID:          planID,
+		plan:            plan,
+		metadata:        metadata,
+		delegateFactory: delegateFactory,
+		buildFactory:    buildFactory,
+	}
+}
+
+func (step *ApproveStep) Run(ctx context.Context, state RunState) (bool, error) {
+	delegate := step.delegateFactory.ApproveStepDelegate(state)
+	ctx, span := delegate.StartSpan(ctx, "approve", tracing.Attrs{
+		"name": step.plan.Name,
+	})
+
+	ok, err := step.run(ctx, delegate)
+	tracing.End(span, err)
+
+	return ok, err
+}
+
+func (step *ApproveStep) run(ctx context.Context, delegate ApproveStepDelegate) (bool, error) {
+	logger := lagerctx.FromContext(ctx)
+	logger = logger.Session("approve-step", lager.Data{
+		"step-name": step.plan.Name,
+		"job-id":    step.metadata.JobID,
+	})
+
+	delegate.Initializing(logger)
+
+	build, found, err := step.buildFactory.Build(step.metadata.BuildID)
+	if err != nil {
+		return false, err
+	}
+
+	if !found {
+		return false, fmt.Errorf("approve step not attached to a buildID")
+	}
+
+	err = build.RequestApproval(step.planID, step.plan.Name, step.plan.Role)
+	if err != nil {
+		return false, err
+	}
+
+	notifier, err := build.ApprovalNotifier(step.planID)
+	if err != nil {
+		return false, err
+	}
+
+	defer db.Close(notifier)
+
+	delegate.Starting(logger)
+	delegate.WaitingForApproval(logger, step.plan.Role)
+
+	for {
+		approval, found, err := build.Approval(step.planID)
+		if err != nil {
+			return false, err
+		}
+
+		if found && approval.Decided {
+			logger.Info("decided", lager.Data{
+				"approved":   approval.Approved,
+				"decided-by": approval.DecidedBy,
+			})
+
+			delegate.ApprovalDecided(logger, approval.Approved, approval.DecidedBy)
+			delegate.Finished(logger, approval.Approved)
+
+			return approval.Approved, nil
+		}
+
+		select {
+		case <-notifier.Notify():
+		case <-ctx.Done():
+			// the step was aborted or timed out; the request can no longer
+			// be decided
+			err := build.WithdrawApproval(step.planID)
+			if err != nil {
+				logger.Error("failed-to-withdraw-approval", err)
+			}
+
+			return false, ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,212 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/tracing"
+)
+
+var _ = Describe("ApproveStep", func() {
+	var (
+		ctx        context.Context
+		cancel     func()
+		testLogger *lagertest.TestLogger
+
+		fakeDelegate        *execfakes.FakeApproveStepDelegate
+		fakeDelegateFactory *execfakes.FakeApproveStepDelegateFactory
+		fakeBuildFactory    *dbfakes.FakeBuildFactory
+		fakeBuild           *dbfakes.FakeBuild
+		fakeNotifier        *dbfakes.FakeNotifier
+		notify              chan struct{}
+
+		state *execfakes.FakeRunState
+
+		step    exec.Step
+		timeout string
+		stepOk  bool
+		stepErr error
+
+		stepMetadata = exec.StepMetadata{
+			TeamID:    123,
+			TeamName:  "some-team",
+			BuildID:   42,
+			BuildName: "some-build",
+		}
+
+		planID = atc.PlanID("56")
+	)
+
+	BeforeEach(func() {
+		testLogger = lagertest.NewTestLogger("approve-step-test")
+		ctx, cancel = context.WithCancel(context.Background())
+		ctx = lagerctx.NewContext(ctx, testLogger)
+
+		state = new(execfakes.FakeRunState)
+
+		fakeDelegate = new(execfakes.FakeApproveStepDelegate)
+		fakeDelegate.StartSpanStub = func(ctx context.Context, _ string, _ tracing.Attrs) (context.Context, trace.Span) {
+			return ctx, tracing.NoopSpan
+		}
+
+		fakeDelegateFactory = new(execfakes.FakeApproveStepDelegateFactory)
+		fakeDelegateFactory.ApproveStepDelegateReturns(fakeDelegate)
+
+		notify = make(chan struct{}, 1)
+		fakeNotifier = new(dbfakes.FakeNotifier)
+		fakeNotifier.NotifyReturns(notify)
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.ApprovalNotifierReturns(fakeNotifier, nil)
+		fakeBuild.ApprovalReturns(db.BuildApproval{Decided: true, Approved: true}, true, nil)
+
+		fakeBuildFactory = new(dbfakes.FakeBuildFactory)
+		fakeBuildFactory.BuildReturns(fakeBuild, true, nil)
+
+		timeout = ""
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		step = exec.NewApproveStep(
+			planID,
+			atc.ApprovePlan{Name: "deploy", Role: "member"},
+			stepMetadata,
+			fakeDelegateFactory,
+			fakeBuildFactory,
+		)
+
+		if timeout != "" {
+			step = exec.Timeout(step, timeout)
+		}
+
+		stepOk, stepErr = step.Run(ctx, state)
+	})
+
+	It("requests approval for the step", func() {
+		Expect(fakeBuildFactory.BuildArgsForCall(0)).To(Equal(42))
+		Expect(fakeBuild.RequestApprovalCallCount()).To(Equal(1))
+		id, name, role := fakeBuild.RequestApprovalArgsForCall(0)
+		Expect(id).To(Equal(planID))
+		Expect(name).To(Equal("deploy"))
+		Expect(role).To(Equal("member"))
+	})
+
+	Context("when the step is approved", func() {
+		BeforeEach(func() {
+			fakeBuild.ApprovalReturnsOnCall(0, db.BuildApproval{}, true, nil)
+			fakeBuild.ApprovalReturnsOnCall(1, db.BuildApproval{
+				Decided:   true,
+				Approved:  true,
+				DecidedBy: "some-user",
+			}, true, nil)
+			notify <- struct{}{}
+		})
+
+		It("succeeds once notified", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(stepOk).To(BeTrue())
+			Expect(fakeBuild.ApprovalCallCount()).To(Equal(2))
+		})
+
+		It("emits events for the approval", func() {
+			Expect(fakeDelegate.WaitingForApprovalCallCount()).To(Equal(1))
+			_, role := fakeDelegate.WaitingForApprovalArgsForCall(0)
+			Expect(role).To(Equal("member"))
+
+			Expect(fakeDelegate.ApprovalDecidedCallCount()).To(Equal(1))
+			_, approved, decidedBy := fakeDelegate.ApprovalDecidedArgsForCall(0)
+			Expect(approved).To(BeTrue())
+			Expect(decidedBy).To(Equal("some-user"))
+
+			Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+			_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+			Expect(succeeded).To(BeTrue())
+		})
+
+		It("closes the notifier", func() {
+			Expect(fakeNotifier.CloseCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when the step is rejected", func() {
+		BeforeEach(func() {
+			fakeBuild.ApprovalReturns(db.BuildApproval{
+				Decided:   true,
+				Approved:  false,
+				DecidedBy: "some-user",
+			}, true, nil)
+		})
+
+		It("fails without erroring", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(stepOk).To(BeFalse())
+
+			_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+			Expect(succeeded).To(BeFalse())
+		})
+	})
+
+	Context("when the step is interrupted", func() {
+		BeforeEach(func() {
+			fakeBuild.ApprovalReturns(db.BuildApproval{}, true, nil)
+			cancel()
+		})
+
+		It("withdraws the approval request", func() {
+			Expect(stepErr).To(Equal(context.Canceled))
+			Expect(fakeBuild.WithdrawApprovalCallCount()).To(Equal(1))
+			Expect(fakeBuild.WithdrawApprovalArgsForCall(0)).To(Equal(planID))
+			Expect(fakeDelegate.FinishedCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the step times out", func() {
+		BeforeEach(func() {
+			fakeBuild.ApprovalReturns(db.BuildApproval{}, true, nil)
+			timeout = "10ms"
+		})
+
+		It("withdraws the approval request and fails without erroring", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(stepOk).To(BeFalse())
+			Expect(fakeBuild.WithdrawApprovalCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when requesting approval fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeBuild.RequestApprovalReturns(disaster)
+		})
+
+		It("errors", func() {
+			Expect(stepErr).To(Equal(disaster))
+		})
+	})
+
+	Context("when the build is not found", func() {
+		BeforeEach(func() {
+			fakeBuildFactory.BuildReturns(nil, false, nil)
+		})
+
+		It("errors", func() {
+			Expect(stepErr).To(MatchError("approve step not attached to a buildID"))
+		})
+	})
+})
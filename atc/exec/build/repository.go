@@ -87,6 +87,20 @@ func (repo *Repository) AsMap() map[ArtifactName]ArtifactEntry {
 	return result
 }
 
+// LocalArtifacts returns the artifacts registered in this scope, leaving out
+// those of its parents.
+func (repo *Repository) LocalArtifacts() map[ArtifactName]ArtifactEntry {
+	result := make(map[ArtifactName]ArtifactEntry)
+
+	repo.repoL.RLock()
+	for name, artifact := range repo.repo {
+		result[name] = artifact
+	}
+	repo.repoL.RUnlock()
+
+	return result
+}
+
 func (repo *Repository) NewLocalScope() *Repository {
 	child := NewRepository()
 	child.parent = repo
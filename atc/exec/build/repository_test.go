@@ -96,6 +96,15 @@ var _ = Describe("ArtifactRepository", func() {
 						},
 					}))
 				})
+
+				It("is the only local artifact of the child", func() {
+					Expect(child.LocalArtifacts()).To(Equal(map[ArtifactName]ArtifactEntry{
+						"second-artifact": {
+							Artifact:  Artifact("second"),
+							FromCache: false,
+						},
+					}))
+				})
 			})
 
 			Context("when an artifact is overridden", func() {
@@ -32,9 +32,11 @@ type BuildStepDelegate interface {
 	Starting(lager.Logger)
 	Finished(lager.Logger, bool)
 	Errored(lager.Logger, string)
+	Skipped(lager.Logger, string)
+	Retrying(lager.Logger, int, time.Duration, string)
 
 	BeforeSelectWorker(lager.Logger) error
-	WaitingForWorker(lager.Logger)
+	WaitingForWorker(lager.Logger, int)
 	SelectedWorker(lager.Logger, string)
 	StreamingVolume(lager.Logger, string, string, string)
 	WaitingForStreamedVolume(lager.Logger, string, string)
@@ -55,3 +57,15 @@ type SetPipelineStepDelegate interface {
 	SetPipelineChanged(lager.Logger, bool)
 	CheckRunSetPipelinePolicy(*atc.Config) error
 }
+
+//counterfeiter:generate . ApproveStepDelegateFactory
+type ApproveStepDelegateFactory interface {
+	ApproveStepDelegate(state RunState) ApproveStepDelegate
+}
+
+//counterfeiter:generate . ApproveStepDelegate
+type ApproveStepDelegate interface {
+	BuildStepDelegate
+	WaitingForApproval(logger lager.Logger, role string)
+	ApprovalDecided(logger lager.Logger, approved bool, decidedBy string)
+}
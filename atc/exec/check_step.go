@@ -248,8 +248,9 @@ func (step *CheckStep) runCheck(
 	fromVersion atc.Version,
 ) ([]atc.Version, runtime.ProcessResult, error) {
 	workerSpec := worker.Spec{
-		Tags:   step.plan.Tags,
-		TeamID: step.metadata.TeamID,
+		Tags:     step.plan.Tags,
+		TeamID:   step.metadata.TeamID,
+		Priority: step.metadata.Priority,
 
 		// Used to filter out non-Linux workers, simply because they don't support
 		// base resource types
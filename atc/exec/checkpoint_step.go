@@ -0,0 +1,158 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/runtime"
+)
+
+// CheckpointStep records the artifacts and version produced by a successful
+// step of a job build, so that a failed build can later be resumed without
+// running the step again.
+type CheckpointStep struct {
+	step            Step
+	plan            atc.Plan
+	build           db.Build
+	workerPool      Pool
+	delegateFactory BuildStepDelegateFactory
+}
+
+// Checkpoint constructs a CheckpointStep.
+func Checkpoint(step Step, plan atc.Plan, build db.Build, workerPool Pool, delegateFactory BuildStepDelegateFactory) Step {
+	return CheckpointStep{
+		step:            step,
+		plan:            plan,
+		build:           build,
+		workerPool:      workerPool,
+		delegateFactory: delegateFactory,
+	}
+}
+
+// Run skips the nested step if the build resumes a build in which the step
+// succeeded and its artifacts are still around, registering those artifacts
+// in its place.
+//
+// Otherwise, the nested step is run and a checkpoint is saved if it
+// succeeds.
+func (step CheckpointStep) Run(ctx context.Context, state RunState) (bool, error) {
+	logger := lagerctx.FromContext(ctx).Session("checkpoint-step", lager.Data{
+		"plan-id": step.plan.ID,
+	})
+
+	checkpoint, found, err := step.build.ResumedStepCheckpoint(step.plan.ID)
+	if err != nil {
+		return false, err
+	}
+
+	if found {
+		reused, err := step.reuse(ctx, logger, state, checkpoint)
+		if err != nil {
+			return false, err
+		}
+
+		if reused {
+			return true, nil
+		}
+	}
+
+	scope := checkpointState{
+		RunState:   state,
+		repository: state.ArtifactRepository().NewLocalScope(),
+	}
+
+	ok, err := step.step.Run(ctx, scope)
+
+	artifacts := scope.repository.LocalArtifacts()
+	for name, entry := range artifacts {
+		state.ArtifactRepository().RegisterArtifact(name, entry.Artifact, entry.FromCache)
+	}
+
+	if err != nil || !ok {
+		return ok, err
+	}
+
+	return true, step.save(state, artifacts)
+}
+
+func (step CheckpointStep) reuse(ctx context.Context, logger lager.Logger, state RunState, checkpoint db.BuildStepCheckpoint) (bool, error) {
+	volumes := map[build.ArtifactName]runtime.Volume{}
+	for name, handle := range checkpoint.Artifacts {
+		volume, _, found, err := step.workerPool.LocateVolume(ctx, step.build.TeamID(), handle)
+		if err != nil {
+			return false, err
+		}
+
+		if !found {
+			logger.Info("checkpoint-volume-not-found", lager.Data{
+				"artifact": name,
+				"handle":   handle,
+			})
+
+			return false, nil
+		}
+
+		volumes[build.ArtifactName(name)] = volume
+	}
+
+	for name, volume := range volumes {
+		state.ArtifactRepository().RegisterArtifact(name, volume, false)
+	}
+
+	switch {
+	case step.plan.Put != nil:
+		state.StoreResult(step.plan.ID, checkpoint.Version)
+	case step.plan.Task != nil:
+		state.StoreResult(step.plan.ID, ExitStatus(0))
+	}
+
+	// the checkpoint is carried over so that this build can be resumed too
+	err := step.build.SaveStepCheckpoint(checkpoint)
+	if err != nil {
+		return false, err
+	}
+
+	delegate := step.delegateFactory.BuildStepDelegate(state)
+	delegate.Initializing(logger)
+	fmt.Fprint(delegate.Stdout(), "\x1b[1;34msucceeded in the build being resumed; reusing its outputs\x1b[0m\n")
+	delegate.Finished(logger, true)
+
+	return true, nil
+}
+
+func (step CheckpointStep) save(state RunState, artifacts map[build.ArtifactName]build.ArtifactEntry) error {
+	checkpoint := db.BuildStepCheckpoint{
+		PlanID:    step.plan.ID,
+		Artifacts: map[string]string{},
+	}
+
+	for name, entry := range artifacts {
+		checkpoint.Artifacts[string(name)] = entry.Artifact.Handle()
+	}
+
+	if step.plan.Put != nil {
+		var version atc.Version
+		if state.Result(step.plan.ID, &version) {
+			checkpoint.Version = version
+		}
+	}
+
+	return step.build.SaveStepCheckpoint(checkpoint)
+}
+
+// checkpointState collects the artifacts registered by a step in a scope of
+// their own, so that they can be recorded in its checkpoint.
+type checkpointState struct {
+	RunState
+
+	repository *build.Repository
+}
+
+func (state checkpointState) ArtifactRepository() *build.Repository {
+	return state.repository
+}
@@ -0,0 +1,211 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/runtime/runtimetest"
+	"github.com/concourse/concourse/vars"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("CheckpointStep", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		state exec.RunState
+
+		fakeStep            *execfakes.FakeStep
+		fakeBuild           *dbfakes.FakeBuild
+		fakeWorkerPool      *execfakes.FakePool
+		fakeDelegate        *execfakes.FakeBuildStepDelegate
+		fakeDelegateFactory *execfakes.FakeBuildStepDelegateFactory
+
+		stdout *gbytes.Buffer
+
+		plan atc.Plan
+		step exec.Step
+
+		stepOk  bool
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		state = exec.NewRunState(noopStepper, vars.StaticVariables{}, false)
+
+		fakeStep = new(execfakes.FakeStep)
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.TeamIDReturns(42)
+		fakeWorkerPool = new(execfakes.FakePool)
+
+		stdout = gbytes.NewBuffer()
+		fakeDelegate = new(execfakes.FakeBuildStepDelegate)
+		fakeDelegate.StdoutReturns(stdout)
+		fakeDelegateFactory = new(execfakes.FakeBuildStepDelegateFactory)
+		fakeDelegateFactory.BuildStepDelegateReturns(fakeDelegate)
+
+		plan = atc.Plan{
+			ID:  "some-plan-id",
+			Put: &atc.PutPlan{Name: "some-put"},
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		step = exec.Checkpoint(fakeStep, plan, fakeBuild, fakeWorkerPool, fakeDelegateFactory)
+		stepOk, stepErr = step.Run(ctx, state)
+	})
+
+	Context("when the build does not resume a checkpoint of the step", func() {
+		BeforeEach(func() {
+			fakeBuild.ResumedStepCheckpointReturns(db.BuildStepCheckpoint{}, false, nil)
+		})
+
+		Context("when the step succeeds", func() {
+			BeforeEach(func() {
+				fakeStep.RunStub = func(ctx context.Context, state exec.RunState) (bool, error) {
+					state.ArtifactRepository().RegisterArtifact("some-output", runtimetest.NewVolume("some-handle"), false)
+					state.StoreResult("some-plan-id", atc.Version{"some": "version"})
+					return true, nil
+				}
+			})
+
+			It("succeeds", func() {
+				Expect(stepErr).ToNot(HaveOccurred())
+				Expect(stepOk).To(BeTrue())
+			})
+
+			It("registers the step's artifacts", func() {
+				artifact, _, found := state.ArtifactRepository().ArtifactFor("some-output")
+				Expect(found).To(BeTrue())
+				Expect(artifact.Handle()).To(Equal("some-handle"))
+			})
+
+			It("saves a checkpoint with the artifacts and version", func() {
+				Expect(fakeBuild.SaveStepCheckpointCallCount()).To(Equal(1))
+				Expect(fakeBuild.SaveStepCheckpointArgsForCall(0)).To(Equal(db.BuildStepCheckpoint{
+					PlanID:    "some-plan-id",
+					Artifacts: map[string]string{"some-output": "some-handle"},
+					Version:   atc.Version{"some": "version"},
+				}))
+			})
+		})
+
+		Context("when the step fails", func() {
+			BeforeEach(func() {
+				fakeStep.RunReturns(false, nil)
+			})
+
+			It("fails", func() {
+				Expect(stepErr).ToNot(HaveOccurred())
+				Expect(stepOk).To(BeFalse())
+			})
+
+			It("does not save a checkpoint", func() {
+				Expect(fakeBuild.SaveStepCheckpointCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the step errors", func() {
+			disaster := errors.New("nope")
+
+			BeforeEach(func() {
+				fakeStep.RunReturns(false, disaster)
+			})
+
+			It("returns the error", func() {
+				Expect(stepErr).To(Equal(disaster))
+			})
+
+			It("does not save a checkpoint", func() {
+				Expect(fakeBuild.SaveStepCheckpointCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Context("when the build resumes a checkpoint of the step", func() {
+		var checkpoint db.BuildStepCheckpoint
+
+		BeforeEach(func() {
+			checkpoint = db.BuildStepCheckpoint{
+				PlanID:    "some-plan-id",
+				Artifacts: map[string]string{"some-output": "some-handle"},
+				Version:   atc.Version{"some": "version"},
+			}
+
+			fakeBuild.ResumedStepCheckpointReturns(checkpoint, true, nil)
+		})
+
+		Context("when the artifact volumes are found", func() {
+			var volume *runtimetest.Volume
+
+			BeforeEach(func() {
+				volume = runtimetest.NewVolume("some-handle")
+				fakeWorkerPool.LocateVolumeReturns(volume, runtimetest.NewWorker("worker"), true, nil)
+			})
+
+			It("does not run the step", func() {
+				Expect(fakeStep.RunCallCount()).To(BeZero())
+				Expect(stepOk).To(BeTrue())
+			})
+
+			It("looks up the volumes in the build's team", func() {
+				_, teamID, handle := fakeWorkerPool.LocateVolumeArgsForCall(0)
+				Expect(teamID).To(Equal(42))
+				Expect(handle).To(Equal("some-handle"))
+			})
+
+			It("registers the artifacts of the checkpoint", func() {
+				artifact, _, found := state.ArtifactRepository().ArtifactFor(build.ArtifactName("some-output"))
+				Expect(found).To(BeTrue())
+				Expect(artifact).To(Equal(volume))
+			})
+
+			It("stores the version of the checkpoint", func() {
+				var version atc.Version
+				Expect(state.Result("some-plan-id", &version)).To(BeTrue())
+				Expect(version).To(Equal(atc.Version{"some": "version"}))
+			})
+
+			It("carries the checkpoint over to the build", func() {
+				Expect(fakeBuild.SaveStepCheckpointCallCount()).To(Equal(1))
+				Expect(fakeBuild.SaveStepCheckpointArgsForCall(0)).To(Equal(checkpoint))
+			})
+
+			It("finishes the step as succeeded", func() {
+				Expect(fakeDelegate.InitializingCallCount()).To(Equal(1))
+				Expect(stdout).To(gbytes.Say("reusing its outputs"))
+
+				Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+				_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+				Expect(succeeded).To(BeTrue())
+			})
+		})
+
+		Context("when an artifact volume is gone", func() {
+			BeforeEach(func() {
+				fakeWorkerPool.LocateVolumeReturns(nil, nil, false, nil)
+				fakeStep.RunReturns(true, nil)
+			})
+
+			It("runs the step", func() {
+				Expect(fakeStep.RunCallCount()).To(Equal(1))
+				Expect(stepOk).To(BeTrue())
+			})
+		})
+	})
+})
@@ -145,10 +145,11 @@ type FakePutDelegate struct {
 		arg2 string
 		arg3 string
 	}
-	WaitingForWorkerStub        func(lager.Logger)
+	WaitingForWorkerStub        func(lager.Logger, int)
 	waitingForWorkerMutex       sync.RWMutex
 	waitingForWorkerArgsForCall []struct {
 		arg1 lager.Logger
+		arg2 int
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
@@ -779,16 +780,17 @@ func (fake *FakePutDelegate) WaitingForStreamedVolumeArgsForCall(i int) (lager.L
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakePutDelegate) WaitingForWorker(arg1 lager.Logger) {
+func (fake *FakePutDelegate) WaitingForWorker(arg1 lager.Logger, arg2 int) {
 	fake.waitingForWorkerMutex.Lock()
 	fake.waitingForWorkerArgsForCall = append(fake.waitingForWorkerArgsForCall, struct {
 		arg1 lager.Logger
-	}{arg1})
+		arg2 int
+	}{arg1, arg2})
 	stub := fake.WaitingForWorkerStub
-	fake.recordInvocation("WaitingForWorker", []interface{}{arg1})
+	fake.recordInvocation("WaitingForWorker", []interface{}{arg1, arg2})
 	fake.waitingForWorkerMutex.Unlock()
 	if stub != nil {
-		fake.WaitingForWorkerStub(arg1)
+		fake.WaitingForWorkerStub(arg1, arg2)
 	}
 }
 
@@ -798,17 +800,17 @@ func (fake *FakePutDelegate) WaitingForWorkerCallCount() int {
 	return len(fake.waitingForWorkerArgsForCall)
 }
 
-func (fake *FakePutDelegate) WaitingForWorkerCalls(stub func(lager.Logger)) {
+func (fake *FakePutDelegate) WaitingForWorkerCalls(stub func(lager.Logger, int)) {
 	fake.waitingForWorkerMutex.Lock()
 	defer fake.waitingForWorkerMutex.Unlock()
 	fake.WaitingForWorkerStub = stub
 }
 
-func (fake *FakePutDelegate) WaitingForWorkerArgsForCall(i int) lager.Logger {
+func (fake *FakePutDelegate) WaitingForWorkerArgsForCall(i int) (lager.Logger, int) {
 	fake.waitingForWorkerMutex.RLock()
 	defer fake.waitingForWorkerMutex.RUnlock()
 	argsForCall := fake.waitingForWorkerArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakePutDelegate) Invocations() map[string][][]interface{} {
@@ -61,6 +61,41 @@ type FakeTaskDelegate struct {
 		result1 runtime.ImageSpec
 		result2 error
 	}
+	FetchServiceImageStub        func(context.Context, string, atc.ImageResource, atc.ResourceTypes, bool, atc.Tags, bool) (runtime.ImageSpec, error)
+	fetchServiceImageMutex       sync.RWMutex
+	fetchServiceImageArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 atc.ImageResource
+		arg4 atc.ResourceTypes
+		arg5 bool
+		arg6 atc.Tags
+		arg7 bool
+	}
+	fetchServiceImageReturns struct {
+		result1 runtime.ImageSpec
+		result2 error
+	}
+	fetchServiceImageReturnsOnCall map[int]struct {
+		result1 runtime.ImageSpec
+		result2 error
+	}
+	FindTaskResultStub        func(string, string) (map[string]string, bool, error)
+	findTaskResultMutex       sync.RWMutex
+	findTaskResultArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	findTaskResultReturns struct {
+		result1 map[string]string
+		result2 bool
+		result3 error
+	}
+	findTaskResultReturnsOnCall map[int]struct {
+		result1 map[string]string
+		result2 bool
+		result3 error
+	}
 	FinishedStub        func(lager.Logger, exec.ExitStatus)
 	finishedMutex       sync.RWMutex
 	finishedArgsForCall []struct {
@@ -72,6 +107,31 @@ type FakeTaskDelegate struct {
 	initializingArgsForCall []struct {
 		arg1 lager.Logger
 	}
+	SaveTaskResultStub        func(string, string, map[string]string) error
+	saveTaskResultMutex       sync.RWMutex
+	saveTaskResultArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]string
+	}
+	saveTaskResultReturns struct {
+		result1 error
+	}
+	saveTaskResultReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SaveTestResultsStub        func(string, []atc.TestResult) error
+	saveTestResultsMutex       sync.RWMutex
+	saveTestResultsArgsForCall []struct {
+		arg1 string
+		arg2 []atc.TestResult
+	}
+	saveTestResultsReturns struct {
+		result1 error
+	}
+	saveTestResultsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SelectedWorkerStub        func(lager.Logger, string)
 	selectedWorkerMutex       sync.RWMutex
 	selectedWorkerArgsForCall []struct {
@@ -131,6 +191,12 @@ type FakeTaskDelegate struct {
 		arg3 string
 		arg4 string
 	}
+	TaskCacheHitStub        func(lager.Logger, string)
+	taskCacheHitMutex       sync.RWMutex
+	taskCacheHitArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
 	WaitingForStreamedVolumeStub        func(lager.Logger, string, string)
 	waitingForStreamedVolumeMutex       sync.RWMutex
 	waitingForStreamedVolumeArgsForCall []struct {
@@ -138,10 +204,11 @@ type FakeTaskDelegate struct {
 		arg2 string
 		arg3 string
 	}
-	WaitingForWorkerStub        func(lager.Logger)
+	WaitingForWorkerStub        func(lager.Logger, int)
 	waitingForWorkerMutex       sync.RWMutex
 	waitingForWorkerArgsForCall []struct {
 		arg1 lager.Logger
+		arg2 int
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
@@ -363,6 +430,144 @@ func (fake *FakeTaskDelegate) FetchImageReturnsOnCall(i int, result1 runtime.Ima
 	}{result1, result2}
 }
 
+func (fake *FakeTaskDelegate) FetchServiceImage(arg1 context.Context, arg2 string, arg3 atc.ImageResource, arg4 atc.ResourceTypes, arg5 bool, arg6 atc.Tags, arg7 bool) (runtime.ImageSpec, error) {
+	fake.fetchServiceImageMutex.Lock()
+	ret, specificReturn := fake.fetchServiceImageReturnsOnCall[len(fake.fetchServiceImageArgsForCall)]
+	fake.fetchServiceImageArgsForCall = append(fake.fetchServiceImageArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 atc.ImageResource
+		arg4 atc.ResourceTypes
+		arg5 bool
+		arg6 atc.Tags
+		arg7 bool
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	stub := fake.FetchServiceImageStub
+	fakeReturns := fake.fetchServiceImageReturns
+	fake.recordInvocation("FetchServiceImage", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	fake.fetchServiceImageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTaskDelegate) FetchServiceImageCallCount() int {
+	fake.fetchServiceImageMutex.RLock()
+	defer fake.fetchServiceImageMutex.RUnlock()
+	return len(fake.fetchServiceImageArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) FetchServiceImageCalls(stub func(context.Context, string, atc.ImageResource, atc.ResourceTypes, bool, atc.Tags, bool) (runtime.ImageSpec, error)) {
+	fake.fetchServiceImageMutex.Lock()
+	defer fake.fetchServiceImageMutex.Unlock()
+	fake.FetchServiceImageStub = stub
+}
+
+func (fake *FakeTaskDelegate) FetchServiceImageArgsForCall(i int) (context.Context, string, atc.ImageResource, atc.ResourceTypes, bool, atc.Tags, bool) {
+	fake.fetchServiceImageMutex.RLock()
+	defer fake.fetchServiceImageMutex.RUnlock()
+	argsForCall := fake.fetchServiceImageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
+}
+
+func (fake *FakeTaskDelegate) FetchServiceImageReturns(result1 runtime.ImageSpec, result2 error) {
+	fake.fetchServiceImageMutex.Lock()
+	defer fake.fetchServiceImageMutex.Unlock()
+	fake.FetchServiceImageStub = nil
+	fake.fetchServiceImageReturns = struct {
+		result1 runtime.ImageSpec
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskDelegate) FetchServiceImageReturnsOnCall(i int, result1 runtime.ImageSpec, result2 error) {
+	fake.fetchServiceImageMutex.Lock()
+	defer fake.fetchServiceImageMutex.Unlock()
+	fake.FetchServiceImageStub = nil
+	if fake.fetchServiceImageReturnsOnCall == nil {
+		fake.fetchServiceImageReturnsOnCall = make(map[int]struct {
+			result1 runtime.ImageSpec
+			result2 error
+		})
+	}
+	fake.fetchServiceImageReturnsOnCall[i] = struct {
+		result1 runtime.ImageSpec
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskDelegate) FindTaskResult(arg1 string, arg2 string) (map[string]string, bool, error) {
+	fake.findTaskResultMutex.Lock()
+	ret, specificReturn := fake.findTaskResultReturnsOnCall[len(fake.findTaskResultArgsForCall)]
+	fake.findTaskResultArgsForCall = append(fake.findTaskResultArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.FindTaskResultStub
+	fakeReturns := fake.findTaskResultReturns
+	fake.recordInvocation("FindTaskResult", []interface{}{arg1, arg2})
+	fake.findTaskResultMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTaskDelegate) FindTaskResultCallCount() int {
+	fake.findTaskResultMutex.RLock()
+	defer fake.findTaskResultMutex.RUnlock()
+	return len(fake.findTaskResultArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) FindTaskResultCalls(stub func(string, string) (map[string]string, bool, error)) {
+	fake.findTaskResultMutex.Lock()
+	defer fake.findTaskResultMutex.Unlock()
+	fake.FindTaskResultStub = stub
+}
+
+func (fake *FakeTaskDelegate) FindTaskResultArgsForCall(i int) (string, string) {
+	fake.findTaskResultMutex.RLock()
+	defer fake.findTaskResultMutex.RUnlock()
+	argsForCall := fake.findTaskResultArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTaskDelegate) FindTaskResultReturns(result1 map[string]string, result2 bool, result3 error) {
+	fake.findTaskResultMutex.Lock()
+	defer fake.findTaskResultMutex.Unlock()
+	fake.FindTaskResultStub = nil
+	fake.findTaskResultReturns = struct {
+		result1 map[string]string
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTaskDelegate) FindTaskResultReturnsOnCall(i int, result1 map[string]string, result2 bool, result3 error) {
+	fake.findTaskResultMutex.Lock()
+	defer fake.findTaskResultMutex.Unlock()
+	fake.FindTaskResultStub = nil
+	if fake.findTaskResultReturnsOnCall == nil {
+		fake.findTaskResultReturnsOnCall = make(map[int]struct {
+			result1 map[string]string
+			result2 bool
+			result3 error
+		})
+	}
+	fake.findTaskResultReturnsOnCall[i] = struct {
+		result1 map[string]string
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTaskDelegate) Finished(arg1 lager.Logger, arg2 exec.ExitStatus) {
 	fake.finishedMutex.Lock()
 	fake.finishedArgsForCall = append(fake.finishedArgsForCall, struct {
@@ -428,6 +633,136 @@ func (fake *FakeTaskDelegate) InitializingArgsForCall(i int) lager.Logger {
 	return argsForCall.arg1
 }
 
+func (fake *FakeTaskDelegate) SaveTaskResult(arg1 string, arg2 string, arg3 map[string]string) error {
+	fake.saveTaskResultMutex.Lock()
+	ret, specificReturn := fake.saveTaskResultReturnsOnCall[len(fake.saveTaskResultArgsForCall)]
+	fake.saveTaskResultArgsForCall = append(fake.saveTaskResultArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]string
+	}{arg1, arg2, arg3})
+	stub := fake.SaveTaskResultStub
+	fakeReturns := fake.saveTaskResultReturns
+	fake.recordInvocation("SaveTaskResult", []interface{}{arg1, arg2, arg3})
+	fake.saveTaskResultMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTaskDelegate) SaveTaskResultCallCount() int {
+	fake.saveTaskResultMutex.RLock()
+	defer fake.saveTaskResultMutex.RUnlock()
+	return len(fake.saveTaskResultArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) SaveTaskResultCalls(stub func(string, string, map[string]string) error) {
+	fake.saveTaskResultMutex.Lock()
+	defer fake.saveTaskResultMutex.Unlock()
+	fake.SaveTaskResultStub = stub
+}
+
+func (fake *FakeTaskDelegate) SaveTaskResultArgsForCall(i int) (string, string, map[string]string) {
+	fake.saveTaskResultMutex.RLock()
+	defer fake.saveTaskResultMutex.RUnlock()
+	argsForCall := fake.saveTaskResultArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTaskDelegate) SaveTaskResultReturns(result1 error) {
+	fake.saveTaskResultMutex.Lock()
+	defer fake.saveTaskResultMutex.Unlock()
+	fake.SaveTaskResultStub = nil
+	fake.saveTaskResultReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTaskDelegate) SaveTaskResultReturnsOnCall(i int, result1 error) {
+	fake.saveTaskResultMutex.Lock()
+	defer fake.saveTaskResultMutex.Unlock()
+	fake.SaveTaskResultStub = nil
+	if fake.saveTaskResultReturnsOnCall == nil {
+		fake.saveTaskResultReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveTaskResultReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTaskDelegate) SaveTestResults(arg1 string, arg2 []atc.TestResult) error {
+	var arg2Copy []atc.TestResult
+	if arg2 != nil {
+		arg2Copy = make([]atc.TestResult, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.saveTestResultsMutex.Lock()
+	ret, specificReturn := fake.saveTestResultsReturnsOnCall[len(fake.saveTestResultsArgsForCall)]
+	fake.saveTestResultsArgsForCall = append(fake.saveTestResultsArgsForCall, struct {
+		arg1 string
+		arg2 []atc.TestResult
+	}{arg1, arg2Copy})
+	stub := fake.SaveTestResultsStub
+	fakeReturns := fake.saveTestResultsReturns
+	fake.recordInvocation("SaveTestResults", []interface{}{arg1, arg2Copy})
+	fake.saveTestResultsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTaskDelegate) SaveTestResultsCallCount() int {
+	fake.saveTestResultsMutex.RLock()
+	defer fake.saveTestResultsMutex.RUnlock()
+	return len(fake.saveTestResultsArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) SaveTestResultsCalls(stub func(string, []atc.TestResult) error) {
+	fake.saveTestResultsMutex.Lock()
+	defer fake.saveTestResultsMutex.Unlock()
+	fake.SaveTestResultsStub = stub
+}
+
+func (fake *FakeTaskDelegate) SaveTestResultsArgsForCall(i int) (string, []atc.TestResult) {
+	fake.saveTestResultsMutex.RLock()
+	defer fake.saveTestResultsMutex.RUnlock()
+	argsForCall := fake.saveTestResultsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTaskDelegate) SaveTestResultsReturns(result1 error) {
+	fake.saveTestResultsMutex.Lock()
+	defer fake.saveTestResultsMutex.Unlock()
+	fake.SaveTestResultsStub = nil
+	fake.saveTestResultsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTaskDelegate) SaveTestResultsReturnsOnCall(i int, result1 error) {
+	fake.saveTestResultsMutex.Lock()
+	defer fake.saveTestResultsMutex.Unlock()
+	fake.SaveTestResultsStub = nil
+	if fake.saveTestResultsReturnsOnCall == nil {
+		fake.saveTestResultsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveTestResultsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTaskDelegate) SelectedWorker(arg1 lager.Logger, arg2 string) {
 	fake.selectedWorkerMutex.Lock()
 	fake.selectedWorkerArgsForCall = append(fake.selectedWorkerArgsForCall, struct {
@@ -732,6 +1067,39 @@ func (fake *FakeTaskDelegate) StreamingVolumeArgsForCall(i int) (lager.Logger, s
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
+func (fake *FakeTaskDelegate) TaskCacheHit(arg1 lager.Logger, arg2 string) {
+	fake.taskCacheHitMutex.Lock()
+	fake.taskCacheHitArgsForCall = append(fake.taskCacheHitArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.TaskCacheHitStub
+	fake.recordInvocation("TaskCacheHit", []interface{}{arg1, arg2})
+	fake.taskCacheHitMutex.Unlock()
+	if stub != nil {
+		fake.TaskCacheHitStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeTaskDelegate) TaskCacheHitCallCount() int {
+	fake.taskCacheHitMutex.RLock()
+	defer fake.taskCacheHitMutex.RUnlock()
+	return len(fake.taskCacheHitArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) TaskCacheHitCalls(stub func(lager.Logger, string)) {
+	fake.taskCacheHitMutex.Lock()
+	defer fake.taskCacheHitMutex.Unlock()
+	fake.TaskCacheHitStub = stub
+}
+
+func (fake *FakeTaskDelegate) TaskCacheHitArgsForCall(i int) (lager.Logger, string) {
+	fake.taskCacheHitMutex.RLock()
+	defer fake.taskCacheHitMutex.RUnlock()
+	argsForCall := fake.taskCacheHitArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeTaskDelegate) WaitingForStreamedVolume(arg1 lager.Logger, arg2 string, arg3 string) {
 	fake.waitingForStreamedVolumeMutex.Lock()
 	fake.waitingForStreamedVolumeArgsForCall = append(fake.waitingForStreamedVolumeArgsForCall, struct {
@@ -766,16 +1134,17 @@ func (fake *FakeTaskDelegate) WaitingForStreamedVolumeArgsForCall(i int) (lager.
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeTaskDelegate) WaitingForWorker(arg1 lager.Logger) {
+func (fake *FakeTaskDelegate) WaitingForWorker(arg1 lager.Logger, arg2 int) {
 	fake.waitingForWorkerMutex.Lock()
 	fake.waitingForWorkerArgsForCall = append(fake.waitingForWorkerArgsForCall, struct {
 		arg1 lager.Logger
-	}{arg1})
+		arg2 int
+	}{arg1, arg2})
 	stub := fake.WaitingForWorkerStub
-	fake.recordInvocation("WaitingForWorker", []interface{}{arg1})
+	fake.recordInvocation("WaitingForWorker", []interface{}{arg1, arg2})
 	fake.waitingForWorkerMutex.Unlock()
 	if stub != nil {
-		fake.WaitingForWorkerStub(arg1)
+		fake.WaitingForWorkerStub(arg1, arg2)
 	}
 }
 
@@ -785,17 +1154,17 @@ func (fake *FakeTaskDelegate) WaitingForWorkerCallCount() int {
 	return len(fake.waitingForWorkerArgsForCall)
 }
 
-func (fake *FakeTaskDelegate) WaitingForWorkerCalls(stub func(lager.Logger)) {
+func (fake *FakeTaskDelegate) WaitingForWorkerCalls(stub func(lager.Logger, int)) {
 	fake.waitingForWorkerMutex.Lock()
 	defer fake.waitingForWorkerMutex.Unlock()
 	fake.WaitingForWorkerStub = stub
 }
 
-func (fake *FakeTaskDelegate) WaitingForWorkerArgsForCall(i int) lager.Logger {
+func (fake *FakeTaskDelegate) WaitingForWorkerArgsForCall(i int) (lager.Logger, int) {
 	fake.waitingForWorkerMutex.RLock()
 	defer fake.waitingForWorkerMutex.RUnlock()
 	argsForCall := fake.waitingForWorkerArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeTaskDelegate) Invocations() map[string][][]interface{} {
@@ -809,10 +1178,18 @@ func (fake *FakeTaskDelegate) Invocations() map[string][][]interface{} {
 	defer fake.erroredMutex.RUnlock()
 	fake.fetchImageMutex.RLock()
 	defer fake.fetchImageMutex.RUnlock()
+	fake.fetchServiceImageMutex.RLock()
+	defer fake.fetchServiceImageMutex.RUnlock()
+	fake.findTaskResultMutex.RLock()
+	defer fake.findTaskResultMutex.RUnlock()
 	fake.finishedMutex.RLock()
 	defer fake.finishedMutex.RUnlock()
 	fake.initializingMutex.RLock()
 	defer fake.initializingMutex.RUnlock()
+	fake.saveTaskResultMutex.RLock()
+	defer fake.saveTaskResultMutex.RUnlock()
+	fake.saveTestResultsMutex.RLock()
+	defer fake.saveTestResultsMutex.RUnlock()
 	fake.selectedWorkerMutex.RLock()
 	defer fake.selectedWorkerMutex.RUnlock()
 	fake.setTaskConfigMutex.RLock()
@@ -827,6 +1204,8 @@ func (fake *FakeTaskDelegate) Invocations() map[string][][]interface{} {
 	defer fake.stdoutMutex.RUnlock()
 	fake.streamingVolumeMutex.RLock()
 	defer fake.streamingVolumeMutex.RUnlock()
+	fake.taskCacheHitMutex.RLock()
+	defer fake.taskCacheHitMutex.RUnlock()
 	fake.waitingForStreamedVolumeMutex.RLock()
 	defer fake.waitingForStreamedVolumeMutex.RUnlock()
 	fake.waitingForWorkerMutex.RLock()
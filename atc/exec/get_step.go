@@ -68,7 +68,7 @@ type GetDelegate interface {
 	BuildStartTime() time.Time
 
 	BeforeSelectWorker(lager.Logger) error
-	WaitingForWorker(lager.Logger)
+	WaitingForWorker(lager.Logger, int)
 	SelectedWorker(lager.Logger, string)
 	StreamingVolume(lager.Logger, string, string, string)
 	WaitingForStreamedVolume(lager.Logger, string, string)
@@ -152,8 +152,9 @@ func (step *GetStep) run(ctx context.Context, state RunState, delegate GetDelega
 	}
 
 	workerSpec := worker.Spec{
-		Tags:   step.plan.Tags,
-		TeamID: step.metadata.TeamID,
+		Tags:     step.plan.Tags,
+		TeamID:   step.metadata.TeamID,
+		Priority: step.metadata.Priority,
 
 		// Used to filter out non-Linux workers, simply because they don't support
 		// base resource types
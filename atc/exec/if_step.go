@@ -0,0 +1,54 @@
+package exec
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+)
+
+// IfStep runs the nested step only if the plan's condition is true.
+type IfStep struct {
+	step            Step
+	plan            atc.IfPlan
+	delegateFactory BuildStepDelegateFactory
+}
+
+// If constructs an IfStep.
+func If(step Step, plan atc.IfPlan, delegateFactory BuildStepDelegateFactory) Step {
+	return IfStep{
+		step:            step,
+		plan:            plan,
+		delegateFactory: delegateFactory,
+	}
+}
+
+// Run evaluates the condition against the build's vars. If it is true, the
+// nested step is run and its result is returned.
+//
+// Otherwise, a skipped event is emitted and the IfStep succeeds without
+// running the nested step.
+func (step IfStep) Run(ctx context.Context, state RunState) (bool, error) {
+	logger := lagerctx.FromContext(ctx).Session("if-step", lager.Data{
+		"condition": step.plan.Condition,
+	})
+
+	condition, err := atc.ParseCondition(step.plan.Condition)
+	if err != nil {
+		return false, err
+	}
+
+	ok, err := condition.Evaluate(state)
+	if err != nil {
+		return false, err
+	}
+
+	if !ok {
+		delegate := step.delegateFactory.BuildStepDelegate(state)
+		delegate.Skipped(logger, step.plan.Condition)
+		return true, nil
+	}
+
+	return step.step.Run(ctx, state)
+}
@@ -0,0 +1,135 @@
+package exec_test
+
+import (
+	"context"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/vars"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("If Step", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		runStep *execfakes.FakeStep
+
+		fakeDelegate        *execfakes.FakeBuildStepDelegate
+		fakeDelegateFactory *execfakes.FakeBuildStepDelegateFactory
+
+		state RunState
+		plan  atc.IfPlan
+
+		stepOk  bool
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		runStep = new(execfakes.FakeStep)
+		runStep.RunReturns(true, nil)
+
+		fakeDelegate = new(execfakes.FakeBuildStepDelegate)
+		fakeDelegateFactory = new(execfakes.FakeBuildStepDelegateFactory)
+		fakeDelegateFactory.BuildStepDelegateReturns(fakeDelegate)
+
+		state = NewRunState(noopStepper, vars.StaticVariables{"env": "prod"}, false)
+	})
+
+	JustBeforeEach(func() {
+		step := If(runStep, plan, fakeDelegateFactory)
+		stepOk, stepErr = step.Run(ctx, state)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	Context("when the condition is true", func() {
+		BeforeEach(func() {
+			plan = atc.IfPlan{Condition: `((env)) == "prod"`}
+		})
+
+		It("runs the nested step", func() {
+			Expect(runStep.RunCallCount()).To(Equal(1))
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(stepOk).To(BeTrue())
+		})
+
+		It("does not emit a skipped event", func() {
+			Expect(fakeDelegate.SkippedCallCount()).To(Equal(0))
+		})
+
+		Context("when the nested step fails", func() {
+			BeforeEach(func() {
+				runStep.RunReturns(false, nil)
+			})
+
+			It("fails", func() {
+				Expect(stepErr).ToNot(HaveOccurred())
+				Expect(stepOk).To(BeFalse())
+			})
+		})
+	})
+
+	Context("when the condition refers to a local var", func() {
+		BeforeEach(func() {
+			plan = atc.IfPlan{Condition: "((.:deploy))"}
+			state.AddLocalVar("deploy", "false", false)
+		})
+
+		It("is evaluated against the local var", func() {
+			Expect(runStep.RunCallCount()).To(Equal(0))
+			Expect(fakeDelegate.SkippedCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when the condition is false", func() {
+		BeforeEach(func() {
+			plan = atc.IfPlan{Condition: `((env)) == "dev"`}
+		})
+
+		It("does not run the nested step", func() {
+			Expect(runStep.RunCallCount()).To(Equal(0))
+		})
+
+		It("succeeds", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(stepOk).To(BeTrue())
+		})
+
+		It("emits a skipped event with the condition", func() {
+			Expect(fakeDelegate.SkippedCallCount()).To(Equal(1))
+			_, condition := fakeDelegate.SkippedArgsForCall(0)
+			Expect(condition).To(Equal(`((env)) == "dev"`))
+		})
+	})
+
+	Context("when the condition refers to an undefined var", func() {
+		BeforeEach(func() {
+			plan = atc.IfPlan{Condition: "((missing))"}
+		})
+
+		It("errors without running the nested step", func() {
+			Expect(stepErr).To(Equal(vars.UndefinedVarsError{Vars: []string{"missing"}}))
+			Expect(stepOk).To(BeFalse())
+			Expect(runStep.RunCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the condition is invalid", func() {
+		BeforeEach(func() {
+			plan = atc.IfPlan{Condition: "((env)) == prod"}
+		})
+
+		It("errors", func() {
+			Expect(stepErr).To(HaveOccurred())
+			Expect(runStep.RunCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -38,7 +38,7 @@ type PutDelegate interface {
 	Errored(lager.Logger, string)
 
 	BeforeSelectWorker(lager.Logger) error
-	WaitingForWorker(lager.Logger)
+	WaitingForWorker(lager.Logger, int)
 	SelectedWorker(lager.Logger, string)
 	StreamingVolume(lager.Logger, string, string, string)
 	WaitingForStreamedVolume(lager.Logger, string, string)
@@ -143,8 +143,9 @@ func (step *PutStep) run(ctx context.Context, state RunState, delegate PutDelega
 	}
 
 	workerSpec := worker.Spec{
-		Tags:   step.plan.Tags,
-		TeamID: step.metadata.TeamID,
+		Tags:     step.plan.Tags,
+		TeamID:   step.metadata.TeamID,
+		Priority: step.metadata.Priority,
 
 		// Used to filter out non-Linux workers, simply because they don't support
 		// base resource types
@@ -2,6 +2,14 @@ package exec
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
 )
 
 // RetryStep is a step that will run the steps in order until one of them
@@ -9,6 +17,11 @@ import (
 type RetryStep struct {
 	Attempts    []Step
 	LastAttempt Step
+
+	attemptPlans    []atc.Plan
+	policy          *atc.RetryPolicy
+	delegateFactory BuildStepDelegateFactory
+	clock           clock.Clock
 }
 
 func Retry(attempts ...Step) Step {
@@ -17,13 +30,63 @@ func Retry(attempts ...Step) Step {
 	}
 }
 
-// Run iterates through each step, stopping once a step succeeds. If all steps
-// fail, the RetryStep will fail.
+// RetryWithPolicy constructs a RetryStep which emits an event before each
+// retry and waits between attempts according to the given policy. The
+// attempts' plans are used to find the exit status of failed tasks when the
+// policy only retries specific exit codes.
+func RetryWithPolicy(
+	attempts []Step,
+	attemptPlans []atc.Plan,
+	policy *atc.RetryPolicy,
+	delegateFactory BuildStepDelegateFactory,
+	clock clock.Clock,
+) Step {
+	return &RetryStep{
+		Attempts: attempts,
+
+		attemptPlans:    attemptPlans,
+		policy:          policy,
+		delegateFactory: delegateFactory,
+		clock:           clock,
+	}
+}
+
+// Run iterates through each step, stopping once a step succeeds or its
+// outcome is not configured to be retried. If all steps fail, the RetryStep
+// will fail.
 func (step *RetryStep) Run(ctx context.Context, state RunState) (bool, error) {
+	logger := lagerctx.FromContext(ctx)
+
+	backoff, maxBackoff, err := step.parseBackoff()
+	if err != nil {
+		return false, err
+	}
+
 	var attemptOk bool
 	var attemptErr error
+	var reason string
+
+	for i, attempt := range step.Attempts {
+		if i > 0 {
+			delay := step.delay(i, backoff, maxBackoff)
+
+			if step.delegateFactory != nil {
+				delegate := step.delegateFactory.BuildStepDelegate(state)
+				delegate.Retrying(logger, i+1, delay, reason)
+			}
+
+			if delay > 0 {
+				timer := step.clock.NewTimer(delay)
+
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return false, ctx.Err()
+				case <-timer.C():
+				}
+			}
+		}
 
-	for _, attempt := range step.Attempts {
 		step.LastAttempt = attempt
 
 		attemptOk, attemptErr = attempt.Run(ctx, state)
@@ -31,14 +94,117 @@ func (step *RetryStep) Run(ctx context.Context, state RunState) (bool, error) {
 			return false, ctx.Err()
 		}
 
-		if attemptErr != nil {
-			continue
+		if attemptErr == nil && attemptOk {
+			break
 		}
 
-		if attemptOk {
+		var retry bool
+		retry, reason = step.shouldRetry(i, state, attemptErr)
+		if !retry {
 			break
 		}
 	}
 
 	return attemptOk, attemptErr
 }
+
+func (step *RetryStep) parseBackoff() (time.Duration, time.Duration, error) {
+	if step.policy == nil {
+		return 0, 0, nil
+	}
+
+	var backoff, maxBackoff time.Duration
+	var err error
+
+	if step.policy.Backoff != "" {
+		backoff, err = time.ParseDuration(step.policy.Backoff)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid backoff: %w", err)
+		}
+	}
+
+	if step.policy.MaxBackoff != "" {
+		maxBackoff, err = time.ParseDuration(step.policy.MaxBackoff)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid max_backoff: %w", err)
+		}
+	}
+
+	return backoff, maxBackoff, nil
+}
+
+// delay returns how long to wait before the given (zero-indexed) attempt. The
+// backoff doubles with each retry and is capped at maxBackoff, after which
+// jitter is applied.
+func (step *RetryStep) delay(attempt int, backoff time.Duration, maxBackoff time.Duration) time.Duration {
+	if backoff <= 0 {
+		return 0
+	}
+
+	delay := backoff
+	for i := 1; i < attempt; i++ {
+		if delay > math.MaxInt64/2 || (maxBackoff > 0 && delay >= maxBackoff) {
+			break
+		}
+
+		delay *= 2
+	}
+
+	if maxBackoff > 0 && delay > maxBackoff {
+		delay = maxBackoff
+	}
+
+	if step.policy.Jitter > 0 {
+		delay -= time.Duration(rand.Float64() * step.policy.Jitter * float64(delay))
+	}
+
+	return delay
+}
+
+// shouldRetry returns whether the outcome of the given attempt should be
+// retried, along with a human-readable reason.
+func (step *RetryStep) shouldRetry(attempt int, state RunState, err error) (bool, string) {
+	var retryOn atc.RetryOnConfig
+	if step.policy == nil || step.policy.RetryOn == nil {
+		retryOn = atc.RetryOnConfig{Failed: true, Errored: true}
+	} else {
+		retryOn = *step.policy.RetryOn
+	}
+
+	if err != nil {
+		return retryOn.Errored, fmt.Sprintf("errored: %s", err)
+	}
+
+	status, found := step.exitStatus(attempt, state)
+	if !found {
+		return retryOn.Failed, "failed"
+	}
+
+	return retryOn.RetriesExitCode(int(status)), fmt.Sprintf("failed with exit status %d", status)
+}
+
+// exitStatus finds the exit status of the last task that ran as part of the
+// given attempt.
+func (step *RetryStep) exitStatus(attempt int, state RunState) (ExitStatus, bool) {
+	if attempt >= len(step.attemptPlans) {
+		return 0, false
+	}
+
+	var status ExitStatus
+	var found bool
+
+	plan := step.attemptPlans[attempt]
+	plan.Each(func(p *atc.Plan) {
+		if p.Task == nil {
+			return
+		}
+
+		var taskStatus ExitStatus
+		if state.Result(p.ID, &taskStatus) {
+			status = taskStatus
+			found = true
+		}
+	})
+
+	return status, found
+}
@@ -3,10 +3,14 @@ package exec_test
 import (
 	"context"
 	"errors"
+	"time"
 
+	"code.cloudfoundry.org/clock/fakeclock"
+	"github.com/concourse/concourse/atc"
 	. "github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/build"
 	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/vars"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -213,4 +217,181 @@ var _ = Describe("Retry Step", func() {
 			})
 		})
 	})
+
+	Describe("with a retry policy", func() {
+		var (
+			fakeClock           *fakeclock.FakeClock
+			fakeDelegate        *execfakes.FakeBuildStepDelegate
+			fakeDelegateFactory *execfakes.FakeBuildStepDelegateFactory
+
+			runState     RunState
+			policy       *atc.RetryPolicy
+			attemptPlans []atc.Plan
+
+			stepOk  bool
+			stepErr error
+			done    chan struct{}
+		)
+
+		BeforeEach(func() {
+			fakeClock = fakeclock.NewFakeClock(time.Unix(0, 123))
+			fakeDelegate = new(execfakes.FakeBuildStepDelegate)
+			fakeDelegateFactory = new(execfakes.FakeBuildStepDelegateFactory)
+			fakeDelegateFactory.BuildStepDelegateReturns(fakeDelegate)
+
+			runState = NewRunState(noopStepper, vars.StaticVariables{}, false)
+			policy = &atc.RetryPolicy{Backoff: "10s", MaxBackoff: "15s"}
+
+			attemptPlans = []atc.Plan{
+				{ID: "1", Task: &atc.TaskPlan{Name: "some-task"}},
+				{ID: "2", Task: &atc.TaskPlan{Name: "some-task"}},
+				{ID: "3", Task: &atc.TaskPlan{Name: "some-task"}},
+			}
+		})
+
+		JustBeforeEach(func() {
+			step = RetryWithPolicy(
+				[]Step{attempt1, attempt2, attempt3},
+				attemptPlans,
+				policy,
+				fakeDelegateFactory,
+				fakeClock,
+			)
+
+			done = make(chan struct{})
+			go func() {
+				defer GinkgoRecover()
+				defer close(done)
+				stepOk, stepErr = step.Run(ctx, runState)
+			}()
+		})
+
+		Context("when every attempt fails", func() {
+			BeforeEach(func() {
+				attempt1.RunReturns(false, nil)
+				attempt2.RunReturns(false, nil)
+				attempt3.RunReturns(false, nil)
+			})
+
+			It("waits between attempts, doubling the backoff up to the maximum", func() {
+				Eventually(fakeDelegate.RetryingCallCount).Should(Equal(1))
+				Consistently(attempt2.RunCallCount).Should(Equal(0))
+
+				_, attempt, delay, reason := fakeDelegate.RetryingArgsForCall(0)
+				Expect(attempt).To(Equal(2))
+				Expect(delay).To(Equal(10 * time.Second))
+				Expect(reason).To(Equal("failed"))
+
+				fakeClock.WaitForWatcherAndIncrement(10 * time.Second)
+				Eventually(attempt2.RunCallCount).Should(Equal(1))
+
+				Eventually(fakeDelegate.RetryingCallCount).Should(Equal(2))
+				_, attempt, delay, _ = fakeDelegate.RetryingArgsForCall(1)
+				Expect(attempt).To(Equal(3))
+				Expect(delay).To(Equal(15 * time.Second))
+
+				fakeClock.WaitForWatcherAndIncrement(15 * time.Second)
+				Eventually(done).Should(BeClosed())
+
+				Expect(attempt3.RunCallCount()).To(Equal(1))
+				Expect(stepErr).ToNot(HaveOccurred())
+				Expect(stepOk).To(BeFalse())
+			})
+		})
+
+		Context("when aborted while waiting", func() {
+			BeforeEach(func() {
+				attempt1.RunReturns(false, nil)
+			})
+
+			It("returns the context error without running the next attempt", func() {
+				Eventually(fakeDelegate.RetryingCallCount).Should(Equal(1))
+				cancel()
+
+				Eventually(done).Should(BeClosed())
+				Expect(stepErr).To(Equal(context.Canceled))
+				Expect(attempt2.RunCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when only errors are retried", func() {
+			BeforeEach(func() {
+				policy = &atc.RetryPolicy{RetryOn: &atc.RetryOnConfig{Errored: true}}
+			})
+
+			Context("and the attempt fails", func() {
+				BeforeEach(func() {
+					attempt1.RunReturns(false, nil)
+				})
+
+				It("does not retry", func() {
+					Eventually(done).Should(BeClosed())
+					Expect(stepOk).To(BeFalse())
+					Expect(attempt2.RunCallCount()).To(Equal(0))
+					Expect(fakeDelegate.RetryingCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("and the attempt errors", func() {
+				BeforeEach(func() {
+					attempt1.RunReturns(false, errors.New("nope"))
+					attempt2.RunReturns(true, nil)
+				})
+
+				It("retries immediately", func() {
+					Eventually(done).Should(BeClosed())
+					Expect(stepOk).To(BeTrue())
+					Expect(attempt2.RunCallCount()).To(Equal(1))
+
+					_, _, delay, reason := fakeDelegate.RetryingArgsForCall(0)
+					Expect(delay).To(BeZero())
+					Expect(reason).To(Equal("errored: nope"))
+				})
+			})
+		})
+
+		Context("when specific exit codes are retried", func() {
+			BeforeEach(func() {
+				policy = &atc.RetryPolicy{RetryOn: &atc.RetryOnConfig{ExitCodes: []int{75}}}
+
+				attempt1.RunStub = func(context.Context, RunState) (bool, error) {
+					runState.StoreResult("1", ExitStatus(75))
+					return false, nil
+				}
+				attempt2.RunStub = func(context.Context, RunState) (bool, error) {
+					runState.StoreResult("2", ExitStatus(1))
+					return false, nil
+				}
+			})
+
+			It("retries only the matching exit codes", func() {
+				Eventually(done).Should(BeClosed())
+				Expect(stepOk).To(BeFalse())
+				Expect(attempt2.RunCallCount()).To(Equal(1))
+				Expect(attempt3.RunCallCount()).To(Equal(0))
+
+				Expect(fakeDelegate.RetryingCallCount()).To(Equal(1))
+				_, _, _, reason := fakeDelegate.RetryingArgsForCall(0)
+				Expect(reason).To(Equal("failed with exit status 75"))
+			})
+		})
+
+		Context("with jitter", func() {
+			BeforeEach(func() {
+				policy = &atc.RetryPolicy{Backoff: "10s", Jitter: 0.5}
+				attempt1.RunReturns(false, nil)
+			})
+
+			It("shortens the delay by up to the jitter fraction", func() {
+				Eventually(fakeDelegate.RetryingCallCount).Should(Equal(1))
+
+				_, _, delay, _ := fakeDelegate.RetryingArgsForCall(0)
+				Expect(delay).To(BeNumerically(">=", 5*time.Second))
+				Expect(delay).To(BeNumerically("<=", 10*time.Second))
+
+				cancel()
+				Eventually(done).Should(BeClosed())
+			})
+		})
+	})
 })
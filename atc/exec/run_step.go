@@ -1,61 +1,369 @@
 package exec
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"path"
+	"path/filepath"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/runtime"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/tracing"
+	"go.opentelemetry.io/otel/trace"
 )
 
-// RunStep will run a message against a prototype.
-type RunStep struct {
-	planID          atc.PlanID
-	plan            atc.RunPlan
-	delegateFactory RunDelegateFactory
+const runProcessID = "run"
+
+// MissingResponseFieldError is returned when a var is to be set from a field
+// which the prototype did not respond with.
+type MissingResponseFieldError struct {
+	Var   string
+	Field string
 }
 
+func (err MissingResponseFieldError) Error() string {
+	return fmt.Sprintf("cannot set var '%s': response has no field '%s'", err.Var, err.Field)
+}
+
+//counterfeiter:generate . RunDelegateFactory
 type RunDelegateFactory interface {
 	RunDelegate(state RunState) RunDelegate
 }
 
+//counterfeiter:generate . RunDelegate
 type RunDelegate interface {
+	StartSpan(context.Context, string, tracing.Attrs) (context.Context, trace.Span)
+
+	FetchImage(context.Context, atc.Plan, *atc.Plan, bool) (runtime.ImageSpec, db.ResourceCache, error)
+
 	Stdout() io.Writer
 	Stderr() io.Writer
 
 	Initializing(lager.Logger)
 	Starting(lager.Logger)
 	Finished(lager.Logger, bool)
+	Errored(lager.Logger, string)
+
+	BeforeSelectWorker(lager.Logger) error
+	WaitingForWorker(lager.Logger, int)
+	SelectedWorker(lager.Logger, string)
+	StreamingVolume(lager.Logger, string, string, string)
+	WaitingForStreamedVolume(lager.Logger, string, string)
+	BuildStartTime() time.Time
+}
+
+// RunStep will run a message against a prototype.
+type RunStep struct {
+	planID            atc.PlanID
+	plan              atc.RunPlan
+	metadata          StepMetadata
+	containerMetadata db.ContainerMetadata
+	strategy          worker.PlacementStrategy
+	workerPool        Pool
+	delegateFactory   RunDelegateFactory
+	defaultTimeout    time.Duration
 }
 
 func NewRunStep(
 	planID atc.PlanID,
 	plan atc.RunPlan,
+	metadata StepMetadata,
+	containerMetadata db.ContainerMetadata,
+	strategy worker.PlacementStrategy,
+	workerPool Pool,
 	delegateFactory RunDelegateFactory,
+	defaultTimeout time.Duration,
 ) Step {
 	return &RunStep{
-		planID:          planID,
-		plan:            plan,
-		delegateFactory: delegateFactory,
+		planID:            planID,
+		plan:              plan,
+		metadata:          metadata,
+		containerMetadata: containerMetadata,
+		strategy:          strategy,
+		workerPool:        workerPool,
+		delegateFactory:   delegateFactory,
+		defaultTimeout:    defaultTimeout,
 	}
 }
 
+// Run fetches the prototype's image, either by fetching the image of its type
+// or from an artifact in the build, and runs the message's executable in a
+// container with the step's inputs mounted.
+//
+// The message's request is written to the process's stdin, and the process
+// responds with a stream of JSON objects on stdout. Vars configured by
+// set_vars are taken from the last response object.
+//
+// If the process exits successfully, the step's outputs are registered with
+// the artifact.Repository.
 func (step *RunStep) Run(ctx context.Context, state RunState) (bool, error) {
+	delegate := step.delegateFactory.RunDelegate(state)
+	ctx, span := delegate.StartSpan(ctx, "run", tracing.Attrs{
+		"message":   step.plan.Message,
+		"prototype": step.plan.Type,
+	})
+
+	ok, err := step.run(ctx, state, delegate)
+	tracing.End(span, err)
+
+	return ok, err
+}
+
+func (step *RunStep) run(ctx context.Context, state RunState, delegate RunDelegate) (bool, error) {
 	logger := lagerctx.FromContext(ctx)
+	logger = logger.Session("run-step", lager.Data{
+		"message": step.plan.Message,
+		"job-id":  step.metadata.JobID,
+	})
 
-	delegate := step.delegateFactory.RunDelegate(state)
 	delegate.Initializing(logger)
 
-	stderr := delegate.Stderr()
-	fmt.Fprint(stderr, "\x1b[1;33mthe run step is not yet implemented\x1b[0m\n\n")
+	object, err := creds.NewParams(state, step.plan.Object).Evaluate()
+	if err != nil {
+		return false, err
+	}
+
+	repository := state.ArtifactRepository()
+
+	imageSpec, err := step.imageSpec(ctx, repository, delegate)
+	if err != nil {
+		return false, err
+	}
+
+	containerSpec, err := step.containerSpec(repository, imageSpec)
+	if err != nil {
+		return false, err
+	}
+	tracing.Inject(ctx, &containerSpec)
+
+	workerSpec := worker.Spec{
+		Tags:     step.plan.Tags,
+		TeamID:   step.metadata.TeamID,
+		Priority: step.metadata.Priority,
+	}
+
+	owner := db.NewBuildStepContainerOwner(step.metadata.BuildID, step.planID, step.metadata.TeamID)
+
+	err = delegate.BeforeSelectWorker(logger)
+	if err != nil {
+		return false, err
+	}
+
+	worker, err := step.workerPool.FindOrSelectWorker(ctx, owner, containerSpec, workerSpec, step.strategy, delegate)
+	if err != nil {
+		return false, err
+	}
+
+	defer func() {
+		step.workerPool.ReleaseWorker(
+			logger,
+			containerSpec,
+			worker,
+			step.strategy,
+		)
+	}()
+
+	delegate.SelectedWorker(logger, worker.Name())
+
+	ctx, cancel, err := MaybeTimeout(ctx, step.plan.Timeout, step.defaultTimeout)
+	if err != nil {
+		return false, err
+	}
+	defer cancel()
+
+	ctx = lagerctx.NewContext(ctx, logger)
+
+	container, volumeMounts, err := worker.FindOrCreateContainer(ctx, owner, step.containerMetadata, containerSpec, delegate)
+	if err != nil {
+		return false, err
+	}
+
+	request, err := json.Marshal(map[string]interface{}{
+		"object": object,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	stdout := new(bytes.Buffer)
 
 	delegate.Starting(logger)
+	process, err := attachOrRun(
+		ctx,
+		container,
+		runtime.ProcessSpec{
+			ID:   runProcessID,
+			Path: path.Join("/usr/bin", step.plan.Message),
+			Args: []string{step.containerMetadata.WorkingDirectory},
+			Dir:  step.containerMetadata.WorkingDirectory,
+		},
+		runtime.ProcessIO{
+			Stdin:  bytes.NewBuffer(request),
+			Stdout: stdout,
+			Stderr: delegate.Stderr(),
+		},
+	)
+	if err != nil {
+		return false, err
+	}
 
-	fmt.Fprintf(stderr, "\x1b[1;34mpretending to run %s on prototype %s...\x1b[0m\n", step.plan.Message, step.plan.Type)
+	result, err := process.Wait(ctx)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			delegate.Errored(logger, TimeoutLogMessage)
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	step.registerOutputs(logger, repository, volumeMounts)
+
+	if result.ExitStatus != 0 {
+		delegate.Finished(logger, false)
+		return false, nil
+	}
+
+	response, err := step.lastResponse(stdout)
+	if err != nil {
+		return false, err
+	}
+
+	for varName, field := range step.plan.SetVars {
+		value, found := response[field]
+		if !found {
+			return false, MissingResponseFieldError{Var: varName, Field: field}
+		}
+
+		state.AddLocalVar(varName, value, true)
+	}
 
 	delegate.Finished(logger, true)
 
 	return true, nil
 }
+
+func (step *RunStep) imageSpec(ctx context.Context, repository *build.Repository, delegate RunDelegate) (runtime.ImageSpec, error) {
+	if step.plan.ImageArtifactName != "" {
+		artifact, _, found := repository.ArtifactFor(build.ArtifactName(step.plan.ImageArtifactName))
+		if !found {
+			return runtime.ImageSpec{}, MissingTaskImageSourceError{step.plan.ImageArtifactName}
+		}
+
+		return runtime.ImageSpec{
+			ImageArtifact: artifact,
+			Privileged:    step.plan.Privileged,
+		}, nil
+	}
+
+	if step.plan.TypeImage.GetPlan == nil {
+		return runtime.ImageSpec{}, fmt.Errorf("no image for prototype '%s'", step.plan.Type)
+	}
+
+	imageSpec, _, err := delegate.FetchImage(
+		ctx,
+		*step.plan.TypeImage.GetPlan,
+		step.plan.TypeImage.CheckPlan,
+		step.plan.Privileged || step.plan.TypeImage.Privileged,
+	)
+	return imageSpec, err
+}
+
+func (step *RunStep) containerSpec(repository *build.Repository, imageSpec runtime.ImageSpec) (runtime.ContainerSpec, error) {
+	containerSpec := runtime.ContainerSpec{
+		TeamID:   step.metadata.TeamID,
+		TeamName: step.metadata.TeamName,
+		JobID:    step.metadata.JobID,
+
+		ImageSpec: imageSpec,
+		Env:       step.metadata.TaskEnv(),
+		Type:      step.containerMetadata.Type,
+
+		Dir: step.containerMetadata.WorkingDirectory,
+	}
+
+	var missingInputs []string
+	for _, input := range step.plan.Inputs {
+		inputName := input
+		if sourceName, ok := step.plan.InputMapping[input]; ok {
+			inputName = sourceName
+		}
+
+		artifact, fromCache, found := repository.ArtifactFor(build.ArtifactName(inputName))
+		if !found {
+			missingInputs = append(missingInputs, inputName)
+			continue
+		}
+
+		containerSpec.Inputs = append(containerSpec.Inputs, runtime.Input{
+			Artifact:        artifact,
+			DestinationPath: resolvePath(step.containerMetadata.WorkingDirectory, input),
+			FromCache:       fromCache,
+		})
+	}
+
+	if len(missingInputs) > 0 {
+		return runtime.ContainerSpec{}, MissingInputsError{missingInputs}
+	}
+
+	containerSpec.Outputs = make(runtime.OutputPaths, len(step.plan.Outputs))
+	for _, output := range step.plan.Outputs {
+		containerSpec.Outputs[output] = ensureTrailingSlash(resolvePath(step.containerMetadata.WorkingDirectory, output))
+	}
+
+	if step.plan.Limits != nil {
+		containerSpec.Limits.CPU = (*uint64)(step.plan.Limits.CPU)
+		containerSpec.Limits.Memory = (*uint64)(step.plan.Limits.Memory)
+	}
+
+	return containerSpec, nil
+}
+
+func (step *RunStep) registerOutputs(logger lager.Logger, repository *build.Repository, volumeMounts []runtime.VolumeMount) {
+	logger.Debug("registering-outputs", lager.Data{"outputs": step.plan.Outputs})
+
+	for _, output := range step.plan.Outputs {
+		outputName := output
+		if destinationName, ok := step.plan.OutputMapping[output]; ok {
+			outputName = destinationName
+		}
+
+		outputPath := resolvePath(step.containerMetadata.WorkingDirectory, output)
+
+		for _, mount := range volumeMounts {
+			if filepath.Clean(mount.MountPath) == filepath.Clean(outputPath) {
+				repository.RegisterArtifact(build.ArtifactName(outputName), mount.Volume, false)
+			}
+		}
+	}
+}
+
+func (step *RunStep) lastResponse(stdout io.Reader) (map[string]interface{}, error) {
+	var response map[string]interface{}
+
+	decoder := json.NewDecoder(stdout)
+	for {
+		var object map[string]interface{}
+		err := decoder.Decode(&object)
+		if err == io.EOF {
+			return response, nil
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid response from prototype: %w", err)
+		}
+
+		response = object
+	}
+}
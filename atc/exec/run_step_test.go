@@ -0,0 +1,323 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/runtime"
+	"github.com/concourse/concourse/atc/runtime/runtimetest"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/tracing"
+	"github.com/concourse/concourse/vars"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("RunStep", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeDelegate        *execfakes.FakeRunDelegate
+		fakeDelegateFactory *execfakes.FakeRunDelegateFactory
+
+		fakePool        *execfakes.FakePool
+		chosenWorker    *runtimetest.Worker
+		chosenContainer *runtimetest.WorkerContainer
+
+		imageVolume  *runtimetest.Volume
+		inputVolume  *runtimetest.Volume
+		outputVolume *runtimetest.Volume
+
+		processStub runtimetest.ProcessStub
+
+		containerMetadata = db.ContainerMetadata{
+			WorkingDirectory: "/tmp/build/run",
+			Type:             db.ContainerTypeRun,
+		}
+
+		planID       = atc.PlanID("some-plan-id")
+		stepMetadata = exec.StepMetadata{
+			TeamID:    123,
+			TeamName:  "some-team",
+			BuildID:   42,
+			BuildName: "some-build",
+			JobID:     87,
+		}
+		expectedOwner = db.NewBuildStepContainerOwner(stepMetadata.BuildID, planID, stepMetadata.TeamID)
+
+		state exec.RunState
+		repo  *build.Repository
+
+		runPlan *atc.RunPlan
+
+		stdoutBuf *gbytes.Buffer
+		stderrBuf *gbytes.Buffer
+
+		stepOk  bool
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		imageVolume = runtimetest.NewVolume("image-volume")
+		inputVolume = runtimetest.NewVolume("input-volume")
+		outputVolume = runtimetest.NewVolume("output-volume")
+
+		processStub = runtimetest.ProcessStub{
+			Attachable: true,
+			Output:     map[string]interface{}{"some-field": "some-value"},
+		}
+
+		fakePool = new(execfakes.FakePool)
+
+		fakeDelegate = new(execfakes.FakeRunDelegate)
+		stdoutBuf = gbytes.NewBuffer()
+		stderrBuf = gbytes.NewBuffer()
+		fakeDelegate.StdoutReturns(stdoutBuf)
+		fakeDelegate.StderrReturns(stderrBuf)
+		fakeDelegate.StartSpanReturns(context.Background(), tracing.NoopSpan)
+		fakeDelegate.FetchImageReturns(runtime.ImageSpec{ImageArtifact: imageVolume}, nil, nil)
+
+		fakeDelegateFactory = new(execfakes.FakeRunDelegateFactory)
+		fakeDelegateFactory.RunDelegateReturns(fakeDelegate)
+
+		state = exec.NewRunState(noopStepper, vars.StaticVariables{
+			"some-var": "some-secret",
+		}, false)
+		repo = state.ArtifactRepository()
+
+		getPlan := atc.Plan{ID: "some-plan-id/image-get", Get: &atc.GetPlan{Name: "some-prototype"}}
+		checkPlan := atc.Plan{ID: "some-plan-id/image-check", Check: &atc.CheckPlan{Name: "some-prototype"}}
+
+		runPlan = &atc.RunPlan{
+			Message: "some-message",
+			Type:    "some-prototype",
+			Object:  atc.Params{"some": "((some-var))"},
+			TypeImage: atc.TypeImage{
+				BaseType:  "registry-image",
+				GetPlan:   &getPlan,
+				CheckPlan: &checkPlan,
+			},
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		chosenWorker = runtimetest.NewWorker("worker").
+			WithContainer(
+				expectedOwner,
+				runtimetest.NewContainer().WithProcess(
+					runtime.ProcessSpec{
+						ID:   "run",
+						Path: "/usr/bin/some-message",
+						Args: []string{"/tmp/build/run"},
+						Dir:  "/tmp/build/run",
+					},
+					processStub,
+				),
+				[]runtime.VolumeMount{
+					{
+						Volume:    outputVolume,
+						MountPath: "/tmp/build/run/some-output",
+					},
+				},
+			)
+		chosenContainer = chosenWorker.Containers[0]
+		fakePool.FindOrSelectWorkerReturns(chosenWorker, nil)
+
+		runStep := exec.NewRunStep(
+			planID,
+			*runPlan,
+			stepMetadata,
+			containerMetadata,
+			nil,
+			fakePool,
+			fakeDelegateFactory,
+			0,
+		)
+
+		stepOk, stepErr = runStep.Run(ctx, state)
+	})
+
+	It("succeeds", func() {
+		Expect(stepErr).ToNot(HaveOccurred())
+		Expect(stepOk).To(BeTrue())
+	})
+
+	It("fetches the image of the prototype", func() {
+		Expect(fakeDelegate.FetchImageCallCount()).To(Equal(1))
+		_, getPlan, checkPlan, privileged := fakeDelegate.FetchImageArgsForCall(0)
+		Expect(getPlan).To(Equal(*runPlan.TypeImage.GetPlan))
+		Expect(checkPlan).To(Equal(runPlan.TypeImage.CheckPlan))
+		Expect(privileged).To(BeFalse())
+
+		Expect(chosenContainer.Spec.ImageSpec).To(Equal(runtime.ImageSpec{ImageArtifact: imageVolume}))
+	})
+
+	It("selects a worker for the step's team", func() {
+		_, _, _, workerSpec, _, _ := fakePool.FindOrSelectWorkerArgsForCall(0)
+		Expect(workerSpec).To(Equal(worker.Spec{TeamID: 123}))
+	})
+
+	It("sends the interpolated object as the request", func() {
+		process := chosenContainer.RunningProcesses()[0]
+		request, err := io.ReadAll(process.Stdin())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(request).To(MatchJSON(`{"object":{"some":"some-secret"}}`))
+	})
+
+	It("finishes the step as succeeded", func() {
+		Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+		_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+		Expect(succeeded).To(BeTrue())
+	})
+
+	Context("when the prototype is privileged", func() {
+		BeforeEach(func() {
+			runPlan.TypeImage.Privileged = true
+		})
+
+		It("fetches a privileged image", func() {
+			_, _, _, privileged := fakeDelegate.FetchImageArgsForCall(0)
+			Expect(privileged).To(BeTrue())
+		})
+	})
+
+	Context("when an image artifact is used", func() {
+		BeforeEach(func() {
+			runPlan.Type = ""
+			runPlan.TypeImage = atc.TypeImage{}
+			runPlan.ImageArtifactName = "some-image"
+		})
+
+		Context("when the artifact is registered", func() {
+			BeforeEach(func() {
+				repo.RegisterArtifact("some-image", imageVolume, false)
+			})
+
+			It("runs with the artifact as its image", func() {
+				Expect(stepErr).ToNot(HaveOccurred())
+				Expect(fakeDelegate.FetchImageCallCount()).To(BeZero())
+				Expect(chosenContainer.Spec.ImageSpec).To(Equal(runtime.ImageSpec{ImageArtifact: imageVolume}))
+			})
+		})
+
+		Context("when the artifact is missing", func() {
+			It("returns a MissingTaskImageSourceError", func() {
+				Expect(stepErr).To(Equal(exec.MissingTaskImageSourceError{SourceName: "some-image"}))
+			})
+		})
+	})
+
+	Context("when inputs are configured", func() {
+		BeforeEach(func() {
+			runPlan.Inputs = []string{"some-input"}
+			runPlan.InputMapping = map[string]string{"some-input": "some-artifact"}
+		})
+
+		Context("when the input is registered", func() {
+			BeforeEach(func() {
+				repo.RegisterArtifact("some-artifact", inputVolume, false)
+			})
+
+			It("mounts it in the working directory", func() {
+				Expect(chosenContainer.Spec.Inputs).To(Equal([]runtime.Input{
+					{
+						Artifact:        inputVolume,
+						DestinationPath: "/tmp/build/run/some-input",
+					},
+				}))
+			})
+		})
+
+		Context("when the input is missing", func() {
+			It("returns a MissingInputsError", func() {
+				Expect(stepErr).To(Equal(exec.MissingInputsError{Inputs: []string{"some-artifact"}}))
+			})
+		})
+	})
+
+	Context("when outputs are configured", func() {
+		BeforeEach(func() {
+			runPlan.Outputs = []string{"some-output"}
+			runPlan.OutputMapping = map[string]string{"some-output": "other-artifact"}
+		})
+
+		It("configures the output paths", func() {
+			Expect(chosenContainer.Spec.Outputs).To(Equal(runtime.OutputPaths{
+				"some-output": "/tmp/build/run/some-output/",
+			}))
+		})
+
+		It("registers the outputs under their mapped names", func() {
+			artifact, _, found := repo.ArtifactFor("other-artifact")
+			Expect(found).To(BeTrue())
+			Expect(artifact).To(Equal(outputVolume))
+		})
+	})
+
+	Context("when set_vars is configured", func() {
+		BeforeEach(func() {
+			runPlan.SetVars = map[string]string{"some-local-var": "some-field"}
+		})
+
+		It("sets the var from the response", func() {
+			value, found, err := state.Get(vars.Reference{Source: ".", Path: "some-local-var"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("some-value"))
+		})
+
+		Context("when the response does not have the field", func() {
+			BeforeEach(func() {
+				runPlan.SetVars = map[string]string{"some-local-var": "bogus-field"}
+			})
+
+			It("returns a MissingResponseFieldError", func() {
+				Expect(stepErr).To(Equal(exec.MissingResponseFieldError{Var: "some-local-var", Field: "bogus-field"}))
+			})
+		})
+	})
+
+	Context("when the process exits nonzero", func() {
+		BeforeEach(func() {
+			processStub.ExitStatus = 1
+			runPlan.SetVars = map[string]string{"some-local-var": "some-field"}
+		})
+
+		It("fails without setting vars", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(stepOk).To(BeFalse())
+
+			_, found, _ := state.Get(vars.Reference{Source: ".", Path: "some-local-var"})
+			Expect(found).To(BeFalse())
+		})
+
+		It("finishes the step as failed", func() {
+			_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+			Expect(succeeded).To(BeFalse())
+		})
+	})
+
+	Context("when the process errors", func() {
+		BeforeEach(func() {
+			processStub.Err = "nope"
+		})
+
+		It("returns the error", func() {
+			Expect(stepErr).To(Equal(errors.New("nope")))
+		})
+	})
+})
@@ -17,6 +17,10 @@ type StepMetadata struct {
 	PipelineInstanceVars map[string]interface{}
 	ExternalURL          string
 	CreatedBy            string
+
+	// Priority is used to order the build's steps amongst other steps waiting
+	// for a worker. It is not exposed to the step.
+	Priority int
 }
 
 func (metadata StepMetadata) Env() []string {
@@ -76,4 +80,4 @@ func (metadata StepMetadata) TaskEnv() []string {
 		env = append(env, "ATC_EXTERNAL_URL="+metadata.ExternalURL)
 	}
 	return env
-}
\ No newline at end of file
+}
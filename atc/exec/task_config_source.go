@@ -119,6 +119,10 @@ func (configSource BaseResourceTypeDefaultsApplySource) FetchConfig(ctx context.
 
 	config.ImageResource.ApplySourceDefaults(configSource.ResourceTypes)
 
+	for _, service := range config.Services {
+		service.ImageResource.ApplySourceDefaults(configSource.ResourceTypes)
+	}
+
 	return config, nil
 }
 
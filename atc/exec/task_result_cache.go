@@ -0,0 +1,125 @@
+package exec
+
+import (
+	"archive/tar"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"sort"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/compression"
+	"github.com/concourse/concourse/atc/runtime"
+)
+
+// taskResultCacheKey identifies everything that goes into running a task: its
+// resolved config (including params), its image and its inputs. Two runs with
+// the same key are expected to produce the same outputs.
+func taskResultCacheKey(ctx context.Context, config atc.TaskConfig, imageSpec runtime.ImageSpec, inputs []runtime.Input) (string, error) {
+	h := sha256.New()
+
+	configJSON, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+
+	fmt.Fprintf(h, "config:%s\n", configJSON)
+	fmt.Fprintf(h, "privileged:%t\n", imageSpec.Privileged)
+
+	switch {
+	case imageSpec.ImageArtifact != nil:
+		fmt.Fprint(h, "image-artifact:")
+		err = hashArtifact(ctx, h, imageSpec.ImageArtifact)
+		if err != nil {
+			return "", err
+		}
+	case imageSpec.ImageURL != "":
+		fmt.Fprintf(h, "image-url:%s\n", imageSpec.ImageURL)
+	case imageSpec.ResourceType != "":
+		fmt.Fprintf(h, "image-resource-type:%s\n", imageSpec.ResourceType)
+	}
+
+	sorted := make([]runtime.Input, len(inputs))
+	copy(sorted, inputs)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].DestinationPath < sorted[j].DestinationPath
+	})
+
+	for _, input := range sorted {
+		fmt.Fprintf(h, "input:%s:", input.DestinationPath)
+		err = hashArtifact(ctx, h, input.Artifact)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// hashArtifact writes an identifier of the artifact's contents to the hash.
+//
+// Volumes are not modified once the step producing them has finished, so
+// they are identified without reading their contents: volumes of resource
+// caches by the resource cache, which already accounts for the source,
+// version and params that produced them, and any other volume by its handle.
+// The handles of outputs reused from a previous result are the same across
+// builds, so steps using them can be reused in turn.
+//
+// Artifacts which are not volumes are streamed out and their files are
+// hashed, ignoring timestamps.
+func hashArtifact(ctx context.Context, h hash.Hash, artifact runtime.Artifact) error {
+	if volume, ok := artifact.(runtime.Volume); ok {
+		if volume.DBVolume() != nil {
+			if resourceCacheID := volume.DBVolume().GetResourceCacheID(); resourceCacheID != 0 {
+				fmt.Fprintf(h, "resource-cache:%d\n", resourceCacheID)
+				return nil
+			}
+		}
+
+		fmt.Fprintf(h, "volume:%s\n", volume.Handle())
+		return nil
+	}
+
+	comp := compression.NewGzipCompression()
+
+	out, err := artifact.StreamOut(ctx, ".", comp)
+	if err != nil {
+		return err
+	}
+
+	defer out.Close()
+
+	reader, err := comp.NewReader(out)
+	if err != nil {
+		return err
+	}
+
+	defer reader.Close()
+
+	tarReader := tar.NewReader(reader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(h, "%s:%d:%o:%s:%d\n", header.Name, header.Typeflag, header.Mode, header.Linkname, header.Size)
+
+		_, err = io.Copy(h, tarReader)
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintln(h)
+
+	return nil
+}
@@ -0,0 +1,275 @@
+package exec
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/runtime"
+)
+
+const serviceProcessID = "service"
+
+const (
+	DefaultServiceReadinessInterval = time.Second
+	DefaultServiceReadinessTimeout  = 5 * time.Minute
+)
+
+// ServiceNotReadyError is returned when a service's readiness probe does not
+// succeed before the readiness timeout.
+type ServiceNotReadyError struct {
+	Name    string
+	Timeout time.Duration
+}
+
+func (err ServiceNotReadyError) Error() string {
+	return fmt.Sprintf("service '%s' did not become ready within %s", err.Name, err.Timeout)
+}
+
+// ServiceExitedError is returned when a service's process exits before the
+// service became ready.
+type ServiceExitedError struct {
+	Name       string
+	ExitStatus int
+}
+
+func (err ServiceExitedError) Error() string {
+	return fmt.Sprintf("service '%s' exited with status %d before becoming ready", err.Name, err.ExitStatus)
+}
+
+type taskService struct {
+	config atc.TaskServiceConfig
+	spec   runtime.ContainerSpec
+
+	container runtime.Container
+	exited    chan serviceExit
+	stop      context.CancelFunc
+}
+
+type serviceExit struct {
+	result runtime.ProcessResult
+	err    error
+}
+
+// services fetches the images and constructs the container specs for the
+// task's services. This happens before a worker is selected, as it does for
+// the task's own image.
+func (step *TaskStep) services(ctx context.Context, delegate TaskDelegate, config atc.TaskConfig) ([]*taskService, error) {
+	var services []*taskService
+
+	for _, service := range config.Services {
+		imageSpec := runtime.ImageSpec{
+			ImageURL:   service.RootfsURI,
+			Privileged: bool(step.plan.Privileged),
+		}
+
+		if service.ImageResource != nil {
+			var err error
+			imageSpec, err = delegate.FetchServiceImage(
+				ctx,
+				service.Name,
+				*service.ImageResource,
+				step.plan.ResourceTypes,
+				step.plan.Privileged,
+				step.plan.Tags,
+				step.plan.CheckSkipInterval,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		services = append(services, &taskService{
+			config: service,
+			spec: runtime.ContainerSpec{
+				TeamID:   step.metadata.TeamID,
+				TeamName: step.metadata.TeamName,
+				JobID:    step.metadata.JobID,
+				StepName: step.plan.Name,
+
+				ImageSpec: imageSpec,
+				Env:       service.Params.Env(),
+				Type:      db.ContainerTypeService,
+			},
+		})
+	}
+
+	return services, nil
+}
+
+// startServices creates the service containers on the same worker as the task
+// container, joined to its network, and waits for each of them to be ready.
+func (step *TaskStep) startServices(ctx context.Context, worker runtime.Worker, container runtime.Container, services []*taskService, delegate TaskDelegate) error {
+	for _, service := range services {
+		owner := db.NewBuildStepContainerOwner(step.metadata.BuildID, step.servicePlanID(service.config.Name), step.metadata.TeamID)
+
+		metadata := step.containerMetadata
+		metadata.Type = db.ContainerTypeService
+		metadata.WorkingDirectory = ""
+
+		service.spec.NetworkContainerHandle = container.DBContainer().Handle()
+
+		serviceContainer, _, err := worker.FindOrCreateContainer(ctx, owner, metadata, service.spec, delegate)
+		if err != nil {
+			return fmt.Errorf("create service '%s': %w", service.config.Name, err)
+		}
+
+		service.container = serviceContainer
+
+		process, err := attachOrRun(
+			ctx,
+			serviceContainer,
+			runtime.ProcessSpec{
+				ID:   serviceProcessID,
+				Path: service.config.Run.Path,
+				Args: service.config.Run.Args,
+				Dir:  service.config.Run.Dir,
+				User: service.config.Run.User,
+			},
+			runtime.ProcessIO{},
+		)
+		if err != nil {
+			return fmt.Errorf("start service '%s': %w", service.config.Name, err)
+		}
+
+		// cancelling the context the process is waited on stops it
+		waitCtx, stop := context.WithCancel(ctx)
+
+		service.stop = stop
+		service.exited = make(chan serviceExit, 1)
+		go func(process runtime.Process, exited chan<- serviceExit) {
+			result, err := process.Wait(waitCtx)
+			exited <- serviceExit{result: result, err: err}
+		}(process, service.exited)
+	}
+
+	for _, service := range services {
+		err := step.waitForService(ctx, service)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// waitForService runs the service's readiness probe until it succeeds, the
+// service exits, or the readiness timeout is reached.
+func (step *TaskStep) waitForService(ctx context.Context, service *taskService) error {
+	readiness := service.config.Readiness
+	if readiness == nil {
+		return nil
+	}
+
+	interval := DefaultServiceReadinessInterval
+	if readiness.Interval != "" {
+		var err error
+		interval, err = time.ParseDuration(readiness.Interval)
+		if err != nil {
+			return err
+		}
+	}
+
+	timeout := DefaultServiceReadinessTimeout
+	if readiness.Timeout != "" {
+		var err error
+		timeout, err = time.ParseDuration(readiness.Timeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	probeCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		ready, err := step.probeService(probeCtx, service)
+		if err != nil && probeCtx.Err() == nil {
+			return fmt.Errorf("probe service '%s': %w", service.config.Name, err)
+		}
+
+		if ready {
+			return nil
+		}
+
+		select {
+		case exit := <-service.exited:
+			if exit.err != nil {
+				return fmt.Errorf("service '%s': %w", service.config.Name, exit.err)
+			}
+
+			return ServiceExitedError{
+				Name:       service.config.Name,
+				ExitStatus: exit.result.ExitStatus,
+			}
+
+		case <-probeCtx.Done():
+			if errors.Is(probeCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+				return ServiceNotReadyError{
+					Name:    service.config.Name,
+					Timeout: timeout,
+				}
+			}
+
+			return ctx.Err()
+
+		case <-time.After(interval):
+		}
+	}
+}
+
+func (step *TaskStep) probeService(ctx context.Context, service *taskService) (bool, error) {
+	probe := service.config.Readiness.Run
+
+	process, err := service.container.Run(
+		ctx,
+		runtime.ProcessSpec{
+			Path: probe.Path,
+			Args: probe.Args,
+			Dir:  probe.Dir,
+			User: probe.User,
+		},
+		runtime.ProcessIO{},
+	)
+	if err != nil {
+		return false, err
+	}
+
+	result, err := process.Wait(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return result.ExitStatus == 0, nil
+}
+
+// stopServices stops the services' processes as soon as the task has
+// finished, and marks their containers as destroying rather than keeping them
+// around for intercepting like the task container. The containers themselves
+// are then removed from the worker by the container collector.
+func (step *TaskStep) stopServices(logger lager.Logger, services []*taskService) {
+	for _, service := range services {
+		if service.stop != nil {
+			service.stop()
+		}
+
+		if service.container == nil {
+			continue
+		}
+
+		_, err := service.container.DBContainer().Destroying()
+		if err != nil {
+			logger.Error("failed-to-destroy-service-container", err, lager.Data{
+				"service": service.config.Name,
+			})
+		}
+	}
+}
+
+func (step *TaskStep) servicePlanID(name string) atc.PlanID {
+	return atc.PlanID(fmt.Sprintf("%s/services/%s", step.planID, name))
+}
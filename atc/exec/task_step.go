@@ -15,6 +15,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec/build"
 	"github.com/concourse/concourse/atc/runtime"
+	"github.com/concourse/concourse/atc/testreport"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/tracing"
 	"github.com/concourse/concourse/vars"
@@ -62,6 +63,7 @@ type TaskDelegate interface {
 	StartSpan(context.Context, string, tracing.Attrs) (context.Context, trace.Span)
 
 	FetchImage(context.Context, atc.ImageResource, atc.ResourceTypes, bool, atc.Tags, bool) (runtime.ImageSpec, error)
+	FetchServiceImage(context.Context, string, atc.ImageResource, atc.ResourceTypes, bool, atc.Tags, bool) (runtime.ImageSpec, error)
 
 	Stdout() io.Writer
 	Stderr() io.Writer
@@ -74,11 +76,34 @@ type TaskDelegate interface {
 	Errored(lager.Logger, string)
 
 	BeforeSelectWorker(lager.Logger) error
-	WaitingForWorker(lager.Logger)
+	WaitingForWorker(lager.Logger, int)
 	SelectedWorker(lager.Logger, string)
 	StreamingVolume(lager.Logger, string, string, string)
 	WaitingForStreamedVolume(lager.Logger, string, string)
 	BuildStartTime() time.Time
+
+	FindTaskResult(stepName string, key string) (map[string]string, bool, error)
+	SaveTaskResult(stepName string, key string, outputs map[string]string) error
+	TaskCacheHit(lager.Logger, string)
+
+	SaveTestResults(stepName string, results []atc.TestResult) error
+}
+
+// TaskCacheStore keeps copies of task caches outside of the workers, so that
+// they can be restored on workers which do not have them yet.
+//
+//counterfeiter:generate . TaskCacheStore
+type TaskCacheStore interface {
+	Restore(ctx context.Context, jobID int, stepName string, path string, volume runtime.Volume) (bool, error)
+	Save(ctx context.Context, jobID int, stepName string, path string, volume runtime.Volume) error
+}
+
+// IDTokenIssuer mints the identity tokens requested by tasks, which identify
+// the step to services trusting the ATC as an OIDC issuer.
+//
+//counterfeiter:generate . IDTokenIssuer
+type IDTokenIssuer interface {
+	IssueIDToken(metadata StepMetadata, stepName string, config atc.IDTokenConfig) (string, error)
 }
 
 // TaskStep executes a TaskConfig, whose inputs will be fetched from the
@@ -92,6 +117,8 @@ type TaskStep struct {
 	strategy           worker.PlacementStrategy
 	workerPool         Pool
 	streamer           Streamer
+	cacheStore         TaskCacheStore
+	idTokenIssuer      IDTokenIssuer
 	delegateFactory    TaskDelegateFactory
 	defaultTaskTimeout time.Duration
 }
@@ -105,6 +132,8 @@ func NewTaskStep(
 	strategy worker.PlacementStrategy,
 	workerPool Pool,
 	streamer Streamer,
+	cacheStore TaskCacheStore,
+	idTokenIssuer IDTokenIssuer,
 	delegateFactory TaskDelegateFactory,
 	defaultTaskTimeout time.Duration,
 ) Step {
@@ -117,6 +146,8 @@ func NewTaskStep(
 		strategy:           strategy,
 		workerPool:         workerPool,
 		streamer:           streamer,
+		cacheStore:         cacheStore,
+		idTokenIssuer:      idTokenIssuer,
 		delegateFactory:    delegateFactory,
 		defaultTaskTimeout: defaultTaskTimeout,
 	}
@@ -240,6 +271,43 @@ func (step *TaskStep) run(ctx context.Context, state RunState, delegate TaskDele
 	}
 	tracing.Inject(ctx, &containerSpec)
 
+	// results are only cached within a job, as one-off builds have nothing to
+	// key them by
+	var cacheKey string
+	if step.plan.CacheResult && step.metadata.JobID != 0 {
+		cacheKey, err = taskResultCacheKey(ctx, config, imageSpec, containerSpec.Inputs)
+		if err != nil {
+			return false, err
+		}
+
+		reused, err := step.reuseResult(ctx, logger, repository, delegate, config, cacheKey)
+		if err != nil {
+			return false, err
+		}
+
+		if reused {
+			state.StoreResult(step.planID, ExitStatus(0))
+			delegate.Finished(logger, ExitStatus(0))
+			return true, nil
+		}
+	}
+
+	// the token is only passed to the process, so that it isn't saved with
+	// the task's config nor part of its cache key
+	if step.plan.IDToken != nil {
+		token, err := step.idToken(*step.plan.IDToken)
+		if err != nil {
+			return false, err
+		}
+
+		containerSpec.Env = append(containerSpec.Env, step.plan.IDToken.ParamName()+"="+token)
+	}
+
+	services, err := step.services(ctx, delegate, config)
+	if err != nil {
+		return false, err
+	}
+
 	owner := db.NewBuildStepContainerOwner(step.metadata.BuildID, step.planID, step.metadata.TeamID)
 
 	err = delegate.BeforeSelectWorker(logger)
@@ -283,32 +351,49 @@ func (step *TaskStep) run(ctx context.Context, state RunState, delegate TaskDele
 		return false, err
 	}
 
+	if len(services) > 0 {
+		defer step.stopServices(logger, services)
+
+		err = step.startServices(ctx, worker, container, services, delegate)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	processIO := runtime.ProcessIO{
+		Stdout: delegate.Stdout(),
+		Stderr: delegate.Stderr(),
+	}
+
 	delegate.Starting(logger)
-	process, err := attachOrRun(
-		ctx,
-		container,
-		runtime.ProcessSpec{
-			ID:   taskProcessID,
-			Path: config.Run.Path,
-			Args: config.Run.Args,
-			Dir:  resolvePath(step.containerMetadata.WorkingDirectory, config.Run.Dir),
-			User: config.Run.User,
-			// Guardian sets the default TTY window size to width: 80, height: 24,
-			// which creates ANSI control sequences that do not work with other window sizes
-			TTY: &runtime.TTYSpec{
-				WindowSize: runtime.WindowSize{
-					Columns: 500,
-					Rows:    500,
+	process, err := container.Attach(ctx, taskProcessID, processIO)
+	if err != nil {
+		// the task has not been started yet, so its caches can still be
+		// filled in
+		step.restoreCaches(ctx, logger, config, volumeMounts)
+
+		process, err = container.Run(
+			ctx,
+			runtime.ProcessSpec{
+				ID:   taskProcessID,
+				Path: config.Run.Path,
+				Args: config.Run.Args,
+				Dir:  resolvePath(step.containerMetadata.WorkingDirectory, config.Run.Dir),
+				User: config.Run.User,
+				// Guardian sets the default TTY window size to width: 80, height: 24,
+				// which creates ANSI control sequences that do not work with other window sizes
+				TTY: &runtime.TTYSpec{
+					WindowSize: runtime.WindowSize{
+						Columns: 500,
+						Rows:    500,
+					},
 				},
 			},
-		},
-		runtime.ProcessIO{
-			Stdout: delegate.Stdout(),
-			Stderr: delegate.Stderr(),
-		},
-	)
-	if err != nil {
-		return false, err
+			processIO,
+		)
+		if err != nil {
+			return false, err
+		}
 	}
 
 	result, runErr := process.Wait(ctx)
@@ -331,6 +416,24 @@ func (step *TaskStep) run(ctx context.Context, state RunState, delegate TaskDele
 		return false, runErr
 	}
 
+	if result.ExitStatus == 0 {
+		step.saveCaches(logger, config, volumeMounts)
+	}
+
+	err = step.saveTestReports(ctx, logger, delegate, config, volumeMounts)
+	if err != nil {
+		return false, err
+	}
+
+	if cacheKey != "" && result.ExitStatus == 0 {
+		err = delegate.SaveTaskResult(step.plan.Name, cacheKey, step.outputHandles(config, volumeMounts, step.containerMetadata))
+		if err != nil {
+			return false, err
+		}
+	}
+
+	state.StoreResult(step.planID, ExitStatus(result.ExitStatus))
+
 	delegate.Finished(logger, ExitStatus(result.ExitStatus))
 	return result.ExitStatus == 0, nil
 }
@@ -409,6 +512,19 @@ func (step *TaskStep) containerInputs(logger lager.Logger, repository *build.Rep
 	return inputs, nil
 }
 
+func (step *TaskStep) idToken(config atc.IDTokenConfig) (string, error) {
+	if step.idTokenIssuer == nil {
+		return "", errors.New("identity tokens are not supported by this ATC")
+	}
+
+	token, err := step.idTokenIssuer.IssueIDToken(step.metadata, step.plan.Name, config)
+	if err != nil {
+		return "", fmt.Errorf("issue id token: %w", err)
+	}
+
+	return token, nil
+}
+
 func (step *TaskStep) containerSpec(logger lager.Logger, state RunState, imageSpec runtime.ImageSpec, config atc.TaskConfig, metadata db.ContainerMetadata) (runtime.ContainerSpec, error) {
 	env := step.metadata.TaskEnv()
 	env = append(env, config.Params.Env()...)
@@ -424,6 +540,8 @@ func (step *TaskStep) containerSpec(logger lager.Logger, state RunState, imageSp
 		Type:      metadata.Type,
 
 		Dir: metadata.WorkingDirectory,
+
+		ServiceContainers: len(config.Services),
 	}
 
 	var err error
@@ -455,6 +573,7 @@ func (step *TaskStep) workerSpec(config atc.TaskConfig) worker.Spec {
 		Platform: config.Platform,
 		Tags:     step.plan.Tags,
 		TeamID:   step.metadata.TeamID,
+		Priority: step.metadata.Priority,
 	}
 }
 
@@ -477,6 +596,132 @@ func (step *TaskStep) registerOutputs(logger lager.Logger, repository *build.Rep
 	}
 }
 
+// outputHandles returns the handles of the volumes of the task's outputs,
+// keyed by their names in the task config.
+func (step *TaskStep) outputHandles(config atc.TaskConfig, volumeMounts []runtime.VolumeMount, metadata db.ContainerMetadata) map[string]string {
+	handles := map[string]string{}
+	for _, output := range config.Outputs {
+		outputPath := artifactPath(metadata.WorkingDirectory, output.Name, output.Path)
+
+		for _, mount := range volumeMounts {
+			if filepath.Clean(mount.MountPath) == filepath.Clean(outputPath) {
+				handles[output.Name] = mount.Volume.Handle()
+			}
+		}
+	}
+
+	return handles
+}
+
+// saveTestReports parses the test reports configured by the task and stores
+// their results with the build. Reports which are missing or malformed are
+// warned about rather than failing the step, as a failing test run may not
+// have gotten around to writing them.
+func (step *TaskStep) saveTestReports(ctx context.Context, logger lager.Logger, delegate TaskDelegate, config atc.TaskConfig, volumeMounts []runtime.VolumeMount) error {
+	volumes := map[string]runtime.Volume{}
+	for _, output := range config.Outputs {
+		outputPath := artifactPath(step.containerMetadata.WorkingDirectory, output.Name, output.Path)
+
+		for _, mount := range volumeMounts {
+			if filepath.Clean(mount.MountPath) == filepath.Clean(outputPath) {
+				volumes[output.Name] = mount.Volume
+			}
+		}
+	}
+
+	for _, report := range config.Reports {
+		outputName, file := report.Output()
+
+		volume, found := volumes[outputName]
+		if !found {
+			fmt.Fprintf(delegate.Stderr(), "[WARNING] test report %s: output '%s' not found\n", report.Path, outputName)
+			continue
+		}
+
+		results, err := step.parseTestReport(ctx, volume, file, report.Format)
+		if err != nil {
+			logger.Info("failed-to-parse-test-report", lager.Data{"path": report.Path, "error": err.Error()})
+			fmt.Fprintf(delegate.Stderr(), "[WARNING] test report %s: %s\n", report.Path, err)
+			continue
+		}
+
+		for i := range results {
+			results[i].Step = step.plan.Name
+		}
+
+		err = delegate.SaveTestResults(step.plan.Name, results)
+		if err != nil {
+			logger.Error("failed-to-save-test-results", err, lager.Data{"path": report.Path})
+			fmt.Fprintf(delegate.Stderr(), "[WARNING] test report %s: failed to save results: %s\n", report.Path, err)
+			continue
+		}
+	}
+
+	return nil
+}
+
+func (step *TaskStep) parseTestReport(ctx context.Context, volume runtime.Volume, file string, format string) ([]atc.TestResult, error) {
+	content, err := step.streamer.StreamFile(ctx, volume, file)
+	if err != nil {
+		return nil, err
+	}
+
+	defer content.Close()
+
+	return testreport.Parse(format, content)
+}
+
+// reuseResult registers the outputs of a previous run of the step with the
+// same cache key in place of running the task. If any of the output volumes
+// are gone, the task has to be run again.
+func (step *TaskStep) reuseResult(ctx context.Context, logger lager.Logger, repository *build.Repository, delegate TaskDelegate, config atc.TaskConfig, cacheKey string) (bool, error) {
+	handles, found, err := delegate.FindTaskResult(step.plan.Name, cacheKey)
+	if err != nil {
+		return false, err
+	}
+
+	if !found {
+		return false, nil
+	}
+
+	volumes := map[string]runtime.Volume{}
+	for _, output := range config.Outputs {
+		handle, found := handles[output.Name]
+		if !found {
+			return false, nil
+		}
+
+		volume, _, found, err := step.workerPool.LocateVolume(ctx, step.metadata.TeamID, handle)
+		if err != nil {
+			return false, err
+		}
+
+		if !found {
+			logger.Info("cached-output-volume-not-found", lager.Data{
+				"output": output.Name,
+				"handle": handle,
+			})
+
+			return false, nil
+		}
+
+		volumes[output.Name] = volume
+	}
+
+	delegate.TaskCacheHit(logger, cacheKey)
+
+	for name, volume := range volumes {
+		outputName := name
+		if destinationName, ok := step.plan.OutputMapping[name]; ok {
+			outputName = destinationName
+		}
+
+		repository.RegisterArtifact(build.ArtifactName(outputName), volume, false)
+	}
+
+	return true, nil
+}
+
 func (step *TaskStep) registerCaches(ctx context.Context, repository *build.Repository, config atc.TaskConfig, volumeMounts []runtime.VolumeMount, metadata db.ContainerMetadata) error {
 	logger := lagerctx.FromContext(ctx)
 	for _, cacheConfig := range config.Caches {
@@ -505,6 +750,85 @@ func (step *TaskStep) registerCaches(ctx context.Context, repository *build.Repo
 	return nil
 }
 
+// restoreCaches fills in caches which the worker did not have yet with their
+// contents in the TaskCacheStore. Failing to do so is not fatal; the task
+// will just run with an empty cache.
+func (step *TaskStep) restoreCaches(ctx context.Context, logger lager.Logger, config atc.TaskConfig, volumeMounts []runtime.VolumeMount) {
+	if step.cacheStore == nil || step.metadata.JobID == 0 {
+		return
+	}
+
+	for _, cacheConfig := range config.Caches {
+		volume, found := step.cacheVolume(cacheConfig, volumeMounts)
+		if !found {
+			continue
+		}
+
+		// caches found on the worker are copy-on-write clones of the
+		// existing cache volume, so only fresh, empty volumes are restored
+		if volume.DBVolume() != nil && volume.DBVolume().ParentHandle() != "" {
+			continue
+		}
+
+		restored, err := step.cacheStore.Restore(ctx, step.metadata.JobID, step.plan.Name, cacheConfig.Path, volume)
+		if err != nil {
+			logger.Error("failed-to-restore-cache", err, lager.Data{"cache": cacheConfig.Path})
+			continue
+		}
+
+		if restored {
+			logger.Debug("restored-cache", lager.Data{"cache": cacheConfig.Path})
+		}
+	}
+}
+
+// saveCaches uploads the caches of a successful run to the TaskCacheStore.
+// The upload happens in the background, as the step's outcome does not depend
+// on it, so it outlives the step's context.
+func (step *TaskStep) saveCaches(logger lager.Logger, config atc.TaskConfig, volumeMounts []runtime.VolumeMount) {
+	if step.cacheStore == nil || step.metadata.JobID == 0 {
+		return
+	}
+
+	var caches []atc.TaskCacheConfig
+	var volumes []runtime.Volume
+	for _, cacheConfig := range config.Caches {
+		volume, found := step.cacheVolume(cacheConfig, volumeMounts)
+		if !found {
+			continue
+		}
+
+		caches = append(caches, cacheConfig)
+		volumes = append(volumes, volume)
+	}
+
+	if len(caches) == 0 {
+		return
+	}
+
+	ctx := lagerctx.NewContext(context.Background(), logger)
+
+	go func() {
+		for i, cacheConfig := range caches {
+			err := step.cacheStore.Save(ctx, step.metadata.JobID, step.plan.Name, cacheConfig.Path, volumes[i])
+			if err != nil {
+				logger.Error("failed-to-save-cache", err, lager.Data{"cache": cacheConfig.Path})
+			}
+		}
+	}()
+}
+
+func (step *TaskStep) cacheVolume(cacheConfig atc.TaskCacheConfig, volumeMounts []runtime.VolumeMount) (runtime.Volume, bool) {
+	mountPath := resolvePath(step.containerMetadata.WorkingDirectory, cacheConfig.Path)
+	for _, volumeMount := range volumeMounts {
+		if filepath.Clean(volumeMount.MountPath) == mountPath {
+			return volumeMount.Volume, true
+		}
+	}
+
+	return nil, false
+}
+
 func artifactPath(workingDir string, name string, path string) string {
 	subdir := path
 	if path == "" {
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"strings"
 	"time"
 
 	"github.com/concourse/concourse/atc"
@@ -31,8 +33,11 @@ var _ = Describe("TaskStep", func() {
 		stdoutBuf *gbytes.Buffer
 		stderrBuf *gbytes.Buffer
 
-		fakePool     *execfakes.FakePool
-		fakeStreamer *execfakes.FakeStreamer
+		fakePool       *execfakes.FakePool
+		fakeStreamer   *execfakes.FakeStreamer
+		fakeCacheStore *execfakes.FakeTaskCacheStore
+
+		fakeIDTokenIssuer *execfakes.FakeIDTokenIssuer
 
 		fakeDelegate *execfakes.FakeTaskDelegate
 
@@ -76,6 +81,8 @@ var _ = Describe("TaskStep", func() {
 		stderrBuf = gbytes.NewBuffer()
 
 		fakeStreamer = new(execfakes.FakeStreamer)
+		fakeCacheStore = new(execfakes.FakeTaskCacheStore)
+		fakeIDTokenIssuer = new(execfakes.FakeIDTokenIssuer)
 
 		fakeDelegate = new(execfakes.FakeTaskDelegate)
 		fakeDelegate.StdoutReturns(stdoutBuf)
@@ -119,6 +126,8 @@ var _ = Describe("TaskStep", func() {
 			nil,
 			fakePool,
 			fakeStreamer,
+			fakeCacheStore,
+			fakeIDTokenIssuer,
 			fakeDelegateFactory,
 			defaultTaskTimeout,
 		)
@@ -181,6 +190,196 @@ var _ = Describe("TaskStep", func() {
 			Expect(chosenContainer.Spec.Env).To(ConsistOf("ATC_EXTERNAL_URL=http://foo.bar", "SECURE=secret-task-param"))
 		})
 
+		It("does not issue an id token", func() {
+			Expect(fakeIDTokenIssuer.IssueIDTokenCallCount()).To(BeZero())
+		})
+
+		Context("when the task requests an id token", func() {
+			BeforeEach(func() {
+				taskPlan.IDToken = &atc.IDTokenConfig{
+					Audience: []string{"sts.amazonaws.com"},
+				}
+
+				fakeIDTokenIssuer.IssueIDTokenReturns("some-id-token", nil)
+			})
+
+			It("issues a token for the step", func() {
+				Expect(fakeIDTokenIssuer.IssueIDTokenCallCount()).To(Equal(1))
+				metadata, stepName, config := fakeIDTokenIssuer.IssueIDTokenArgsForCall(0)
+				Expect(metadata).To(Equal(stepMetadata))
+				Expect(stepName).To(Equal("some-task"))
+				Expect(config).To(Equal(atc.IDTokenConfig{
+					Audience: []string{"sts.amazonaws.com"},
+				}))
+			})
+
+			It("adds the token to the task env", func() {
+				Expect(chosenContainer.Spec.Env).To(ContainElement("CONCOURSE_ID_TOKEN=some-id-token"))
+			})
+
+			Context("when a param name is given", func() {
+				BeforeEach(func() {
+					taskPlan.IDToken.Param = "AWS_WEB_IDENTITY_TOKEN"
+				})
+
+				It("adds the token to the task env under that name", func() {
+					Expect(chosenContainer.Spec.Env).To(ContainElement("AWS_WEB_IDENTITY_TOKEN=some-id-token"))
+					Expect(chosenContainer.Spec.Env).ToNot(ContainElement(HavePrefix("CONCOURSE_ID_TOKEN=")))
+				})
+			})
+
+			Context("when issuing the token fails", func() {
+				disaster := errors.New("nope")
+
+				BeforeEach(func() {
+					fakeIDTokenIssuer.IssueIDTokenReturns("", disaster)
+				})
+
+				It("returns the error", func() {
+					Expect(stepErr).To(MatchError(disaster))
+				})
+			})
+		})
+
+		Context("when the task has services", func() {
+			var serviceOwner db.ContainerOwner
+			var serviceContainer *runtimetest.Container
+			var serviceStopped chan struct{}
+
+			var serviceProcess runtime.ProcessSpec
+			var readinessProbe runtime.ProcessSpec
+
+			BeforeEach(func() {
+				taskPlan.Config.Services = []atc.TaskServiceConfig{
+					{
+						Name: "postgres",
+						ImageResource: &atc.ImageResource{
+							Type:   "registry-image",
+							Source: atc.Source{"repository": "postgres"},
+						},
+						Params: atc.TaskEnv{"POSTGRES_PASSWORD": "password"},
+						Run: atc.TaskRunConfig{
+							Path: "docker-entrypoint.sh",
+							Args: []string{"postgres"},
+						},
+						Readiness: &atc.TaskServiceReadinessConfig{
+							Run:      atc.TaskRunConfig{Path: "pg_isready"},
+							Interval: "1ms",
+						},
+					},
+				}
+
+				fakeDelegate.FetchServiceImageReturns(runtime.ImageSpec{ImageURL: "postgres-image"}, nil)
+
+				chosenContainer.DBContainer_.HandleReturns("task-handle")
+
+				serviceProcess = runtime.ProcessSpec{
+					ID:   "service",
+					Path: "docker-entrypoint.sh",
+					Args: []string{"postgres"},
+				}
+				readinessProbe = runtime.ProcessSpec{Path: "pg_isready"}
+
+				serviceOwner = db.NewBuildStepContainerOwner(stepMetadata.BuildID, planID+"/services/postgres", stepMetadata.TeamID)
+				serviceStopped = make(chan struct{})
+				serviceContainer = runtimetest.NewContainer().
+					WithProcess(serviceProcess, runtimetest.ProcessStub{
+						Call: func(ctx context.Context, _ *runtimetest.Process) (runtime.ProcessResult, error) {
+							<-ctx.Done()
+							close(serviceStopped)
+							return runtime.ProcessResult{}, ctx.Err()
+						},
+					}).
+					WithProcess(readinessProbe, runtimetest.ProcessStub{ExitStatus: 1}).
+					WithProcess(readinessProbe, runtimetest.ProcessStub{ExitStatus: 0})
+
+				chosenWorker.AddContainer(serviceOwner, serviceContainer, nil)
+			})
+
+			It("succeeds", func() {
+				Expect(stepErr).ToNot(HaveOccurred())
+				Expect(stepOk).To(BeTrue())
+			})
+
+			It("fetches the service image", func() {
+				Expect(fakeDelegate.FetchServiceImageCallCount()).To(Equal(1))
+				_, name, image, types, privileged, _, _ := fakeDelegate.FetchServiceImageArgsForCall(0)
+				Expect(name).To(Equal("postgres"))
+				Expect(image.Source).To(Equal(atc.Source{"repository": "postgres"}))
+				Expect(types).To(Equal(taskPlan.ResourceTypes))
+				Expect(privileged).To(BeFalse())
+			})
+
+			It("accounts for the service when selecting a worker", func() {
+				_, _, spec, _, _, _ := fakePool.FindOrSelectWorkerArgsForCall(0)
+				Expect(spec.ServiceContainers).To(Equal(1))
+			})
+
+			It("creates the service container in the task container's network", func() {
+				workerContainer, _, found := chosenWorker.FindContainerByOwner(serviceOwner)
+				Expect(found).To(BeTrue())
+				Expect(workerContainer.Spec.NetworkContainerHandle).To(Equal("task-handle"))
+				Expect(workerContainer.Spec.Type).To(Equal(db.ContainerTypeService))
+				Expect(workerContainer.Spec.ImageSpec).To(Equal(runtime.ImageSpec{ImageURL: "postgres-image"}))
+				Expect(workerContainer.Spec.Env).To(ConsistOf("POSTGRES_PASSWORD=password"))
+			})
+
+			It("probes the service until it is ready before running the task", func() {
+				Expect(serviceContainer.RunningProcesses()).To(HaveLen(3))
+				Expect(chosenContainer.RunningProcesses()).To(HaveLen(1))
+			})
+
+			It("stops the service once the task has finished", func() {
+				Eventually(serviceStopped).Should(BeClosed())
+			})
+
+			It("destroys the service container once the task has finished", func() {
+				Expect(serviceContainer.DBContainer_.DestroyingCallCount()).To(Equal(1))
+			})
+
+			Context("when the service exits before becoming ready", func() {
+				BeforeEach(func() {
+					serviceContainer.ProcessDefs = []runtimetest.ProcessDefinition{
+						{Spec: serviceProcess, Stub: runtimetest.ProcessStub{ExitStatus: 2}},
+						{Spec: readinessProbe, Stub: runtimetest.ProcessStub{
+							Do: func(context.Context, *runtimetest.Process) error {
+								// give the service a chance to exit
+								time.Sleep(10 * time.Millisecond)
+								return nil
+							},
+							ExitStatus: 1,
+						}},
+					}
+				})
+
+				It("errors without running the task", func() {
+					Expect(stepErr).To(Equal(exec.ServiceExitedError{Name: "postgres", ExitStatus: 2}))
+					Expect(chosenContainer.RunningProcesses()).To(BeEmpty())
+				})
+
+				It("still destroys the service container", func() {
+					Expect(serviceContainer.DBContainer_.DestroyingCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the service does not become ready in time", func() {
+				BeforeEach(func() {
+					taskPlan.Config.Services[0].Readiness.Timeout = "10ms"
+					serviceContainer.ProcessDefs[1].Stub = runtimetest.ProcessStub{
+						Call: func(ctx context.Context, _ *runtimetest.Process) (runtime.ProcessResult, error) {
+							<-ctx.Done()
+							return runtime.ProcessResult{}, ctx.Err()
+						},
+					}
+				})
+
+				It("errors without running the task", func() {
+					Expect(stepErr).To(Equal(exec.ServiceNotReadyError{Name: "postgres", Timeout: 10 * time.Millisecond}))
+					Expect(chosenContainer.RunningProcesses()).To(BeEmpty())
+				})
+			})
+		})
+
 		Context("before running the task", func() {
 			BeforeEach(func() {
 				chosenContainer.ProcessDefs[0].Stub.Do = func(_ context.Context, _ *runtimetest.Process) error {
@@ -231,6 +430,16 @@ var _ = Describe("TaskStep", func() {
 				})
 			})
 
+			Context("when the build has a priority", func() {
+				BeforeEach(func() {
+					stepMetadata.Priority = 10
+				})
+
+				It("creates a worker spec with the priority", func() {
+					Expect(workerSpec.Priority).To(Equal(10))
+				})
+			})
+
 			Context("when selecting a worker fails", func() {
 				BeforeEach(func() {
 					fakePool.FindOrSelectWorkerReturns(nil, errors.New("nope"))
@@ -605,6 +814,115 @@ var _ = Describe("TaskStep", func() {
 
 				itRegistersCaches(false)
 			})
+
+			Context("when the caches are kept in the task cache store", func() {
+				BeforeEach(func() {
+					stepMetadata.JobID = 12
+				})
+
+				Context("when the worker does not have the caches yet", func() {
+					It("restores them before running the task", func() {
+						Expect(fakeCacheStore.RestoreCallCount()).To(Equal(2))
+
+						_, jobID, stepName, path, volume := fakeCacheStore.RestoreArgsForCall(0)
+						Expect(jobID).To(Equal(12))
+						Expect(stepName).To(Equal("some-task"))
+						Expect(path).To(Equal("some-path-1"))
+						Expect(volume).To(Equal(volume1))
+
+						_, _, _, path, volume = fakeCacheStore.RestoreArgsForCall(1)
+						Expect(path).To(Equal("some-path-2"))
+						Expect(volume).To(Equal(volume2))
+					})
+
+					Context("when restoring fails", func() {
+						BeforeEach(func() {
+							fakeCacheStore.RestoreReturns(false, errors.New("nope"))
+						})
+
+						It("runs the task with empty caches", func() {
+							Expect(stepErr).ToNot(HaveOccurred())
+							Expect(stepOk).To(BeTrue())
+						})
+					})
+				})
+
+				Context("when the worker already has the caches", func() {
+					BeforeEach(func() {
+						volume1.DBVolume_.ParentHandleReturns("existing-cache-1")
+						volume2.DBVolume_.ParentHandleReturns("existing-cache-2")
+					})
+
+					It("does not restore them", func() {
+						Expect(fakeCacheStore.RestoreCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when the task succeeds", func() {
+					It("saves the caches", func() {
+						Eventually(fakeCacheStore.SaveCallCount).Should(Equal(2))
+
+						_, jobID, stepName, path, volume := fakeCacheStore.SaveArgsForCall(0)
+						Expect(jobID).To(Equal(12))
+						Expect(stepName).To(Equal("some-task"))
+						Expect(path).To(Equal("some-path-1"))
+						Expect(volume).To(Equal(volume1))
+					})
+
+					Context("when saving takes a while", func() {
+						var saved chan struct{}
+
+						BeforeEach(func() {
+							saved = make(chan struct{})
+							fakeCacheStore.SaveStub = func(context.Context, int, string, string, runtime.Volume) error {
+								<-saved
+								return nil
+							}
+						})
+
+						AfterEach(func() {
+							close(saved)
+						})
+
+						It("does not wait for it", func() {
+							Expect(stepErr).ToNot(HaveOccurred())
+							Expect(stepOk).To(BeTrue())
+						})
+					})
+
+					Context("when saving fails", func() {
+						BeforeEach(func() {
+							fakeCacheStore.SaveReturns(errors.New("nope"))
+						})
+
+						It("still succeeds", func() {
+							Expect(stepErr).ToNot(HaveOccurred())
+							Expect(stepOk).To(BeTrue())
+						})
+					})
+				})
+
+				Context("when the task exits nonzero", func() {
+					BeforeEach(func() {
+						chosenContainer.ProcessDefs[0].Stub.ExitStatus = 1
+					})
+
+					It("does not save the caches", func() {
+						Consistently(fakeCacheStore.SaveCallCount).Should(BeZero())
+					})
+				})
+
+				Context("when the task is a one-off build", func() {
+					BeforeEach(func() {
+						stepMetadata.JobID = 0
+					})
+
+					It("neither restores nor saves the caches", func() {
+						Expect(fakeCacheStore.RestoreCallCount()).To(BeZero())
+						Consistently(fakeCacheStore.SaveCallCount).Should(BeZero())
+					})
+				})
+			})
 		})
 
 		Context("when the configuration specifies paths for outputs", func() {
@@ -666,6 +984,283 @@ var _ = Describe("TaskStep", func() {
 			})
 		})
 
+		Context("when the task writes test reports", func() {
+			var outputVolume *runtimetest.Volume
+
+			BeforeEach(func() {
+				taskPlan.Config.Outputs = []atc.TaskOutputConfig{{Name: "some-output"}}
+				taskPlan.Config.Reports = []atc.TaskReportConfig{
+					{Path: "some-output/report.xml", Format: atc.TestReportFormatJUnit},
+				}
+
+				outputVolume = runtimetest.NewVolume("output")
+				chosenContainer.Mounts = []runtime.VolumeMount{
+					{
+						Volume:    outputVolume,
+						MountPath: "some-artifact-root/some-output/",
+					},
+				}
+
+				fakeStreamer.StreamFileReturns(io.NopCloser(strings.NewReader(`
+					<testsuite name="some-suite">
+						<testcase name="passes"/>
+						<testcase name="fails"><failure message="boom"/></testcase>
+					</testsuite>
+				`)), nil)
+			})
+
+			Context("when the task fails", func() {
+				BeforeEach(func() {
+					chosenContainer.ProcessDefs[0].Stub.ExitStatus = 1
+				})
+
+				It("still saves the results of the report", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+					Expect(stepOk).To(BeFalse())
+
+					_, artifact, path := fakeStreamer.StreamFileArgsForCall(0)
+					Expect(artifact).To(Equal(outputVolume))
+					Expect(path).To(Equal("report.xml"))
+
+					Expect(fakeDelegate.SaveTestResultsCallCount()).To(Equal(1))
+					stepName, results := fakeDelegate.SaveTestResultsArgsForCall(0)
+					Expect(stepName).To(Equal("some-task"))
+					Expect(results).To(Equal([]atc.TestResult{
+						{Step: "some-task", Suite: "some-suite", Name: "passes", Status: atc.TestStatusPassed},
+						{Step: "some-task", Suite: "some-suite", Name: "fails", Status: atc.TestStatusFailed, Message: "boom"},
+					}))
+				})
+			})
+
+			Context("when the report cannot be read", func() {
+				BeforeEach(func() {
+					fakeStreamer.StreamFileReturns(nil, errors.New("file not found"))
+				})
+
+				It("warns without failing the step", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+					Expect(stepOk).To(BeTrue())
+					Expect(stderrBuf).To(gbytes.Say(`\[WARNING\] test report some-output/report.xml: file not found`))
+					Expect(fakeDelegate.SaveTestResultsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when saving the results fails", func() {
+				BeforeEach(func() {
+					fakeDelegate.SaveTestResultsReturns(errors.New("disaster"))
+				})
+
+				It("warns without failing the step", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+					Expect(stepOk).To(BeTrue())
+					Expect(stderrBuf).To(gbytes.Say(`\[WARNING\] test report some-output/report.xml: failed to save results: disaster`))
+				})
+			})
+		})
+
+		Context("when the task caches its result", func() {
+			var inputVolume, outputVolume, cachedVolume *runtimetest.Volume
+
+			BeforeEach(func() {
+				stepMetadata.JobID = 12345
+
+				taskPlan.CacheResult = true
+				taskPlan.Config.Inputs = []atc.TaskInputConfig{{Name: "some-input"}}
+				taskPlan.Config.Outputs = []atc.TaskOutputConfig{{Name: "some-output"}}
+				taskPlan.OutputMapping = map[string]string{"some-output": "some-remapped-output"}
+
+				inputVolume = runtimetest.NewVolume("input").WithContent(runtimetest.VolumeContent{
+					"some-file": {Data: []byte("some-content")},
+				})
+				repo.RegisterArtifact("some-input", inputVolume, false)
+
+				outputVolume = runtimetest.NewVolume("output")
+				chosenContainer.Mounts = []runtime.VolumeMount{
+					{
+						Volume:    outputVolume,
+						MountPath: "some-artifact-root/some-output/",
+					},
+				}
+
+				cachedVolume = runtimetest.NewVolume("cached-output")
+			})
+
+			Context("when no previous result is found", func() {
+				BeforeEach(func() {
+					fakeDelegate.FindTaskResultReturns(nil, false, nil)
+				})
+
+				It("runs the task", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+					Expect(stepOk).To(BeTrue())
+					Expect(chosenContainer.RunningProcesses()).To(HaveLen(1))
+				})
+
+				It("saves the outputs under the key it looked up", func() {
+					stepName, key := fakeDelegate.FindTaskResultArgsForCall(0)
+					Expect(stepName).To(Equal("some-task"))
+					Expect(key).ToNot(BeEmpty())
+
+					Expect(fakeDelegate.SaveTaskResultCallCount()).To(Equal(1))
+					savedStepName, savedKey, outputs := fakeDelegate.SaveTaskResultArgsForCall(0)
+					Expect(savedStepName).To(Equal("some-task"))
+					Expect(savedKey).To(Equal(key))
+					Expect(outputs).To(Equal(map[string]string{"some-output": "output"}))
+				})
+
+				It("computes the same key for the same inputs", func() {
+					_, key := fakeDelegate.FindTaskResultArgsForCall(0)
+
+					fakeDelegate.FindTaskResultReturns(nil, false, errors.New("stop"))
+
+					_, err := taskStep.Run(ctx, state)
+					Expect(err).To(MatchError("stop"))
+
+					_, rerunKey := fakeDelegate.FindTaskResultArgsForCall(1)
+					Expect(rerunKey).To(Equal(key))
+				})
+
+				It("computes a different key when an input is a different volume", func() {
+					_, key := fakeDelegate.FindTaskResultArgsForCall(0)
+
+					fakeDelegate.FindTaskResultReturns(nil, false, errors.New("stop"))
+					repo.RegisterArtifact("some-input", runtimetest.NewVolume("other-input").WithContent(runtimetest.VolumeContent{
+						"some-file": {Data: []byte("some-content")},
+					}), false)
+
+					_, err := taskStep.Run(ctx, state)
+					Expect(err).To(MatchError("stop"))
+
+					_, rerunKey := fakeDelegate.FindTaskResultArgsForCall(1)
+					Expect(rerunKey).ToNot(Equal(key))
+				})
+
+				It("does not read the contents of input volumes", func() {
+					_, key := fakeDelegate.FindTaskResultArgsForCall(0)
+
+					fakeDelegate.FindTaskResultReturns(nil, false, errors.New("stop"))
+					inputVolume.Content["some-file"].Data = []byte("other-content")
+
+					_, err := taskStep.Run(ctx, state)
+					Expect(err).To(MatchError("stop"))
+
+					_, rerunKey := fakeDelegate.FindTaskResultArgsForCall(1)
+					Expect(rerunKey).To(Equal(key))
+				})
+
+				Context("when an input is a resource cache", func() {
+					BeforeEach(func() {
+						inputVolume.DBVolume_.GetResourceCacheIDReturns(42)
+					})
+
+					It("computes the same key for other volumes of the resource cache", func() {
+						_, key := fakeDelegate.FindTaskResultArgsForCall(0)
+
+						fakeDelegate.FindTaskResultReturns(nil, false, errors.New("stop"))
+						otherVolume := runtimetest.NewVolume("other-input")
+						otherVolume.DBVolume_.GetResourceCacheIDReturns(42)
+						repo.RegisterArtifact("some-input", otherVolume, false)
+
+						_, err := taskStep.Run(ctx, state)
+						Expect(err).To(MatchError("stop"))
+
+						_, rerunKey := fakeDelegate.FindTaskResultArgsForCall(1)
+						Expect(rerunKey).To(Equal(key))
+					})
+				})
+
+				Context("when an input is not a volume", func() {
+					BeforeEach(func() {
+						repo.RegisterArtifact("some-input", runtimetest.Artifact{Content: runtimetest.VolumeContent{
+							"some-file": {Data: []byte("some-content")},
+						}}, false)
+					})
+
+					It("computes a different key when its contents change", func() {
+						_, key := fakeDelegate.FindTaskResultArgsForCall(0)
+
+						fakeDelegate.FindTaskResultReturns(nil, false, errors.New("stop"))
+						repo.RegisterArtifact("some-input", runtimetest.Artifact{Content: runtimetest.VolumeContent{
+							"some-file": {Data: []byte("other-content")},
+						}}, false)
+
+						_, err := taskStep.Run(ctx, state)
+						Expect(err).To(MatchError("stop"))
+
+						_, rerunKey := fakeDelegate.FindTaskResultArgsForCall(1)
+						Expect(rerunKey).ToNot(Equal(key))
+					})
+				})
+			})
+
+			Context("when the build is a one-off build", func() {
+				BeforeEach(func() {
+					stepMetadata.JobID = 0
+				})
+
+				It("runs the task without looking up a previous result", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+					Expect(fakeDelegate.FindTaskResultCallCount()).To(BeZero())
+					Expect(fakeDelegate.SaveTaskResultCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when a previous result is found", func() {
+				BeforeEach(func() {
+					fakeDelegate.FindTaskResultReturns(map[string]string{"some-output": "cached-output"}, true, nil)
+				})
+
+				Context("when its output volumes are still around", func() {
+					BeforeEach(func() {
+						fakePool.LocateVolumeReturns(cachedVolume, chosenWorker, true, nil)
+					})
+
+					It("does not run the task", func() {
+						Expect(stepErr).ToNot(HaveOccurred())
+						Expect(stepOk).To(BeTrue())
+						Expect(fakePool.FindOrSelectWorkerCallCount()).To(BeZero())
+					})
+
+					It("registers the cached outputs under their mapped names", func() {
+						artifact, _, found := repo.ArtifactFor("some-remapped-output")
+						Expect(found).To(BeTrue())
+						Expect(artifact).To(Equal(cachedVolume))
+					})
+
+					It("emits a cache hit and finishes successfully", func() {
+						Expect(fakeDelegate.TaskCacheHitCallCount()).To(Equal(1))
+
+						Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+						_, status := fakeDelegate.FinishedArgsForCall(0)
+						Expect(status).To(Equal(exec.ExitStatus(0)))
+
+						var result exec.ExitStatus
+						Expect(state.Result(planID, &result)).To(BeTrue())
+						Expect(result).To(Equal(exec.ExitStatus(0)))
+					})
+				})
+
+				Context("when an output volume is gone", func() {
+					BeforeEach(func() {
+						fakePool.LocateVolumeReturns(nil, nil, false, nil)
+					})
+
+					It("runs the task", func() {
+						Expect(stepErr).ToNot(HaveOccurred())
+						Expect(fakeDelegate.TaskCacheHitCallCount()).To(BeZero())
+						Expect(chosenContainer.RunningProcesses()).To(HaveLen(1))
+					})
+				})
+			})
+		})
+
+		Context("when the task does not cache its result", func() {
+			It("does not look up a previous result", func() {
+				Expect(fakeDelegate.FindTaskResultCallCount()).To(BeZero())
+				Expect(fakeDelegate.SaveTaskResultCallCount()).To(BeZero())
+			})
+		})
+
 		Context("when missing the platform", func() {
 			BeforeEach(func() {
 				taskPlan.Config.Platform = ""
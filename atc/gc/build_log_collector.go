@@ -7,29 +7,36 @@ import (
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/eventstore"
 )
 
 type buildLogCollector struct {
 	pipelineFactory             db.PipelineFactory
 	pipelineLifecycle           db.PipelineLifecycle
+	teamFactory                 db.TeamFactory
 	batchSize                   int
 	drainerConfigured           bool
 	buildLogRetentionCalculator BuildLogRetentionCalculator
+	eventStore                  *eventstore.Store
 }
 
 func NewBuildLogCollector(
 	pipelineFactory db.PipelineFactory,
 	pipelineLifecycle db.PipelineLifecycle,
+	teamFactory db.TeamFactory,
 	batchSize int,
 	buildLogRetentionCalculator BuildLogRetentionCalculator,
 	drainerConfigured bool,
+	eventStore *eventstore.Store,
 ) *buildLogCollector {
 	return &buildLogCollector{
 		pipelineFactory:             pipelineFactory,
 		pipelineLifecycle:           pipelineLifecycle,
+		teamFactory:                 teamFactory,
 		batchSize:                   batchSize,
 		drainerConfigured:           drainerConfigured,
 		buildLogRetentionCalculator: buildLogRetentionCalculator,
+		eventStore:                  eventStore,
 	}
 }
 
@@ -39,12 +46,48 @@ func (br *buildLogCollector) Run(ctx context.Context) error {
 	logger.Debug("start")
 	defer logger.Debug("done")
 
-	err := br.pipelineLifecycle.RemoveBuildEventsForDeletedPipelines()
+	deletedPipelineIDs, err := br.pipelineLifecycle.RemoveBuildEventsForDeletedPipelines()
 	if err != nil {
 		logger.Error("failed-to-remove-build-events-for-deleted-pipelines", err)
 		return err
 	}
 
+	// the offloaded events are deleted before the pipelines are forgotten so
+	// that a failure is retried on the next run rather than orphaning them
+	if br.eventStore != nil && len(deletedPipelineIDs) > 0 {
+		err = br.eventStore.DeletePipelines(ctx, deletedPipelineIDs)
+		if err != nil {
+			logger.Error("failed-to-remove-offloaded-build-events-for-deleted-pipelines", err)
+			return err
+		}
+	}
+
+	err = br.pipelineLifecycle.ForgetDeletedPipelines(deletedPipelineIDs)
+	if err != nil {
+		logger.Error("failed-to-forget-deleted-pipelines", err)
+		return err
+	}
+
+	deletedTeamIDs, err := br.teamFactory.DeletedTeams()
+	if err != nil {
+		logger.Error("failed-to-get-deleted-teams", err)
+		return err
+	}
+
+	if br.eventStore != nil && len(deletedTeamIDs) > 0 {
+		err = br.eventStore.DeleteTeams(ctx, deletedTeamIDs)
+		if err != nil {
+			logger.Error("failed-to-remove-offloaded-build-events-for-deleted-teams", err)
+			return err
+		}
+	}
+
+	err = br.teamFactory.ForgetDeletedTeams(deletedTeamIDs)
+	if err != nil {
+		logger.Error("failed-to-forget-deleted-teams", err)
+		return err
+	}
+
 	pipelines, err := br.pipelineFactory.AllPipelines()
 	if err != nil {
 		logger.Error("failed-to-get-pipelines", err)
@@ -67,7 +110,7 @@ func (br *buildLogCollector) Run(ctx context.Context) error {
 				continue
 			}
 
-			err = br.reapLogsOfJob(pipeline, job, logger)
+			err = br.reapLogsOfJob(ctx, pipeline, job, logger)
 			if err != nil {
 				continue
 			}
@@ -77,7 +120,8 @@ func (br *buildLogCollector) Run(ctx context.Context) error {
 	return nil
 }
 
-func (br *buildLogCollector) reapLogsOfJob(pipeline db.Pipeline,
+func (br *buildLogCollector) reapLogsOfJob(ctx context.Context,
+	pipeline db.Pipeline,
 	job db.Job,
 	logger lager.Logger) error {
 
@@ -213,6 +257,14 @@ func (br *buildLogCollector) reapLogsOfJob(pipeline db.Pipeline,
 		return err
 	}
 
+	if br.eventStore != nil {
+		err = br.eventStore.DeleteBuilds(ctx, pipeline.ID(), buildIDsToDelete)
+		if err != nil {
+			logger.Error("failed-to-delete-offloaded-build-events", err)
+			return err
+		}
+	}
+
 	if firstLoggedBuildID > job.FirstLoggedBuildID() {
 		err = job.UpdateFirstLoggedBuildID(firstLoggedBuildID)
 		if err != nil {
@@ -9,8 +9,11 @@ import (
 	"code.cloudfoundry.org/lager/lagerctx"
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/blobstore"
+	"github.com/concourse/concourse/atc/blobstore/blobstorefakes"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/eventstore"
 	. "github.com/concourse/concourse/atc/gc"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -23,28 +26,36 @@ var _ = Describe("BuildLogCollector", func() {
 		buildLogCollector     GcCollector
 		fakePipelineFactory   *dbfakes.FakePipelineFactory
 		fakePipelineLifecycle *dbfakes.FakePipelineLifecycle
+		fakeTeamFactory       *dbfakes.FakeTeamFactory
 		batchSize             int
 		buildLogRetainCalc    BuildLogRetentionCalculator
 		logger                *lagertest.TestLogger
 		ctx                   context.Context
+		fakeEventObjects      *blobstorefakes.FakeObjectStore
+		eventStore            *eventstore.Store
 	)
 
 	BeforeEach(func() {
 		fakePipelineFactory = new(dbfakes.FakePipelineFactory)
 		fakePipelineLifecycle = new(dbfakes.FakePipelineLifecycle)
+		fakeTeamFactory = new(dbfakes.FakeTeamFactory)
 		batchSize = 5
 		buildLogRetainCalc = NewBuildLogRetentionCalculator(0, 0, 0, 0)
 		logger = lagertest.NewTestLogger("test")
 		ctx = lagerctx.NewContext(context.Background(), logger)
+		fakeEventObjects = new(blobstorefakes.FakeObjectStore)
+		eventStore = nil
 	})
 
 	JustBeforeEach(func() {
 		buildLogCollector = NewBuildLogCollector(
 			fakePipelineFactory,
 			fakePipelineLifecycle,
+			fakeTeamFactory,
 			batchSize,
 			buildLogRetainCalc,
 			false,
+			eventStore,
 		)
 	})
 
@@ -52,11 +63,12 @@ var _ = Describe("BuildLogCollector", func() {
 		err := buildLogCollector.Run(ctx)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(fakePipelineLifecycle.RemoveBuildEventsForDeletedPipelinesCallCount()).To(Equal(1))
+		Expect(fakePipelineLifecycle.ForgetDeletedPipelinesCallCount()).To(Equal(1))
 	})
 
 	Context("when removing build events from deleted pipelines fails", func() {
 		BeforeEach(func() {
-			fakePipelineLifecycle.RemoveBuildEventsForDeletedPipelinesReturns(errors.New("error"))
+			fakePipelineLifecycle.RemoveBuildEventsForDeletedPipelinesReturns(nil, errors.New("error"))
 		})
 
 		It("errors", func() {
@@ -65,6 +77,93 @@ var _ = Describe("BuildLogCollector", func() {
 		})
 	})
 
+	It("forgets about deleted teams", func() {
+		err := buildLogCollector.Run(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fakeTeamFactory.DeletedTeamsCallCount()).To(Equal(1))
+		Expect(fakeTeamFactory.ForgetDeletedTeamsCallCount()).To(Equal(1))
+	})
+
+	Context("when getting deleted teams fails", func() {
+		BeforeEach(func() {
+			fakeTeamFactory.DeletedTeamsReturns(nil, errors.New("error"))
+		})
+
+		It("errors", func() {
+			err := buildLogCollector.Run(ctx)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("when build events are offloaded to an event store", func() {
+		BeforeEach(func() {
+			eventStore = eventstore.NewStore(fakeEventObjects)
+
+			fakePipelineLifecycle.RemoveBuildEventsForDeletedPipelinesReturns([]int{1}, nil)
+			fakeEventObjects.ListReturns([]blobstore.Object{
+				{Key: "pipelines/1/10.json.gz"},
+				{Key: "pipelines/1/11.json.gz"},
+			}, nil)
+		})
+
+		It("removes the offloaded build events of deleted pipelines", func() {
+			err := buildLogCollector.Run(ctx)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeEventObjects.ListCallCount()).To(Equal(1))
+			_, prefix := fakeEventObjects.ListArgsForCall(0)
+			Expect(prefix).To(Equal("pipelines/1/"))
+
+			Expect(fakeEventObjects.DeleteCallCount()).To(Equal(2))
+			_, key := fakeEventObjects.DeleteArgsForCall(0)
+			Expect(key).To(Equal("pipelines/1/10.json.gz"))
+			_, key = fakeEventObjects.DeleteArgsForCall(1)
+			Expect(key).To(Equal("pipelines/1/11.json.gz"))
+		})
+
+		Context("when teams have been deleted", func() {
+			BeforeEach(func() {
+				fakePipelineLifecycle.RemoveBuildEventsForDeletedPipelinesReturns(nil, nil)
+				fakeTeamFactory.DeletedTeamsReturns([]int{2}, nil)
+				fakeEventObjects.ListReturns([]blobstore.Object{
+					{Key: "teams/2/12.json.gz"},
+				}, nil)
+			})
+
+			It("removes the offloaded events of their one-off builds", func() {
+				err := buildLogCollector.Run(ctx)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeEventObjects.ListCallCount()).To(Equal(1))
+				_, prefix := fakeEventObjects.ListArgsForCall(0)
+				Expect(prefix).To(Equal("teams/2/"))
+
+				Expect(fakeEventObjects.DeleteCallCount()).To(Equal(1))
+				_, key := fakeEventObjects.DeleteArgsForCall(0)
+				Expect(key).To(Equal("teams/2/12.json.gz"))
+
+				Expect(fakeTeamFactory.ForgetDeletedTeamsCallCount()).To(Equal(1))
+				Expect(fakeTeamFactory.ForgetDeletedTeamsArgsForCall(0)).To(Equal([]int{2}))
+			})
+		})
+
+		Context("when removing the offloaded build events fails", func() {
+			BeforeEach(func() {
+				fakeEventObjects.DeleteReturns(errors.New("error"))
+			})
+
+			It("errors", func() {
+				err := buildLogCollector.Run(ctx)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("does not forget the deleted pipelines", func() {
+				buildLogCollector.Run(ctx)
+				Expect(fakePipelineLifecycle.ForgetDeletedPipelinesCallCount()).To(BeZero())
+			})
+		})
+	})
+
 	Context("when there is a pipeline", func() {
 		var fakePipeline *dbfakes.FakePipeline
 
@@ -108,9 +207,11 @@ var _ = Describe("BuildLogCollector", func() {
 					buildLogCollector = NewBuildLogCollector(
 						fakePipelineFactory,
 						fakePipelineLifecycle,
+						fakeTeamFactory,
 						batchSize,
 						buildLogRetainCalc,
 						true,
+						nil,
 					)
 				})
 				BeforeEach(func() {
@@ -161,9 +262,11 @@ var _ = Describe("BuildLogCollector", func() {
 					buildLogCollector = NewBuildLogCollector(
 						fakePipelineFactory,
 						fakePipelineLifecycle,
+						fakeTeamFactory,
 						batchSize,
 						buildLogRetainCalc,
 						false,
+						nil,
 					)
 					fakeJob.ChronoBuildsStub = func(page db.Page) ([]db.BuildForAPI, db.Pagination, error) {
 						if *page.From == 5 {
@@ -380,6 +483,21 @@ var _ = Describe("BuildLogCollector", func() {
 					actualBuildIDs := fakePipeline.DeleteBuildEventsByBuildIDsArgsForCall(0)
 					Expect(actualBuildIDs).To(ConsistOf(5))
 				})
+
+				Context("when build events are offloaded to an event store", func() {
+					BeforeEach(func() {
+						eventStore = eventstore.NewStore(fakeEventObjects)
+					})
+
+					It("deletes the offloaded events too", func() {
+						err := buildLogCollector.Run(ctx)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(fakeEventObjects.DeleteCallCount()).To(Equal(1))
+						_, key := fakeEventObjects.DeleteArgsForCall(0)
+						Expect(key).To(Equal("pipelines/42/5.json.gz"))
+					})
+				})
 			})
 
 			Context("when only date is set", func() {
@@ -56,6 +56,12 @@ func (c *containerCollector) Run(ctx context.Context) error {
 		logger.Error("failed-to-clean-up-failed-containers", err)
 	}
 
+	err = c.destroyCompletedBuildServiceContainers(logger.Session("service-containers"))
+	if err != nil {
+		errs = multierror.Append(errs, err)
+		logger.Error("failed-to-clean-up-service-containers", err)
+	}
+
 	_, err = c.containerRepository.RemoveMissingContainers(c.missingContainerGracePeriod)
 	if err != nil {
 		errs = multierror.Append(errs, err)
@@ -86,6 +92,22 @@ func (c *containerCollector) markFailedContainersAsDestroying(logger lager.Logge
 	return nil
 }
 
+func (c *containerCollector) destroyCompletedBuildServiceContainers(logger lager.Logger) error {
+	numServiceContainers, err := c.containerRepository.DestroyCompletedBuildServiceContainers()
+	if err != nil {
+		logger.Error("failed-to-destroy-service-containers", err)
+		return err
+	}
+
+	if numServiceContainers > 0 {
+		logger.Debug("found-service-containers-for-deletion", lager.Data{
+			"number": numServiceContainers,
+		})
+	}
+
+	return nil
+}
+
 func (c *containerCollector) cleanupOrphanedContainers(logger lager.Logger) error {
 	_, err := c.containerRepository.DestroyDirtyInMemoryBuildContainers()
 	if err != nil {
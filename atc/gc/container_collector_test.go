@@ -83,6 +83,28 @@ var _ = Describe("ContainerCollector", func() {
 			})
 		})
 
+		Describe("Service Containers", func() {
+			It("tries to destroy the service containers of completed builds", func() {
+				Expect(fakeContainerRepository.DestroyCompletedBuildServiceContainersCallCount()).To(Equal(1))
+			})
+
+			Context("when destroying service containers fails", func() {
+				BeforeEach(func() {
+					fakeContainerRepository.DestroyCompletedBuildServiceContainersReturns(
+						0, errors.New("disaster"),
+					)
+				})
+
+				It("returns the error", func() {
+					Expect(err).To(HaveOccurred())
+				})
+
+				It("still tries to remove the missing containers", func() {
+					Expect(fakeContainerRepository.RemoveMissingContainersCallCount()).To(Equal(1))
+				})
+			})
+		})
+
 		Describe("Orphaned Containers", func() {
 
 			var (
@@ -2,21 +2,45 @@ package gc
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/blobstore"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/taskcache"
 )
 
 type taskCacheCollector struct {
-	cacheLifecycle db.TaskCacheLifecycle
+	cacheLifecycle   db.TaskCacheLifecycle
+	taskCacheFactory db.TaskCacheFactory
+
+	remoteCaches  blobstore.ObjectStore
+	remoteMaxAge  time.Duration
+	remoteMaxSize uint64
 }
 
-func NewTaskCacheCollector(cacheLifecycle db.TaskCacheLifecycle) *taskCacheCollector {
+// NewTaskCacheCollector constructs a collector which removes task caches that
+// are no longer configured by their job.
+//
+// If remoteCaches is not nil, the task caches kept in it are removed too,
+// along with any that were last saved longer than remoteMaxAge ago or that
+// do not fit in remoteMaxSize bytes. A zero limit means no limit.
+func NewTaskCacheCollector(
+	cacheLifecycle db.TaskCacheLifecycle,
+	taskCacheFactory db.TaskCacheFactory,
+	remoteCaches blobstore.ObjectStore,
+	remoteMaxAge time.Duration,
+	remoteMaxSize uint64,
+) *taskCacheCollector {
 	return &taskCacheCollector{
-		cacheLifecycle: cacheLifecycle,
+		cacheLifecycle:   cacheLifecycle,
+		taskCacheFactory: taskCacheFactory,
+		remoteCaches:     remoteCaches,
+		remoteMaxAge:     remoteMaxAge,
+		remoteMaxSize:    remoteMaxSize,
 	}
 }
 
@@ -42,5 +66,88 @@ func (rcc *taskCacheCollector) Run(ctx context.Context) error {
 		logger.Debug("deleted-task-caches", lager.Data{"id": deletedCacheIDs})
 	}
 
+	if rcc.remoteCaches != nil {
+		err = rcc.collectRemoteCaches(ctx, logger)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (rcc *taskCacheCollector) collectRemoteCaches(ctx context.Context, logger lager.Logger) error {
+	objects, err := rcc.remoteCaches.List(ctx, "")
+	if err != nil {
+		return err
+	}
+
+	var kept []blobstore.Object
+	for _, object := range objects {
+		jobID, stepName, path, ok := taskcache.ParseKey(object.Key)
+		if !ok {
+			continue
+		}
+
+		expired := rcc.remoteMaxAge != 0 && time.Since(object.LastModified) > rcc.remoteMaxAge
+
+		found := false
+		if !expired {
+			_, found, err = rcc.taskCacheFactory.Find(jobID, stepName, path)
+			if err != nil {
+				return err
+			}
+		}
+
+		if expired || !found {
+			err = rcc.deleteRemoteCache(ctx, logger, object)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		kept = append(kept, object)
+	}
+
+	if rcc.remoteMaxSize == 0 {
+		return nil
+	}
+
+	// keep the most recently saved caches that fit
+	sort.Slice(kept, func(i, j int) bool {
+		return kept[i].LastModified.After(kept[j].LastModified)
+	})
+
+	var size uint64
+	for _, object := range kept {
+		size += uint64(object.Size)
+		if size <= rcc.remoteMaxSize {
+			continue
+		}
+
+		err = rcc.deleteRemoteCache(ctx, logger, object)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (rcc *taskCacheCollector) deleteRemoteCache(ctx context.Context, logger lager.Logger, object blobstore.Object) error {
+	err := rcc.remoteCaches.Delete(ctx, object.Key)
+	if err != nil {
+		return err
+	}
+
+	err = rcc.remoteCaches.Delete(ctx, taskcache.DigestKey(object.Key))
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("deleted-remote-task-cache", lager.Data{"key": object.Key})
+
 	return nil
 }
@@ -0,0 +1,139 @@
+package gc_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/concourse/concourse/atc/blobstore"
+	"github.com/concourse/concourse/atc/blobstore/blobstorefakes"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+	"github.com/concourse/concourse/atc/taskcache"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TaskCacheCollector", func() {
+	var (
+		collector GcCollector
+
+		fakeLifecycle        *dbfakes.FakeTaskCacheLifecycle
+		fakeTaskCacheFactory *dbfakes.FakeTaskCacheFactory
+		fakeRemoteCaches     *blobstorefakes.FakeObjectStore
+		remoteCaches         blobstore.ObjectStore
+
+		remoteMaxAge  time.Duration
+		remoteMaxSize uint64
+
+		runErr error
+	)
+
+	BeforeEach(func() {
+		fakeLifecycle = new(dbfakes.FakeTaskCacheLifecycle)
+		fakeTaskCacheFactory = new(dbfakes.FakeTaskCacheFactory)
+		fakeTaskCacheFactory.FindReturns(nil, true, nil)
+		fakeRemoteCaches = new(blobstorefakes.FakeObjectStore)
+		remoteCaches = fakeRemoteCaches
+
+		remoteMaxAge = 0
+		remoteMaxSize = 0
+	})
+
+	JustBeforeEach(func() {
+		collector = gc.NewTaskCacheCollector(fakeLifecycle, fakeTaskCacheFactory, remoteCaches, remoteMaxAge, remoteMaxSize)
+		runErr = collector.Run(context.TODO())
+	})
+
+	It("cleans up invalid task caches", func() {
+		Expect(runErr).ToNot(HaveOccurred())
+		Expect(fakeLifecycle.CleanUpInvalidTaskCachesCallCount()).To(Equal(1))
+	})
+
+	Context("when the remote store has caches", func() {
+		var deletedKeys []string
+
+		BeforeEach(func() {
+			fakeRemoteCaches.ListReturns([]blobstore.Object{
+				{Key: taskcache.Key(1, "some-step", "some-path"), Size: 10, LastModified: time.Now().Add(-time.Hour)},
+				{Key: taskcache.Key(1, "some-step", "other-path"), Size: 20, LastModified: time.Now().Add(-2 * time.Hour)},
+				{Key: taskcache.Key(2, "other-step", "some-path"), Size: 30, LastModified: time.Now().Add(-3 * time.Hour)},
+				{Key: taskcache.DigestKey(taskcache.Key(1, "some-step", "some-path")), Size: 64, LastModified: time.Now().Add(-100 * time.Hour)},
+				{Key: "unrecognized-key", Size: 100, LastModified: time.Now().Add(-100 * time.Hour)},
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			deletedKeys = nil
+			for i := 0; i < fakeRemoteCaches.DeleteCallCount(); i++ {
+				_, key := fakeRemoteCaches.DeleteArgsForCall(i)
+				deletedKeys = append(deletedKeys, key)
+			}
+		})
+
+		It("keeps the caches that are still configured", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(deletedKeys).To(BeEmpty())
+
+			Expect(fakeTaskCacheFactory.FindCallCount()).To(Equal(3))
+			jobID, stepName, path := fakeTaskCacheFactory.FindArgsForCall(0)
+			Expect(jobID).To(Equal(1))
+			Expect(stepName).To(Equal("some-step"))
+			Expect(path).To(Equal("some-path"))
+		})
+
+		Context("when a cache is no longer configured", func() {
+			BeforeEach(func() {
+				fakeTaskCacheFactory.FindStub = func(jobID int, stepName string, path string) (db.UsedTaskCache, bool, error) {
+					return nil, jobID == 1, nil
+				}
+			})
+
+			It("deletes it along with its digest", func() {
+				Expect(deletedKeys).To(ConsistOf(
+					taskcache.Key(2, "other-step", "some-path"),
+					taskcache.DigestKey(taskcache.Key(2, "other-step", "some-path")),
+				))
+			})
+		})
+
+		Context("when caches are older than the max age", func() {
+			BeforeEach(func() {
+				remoteMaxAge = 90 * time.Minute
+			})
+
+			It("deletes them", func() {
+				Expect(deletedKeys).To(ConsistOf(
+					taskcache.Key(1, "some-step", "other-path"),
+					taskcache.DigestKey(taskcache.Key(1, "some-step", "other-path")),
+					taskcache.Key(2, "other-step", "some-path"),
+					taskcache.DigestKey(taskcache.Key(2, "other-step", "some-path")),
+				))
+			})
+		})
+
+		Context("when the caches exceed the max size", func() {
+			BeforeEach(func() {
+				remoteMaxSize = 35
+			})
+
+			It("deletes the least recently saved caches", func() {
+				Expect(deletedKeys).To(ConsistOf(
+					taskcache.Key(2, "other-step", "some-path"),
+					taskcache.DigestKey(taskcache.Key(2, "other-step", "some-path")),
+				))
+			})
+		})
+	})
+
+	Context("when there is no remote store", func() {
+		BeforeEach(func() {
+			remoteCaches = nil
+		})
+
+		It("only cleans up invalid task caches", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(fakeLifecycle.CleanUpInvalidTaskCachesCallCount()).To(Equal(1))
+		})
+	})
+})
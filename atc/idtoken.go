@@ -0,0 +1,48 @@
+package atc
+
+import (
+	"regexp"
+	"time"
+)
+
+const (
+	// DefaultIDTokenParam is the param in which a task receives its identity
+	// token when its `id_token:` doesn't name one.
+	DefaultIDTokenParam = "CONCOURSE_ID_TOKEN"
+
+	DefaultIDTokenExpiry = time.Hour
+	MaxIDTokenExpiry     = 24 * time.Hour
+)
+
+var envVarNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// IDTokenConfig requests an OIDC identity token for a task, signed by the
+// ATC, which identifies the build to services trusting the ATC as an issuer.
+type IDTokenConfig struct {
+	// The param in which the token is passed to the task's process.
+	Param string `json:"param,omitempty"`
+
+	// The audiences the token is intended for. Defaults to the ATC's own URL.
+	Audience []string `json:"audience,omitempty"`
+
+	// How long the token is valid for.
+	ExpiresIn string `json:"expires_in,omitempty"`
+}
+
+// ParamName returns the param in which the token is passed to the task.
+func (config IDTokenConfig) ParamName() string {
+	if config.Param == "" {
+		return DefaultIDTokenParam
+	}
+
+	return config.Param
+}
+
+// Expiry returns how long the token is valid for.
+func (config IDTokenConfig) Expiry() (time.Duration, error) {
+	if config.ExpiresIn == "" {
+		return DefaultIDTokenExpiry, nil
+	}
+
+	return time.ParseDuration(config.ExpiresIn)
+}
@@ -0,0 +1,93 @@
+package idtoken
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+	"gopkg.in/square/go-jose.v2"
+)
+
+const (
+	DiscoveryPath = "/.well-known/openid-configuration"
+	JWKSPath      = "/.well-known/jwks.json"
+)
+
+// Discovery is the subset of the OpenID Provider Metadata relevant to
+// verifying identity tokens.
+type Discovery struct {
+	Issuer                           string   `json:"issuer"`
+	JWKSURI                          string   `json:"jwks_uri"`
+	ResponseTypesSupported           []string `json:"response_types_supported"`
+	SubjectTypesSupported            []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported []string `json:"id_token_signing_alg_values_supported"`
+	ClaimsSupported                  []string `json:"claims_supported"`
+}
+
+type handler struct {
+	logger            lager.Logger
+	url               string
+	signingKeyFactory db.SigningKeyFactory
+}
+
+// NewHandler serves the discovery document and the public signing keys, which
+// is what relying parties need to verify the identity tokens.
+func NewHandler(logger lager.Logger, url string, signingKeyFactory db.SigningKeyFactory) http.Handler {
+	return &handler{
+		logger:            logger,
+		url:               strings.TrimRight(url, "/"),
+		signingKeyFactory: signingKeyFactory,
+	}
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	switch r.URL.Path {
+	case DiscoveryPath:
+		h.writeJSON(w, Discovery{
+			Issuer:                           h.url,
+			JWKSURI:                          h.url + JWKSPath,
+			ResponseTypesSupported:           []string{"id_token"},
+			SubjectTypesSupported:            []string{"public"},
+			IDTokenSigningAlgValuesSupported: []string{string(SigningAlgorithm)},
+			ClaimsSupported: []string{
+				"iss", "sub", "aud", "exp", "iat", "nbf",
+				"team", "pipeline", "instance_vars", "job", "build_id", "build_name", "step",
+			},
+		})
+
+	case JWKSPath:
+		keys, err := h.signingKeyFactory.GetAllKeys()
+		if err != nil {
+			h.logger.Error("failed-to-get-signing-keys", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		jwks := jose.JSONWebKeySet{Keys: []jose.JSONWebKey{}}
+		for _, key := range keys {
+			jwk := key.JWK()
+			jwks.Keys = append(jwks.Keys, jwk.Public())
+		}
+
+		h.writeJSON(w, jwks)
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (h *handler) writeJSON(w http.ResponseWriter, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+
+	err := json.NewEncoder(w).Encode(value)
+	if err != nil {
+		h.logger.Error("failed-to-encode-json", err)
+	}
+}
@@ -0,0 +1,114 @@
+package idtoken_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/idtoken"
+	"gopkg.in/square/go-jose.v2"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Handler", func() {
+	var (
+		fakeSigningKeyFactory *dbfakes.FakeSigningKeyFactory
+		handler               http.Handler
+
+		method   string
+		path     string
+		recorder *httptest.ResponseRecorder
+	)
+
+	BeforeEach(func() {
+		fakeSigningKeyFactory = new(dbfakes.FakeSigningKeyFactory)
+		handler = idtoken.NewHandler(lagertest.NewTestLogger("test"), "https://ci.example.com/", fakeSigningKeyFactory)
+
+		method = "GET"
+	})
+
+	JustBeforeEach(func() {
+		recorder = httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(method, path, nil))
+	})
+
+	Describe("the discovery document", func() {
+		BeforeEach(func() {
+			path = "/.well-known/openid-configuration"
+		})
+
+		It("points to the signing keys", func() {
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Header().Get("Content-Type")).To(Equal("application/json"))
+
+			var discovery idtoken.Discovery
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &discovery)).To(Succeed())
+			Expect(discovery.Issuer).To(Equal("https://ci.example.com"))
+			Expect(discovery.JWKSURI).To(Equal("https://ci.example.com/.well-known/jwks.json"))
+			Expect(discovery.IDTokenSigningAlgValuesSupported).To(Equal([]string{"RS256"}))
+		})
+
+		Context("when the method is not GET", func() {
+			BeforeEach(func() {
+				method = "POST"
+			})
+
+			It("returns 405", func() {
+				Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+			})
+		})
+	})
+
+	Describe("the signing keys", func() {
+		var signingKey jose.JSONWebKey
+
+		BeforeEach(func() {
+			path = "/.well-known/jwks.json"
+
+			var err error
+			signingKey, err = idtoken.GenerateKey()
+			Expect(err).ToNot(HaveOccurred())
+
+			fakeSigningKey := new(dbfakes.FakeSigningKey)
+			fakeSigningKey.JWKReturns(signingKey)
+			fakeSigningKeyFactory.GetAllKeysReturns([]db.SigningKey{fakeSigningKey}, nil)
+		})
+
+		It("returns only their public keys", func() {
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+
+			var jwks jose.JSONWebKeySet
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &jwks)).To(Succeed())
+			Expect(jwks.Keys).To(HaveLen(1))
+			Expect(jwks.Keys[0].KeyID).To(Equal(signingKey.KeyID))
+			Expect(jwks.Keys[0].IsPublic()).To(BeTrue())
+			Expect(jwks.Keys[0].Key).To(Equal(signingKey.Public().Key))
+		})
+
+		Context("when getting the keys fails", func() {
+			BeforeEach(func() {
+				fakeSigningKeyFactory.GetAllKeysReturns(nil, errors.New("nope"))
+			})
+
+			It("returns 500", func() {
+				Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
+
+	Context("when the path is unknown", func() {
+		BeforeEach(func() {
+			path = "/.well-known/something-else"
+		})
+
+		It("returns 404", func() {
+			Expect(recorder.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package idtoken_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestIDToken(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ID Token Suite")
+}
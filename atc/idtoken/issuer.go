@@ -0,0 +1,136 @@
+package idtoken
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/clock"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/exec"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+// Claims are the claims of an identity token. Besides the registered claims,
+// they identify the step the token was minted for, so that relying parties
+// can trust e.g. only the builds of a given pipeline.
+type Claims struct {
+	jwt.Claims
+
+	Team         string           `json:"team"`
+	Pipeline     string           `json:"pipeline,omitempty"`
+	InstanceVars atc.InstanceVars `json:"instance_vars,omitempty"`
+	Job          string           `json:"job,omitempty"`
+	BuildID      int              `json:"build_id"`
+	BuildName    string           `json:"build_name"`
+	Step         string           `json:"step"`
+}
+
+type Issuer struct {
+	url               string
+	signingKeyFactory db.SigningKeyFactory
+	clock             clock.Clock
+}
+
+// NewIssuer returns an issuer which mints identity tokens signed with the
+// newest signing key. The url identifies the ATC as the tokens' issuer, and
+// must serve the discovery document so that the tokens can be verified.
+func NewIssuer(url string, signingKeyFactory db.SigningKeyFactory, clock clock.Clock) *Issuer {
+	return &Issuer{
+		url:               strings.TrimRight(url, "/"),
+		signingKeyFactory: signingKeyFactory,
+		clock:             clock,
+	}
+}
+
+func (i *Issuer) IssueIDToken(metadata exec.StepMetadata, stepName string, config atc.IDTokenConfig) (string, error) {
+	expiry, err := config.Expiry()
+	if err != nil {
+		return "", err
+	}
+
+	if expiry > atc.MaxIDTokenExpiry {
+		expiry = atc.MaxIDTokenExpiry
+	}
+
+	audience := config.Audience
+	if len(audience) == 0 {
+		audience = []string{i.url}
+	}
+
+	key, err := i.signingKey()
+	if err != nil {
+		return "", err
+	}
+
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: SigningAlgorithm, Key: key},
+		(&jose.SignerOptions{}).WithType("JWT"),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	now := i.clock.Now()
+
+	claims := Claims{
+		Claims: jwt.Claims{
+			Issuer:    i.url,
+			Subject:   subject(metadata),
+			Audience:  audience,
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			Expiry:    jwt.NewNumericDate(now.Add(expiry)),
+		},
+		Team:         metadata.TeamName,
+		Pipeline:     metadata.PipelineName,
+		InstanceVars: metadata.PipelineInstanceVars,
+		Job:          metadata.JobName,
+		BuildID:      metadata.BuildID,
+		BuildName:    metadata.BuildName,
+		Step:         stepName,
+	}
+
+	return jwt.Signed(signer).Claims(claims).CompactSerialize()
+}
+
+func (i *Issuer) signingKey() (jose.JSONWebKey, error) {
+	key, found, err := i.signingKeyFactory.GetNewestKey()
+	if err != nil {
+		return jose.JSONWebKey{}, fmt.Errorf("get signing key: %w", err)
+	}
+
+	if found {
+		return key.JWK(), nil
+	}
+
+	// the rotator hasn't run yet; create the first key rather than failing
+	// the build
+	jwk, err := GenerateKey()
+	if err != nil {
+		return jose.JSONWebKey{}, err
+	}
+
+	err = i.signingKeyFactory.CreateKey(jwk)
+	if err != nil {
+		return jose.JSONWebKey{}, fmt.Errorf("create signing key: %w", err)
+	}
+
+	return jwk, nil
+}
+
+// subject identifies the build's job, or its team for a one-off build, e.g.
+// 'main/some-pipeline/some-job'.
+func subject(metadata exec.StepMetadata) string {
+	parts := []string{metadata.TeamName}
+	if metadata.PipelineName != "" {
+		parts = append(parts, metadata.PipelineName)
+	}
+
+	if metadata.JobName != "" {
+		parts = append(parts, metadata.JobName)
+	}
+
+	return strings.Join(parts, "/")
+}
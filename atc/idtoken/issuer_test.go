@@ -0,0 +1,161 @@
+package idtoken_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/idtoken"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Issuer", func() {
+	var (
+		fakeSigningKeyFactory *dbfakes.FakeSigningKeyFactory
+		fakeClock             *fakeclock.FakeClock
+		signingKey            jose.JSONWebKey
+
+		issuer *idtoken.Issuer
+
+		metadata exec.StepMetadata
+		config   atc.IDTokenConfig
+
+		token     string
+		issueErr  error
+		claims    idtoken.Claims
+		headerKID string
+	)
+
+	BeforeEach(func() {
+		var err error
+		signingKey, err = idtoken.GenerateKey()
+		Expect(err).ToNot(HaveOccurred())
+
+		fakeSigningKey := new(dbfakes.FakeSigningKey)
+		fakeSigningKey.JWKReturns(signingKey)
+
+		fakeSigningKeyFactory = new(dbfakes.FakeSigningKeyFactory)
+		fakeSigningKeyFactory.GetNewestKeyReturns(fakeSigningKey, true, nil)
+
+		fakeClock = fakeclock.NewFakeClock(time.Unix(1000, 0))
+
+		issuer = idtoken.NewIssuer("https://ci.example.com/", fakeSigningKeyFactory, fakeClock)
+
+		metadata = exec.StepMetadata{
+			TeamName:             "main",
+			PipelineName:         "some-pipeline",
+			PipelineInstanceVars: atc.InstanceVars{"branch": "main"},
+			JobName:              "some-job",
+			BuildID:              42,
+			BuildName:            "7",
+		}
+
+		config = atc.IDTokenConfig{
+			Audience: []string{"sts.amazonaws.com"},
+		}
+	})
+
+	JustBeforeEach(func() {
+		token, issueErr = issuer.IssueIDToken(metadata, "some-task", config)
+		if issueErr != nil {
+			return
+		}
+
+		parsed, err := jwt.ParseSigned(token)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(parsed.Headers).To(HaveLen(1))
+		headerKID = parsed.Headers[0].KeyID
+
+		claims = idtoken.Claims{}
+		err = parsed.Claims(signingKey.Public().Key, &claims)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("signs the token with the newest key", func() {
+		Expect(issueErr).ToNot(HaveOccurred())
+		Expect(headerKID).To(Equal(signingKey.KeyID))
+	})
+
+	It("identifies the step", func() {
+		Expect(claims.Issuer).To(Equal("https://ci.example.com"))
+		Expect(claims.Subject).To(Equal("main/some-pipeline/some-job"))
+		Expect(claims.Audience).To(Equal(jwt.Audience{"sts.amazonaws.com"}))
+		Expect(claims.Team).To(Equal("main"))
+		Expect(claims.Pipeline).To(Equal("some-pipeline"))
+		Expect(claims.InstanceVars).To(Equal(atc.InstanceVars{"branch": "main"}))
+		Expect(claims.Job).To(Equal("some-job"))
+		Expect(claims.BuildID).To(Equal(42))
+		Expect(claims.BuildName).To(Equal("7"))
+		Expect(claims.Step).To(Equal("some-task"))
+	})
+
+	It("expires after an hour by default", func() {
+		Expect(claims.IssuedAt.Time()).To(Equal(fakeClock.Now()))
+		Expect(claims.Expiry.Time()).To(Equal(fakeClock.Now().Add(time.Hour)))
+	})
+
+	Context("when an expiry is configured", func() {
+		BeforeEach(func() {
+			config.ExpiresIn = "15m"
+		})
+
+		It("expires after it", func() {
+			Expect(claims.Expiry.Time()).To(Equal(fakeClock.Now().Add(15 * time.Minute)))
+		})
+	})
+
+	Context("when no audience is configured", func() {
+		BeforeEach(func() {
+			config.Audience = nil
+		})
+
+		It("is intended for the issuer", func() {
+			Expect(claims.Audience).To(Equal(jwt.Audience{"https://ci.example.com"}))
+		})
+	})
+
+	Context("when the build is a one-off", func() {
+		BeforeEach(func() {
+			metadata.PipelineName = ""
+			metadata.PipelineInstanceVars = nil
+			metadata.JobName = ""
+		})
+
+		It("is the team's", func() {
+			Expect(claims.Subject).To(Equal("main"))
+		})
+	})
+
+	Context("when there is no signing key yet", func() {
+		BeforeEach(func() {
+			fakeSigningKeyFactory.GetNewestKeyReturns(nil, false, nil)
+			fakeSigningKeyFactory.CreateKeyStub = func(jwk jose.JSONWebKey) error {
+				signingKey = jwk
+				return nil
+			}
+		})
+
+		It("creates one and signs the token with it", func() {
+			Expect(issueErr).ToNot(HaveOccurred())
+			Expect(fakeSigningKeyFactory.CreateKeyCallCount()).To(Equal(1))
+			Expect(headerKID).To(Equal(signingKey.KeyID))
+		})
+	})
+
+	Context("when getting the signing key fails", func() {
+		BeforeEach(func() {
+			fakeSigningKeyFactory.GetNewestKeyReturns(nil, false, errors.New("nope"))
+		})
+
+		It("errors", func() {
+			Expect(issueErr).To(MatchError(ContainSubstring("nope")))
+		})
+	})
+})
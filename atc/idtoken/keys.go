@@ -0,0 +1,36 @@
+package idtoken
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/hex"
+
+	"gopkg.in/square/go-jose.v2"
+)
+
+// SigningAlgorithm is the algorithm identity tokens are signed with. RS256 is
+// the one every OIDC relying party is required to support.
+const SigningAlgorithm = jose.RS256
+
+const keySize = 2048
+
+// GenerateKey returns a new private key to sign identity tokens with.
+func GenerateKey() (jose.JSONWebKey, error) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, keySize)
+	if err != nil {
+		return jose.JSONWebKey{}, err
+	}
+
+	id := make([]byte, 16)
+	_, err = rand.Read(id)
+	if err != nil {
+		return jose.JSONWebKey{}, err
+	}
+
+	return jose.JSONWebKey{
+		Key:       privateKey,
+		KeyID:     hex.EncodeToString(id),
+		Algorithm: string(SigningAlgorithm),
+		Use:       "sig",
+	}, nil
+}
@@ -0,0 +1,82 @@
+package idtoken
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type rotator struct {
+	signingKeyFactory db.SigningKeyFactory
+	rotationPeriod    time.Duration
+	clock             clock.Clock
+}
+
+// NewRotator constructs a component which replaces the signing key once it's
+// older than the rotation period. A replaced key is kept until every token
+// it signed has expired, so that they can still be verified.
+func NewRotator(signingKeyFactory db.SigningKeyFactory, rotationPeriod time.Duration, clock clock.Clock) *rotator {
+	return &rotator{
+		signingKeyFactory: signingKeyFactory,
+		rotationPeriod:    rotationPeriod,
+		clock:             clock,
+	}
+}
+
+func (r *rotator) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("signing-key-rotator")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	keys, err := r.signingKeyFactory.GetAllKeys()
+	if err != nil {
+		logger.Error("failed-to-get-signing-keys", err)
+		return err
+	}
+
+	now := r.clock.Now()
+
+	// keys are ordered newest first, so the newest key is only replaced once
+	// it's due
+	replacedAt := now
+	if len(keys) == 0 || now.Sub(keys[0].CreatedAt()) >= r.rotationPeriod {
+		jwk, err := GenerateKey()
+		if err != nil {
+			logger.Error("failed-to-generate-signing-key", err)
+			return err
+		}
+
+		err = r.signingKeyFactory.CreateKey(jwk)
+		if err != nil {
+			logger.Error("failed-to-create-signing-key", err)
+			return err
+		}
+
+		logger.Info("rotated-signing-key", lager.Data{"kid": jwk.KeyID})
+	} else {
+		replacedAt = keys[0].CreatedAt()
+		keys = keys[1:]
+	}
+
+	for _, key := range keys {
+		if now.Sub(replacedAt) > atc.MaxIDTokenExpiry {
+			err := key.Delete()
+			if err != nil {
+				logger.Error("failed-to-delete-signing-key", err, lager.Data{"kid": key.KeyID()})
+				return err
+			}
+
+			logger.Info("deleted-signing-key", lager.Data{"kid": key.KeyID()})
+		}
+
+		replacedAt = key.CreatedAt()
+	}
+
+	return nil
+}
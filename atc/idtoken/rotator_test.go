@@ -0,0 +1,103 @@
+package idtoken_test
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"github.com/concourse/concourse/atc/component"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/idtoken"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Rotator", func() {
+	var (
+		fakeSigningKeyFactory *dbfakes.FakeSigningKeyFactory
+		fakeClock             *fakeclock.FakeClock
+
+		rotator component.Runnable
+
+		runErr error
+	)
+
+	newKey := func(kid string, age time.Duration) *dbfakes.FakeSigningKey {
+		key := new(dbfakes.FakeSigningKey)
+		key.KeyIDReturns(kid)
+		key.CreatedAtReturns(fakeClock.Now().Add(-age))
+		return key
+	}
+
+	BeforeEach(func() {
+		fakeSigningKeyFactory = new(dbfakes.FakeSigningKeyFactory)
+		fakeClock = fakeclock.NewFakeClock(time.Unix(1000000, 0))
+
+		rotator = idtoken.NewRotator(fakeSigningKeyFactory, 7*24*time.Hour, fakeClock)
+	})
+
+	JustBeforeEach(func() {
+		runErr = rotator.Run(context.TODO())
+	})
+
+	Context("when there are no keys", func() {
+		It("creates one", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(fakeSigningKeyFactory.CreateKeyCallCount()).To(Equal(1))
+			Expect(fakeSigningKeyFactory.CreateKeyArgsForCall(0).KeyID).ToNot(BeEmpty())
+		})
+	})
+
+	Context("when the newest key is not due for rotation", func() {
+		var newest, replaced, expired *dbfakes.FakeSigningKey
+
+		BeforeEach(func() {
+			newest = newKey("newest", 6*24*time.Hour)
+			replaced = newKey("replaced", 13*24*time.Hour)
+			expired = newKey("expired", 20*24*time.Hour)
+
+			fakeSigningKeyFactory.GetAllKeysReturns([]db.SigningKey{newest, replaced, expired}, nil)
+		})
+
+		It("does not create a key", func() {
+			Expect(fakeSigningKeyFactory.CreateKeyCallCount()).To(BeZero())
+		})
+
+		It("deletes the keys which were replaced long enough ago for their tokens to have expired", func() {
+			Expect(newest.DeleteCallCount()).To(BeZero())
+			Expect(replaced.DeleteCallCount()).To(Equal(1))
+			Expect(expired.DeleteCallCount()).To(Equal(1))
+		})
+
+		Context("when the newest key was created recently", func() {
+			BeforeEach(func() {
+				newest.CreatedAtReturns(fakeClock.Now().Add(-time.Hour))
+			})
+
+			It("keeps the key it replaced", func() {
+				Expect(replaced.DeleteCallCount()).To(BeZero())
+				Expect(expired.DeleteCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Context("when the newest key is due for rotation", func() {
+		var newest *dbfakes.FakeSigningKey
+
+		BeforeEach(func() {
+			newest = newKey("newest", 8*24*time.Hour)
+
+			fakeSigningKeyFactory.GetAllKeysReturns([]db.SigningKey{newest}, nil)
+		})
+
+		It("creates a new key", func() {
+			Expect(fakeSigningKeyFactory.CreateKeyCallCount()).To(Equal(1))
+		})
+
+		It("keeps the replaced key", func() {
+			Expect(newest.DeleteCallCount()).To(BeZero())
+		})
+	})
+})
@@ -20,6 +20,9 @@ type Job struct {
 	FirstLoggedBuildID   int  `json:"first_logged_build_id,omitempty"`
 	DisableManualTrigger bool `json:"disable_manual_trigger,omitempty"`
 
+	Schedule             *JobSchedule `json:"schedule,omitempty"`
+	NextScheduledTrigger int64        `json:"next_scheduled_trigger,omitempty"`
+
 	NextBuild       *Build `json:"next_build"`
 	FinishedBuild   *Build `json:"finished_build"`
 	TransitionBuild *Build `json:"transition_build,omitempty"`
@@ -1,5 +1,12 @@
 package atc
 
+// MinJobPriority and MaxJobPriority bound the priority of a job's builds,
+// including the priority given to a build when it is triggered manually.
+const (
+	MinJobPriority = -100
+	MaxJobPriority = 100
+)
+
 type JobConfig struct {
 	Name    string `json:"name"`
 	OldName string `json:"old_name,omitempty"`
@@ -14,6 +21,16 @@ type JobConfig struct {
 
 	BuildLogRetention *BuildLogRetention `json:"build_log_retention,omitempty"`
 
+	// Priority orders the job's builds amongst other builds waiting for a
+	// worker. Builds with a higher priority are placed first. It must be
+	// between MinJobPriority and MaxJobPriority.
+	Priority int `json:"priority,omitempty"`
+
+	Params   JobParams    `json:"params,omitempty"`
+	Schedule *JobSchedule `json:"schedule,omitempty"`
+
+	ConcurrencyGroup *ConcurrencyGroupConfig `json:"concurrency_group,omitempty"`
+
 	OnSuccess *Step `json:"on_success,omitempty"`
 	OnFailure *Step `json:"on_failure,omitempty"`
 	OnAbort   *Step `json:"on_abort,omitempty"`
@@ -29,6 +46,18 @@ type BuildLogRetention struct {
 	Days                   int `json:"days,omitempty"`
 }
 
+// ConcurrencyGroupConfig places a job in a named group shared by all jobs of
+// the team which use the same name, including jobs of other pipelines and
+// pipeline instances. Group names are not scoped to the pipeline, so a build
+// of one pipeline can supersede the builds of another.
+type ConcurrencyGroupConfig struct {
+	Name string `json:"name"`
+
+	// CancelInProgress aborts any older pending or running builds of the group
+	// as soon as a new build of this job is created.
+	CancelInProgress bool `json:"cancel_in_progress,omitempty"`
+}
+
 func (config JobConfig) Step() Step {
 	return Step{Config: config.StepConfig()}
 }
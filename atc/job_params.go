@@ -0,0 +1,141 @@
+package atc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type JobParamType string
+
+const (
+	JobParamTypeString JobParamType = "string"
+	JobParamTypeEnum   JobParamType = "enum"
+	JobParamTypeBool   JobParamType = "bool"
+)
+
+// JobParamConfig declares a param which can be provided when manually
+// triggering a job. The resolved value is made available to the build as the
+// local var ((.:name)).
+type JobParamConfig struct {
+	Name        string       `json:"name"`
+	Type        JobParamType `json:"type,omitempty"`
+	Values      []string     `json:"values,omitempty"`
+	Default     interface{}  `json:"default,omitempty"`
+	Description string       `json:"description,omitempty"`
+}
+
+// ParamType returns the type of the param, defaulting to string.
+func (param JobParamConfig) ParamType() JobParamType {
+	if param.Type == "" {
+		return JobParamTypeString
+	}
+
+	return param.Type
+}
+
+// Coerce converts the given value to the param's type, returning an error if
+// the value is not valid for the param. Bool params accept "true" and "false"
+// so that values can be given as strings, e.g. from the command line.
+func (param JobParamConfig) Coerce(value interface{}) (interface{}, error) {
+	switch param.ParamType() {
+	case JobParamTypeString:
+		str, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("param '%s' must be a string", param.Name)
+		}
+
+		return str, nil
+
+	case JobParamTypeEnum:
+		str, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("param '%s' must be a string", param.Name)
+		}
+
+		for _, v := range param.Values {
+			if v == str {
+				return str, nil
+			}
+		}
+
+		return nil, fmt.Errorf("param '%s' must be one of %s", param.Name, strings.Join(param.Values, ", "))
+
+	case JobParamTypeBool:
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case string:
+			if v == "true" || v == "false" {
+				return v == "true", nil
+			}
+		}
+
+		return nil, fmt.Errorf("param '%s' must be true or false", param.Name)
+
+	default:
+		return nil, fmt.Errorf("param '%s' has unknown type '%s'", param.Name, param.Type)
+	}
+}
+
+type JobParams []JobParamConfig
+
+// Resolve validates the given values against the declared params and applies
+// defaults, returning the values to use for the build. Values for params which
+// are not declared are rejected, as are missing values for params which have
+// no default.
+func (params JobParams) Resolve(values map[string]interface{}) (map[string]interface{}, error) {
+	declared := map[string]JobParamConfig{}
+	for _, param := range params {
+		declared[param.Name] = param
+	}
+
+	var unknown []string
+	for name := range values {
+		if _, found := declared[name]; !found {
+			unknown = append(unknown, name)
+		}
+	}
+
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("unknown params: %s", strings.Join(unknown, ", "))
+	}
+
+	if len(params) == 0 {
+		return nil, nil
+	}
+
+	resolved := map[string]interface{}{}
+	for _, param := range params {
+		value, found := values[param.Name]
+		if !found {
+			if param.Default == nil {
+				return nil, fmt.Errorf("missing value for param '%s'", param.Name)
+			}
+
+			value = param.Default
+		}
+
+		coerced, err := param.Coerce(value)
+		if err != nil {
+			return nil, err
+		}
+
+		resolved[param.Name] = coerced
+	}
+
+	return resolved, nil
+}
+
+// CreateJobBuildBody is the body of a request to trigger a build of a job.
+// Options for the build are added here rather than to the client's
+// CreateJobBuild signature.
+type CreateJobBuildBody struct {
+	// Params are the values of the job's params for the build.
+	Params map[string]interface{} `json:"params,omitempty"`
+
+	// Priority overrides the job's priority for the build. It must be between
+	// MinJobPriority and MaxJobPriority.
+	Priority *int `json:"priority,omitempty"`
+}
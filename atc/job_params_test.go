@@ -0,0 +1,84 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("JobParams", func() {
+	var params atc.JobParams
+
+	BeforeEach(func() {
+		params = atc.JobParams{
+			{Name: "target"},
+			{Name: "region", Type: atc.JobParamTypeEnum, Values: []string{"eu", "us"}, Default: "eu"},
+			{Name: "dry-run", Type: atc.JobParamTypeBool, Default: false},
+		}
+	})
+
+	Describe("Resolve", func() {
+		It("applies defaults for params without values", func() {
+			resolved, err := params.Resolve(map[string]interface{}{"target": "prod"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resolved).To(Equal(map[string]interface{}{
+				"target":  "prod",
+				"region":  "eu",
+				"dry-run": false,
+			}))
+		})
+
+		It("coerces bool params given as strings", func() {
+			resolved, err := params.Resolve(map[string]interface{}{
+				"target":  "prod",
+				"region":  "us",
+				"dry-run": "true",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resolved).To(Equal(map[string]interface{}{
+				"target":  "prod",
+				"region":  "us",
+				"dry-run": true,
+			}))
+		})
+
+		It("errors when a param without a default is missing", func() {
+			_, err := params.Resolve(nil)
+			Expect(err).To(MatchError("missing value for param 'target'"))
+		})
+
+		It("errors on unknown params", func() {
+			_, err := params.Resolve(map[string]interface{}{"target": "prod", "bogus": "x", "another": "y"})
+			Expect(err).To(MatchError("unknown params: another, bogus"))
+		})
+
+		It("errors when an enum value is not allowed", func() {
+			_, err := params.Resolve(map[string]interface{}{"target": "prod", "region": "ap"})
+			Expect(err).To(MatchError("param 'region' must be one of eu, us"))
+		})
+
+		It("errors when a bool value is invalid", func() {
+			_, err := params.Resolve(map[string]interface{}{"target": "prod", "dry-run": "yes"})
+			Expect(err).To(MatchError("param 'dry-run' must be true or false"))
+		})
+
+		It("errors when a string value is not a string", func() {
+			_, err := params.Resolve(map[string]interface{}{"target": 42})
+			Expect(err).To(MatchError("param 'target' must be a string"))
+		})
+
+		Context("when no params are declared", func() {
+			It("returns no values", func() {
+				resolved, err := atc.JobParams{}.Resolve(nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resolved).To(BeNil())
+			})
+
+			It("rejects any values", func() {
+				_, err := atc.JobParams{}.Resolve(map[string]interface{}{"target": "prod"})
+				Expect(err).To(MatchError("unknown params: target"))
+			})
+		})
+	})
+})
@@ -0,0 +1,67 @@
+package atc
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// BuildTriggerReasonScheduled is recorded on builds created by a job's
+// schedule.
+const BuildTriggerReasonScheduled = "scheduled"
+
+// JobSchedule configures a job to be triggered periodically, without needing
+// a resource to trigger it.
+type JobSchedule struct {
+	// Cron is a standard five-field cron expression, e.g. "0 3 * * 1-5". The
+	// descriptors supported by cron, such as "@daily", are also allowed.
+	Cron string `json:"cron"`
+
+	// Timezone is the IANA name of the location in which the cron expression is
+	// evaluated. Defaults to UTC.
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// Validate checks that the cron expression and timezone can be parsed.
+func (schedule JobSchedule) Validate() error {
+	_, err := schedule.parse()
+	return err
+}
+
+// Next returns the first time the schedule fires after the given time.
+func (schedule JobSchedule) Next(after time.Time) (time.Time, error) {
+	sched, err := schedule.parse()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return sched.Next(after), nil
+}
+
+func (schedule JobSchedule) parse() (cron.Schedule, error) {
+	if schedule.Cron == "" {
+		return nil, errors.New("no cron expression")
+	}
+
+	location := time.UTC
+	if schedule.Timezone != "" {
+		var err error
+		location, err = time.LoadLocation(schedule.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timezone '%s': %w", schedule.Timezone, err)
+		}
+	}
+
+	sched, err := cron.ParseStandard(schedule.Cron)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron expression '%s': %w", schedule.Cron, err)
+	}
+
+	if spec, ok := sched.(*cron.SpecSchedule); ok {
+		spec.Location = location
+	}
+
+	return sched, nil
+}
@@ -0,0 +1,51 @@
+package atc_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("JobSchedule", func() {
+	now := time.Date(2022, 11, 1, 12, 30, 0, 0, time.UTC)
+
+	Describe("Next", func() {
+		It("returns the next time the cron expression fires in UTC", func() {
+			next, err := atc.JobSchedule{Cron: "0 3 * * *"}.Next(now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(next).To(BeTemporally("==", time.Date(2022, 11, 2, 3, 0, 0, 0, time.UTC)))
+		})
+
+		It("evaluates the cron expression in the timezone", func() {
+			next, err := atc.JobSchedule{Cron: "0 3 * * *", Timezone: "America/New_York"}.Next(now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(next).To(BeTemporally("==", time.Date(2022, 11, 2, 7, 0, 0, 0, time.UTC)))
+		})
+
+		It("supports descriptors", func() {
+			next, err := atc.JobSchedule{Cron: "@hourly"}.Next(now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(next).To(BeTemporally("==", time.Date(2022, 11, 1, 13, 0, 0, 0, time.UTC)))
+		})
+	})
+
+	Describe("Validate", func() {
+		It("rejects invalid cron expressions", func() {
+			err := atc.JobSchedule{Cron: "every day"}.Validate()
+			Expect(err).To(MatchError(ContainSubstring("invalid cron expression 'every day'")))
+		})
+
+		It("rejects an empty cron expression", func() {
+			err := atc.JobSchedule{}.Validate()
+			Expect(err).To(MatchError("no cron expression"))
+		})
+
+		It("rejects unknown timezones", func() {
+			err := atc.JobSchedule{Cron: "0 3 * * *", Timezone: "Mars/Olympus"}.Validate()
+			Expect(err).To(MatchError(ContainSubstring("invalid timezone 'Mars/Olympus'")))
+		})
+	})
+})
@@ -0,0 +1,44 @@
+package atc
+
+// JobStats summarizes the builds of a job which started within a window of
+// time. Durations are given in seconds.
+type JobStats struct {
+	Since int64 `json:"since"`
+	Until int64 `json:"until"`
+
+	Builds    int `json:"builds"`
+	Succeeded int `json:"succeeded"`
+	Failed    int `json:"failed"`
+	Errored   int `json:"errored"`
+	Aborted   int `json:"aborted"`
+
+	// SuccessRate is the share of builds which succeeded, not counting
+	// aborted builds.
+	SuccessRate float64 `json:"success_rate"`
+
+	// MeanTimeToRecovery is the mean time from a build breaking the job to the
+	// next build which succeeded.
+	MeanTimeToRecovery float64 `json:"mean_time_to_recovery"`
+
+	MedianDuration  float64 `json:"median_duration"`
+	MedianQueueTime float64 `json:"median_queue_time"`
+
+	// FlakyRetries is the number of failed builds which succeeded when rerun.
+	FlakyRetries int `json:"flaky_retries"`
+
+	// FlakyTests is the number of tests which both passed and failed.
+	FlakyTests int `json:"flaky_tests"`
+
+	Steps []StepStats `json:"steps"`
+}
+
+// StepStats gives the duration percentiles of the runs of a step.
+type StepStats struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Runs int    `json:"runs"`
+
+	P50 float64 `json:"p50"`
+	P90 float64 `json:"p90"`
+	P95 float64 `json:"p95"`
+}
@@ -0,0 +1,59 @@
+package lidar
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// idleRatio is how much longer a resource has to go without a new version
+// than its interval for the interval to be lengthened, e.g. a resource which
+// hasn't had a new version in 10 hours is checked every hour.
+const idleRatio = 10
+
+// idleStep is the smallest step the idle interval moves in. The idle interval
+// is truncated to a multiple of it (or of the configured interval, if that is
+// longer) so that it stays the same from one scan to the next rather than
+// being saved again every time the resource is scanned.
+const idleStep = time.Minute
+
+// adaptiveInterval returns the interval on which a resource should be checked
+// given how long ago it last had a new version and how many of its checks
+// have failed in a row. The interval is never shorter than the configured one
+// and never longer than the max.
+func adaptiveInterval(configured time.Duration, max time.Duration, lastVersion time.Time, failures int, now time.Time) (time.Duration, atc.CheckIntervalReason) {
+	if max <= configured {
+		return configured, atc.CheckIntervalConfigured
+	}
+
+	interval, reason := configured, atc.CheckIntervalActive
+
+	if !lastVersion.IsZero() {
+		step := configured
+		if step < idleStep {
+			step = idleStep
+		}
+
+		idle := (now.Sub(lastVersion) / idleRatio).Truncate(step)
+		if idle > interval {
+			interval, reason = idle, atc.CheckIntervalIdle
+		}
+	}
+
+	if failures > 0 {
+		backoff := configured
+		for i := 0; i < failures && backoff < max; i++ {
+			backoff *= 2
+		}
+
+		if backoff > interval {
+			interval, reason = backoff, atc.CheckIntervalErroring
+		}
+	}
+
+	if interval > max {
+		interval = max
+	}
+
+	return interval, reason
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"strconv"
 	"sync"
+	"time"
 
+	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
@@ -13,16 +15,22 @@ import (
 	"github.com/concourse/concourse/tracing"
 )
 
-func NewScanner(checkFactory db.CheckFactory, planFactory atc.PlanFactory) *scanner {
+// NewScanner constructs a component which creates checks for resources whose
+// interval has elapsed. When maxCheckInterval is longer than a resource's
+// interval, resources which rarely have new versions or whose checks keep
+// failing are checked less often, up to maxCheckInterval.
+func NewScanner(checkFactory db.CheckFactory, planFactory atc.PlanFactory, maxCheckInterval time.Duration) *scanner {
 	return &scanner{
-		checkFactory: checkFactory,
-		planFactory:  planFactory,
+		checkFactory:     checkFactory,
+		planFactory:      planFactory,
+		maxCheckInterval: maxCheckInterval,
 	}
 }
 
 type scanner struct {
-	checkFactory db.CheckFactory
-	planFactory  atc.PlanFactory
+	checkFactory     db.CheckFactory
+	planFactory      atc.PlanFactory
+	maxCheckInterval time.Duration
 }
 
 func (s *scanner) Run(ctx context.Context) error {
@@ -73,7 +81,7 @@ func (s *scanner) scanResources(ctx context.Context, resources []db.Resource, re
 	waitGroup.Wait()
 }
 
-func (s *scanner) check(ctx context.Context, checkable db.Checkable, resourceTypes db.ResourceTypes) {
+func (s *scanner) check(ctx context.Context, checkable db.Resource, resourceTypes db.ResourceTypes) {
 	logger := lagerctx.FromContext(ctx)
 
 	spanCtx, span := tracing.StartSpan(ctx, "scanner.check", tracing.Attrs{
@@ -91,6 +99,10 @@ func (s *scanner) check(ctx context.Context, checkable db.Checkable, resourceTyp
 		return
 	}
 
+	if s.maxCheckInterval > 0 && !s.due(logger, checkable) {
+		return
+	}
+
 	_, created, err := s.checkFactory.TryCreateCheck(lagerctx.NewContext(spanCtx, logger), checkable, resourceTypes, version, false, false, false)
 	if err != nil {
 		logger.Error("failed-to-create-check", err)
@@ -103,3 +115,27 @@ func (s *scanner) check(ctx context.Context, checkable db.Checkable, resourceTyp
 		metric.Metrics.ChecksEnqueued.Inc()
 	}
 }
+
+// due adjusts the interval on which the resource is checked and returns
+// whether it has elapsed. Manual and webhook checks are not affected, as they
+// skip the interval altogether.
+func (s *scanner) due(logger lager.Logger, resource db.Resource) bool {
+	now := time.Now()
+
+	interval, reason := adaptiveInterval(
+		db.ConfiguredCheckInterval(resource).Interval,
+		s.maxCheckInterval,
+		resource.LastVersionTime(),
+		resource.CheckFailures(),
+		now,
+	)
+
+	if interval != resource.CheckInterval() || reason != resource.CheckIntervalReason() {
+		err := resource.SetCheckInterval(interval, reason)
+		if err != nil {
+			logger.Error("failed-to-set-check-interval", err)
+		}
+	}
+
+	return !now.Before(resource.LastCheckEndTime().Add(interval))
+}
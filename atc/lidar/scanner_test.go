@@ -3,6 +3,7 @@ package lidar_test
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
@@ -30,7 +31,7 @@ var _ = Describe("Scanner", func() {
 		planFactory = atc.NewPlanFactory(0)
 		fakeCheckFactory = new(dbfakes.FakeCheckFactory)
 
-		scanner = lidar.NewScanner(fakeCheckFactory, planFactory)
+		scanner = lidar.NewScanner(fakeCheckFactory, planFactory, 0)
 	})
 
 	JustBeforeEach(func() {
@@ -160,6 +161,112 @@ var _ = Describe("Scanner", func() {
 					})
 				})
 
+				Context("when check intervals are adaptive", func() {
+					BeforeEach(func() {
+						scanner = lidar.NewScanner(fakeCheckFactory, planFactory, time.Hour)
+
+						fakeResource.TypeReturns("some-type")
+						fakeResource.CheckEveryReturns(&atc.CheckEvery{Interval: time.Minute})
+						fakeResource.LastVersionTimeReturns(time.Now().Add(-time.Minute))
+						fakeResource.LastCheckEndTimeReturns(time.Now().Add(-2 * time.Minute))
+					})
+
+					Context("when the resource recently had a new version", func() {
+						It("checks it on its configured interval", func() {
+							Expect(fakeResource.SetCheckIntervalCallCount()).To(Equal(1))
+							interval, reason := fakeResource.SetCheckIntervalArgsForCall(0)
+							Expect(interval).To(Equal(time.Minute))
+							Expect(reason).To(Equal(atc.CheckIntervalActive))
+
+							Expect(fakeCheckFactory.TryCreateCheckCallCount()).To(Equal(1))
+						})
+
+						Context("when the interval is already recorded", func() {
+							BeforeEach(func() {
+								fakeResource.CheckIntervalReturns(time.Minute)
+								fakeResource.CheckIntervalReasonReturns(atc.CheckIntervalActive)
+							})
+
+							It("does not record it again", func() {
+								Expect(fakeResource.SetCheckIntervalCallCount()).To(BeZero())
+								Expect(fakeCheckFactory.TryCreateCheckCallCount()).To(Equal(1))
+							})
+						})
+					})
+
+					Context("when the resource has not had a new version in a while", func() {
+						BeforeEach(func() {
+							fakeResource.LastVersionTimeReturns(time.Now().Add(-5 * time.Hour))
+						})
+
+						It("lengthens its interval", func() {
+							Expect(fakeResource.SetCheckIntervalCallCount()).To(Equal(1))
+							interval, reason := fakeResource.SetCheckIntervalArgsForCall(0)
+							Expect(interval).To(Equal(30 * time.Minute))
+							Expect(reason).To(Equal(atc.CheckIntervalIdle))
+
+							Expect(fakeCheckFactory.TryCreateCheckCallCount()).To(BeZero())
+						})
+
+						Context("when the lengthened interval is already recorded", func() {
+							BeforeEach(func() {
+								fakeResource.LastVersionTimeReturns(time.Now().Add(-5*time.Hour - 5*time.Minute))
+								fakeResource.CheckIntervalReturns(30 * time.Minute)
+								fakeResource.CheckIntervalReasonReturns(atc.CheckIntervalIdle)
+							})
+
+							It("does not record it again", func() {
+								Expect(fakeResource.SetCheckIntervalCallCount()).To(BeZero())
+							})
+						})
+
+						Context("for long enough to exceed the max interval", func() {
+							BeforeEach(func() {
+								fakeResource.LastVersionTimeReturns(time.Now().Add(-100 * time.Hour))
+								fakeResource.LastCheckEndTimeReturns(time.Now().Add(-61 * time.Minute))
+							})
+
+							It("checks it on the max interval", func() {
+								interval, reason := fakeResource.SetCheckIntervalArgsForCall(0)
+								Expect(interval).To(Equal(time.Hour))
+								Expect(reason).To(Equal(atc.CheckIntervalIdle))
+
+								Expect(fakeCheckFactory.TryCreateCheckCallCount()).To(Equal(1))
+							})
+						})
+					})
+
+					Context("when the resource's checks keep failing", func() {
+						BeforeEach(func() {
+							fakeResource.CheckFailuresReturns(3)
+						})
+
+						It("backs off", func() {
+							interval, reason := fakeResource.SetCheckIntervalArgsForCall(0)
+							Expect(interval).To(Equal(8 * time.Minute))
+							Expect(reason).To(Equal(atc.CheckIntervalErroring))
+
+							Expect(fakeCheckFactory.TryCreateCheckCallCount()).To(BeZero())
+						})
+					})
+
+					Context("when the resource's interval is longer than the max interval", func() {
+						BeforeEach(func() {
+							fakeResource.CheckEveryReturns(&atc.CheckEvery{Interval: 2 * time.Hour})
+							fakeResource.LastCheckEndTimeReturns(time.Now().Add(-3 * time.Hour))
+							fakeResource.CheckFailuresReturns(3)
+						})
+
+						It("keeps its interval", func() {
+							interval, reason := fakeResource.SetCheckIntervalArgsForCall(0)
+							Expect(interval).To(Equal(2 * time.Hour))
+							Expect(reason).To(Equal(atc.CheckIntervalConfigured))
+
+							Expect(fakeCheckFactory.TryCreateCheckCallCount()).To(Equal(1))
+						})
+					})
+				})
+
 				Context("when there's a put-only resource", func() {
 					BeforeEach(func() {
 						By("checkFactory.Resources should not return any put-only resources")
@@ -85,6 +85,8 @@ type Monitor struct {
 
 	GetStepCacheHits       Counter
 	StreamedResourceCaches Counter
+
+	WebhooksRejected Counter
 }
 
 var Metrics = NewMonitor()
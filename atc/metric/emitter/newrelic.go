@@ -104,7 +104,8 @@ func (emitter *NewRelicEmitter) Emit(logger lager.Logger, event metric.Event) {
 		"worker unknown volumes",
 		"volumes streamed",
 		"get step cache hits",
-		"streamed resource caches":
+		"streamed resource caches",
+		"webhooks rejected":
 		emitter.NewRelicBatch = append(emitter.NewRelicBatch, emitter.transformToNewRelicEvent(event, ""))
 
 	// These are periodic metrics that are consolidated and only emitted once
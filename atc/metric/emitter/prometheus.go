@@ -81,6 +81,8 @@ type PrometheusEmitter struct {
 	getStepCacheHits       prometheus.Counter
 	streamedResourceCaches prometheus.Counter
 
+	webhooksRejected prometheus.Counter
+
 	workerContainers                   *prometheus.GaugeVec
 	workerUnknownContainers            *prometheus.GaugeVec
 	workerVolumes                      *prometheus.GaugeVec
@@ -794,6 +796,17 @@ func (config *PrometheusConfig) NewEmitter(attributes map[string]string) (metric
 	)
 	prometheus.MustRegister(streamedResourceCaches)
 
+	webhooksRejected := prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace:   "concourse",
+			Subsystem:   "webhooks",
+			Name:        "rejected_total",
+			Help:        "Total number of check webhook requests rejected because their token or signature could not be verified",
+			ConstLabels: attributes,
+		},
+	)
+	prometheus.MustRegister(webhooksRejected)
+
 	listener, err := net.Listen("tcp", config.bind())
 	if err != nil {
 		return nil, err
@@ -881,6 +894,8 @@ func (config *PrometheusConfig) NewEmitter(attributes map[string]string) (metric
 
 		getStepCacheHits:       getStepCacheHits,
 		streamedResourceCaches: streamedResourceCaches,
+
+		webhooksRejected: webhooksRejected,
 	}
 	go emitter.periodicMetricGC()
 
@@ -1015,6 +1030,8 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.getStepCacheHits.Add(event.Value)
 	case "streamed resource caches":
 		emitter.streamedResourceCaches.Add(event.Value)
+	case "webhooks rejected":
+		emitter.webhooksRejected.Add(event.Value)
 	default:
 		// unless we have a specific metric, we do nothing
 	}
@@ -91,6 +91,14 @@ func tick(logger lager.Logger, m *Monitor) {
 		},
 	)
 
+	m.emit(
+		logger.Session("webhooks-rejected"),
+		Event{
+			Name:  "webhooks rejected",
+			Value: m.WebhooksRejected.Delta(),
+		},
+	)
+
 	m.emit(
 		logger.Session("containers-created"),
 		Event{
@@ -0,0 +1,101 @@
+package atc
+
+// NotificationConfig configures an endpoint to which a JSON payload is
+// POSTed whenever a build of the pipeline changes state.
+type NotificationConfig struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+
+	// Secret used to sign the payload. The signature is sent in the
+	// X-Concourse-Signature header as 'sha256=<hex encoded HMAC>'.
+	Secret string `json:"secret,omitempty"`
+
+	// Build statuses to notify about. All of them are notified about when
+	// left empty. It isn't called 'on' as YAML 1.1 parsers read that key as
+	// a boolean.
+	Statuses []BuildStatus `json:"statuses,omitempty"`
+
+	// Jobs whose builds are notified about. The builds of all jobs are
+	// notified about when left empty.
+	Jobs []string `json:"jobs,omitempty"`
+}
+
+// Notifies returns whether a build of the given job changing to the given
+// status should be notified about.
+func (config NotificationConfig) Notifies(jobName string, status BuildStatus) bool {
+	if len(config.Jobs) > 0 && !containsString(config.Jobs, jobName) {
+		return false
+	}
+
+	if len(config.Statuses) == 0 {
+		return true
+	}
+
+	for _, s := range config.Statuses {
+		if s == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+type NotificationConfigs []NotificationConfig
+
+func (configs NotificationConfigs) Lookup(name string) (NotificationConfig, bool) {
+	for _, config := range configs {
+		if config.Name == name {
+			return config, true
+		}
+	}
+
+	return NotificationConfig{}, false
+}
+
+const (
+	NotificationSignatureHeader = "X-Concourse-Signature"
+	NotificationDeliveryHeader  = "X-Concourse-Delivery"
+)
+
+// BuildNotification is the payload sent to notification endpoints.
+type BuildNotification struct {
+	Status BuildStatus        `json:"status"`
+	Build  Build              `json:"build"`
+	Inputs []PublicBuildInput `json:"inputs"`
+	URL    string             `json:"url"`
+}
+
+type NotificationDeliveryState string
+
+const (
+	NotificationDeliveryPending   NotificationDeliveryState = "pending"
+	NotificationDeliveryDelivered NotificationDeliveryState = "delivered"
+	NotificationDeliveryFailed    NotificationDeliveryState = "failed"
+)
+
+// NotificationDelivery records the delivery of a build notification to one
+// of the pipeline's notification endpoints.
+type NotificationDelivery struct {
+	ID           int                       `json:"id"`
+	Notification string                    `json:"notification"`
+	BuildID      int                       `json:"build_id"`
+	BuildName    string                    `json:"build_name"`
+	JobName      string                    `json:"job_name,omitempty"`
+	Status       BuildStatus               `json:"status"`
+	State        NotificationDeliveryState `json:"state"`
+	Attempts     int                       `json:"attempts"`
+	ResponseCode int                       `json:"response_code,omitempty"`
+	Error        string                    `json:"error,omitempty"`
+	CreatedAt    int64                     `json:"created_at"`
+	DeliveredAt  int64                     `json:"delivered_at,omitempty"`
+}
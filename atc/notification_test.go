@@ -0,0 +1,45 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NotificationConfig", func() {
+	Describe("Notifies", func() {
+		var config atc.NotificationConfig
+
+		BeforeEach(func() {
+			config = atc.NotificationConfig{Name: "some-hook"}
+		})
+
+		It("notifies about every status of every job by default", func() {
+			Expect(config.Notifies("some-job", atc.StatusStarted)).To(BeTrue())
+			Expect(config.Notifies("other-job", atc.StatusAborted)).To(BeTrue())
+		})
+
+		Context("when statuses are given", func() {
+			BeforeEach(func() {
+				config.Statuses = []atc.BuildStatus{atc.StatusFailed, atc.StatusErrored}
+			})
+
+			It("only notifies about them", func() {
+				Expect(config.Notifies("some-job", atc.StatusFailed)).To(BeTrue())
+				Expect(config.Notifies("some-job", atc.StatusErrored)).To(BeTrue())
+				Expect(config.Notifies("some-job", atc.StatusSucceeded)).To(BeFalse())
+			})
+		})
+
+		Context("when jobs are given", func() {
+			BeforeEach(func() {
+				config.Jobs = []string{"some-job"}
+			})
+
+			It("only notifies about their builds", func() {
+				Expect(config.Notifies("some-job", atc.StatusSucceeded)).To(BeTrue())
+				Expect(config.Notifies("other-job", atc.StatusSucceeded)).To(BeFalse())
+			})
+		})
+	})
+})
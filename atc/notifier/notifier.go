@@ -0,0 +1,280 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+)
+
+const (
+	batchSize     = 100
+	maxInFlight   = 8
+	retryBackoff  = 15 * time.Second
+	maxRetryDelay = time.Hour
+)
+
+type notifier struct {
+	notificationFactory db.BuildNotificationFactory
+	buildFactory        db.BuildFactory
+	secrets             creds.Secrets
+	varSourcePool       creds.VarSourcePool
+	httpClient          *http.Client
+	externalURL         string
+	maxAttempts         int
+	clock               clock.Clock
+}
+
+// NewNotifier constructs a component which delivers the build notifications
+// queued as builds change state. A delivery which fails is retried with an
+// exponential backoff until it has been attempted maxAttempts times.
+func NewNotifier(
+	notificationFactory db.BuildNotificationFactory,
+	buildFactory db.BuildFactory,
+	secrets creds.Secrets,
+	varSourcePool creds.VarSourcePool,
+	httpClient *http.Client,
+	externalURL string,
+	maxAttempts int,
+	clock clock.Clock,
+) *notifier {
+	return &notifier{
+		notificationFactory: notificationFactory,
+		buildFactory:        buildFactory,
+		secrets:             secrets,
+		varSourcePool:       varSourcePool,
+		httpClient:          httpClient,
+		externalURL:         externalURL,
+		maxAttempts:         maxAttempts,
+		clock:               clock,
+	}
+}
+
+func (n *notifier) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("notifier")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	notifications, err := n.notificationFactory.DueNotifications(batchSize)
+	if err != nil {
+		logger.Error("failed-to-get-due-notifications", err)
+		return err
+	}
+
+	sem := make(chan struct{}, maxInFlight)
+
+	wg := new(sync.WaitGroup)
+	for _, notification := range notifications {
+		sem <- struct{}{}
+		wg.Add(1)
+
+		go func(notification db.BuildNotification) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			n.deliver(ctx, logger, notification)
+		}(notification)
+	}
+
+	wg.Wait()
+
+	return nil
+}
+
+func (n *notifier) deliver(ctx context.Context, logger lager.Logger, notification db.BuildNotification) {
+	logger = logger.Session("deliver", lager.Data{
+		"id":           notification.ID(),
+		"build":        notification.BuildID(),
+		"notification": notification.Notification(),
+	})
+
+	responseCode, err := n.send(ctx, notification)
+	if err == nil {
+		err = notification.Delivered(responseCode)
+		if err != nil {
+			logger.Error("failed-to-mark-delivered", err)
+		}
+
+		return
+	}
+
+	logger.Info("failed-to-deliver", lager.Data{"error": err.Error(), "attempt": notification.Attempts() + 1})
+
+	var permanent permanentError
+	if errors.As(err, &permanent) || notification.Attempts()+1 >= n.maxAttempts {
+		err = notification.Fail(responseCode, err.Error())
+		if err != nil {
+			logger.Error("failed-to-mark-failed", err)
+		}
+
+		return
+	}
+
+	err = notification.Retry(responseCode, err.Error(), n.clock.Now().Add(backoff(notification.Attempts())))
+	if err != nil {
+		logger.Error("failed-to-schedule-retry", err)
+	}
+}
+
+// permanentError is returned for deliveries which can never succeed, e.g.
+// because the notification was removed from the pipeline or team.
+type permanentError struct {
+	error
+}
+
+func (n *notifier) send(ctx context.Context, notification db.BuildNotification) (int, error) {
+	build, found, err := n.buildFactory.Build(notification.BuildID())
+	if err != nil {
+		return 0, err
+	}
+
+	if !found {
+		return 0, permanentError{errors.New("build not found")}
+	}
+
+	pipeline, found, err := build.Pipeline()
+	if err != nil {
+		return 0, err
+	}
+
+	if !found {
+		return 0, permanentError{errors.New("pipeline not found")}
+	}
+
+	config, found, err := pipeline.LookupNotification(notification.Notification())
+	if err != nil {
+		return 0, err
+	}
+
+	if !found {
+		return 0, permanentError{fmt.Errorf("notification '%s' is no longer configured", notification.Notification())}
+	}
+
+	variables, err := pipeline.Variables(lagerctx.FromContext(ctx), n.secrets, n.varSourcePool)
+	if err != nil {
+		return 0, err
+	}
+
+	url, err := creds.NewString(variables, config.URL).Evaluate()
+	if err != nil {
+		return 0, fmt.Errorf("evaluate url: %w", err)
+	}
+
+	secret, err := creds.NewString(variables, config.Secret).Evaluate()
+	if err != nil {
+		return 0, fmt.Errorf("evaluate secret: %w", err)
+	}
+
+	payload, err := n.payload(build, notification.Status())
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return 0, permanentError{err}
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(atc.NotificationDeliveryHeader, strconv.Itoa(notification.ID()))
+
+	if secret != "" {
+		req.Header.Set(atc.NotificationSignatureHeader, Sign([]byte(secret), payload))
+	}
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected response: %s", resp.Status)
+	}
+
+	return resp.StatusCode, nil
+}
+
+func (n *notifier) payload(build db.Build, status atc.BuildStatus) ([]byte, error) {
+	inputs, _, err := build.Resources()
+	if err != nil {
+		return nil, err
+	}
+
+	publicInputs := make([]atc.PublicBuildInput, len(inputs))
+	for i, input := range inputs {
+		publicInputs[i] = present.PublicBuildInput(input, build.PipelineID())
+	}
+
+	return json.Marshal(atc.BuildNotification{
+		Status: status,
+		Build:  present.Build(build, nil, nil),
+		Inputs: publicInputs,
+		URL:    n.buildURL(build),
+	})
+}
+
+func (n *notifier) buildURL(build db.Build) string {
+	if build.JobName() == "" {
+		return fmt.Sprintf("%s/builds/%d", n.externalURL, build.ID())
+	}
+
+	url := fmt.Sprintf(
+		"%s/teams/%s/pipelines/%s/jobs/%s/builds/%s",
+		n.externalURL,
+		build.TeamName(),
+		build.PipelineName(),
+		build.JobName(),
+		build.Name(),
+	)
+
+	if query := build.PipelineRef().QueryParams().Encode(); query != "" {
+		url += "?" + query
+	}
+
+	return url
+}
+
+// Sign returns the value of the signature header for the payload, so that
+// the receiving end can verify that it was sent by Concourse.
+func Sign(secret []byte, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func backoff(attempts int) time.Duration {
+	delay := retryBackoff
+	for i := 0; i < attempts; i++ {
+		delay *= 2
+		if delay >= maxRetryDelay {
+			return maxRetryDelay
+		}
+	}
+
+	return delay
+}
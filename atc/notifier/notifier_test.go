@@ -0,0 +1,187 @@
+package notifier_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/notifier"
+	"github.com/concourse/concourse/vars"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Notifier", func() {
+	var (
+		server *ghttp.Server
+
+		fakeNotificationFactory *dbfakes.FakeBuildNotificationFactory
+		fakeBuildFactory        *dbfakes.FakeBuildFactory
+		fakeBuild               *dbfakes.FakeBuild
+		fakePipeline            *dbfakes.FakePipeline
+		fakeNotification        *dbfakes.FakeBuildNotification
+		fakeClock               *fakeclock.FakeClock
+
+		maxAttempts int
+
+		err error
+	)
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+
+		fakeNotificationFactory = new(dbfakes.FakeBuildNotificationFactory)
+		fakeBuildFactory = new(dbfakes.FakeBuildFactory)
+		fakeClock = fakeclock.NewFakeClock(time.Unix(1000, 0))
+		maxAttempts = 3
+
+		fakeNotification = new(dbfakes.FakeBuildNotification)
+		fakeNotification.IDReturns(42)
+		fakeNotification.BuildIDReturns(1)
+		fakeNotification.NotificationReturns("some-hook")
+		fakeNotification.StatusReturns(atc.StatusFailed)
+		fakeNotificationFactory.DueNotificationsReturns([]db.BuildNotification{fakeNotification}, nil)
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.IDReturns(1)
+		fakeBuild.NameReturns("7")
+		fakeBuild.JobNameReturns("some-job")
+		fakeBuild.TeamNameReturns("some-team")
+		fakeBuild.PipelineIDReturns(3)
+		fakeBuild.PipelineNameReturns("some-pipeline")
+		fakeBuild.PipelineRefReturns(atc.PipelineRef{Name: "some-pipeline"})
+		fakeBuild.StatusReturns(db.BuildStatusFailed)
+		fakeBuild.ResourcesReturns([]db.BuildInput{
+			{Name: "some-input", Version: atc.Version{"ref": "abc"}, FirstOccurrence: true},
+		}, nil, nil)
+		fakeBuildFactory.BuildReturns(fakeBuild, true, nil)
+
+		fakePipeline = new(dbfakes.FakePipeline)
+		fakePipeline.LookupNotificationReturns(atc.NotificationConfig{
+			Name:   "some-hook",
+			URL:    server.URL() + "/((hook_path))",
+			Secret: "((hook_secret))",
+		}, true, nil)
+		fakePipeline.VariablesReturns(vars.StaticVariables{
+			"hook_path":   "hook",
+			"hook_secret": "s3cr3t",
+		}, nil)
+		fakeBuild.PipelineReturns(fakePipeline, true, nil)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	JustBeforeEach(func() {
+		err = notifier.NewNotifier(
+			fakeNotificationFactory,
+			fakeBuildFactory,
+			nil,
+			nil,
+			http.DefaultClient,
+			"https://ci.example.com",
+			maxAttempts,
+			fakeClock,
+		).Run(context.TODO())
+	})
+
+	Context("when the endpoint accepts the notification", func() {
+		var payload []byte
+
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/hook"),
+					ghttp.VerifyHeaderKV("Content-Type", "application/json"),
+					ghttp.VerifyHeaderKV(atc.NotificationDeliveryHeader, "42"),
+					func(w http.ResponseWriter, r *http.Request) {
+						payload, _ = ioutil.ReadAll(r.Body)
+						Expect(r.Header.Get(atc.NotificationSignatureHeader)).To(Equal(notifier.Sign([]byte("s3cr3t"), payload)))
+					},
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+		})
+
+		It("posts the signed build notification", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+
+			var notification atc.BuildNotification
+			Expect(json.Unmarshal(payload, &notification)).To(Succeed())
+			Expect(notification.Status).To(Equal(atc.StatusFailed))
+			Expect(notification.Build.ID).To(Equal(1))
+			Expect(notification.Build.JobName).To(Equal("some-job"))
+			Expect(notification.Inputs).To(Equal([]atc.PublicBuildInput{
+				{Name: "some-input", Version: atc.Version{"ref": "abc"}, PipelineID: 3, FirstOccurrence: true},
+			}))
+			Expect(notification.URL).To(Equal("https://ci.example.com/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds/7"))
+		})
+
+		It("looks up the notification by name", func() {
+			Expect(fakePipeline.LookupNotificationCallCount()).To(Equal(1))
+			Expect(fakePipeline.LookupNotificationArgsForCall(0)).To(Equal("some-hook"))
+		})
+
+		It("marks the notification as delivered", func() {
+			Expect(fakeNotification.DeliveredCallCount()).To(Equal(1))
+			Expect(fakeNotification.DeliveredArgsForCall(0)).To(Equal(http.StatusNoContent))
+			Expect(fakeNotification.RetryCallCount()).To(BeZero())
+			Expect(fakeNotification.FailCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the endpoint rejects the notification", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(ghttp.RespondWith(http.StatusBadGateway, nil))
+			fakeNotification.AttemptsReturns(1)
+		})
+
+		It("retries it with a backoff", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeNotification.DeliveredCallCount()).To(BeZero())
+			Expect(fakeNotification.RetryCallCount()).To(Equal(1))
+
+			code, reason, at := fakeNotification.RetryArgsForCall(0)
+			Expect(code).To(Equal(http.StatusBadGateway))
+			Expect(reason).To(ContainSubstring("502"))
+			Expect(at).To(Equal(fakeClock.Now().Add(30 * time.Second)))
+		})
+
+		Context("when it has been attempted too many times", func() {
+			BeforeEach(func() {
+				fakeNotification.AttemptsReturns(2)
+			})
+
+			It("gives up on it", func() {
+				Expect(fakeNotification.RetryCallCount()).To(BeZero())
+				Expect(fakeNotification.FailCallCount()).To(Equal(1))
+
+				code, _ := fakeNotification.FailArgsForCall(0)
+				Expect(code).To(Equal(http.StatusBadGateway))
+			})
+		})
+	})
+
+	Context("when the notification is no longer configured", func() {
+		BeforeEach(func() {
+			fakePipeline.LookupNotificationReturns(atc.NotificationConfig{}, false, nil)
+		})
+
+		It("gives up on it without posting", func() {
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+			Expect(fakeNotification.FailCallCount()).To(Equal(1))
+
+			_, reason := fakeNotification.FailArgsForCall(0)
+			Expect(reason).To(ContainSubstring("no longer configured"))
+		})
+	})
+})
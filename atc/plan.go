@@ -11,6 +11,7 @@ type Plan struct {
 	Run         *RunPlan         `json:"run,omitempty"`
 	SetPipeline *SetPipelinePlan `json:"set_pipeline,omitempty"`
 	LoadVar     *LoadVarPlan     `json:"load_var,omitempty"`
+	Approve     *ApprovePlan     `json:"approve,omitempty"`
 
 	Do         *DoPlan         `json:"do,omitempty"`
 	InParallel *InParallelPlan `json:"in_parallel,omitempty"`
@@ -25,6 +26,11 @@ type Plan struct {
 	Try     *TryPlan     `json:"try,omitempty"`
 	Timeout *TimeoutPlan `json:"timeout,omitempty"`
 	Retry   *RetryPlan   `json:"retry,omitempty"`
+	If      *IfPlan      `json:"if,omitempty"`
+
+	// Backoff and retry conditions for the attempts in Retry. This is kept
+	// separate from RetryPlan to remain compatible with existing build plans.
+	RetryPolicy *RetryPolicy `json:"retry_policy,omitempty"`
 
 	// used for 'fly execute'
 	ArtifactInput  *ArtifactInputPlan  `json:"artifact_input,omitempty"`
@@ -84,6 +90,10 @@ func (plan *Plan) Each(f func(*Plan)) {
 		plan.Timeout.Step.Each(f)
 	}
 
+	if plan.If != nil {
+		plan.If.Step.Each(f)
+	}
+
 	if plan.Retry != nil {
 		for i, p := range *plan.Retry {
 			p.Each(f)
@@ -99,6 +109,10 @@ func (plan *Plan) Each(f func(*Plan)) {
 		plan.Put.TypeImage.EachPlan(f)
 	}
 
+	if plan.Run != nil {
+		plan.Run.TypeImage.EachPlan(f)
+	}
+
 	if plan.Check != nil {
 		plan.Check.TypeImage.EachPlan(f)
 	}
@@ -170,6 +184,11 @@ type TimeoutPlan struct {
 	Duration string `json:"duration"`
 }
 
+type IfPlan struct {
+	Step      Plan   `json:"step"`
+	Condition string `json:"condition"`
+}
+
 type TryPlan struct {
 	Step Plan `json:"step"`
 }
@@ -343,6 +362,13 @@ type TaskPlan struct {
 	// image does not count towards the timeout.
 	Timeout string `json:"timeout,omitempty"`
 
+	// Skip running the task and reuse the outputs of a previous successful run
+	// of the same step if its config, image and inputs are unchanged.
+	CacheResult bool `json:"cache_result,omitempty"`
+
+	// An OIDC identity token to mint for the step and pass to the task.
+	IDToken *IDTokenConfig `json:"id_token,omitempty"`
+
 	// Resource types to have available for use when fetching the task's image.
 	ResourceTypes ResourceTypes `json:"resource_types,omitempty"`
 
@@ -375,6 +401,26 @@ type RunPlan struct {
 	// A timeout to enforce on the run step's process. Note that fetching the
 	// prototype's image does not count towards the timeout.
 	Timeout string `json:"timeout,omitempty"`
+
+	// Information needed for fetching the prototype's image.
+	TypeImage TypeImage `json:"image"`
+
+	// An artifact in the build plan to use as the prototype's image, in place
+	// of the image of the prototype type.
+	ImageArtifactName string `json:"image_artifact,omitempty"`
+
+	// Artifacts to mount in the container, and artifacts to register from it,
+	// by their names in the container's working directory.
+	Inputs  []string `json:"inputs,omitempty"`
+	Outputs []string `json:"outputs,omitempty"`
+
+	// Remap inputs and output artifacts from names in the container to other
+	// names in the build plan.
+	InputMapping  map[string]string `json:"input_mapping,omitempty"`
+	OutputMapping map[string]string `json:"output_mapping,omitempty"`
+
+	// Local vars to set from the fields of the response, keyed by var name.
+	SetVars map[string]string `json:"set_vars,omitempty"`
 }
 
 type SetPipelinePlan struct {
@@ -393,8 +439,20 @@ type LoadVarPlan struct {
 	Reveal bool   `json:"reveal,omitempty"`
 }
 
+type ApprovePlan struct {
+	Name string `json:"name"`
+	Role string `json:"role"`
+}
+
 type RetryPlan []Plan
 
+type RetryPolicy struct {
+	Backoff    string         `json:"backoff,omitempty"`
+	MaxBackoff string         `json:"max_backoff,omitempty"`
+	Jitter     float64        `json:"jitter,omitempty"`
+	RetryOn    *RetryOnConfig `json:"retry_on,omitempty"`
+}
+
 type DependentGetPlan struct {
 	Type     string `json:"type"`
 	Name     string `json:"name,omitempty"`
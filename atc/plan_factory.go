@@ -43,6 +43,8 @@ func (factory PlanFactory) NewPlan(step PlanConfig) Plan {
 		plan.SetPipeline = &t
 	case LoadVarPlan:
 		plan.LoadVar = &t
+	case ApprovePlan:
+		plan.Approve = &t
 	case CheckPlan:
 		plan.Check = &t
 	case OnAbortPlan:
@@ -59,6 +61,8 @@ func (factory PlanFactory) NewPlan(step PlanConfig) Plan {
 		plan.Try = &t
 	case TimeoutPlan:
 		plan.Timeout = &t
+	case IfPlan:
+		plan.If = &t
 	case RetryPlan:
 		plan.Retry = &t
 	case ArtifactInputPlan:
@@ -20,6 +20,7 @@ func (plan *Plan) Public() *json.RawMessage {
 		Run            *json.RawMessage `json:"run,omitempty"`
 		SetPipeline    *json.RawMessage `json:"set_pipeline,omitempty"`
 		LoadVar        *json.RawMessage `json:"load_var,omitempty"`
+		Approve        *json.RawMessage `json:"approve,omitempty"`
 		OnAbort        *json.RawMessage `json:"on_abort,omitempty"`
 		OnError        *json.RawMessage `json:"on_error,omitempty"`
 		Ensure         *json.RawMessage `json:"ensure,omitempty"`
@@ -29,6 +30,7 @@ func (plan *Plan) Public() *json.RawMessage {
 		DependentGet   *json.RawMessage `json:"dependent_get,omitempty"`
 		Timeout        *json.RawMessage `json:"timeout,omitempty"`
 		Retry          *json.RawMessage `json:"retry,omitempty"`
+		If             *json.RawMessage `json:"if,omitempty"`
 		ArtifactInput  *json.RawMessage `json:"artifact_input,omitempty"`
 		ArtifactOutput *json.RawMessage `json:"artifact_output,omitempty"`
 	}
@@ -75,6 +77,10 @@ func (plan *Plan) Public() *json.RawMessage {
 		public.LoadVar = plan.LoadVar.Public()
 	}
 
+	if plan.Approve != nil {
+		public.Approve = plan.Approve.Public()
+	}
+
 	if plan.OnAbort != nil {
 		public.OnAbort = plan.OnAbort.Public()
 	}
@@ -107,6 +113,10 @@ func (plan *Plan) Public() *json.RawMessage {
 		public.Retry = plan.Retry.Public()
 	}
 
+	if plan.If != nil {
+		public.If = plan.If.Public()
+	}
+
 	if plan.ArtifactInput != nil {
 		public.ArtifactInput = plan.ArtifactInput.Public()
 	}
@@ -293,13 +303,17 @@ func (plan TaskPlan) Public() *json.RawMessage {
 
 func (plan RunPlan) Public() *json.RawMessage {
 	return enc(struct {
-		Message    string `json:"message"`
-		Type       string `json:"type"`
-		Privileged bool   `json:"privileged"`
+		Message        string           `json:"message"`
+		Type           string           `json:"type"`
+		Privileged     bool             `json:"privileged"`
+		ImageGetPlan   *json.RawMessage `json:"image_get_plan,omitempty"`
+		ImageCheckPlan *json.RawMessage `json:"image_check_plan,omitempty"`
 	}{
-		Message:    plan.Message,
-		Type:       plan.Type,
-		Privileged: plan.Privileged,
+		Message:        plan.Message,
+		Type:           plan.Type,
+		Privileged:     plan.Privileged,
+		ImageGetPlan:   plan.TypeImage.GetPlan.Public(),
+		ImageCheckPlan: plan.TypeImage.CheckPlan.Public(),
 	})
 }
 
@@ -323,6 +337,16 @@ func (plan LoadVarPlan) Public() *json.RawMessage {
 	})
 }
 
+func (plan ApprovePlan) Public() *json.RawMessage {
+	return enc(struct {
+		Name string `json:"name"`
+		Role string `json:"role"`
+	}{
+		Name: plan.Name,
+		Role: plan.Role,
+	})
+}
+
 func (plan TimeoutPlan) Public() *json.RawMessage {
 	return enc(struct {
 		Step     *json.RawMessage `json:"step"`
@@ -333,6 +357,16 @@ func (plan TimeoutPlan) Public() *json.RawMessage {
 	})
 }
 
+func (plan IfPlan) Public() *json.RawMessage {
+	return enc(struct {
+		Step      *json.RawMessage `json:"step"`
+		Condition string           `json:"condition"`
+	}{
+		Step:      plan.Step.Public(),
+		Condition: plan.Condition,
+	})
+}
+
 func (plan TryPlan) Public() *json.RawMessage {
 	return enc(struct {
 		Step *json.RawMessage `json:"step"`
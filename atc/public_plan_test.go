@@ -547,6 +547,13 @@ var _ = Describe("Plan", func() {
 								Reveal: true,
 							},
 						},
+						{
+							ID: "43",
+							Approve: &atc.ApprovePlan{
+								Name: "some-name",
+								Role: "owner",
+							},
+						},
 					},
 				},
 			}
@@ -935,6 +942,13 @@ var _ = Describe("Plan", func() {
 				"load_var": {
 					"name": "some-name"
 				}
+			},
+			{
+				"id": "43",
+				"approve": {
+					"name": "some-name",
+					"role": "owner"
+				}
 			}
 		]
 	}
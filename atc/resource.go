@@ -10,6 +10,9 @@ type Resource struct {
 	LastChecked          int64        `json:"last_checked,omitempty"`
 	Icon                 string       `json:"icon,omitempty"`
 
+	CheckInterval       string              `json:"check_interval,omitempty"`
+	CheckIntervalReason CheckIntervalReason `json:"check_interval_reason,omitempty"`
+
 	PinnedVersion  Version `json:"pinned_version,omitempty"`
 	PinnedInConfig bool    `json:"pinned_in_config,omitempty"`
 	PinComment     string  `json:"pin_comment,omitempty"`
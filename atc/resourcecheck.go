@@ -7,6 +7,28 @@ var (
 	DefaultWebhookInterval time.Duration
 )
 
+// CheckIntervalReason explains why a resource is checked on its current
+// interval.
+type CheckIntervalReason string
+
+const (
+	// CheckIntervalConfigured is the interval configured for the resource,
+	// or the default one.
+	CheckIntervalConfigured CheckIntervalReason = "configured"
+
+	// CheckIntervalActive is used for resources which recently had new
+	// versions, so they are checked as often as they are configured to be.
+	CheckIntervalActive CheckIntervalReason = "active"
+
+	// CheckIntervalIdle is used for resources which have not had a new
+	// version in a while, so they are checked less often.
+	CheckIntervalIdle CheckIntervalReason = "idle"
+
+	// CheckIntervalErroring is used for resources whose checks keep failing,
+	// so they are checked less often until they succeed again.
+	CheckIntervalErroring CheckIntervalReason = "erroring"
+)
+
 type CheckRequestBody struct {
 	From    Version `json:"from"`
 	Shallow bool    `json:"shallow"`
@@ -0,0 +1,12 @@
+package atc
+
+// The roles a user can have on a team, from the most to the least privileged.
+const (
+	OwnerRole    = "owner"
+	MemberRole   = "member"
+	OperatorRole = "pipeline-operator"
+	ViewerRole   = "viewer"
+)
+
+// TeamRoles are all of the roles a user can have on a team.
+var TeamRoles = []string{OwnerRole, MemberRole, OperatorRole, ViewerRole}
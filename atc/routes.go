@@ -15,6 +15,7 @@ const (
 	AbortBuild          = "AbortBuild"
 	GetBuildPreparation = "GetBuildPreparation"
 	SetBuildComment     = "SetBuildComment"
+	ApproveBuild        = "ApproveBuild"
 
 	GetJob         = "GetJob"
 	CreateJobBuild = "CreateJobBuild"
@@ -41,6 +42,7 @@ const (
 	GetResource               = "GetResource"
 	CheckResource             = "CheckResource"
 	CheckResourceWebHook      = "CheckResourceWebHook"
+	CheckTeamWebHook          = "CheckTeamWebHook"
 	CheckResourceType         = "CheckResourceType"
 	CheckPrototype            = "CheckPrototype"
 
@@ -77,6 +79,8 @@ const (
 	CreatePipelineBuild       = "CreatePipelineBuild"
 	PipelineBadge             = "PipelineBadge"
 
+	ListNotificationDeliveries = "ListNotificationDeliveries"
+
 	RegisterWorker  = "RegisterWorker"
 	LandWorker      = "LandWorker"
 	RetireWorker    = "RetireWorker"
@@ -109,13 +113,28 @@ const (
 	DestroyTeam    = "DestroyTeam"
 	ListTeamBuilds = "ListTeamBuilds"
 
+	SearchTeamBuildLogs     = "SearchTeamBuildLogs"
+	SearchPipelineBuildLogs = "SearchPipelineBuildLogs"
+	SearchJobBuildLogs      = "SearchJobBuildLogs"
+
 	CreateArtifact     = "CreateArtifact"
 	GetArtifact        = "GetArtifact"
 	ListBuildArtifacts = "ListBuildArtifacts"
 
+	ListBuildTestResults = "ListBuildTestResults"
+	ListJobTestSummaries = "ListJobTestSummaries"
+
+	ListBuildSecretAccesses = "ListBuildSecretAccesses"
+
+	GetJobStats = "GetJobStats"
+
 	GetUser              = "GetUser"
 	ListActiveUsersSince = "ListActiveUsersSince"
 
+	ListSecrets  = "ListSecrets"
+	SetSecret    = "SetSecret"
+	DeleteSecret = "DeleteSecret"
+
 	SetWall   = "SetWall"
 	GetWall   = "GetWall"
 	ClearWall = "ClearWall"
@@ -124,6 +143,14 @@ const (
 const (
 	ClearTaskCacheQueryPath = "cache_path"
 	SaveConfigCheckCreds    = "check_creds"
+
+	BuildLogSearchQuery  = "query"
+	BuildLogSearchStatus = "status"
+	BuildLogSearchSince  = "since"
+	BuildLogSearchUntil  = "until"
+
+	JobStatsSince = "since"
+	JobStatsUntil = "until"
 )
 
 var Routes = rata.Routes([]rata.Route{
@@ -140,7 +167,10 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/builds/:build_id/abort", Method: "PUT", Name: AbortBuild},
 	{Path: "/api/v1/builds/:build_id/preparation", Method: "GET", Name: GetBuildPreparation},
 	{Path: "/api/v1/builds/:build_id/artifacts", Method: "GET", Name: ListBuildArtifacts},
+	{Path: "/api/v1/builds/:build_id/test_results", Method: "GET", Name: ListBuildTestResults},
+	{Path: "/api/v1/builds/:build_id/secret_accesses", Method: "GET", Name: ListBuildSecretAccesses},
 	{Path: "/api/v1/builds/:build_id/comment", Method: "PUT", Name: SetBuildComment},
+	{Path: "/api/v1/builds/:build_id/approval", Method: "PUT", Name: ApproveBuild},
 
 	{Path: "/api/v1/jobs", Method: "GET", Name: ListAllJobs},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs", Method: "GET", Name: ListJobs},
@@ -174,6 +204,7 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/builds", Method: "GET", Name: ListPipelineBuilds},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/builds", Method: "POST", Name: CreatePipelineBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/badge", Method: "GET", Name: PipelineBadge},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/notifications", Method: "GET", Name: ListNotificationDeliveries},
 
 	{Path: "/api/v1/resources", Method: "GET", Name: ListAllResources},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources", Method: "GET", Name: ListResources},
@@ -183,6 +214,7 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name", Method: "GET", Name: GetResource},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/check", Method: "POST", Name: CheckResource},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/check/webhook", Method: "POST", Name: CheckResourceWebHook},
+	{Path: "/api/v1/teams/:team_name/webhooks/:webhook_name", Method: "POST", Name: CheckTeamWebHook},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resource-types/:resource_type_name/check", Method: "POST", Name: CheckResourceType},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/prototypes/:prototype_name/check", Method: "POST", Name: CheckPrototype},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/cache", Method: "DELETE", Name: ClearResourceCache},
@@ -239,9 +271,19 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name", Method: "DELETE", Name: DestroyTeam},
 	{Path: "/api/v1/teams/:team_name/builds", Method: "GET", Name: ListTeamBuilds},
 
+	{Path: "/api/v1/teams/:team_name/build_logs", Method: "GET", Name: SearchTeamBuildLogs},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/build_logs", Method: "GET", Name: SearchPipelineBuildLogs},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/build_logs", Method: "GET", Name: SearchJobBuildLogs},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/test_results", Method: "GET", Name: ListJobTestSummaries},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/stats", Method: "GET", Name: GetJobStats},
+
 	{Path: "/api/v1/teams/:team_name/artifacts", Method: "POST", Name: CreateArtifact},
 	{Path: "/api/v1/teams/:team_name/artifacts/:artifact_id", Method: "GET", Name: GetArtifact},
 
+	{Path: "/api/v1/teams/:team_name/secrets", Method: "GET", Name: ListSecrets},
+	{Path: "/api/v1/teams/:team_name/secrets/:secret_name", Method: "PUT", Name: SetSecret},
+	{Path: "/api/v1/teams/:team_name/secrets/:secret_name", Method: "DELETE", Name: DeleteSecret},
+
 	{Path: "/api/v1/wall", Method: "GET", Name: GetWall},
 	{Path: "/api/v1/wall", Method: "PUT", Name: SetWall},
 	{Path: "/api/v1/wall", Method: "DELETE", Name: ClearWall},
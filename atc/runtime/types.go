@@ -123,6 +123,16 @@ type ContainerSpec struct {
 	// CertsBindMount indicates whether or not to mount the worker's Certs
 	// volume onto the container.
 	CertsBindMount bool
+
+	// NetworkContainerHandle is the handle of a Container on the same Worker
+	// whose network namespace the Container should join, rather than having
+	// its own network. This is used for task services.
+	NetworkContainerHandle string
+
+	// ServiceContainers is the number of additional containers that will be
+	// created on the same Worker alongside the Container. Placement strategies
+	// which limit the number of containers on a Worker must account for them.
+	ServiceContainers int
 }
 
 type BuildStepDelegate interface {
@@ -191,12 +191,20 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		}, nil
 	}
 
-	config, err := job.Config()
-	if err != nil {
-		return startResults{}, fmt.Errorf("config: %w", err)
-	}
+	var plan atc.Plan
+	if nextPendingBuild.ResumedFrom() != 0 {
+		// a resumed build runs the plan of the build it resumes, so that its
+		// steps can be matched up with the steps that already succeeded
+		plan = nextPendingBuild.PrivatePlan()
+	} else {
+		var config atc.JobConfig
+		config, err = job.Config()
+		if err != nil {
+			return startResults{}, fmt.Errorf("config: %w", err)
+		}
 
-	plan, err := s.planner.Create(config.StepConfig(), job.Resources, job.ResourceTypes, job.Prototypes, buildInputs, nextPendingBuild.IsManuallyTriggered())
+		plan, err = s.planner.Create(config.StepConfig(), job.Resources, job.ResourceTypes, job.Prototypes, buildInputs, nextPendingBuild.IsManuallyTriggered())
+	}
 	if err != nil {
 		logger.Error("failed-to-create-build-plan", err)
 
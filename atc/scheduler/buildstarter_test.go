@@ -790,6 +790,32 @@ var _ = Describe("BuildStarter", func() {
 											Expect(rerunBuild.StartArgsForCall(0)).To(Equal(plannedPlan))
 										})
 									})
+
+									Context("when the rerun build resumes a failed build", func() {
+										var resumedPlan atc.Plan
+
+										BeforeEach(func() {
+											resumedPlan = atc.Plan{
+												ID: "resumed-plan",
+												Get: &atc.GetPlan{
+													Name:     "some-input",
+													Resource: "some-input",
+												},
+											}
+
+											rerunBuild.ResumedFromReturns(pendingBuild1.ID())
+											rerunBuild.PrivatePlanReturns(resumedPlan)
+										})
+
+										It("does not create a new plan for it", func() {
+											Expect(fakePlanner.CreateCallCount()).To(Equal(2))
+										})
+
+										It("starts it with the plan of the build it resumes", func() {
+											Expect(rerunBuild.StartCallCount()).To(Equal(1))
+											Expect(rerunBuild.StartArgsForCall(0)).To(Equal(resumedPlan))
+										})
+									})
 								})
 							})
 						})
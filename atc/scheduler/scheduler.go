@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/db"
@@ -58,9 +59,54 @@ func (s *Scheduler) Schedule(
 		return false, err
 	}
 
+	err = s.createScheduledBuild(logger, job)
+	if err != nil {
+		return false, err
+	}
+
 	return s.BuildStarter.TryStartPendingBuildsForJob(logger, job, jobInputs)
 }
 
+// createScheduledBuild creates a build if the job's schedule is due. Only one
+// build is created however many triggers were missed, e.g. while no ATC was
+// running. Triggers missed while the job was paused are skipped when it is
+// unpaused.
+func (s *Scheduler) createScheduledBuild(logger lager.Logger, job db.SchedulerJob) error {
+	trigger := job.ScheduleNextTrigger()
+	if trigger.IsZero() || trigger.After(time.Now()) {
+		return nil
+	}
+
+	config, err := job.Config()
+	if err != nil {
+		return fmt.Errorf("get job config: %w", err)
+	}
+
+	if config.Schedule == nil {
+		return nil
+	}
+
+	nextTrigger, err := config.Schedule.Next(time.Now())
+	if err != nil {
+		return fmt.Errorf("compute next trigger: %w", err)
+	}
+
+	build, created, err := job.CreateScheduledBuild(trigger, nextTrigger)
+	if err != nil {
+		return fmt.Errorf("create scheduled build: %w", err)
+	}
+
+	if created {
+		logger.Info("created-scheduled-build", lager.Data{
+			"build":        build.Name(),
+			"trigger":      trigger,
+			"next-trigger": nextTrigger,
+		})
+	}
+
+	return nil
+}
+
 func (s *Scheduler) ensurePendingBuildExists(
 	ctx context.Context,
 	logger lager.Logger,
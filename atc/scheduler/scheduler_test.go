@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/concourse/concourse/atc"
@@ -475,5 +476,59 @@ var _ = Describe("Scheduler", func() {
 				Expect(scheduleErr).To(Equal(fmt.Errorf("inputs: %w", disaster)))
 			})
 		})
+
+		Context("when the job has a schedule", func() {
+			BeforeEach(func() {
+				fakeJob.ConfigReturns(atc.JobConfig{
+					Name:     "some-job",
+					Schedule: &atc.JobSchedule{Cron: "0 3 * * *"},
+				}, nil)
+				fakeJob.CreateScheduledBuildReturns(new(dbfakes.FakeBuild), true, nil)
+			})
+
+			Context("when the next trigger is due", func() {
+				var trigger time.Time
+
+				BeforeEach(func() {
+					trigger = time.Now().Add(-time.Minute)
+					fakeJob.ScheduleNextTriggerReturns(trigger)
+				})
+
+				It("creates a scheduled build and moves the trigger forward", func() {
+					Expect(scheduleErr).ToNot(HaveOccurred())
+					Expect(fakeJob.CreateScheduledBuildCallCount()).To(Equal(1))
+
+					actualTrigger, nextTrigger := fakeJob.CreateScheduledBuildArgsForCall(0)
+					Expect(actualTrigger).To(Equal(trigger))
+					Expect(nextTrigger).To(BeTemporally(">", time.Now()))
+					Expect(nextTrigger.UTC().Hour()).To(Equal(3))
+				})
+
+				It("still starts pending builds", func() {
+					Expect(fakeBuildStarter.TryStartPendingBuildsForJobCallCount()).To(Equal(1))
+				})
+
+				Context("when creating the build fails", func() {
+					BeforeEach(func() {
+						fakeJob.CreateScheduledBuildReturns(nil, false, disaster)
+					})
+
+					It("returns the error", func() {
+						Expect(scheduleErr).To(Equal(fmt.Errorf("create scheduled build: %w", disaster)))
+					})
+				})
+			})
+
+			Context("when the next trigger is in the future", func() {
+				BeforeEach(func() {
+					fakeJob.ScheduleNextTriggerReturns(time.Now().Add(time.Hour))
+				})
+
+				It("does not create a build", func() {
+					Expect(scheduleErr).ToNot(HaveOccurred())
+					Expect(fakeJob.CreateScheduledBuildCallCount()).To(BeZero())
+				})
+			})
+		})
 	})
 })
@@ -0,0 +1,15 @@
+package atc
+
+// Secret describes a secret held by the built-in credential manager. Its
+// value is never returned by the API.
+type Secret struct {
+	Name         string `json:"name"`
+	TeamName     string `json:"team_name"`
+	PipelineName string `json:"pipeline_name,omitempty"`
+	UpdatedAt    int64  `json:"updated_at"`
+}
+
+type SetSecretRequest struct {
+	PipelineName string      `json:"pipeline_name,omitempty"`
+	Value        interface{} `json:"value"`
+}
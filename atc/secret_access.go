@@ -0,0 +1,22 @@
+package atc
+
+// SecretAccess is a secret looked up while running a build, for auditing
+// which credentials a build touched. The secret's value is never recorded.
+type SecretAccess struct {
+	// Source is the name of the var source the secret was looked up in, or
+	// empty for the cluster-wide credential manager.
+	Source string `json:"source,omitempty"`
+
+	// Path is the path of the secret in the credential manager, as resolved
+	// from the var's name.
+	Path string `json:"path"`
+
+	CredentialManager string `json:"credential_manager,omitempty"`
+
+	Found  bool `json:"found"`
+	Cached bool `json:"cached"`
+
+	// Error is the error the credential manager failed the lookup with, if
+	// any.
+	Error string `json:"error,omitempty"`
+}
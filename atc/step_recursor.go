@@ -25,6 +25,9 @@ type StepRecursor struct {
 
 	// OnLoadVar will be invoked for any *LoadVarStep present in the StepConfig.
 	OnLoadVar func(*LoadVarStep) error
+
+	// OnApprove will be invoked for any *ApproveStep present in the StepConfig.
+	OnApprove func(*ApproveStep) error
 }
 
 // VisitTask calls the OnTask hook if configured.
@@ -81,6 +84,15 @@ func (recursor StepRecursor) VisitLoadVar(step *LoadVarStep) error {
 	return nil
 }
 
+// VisitApprove calls the OnApprove hook if configured.
+func (recursor StepRecursor) VisitApprove(step *ApproveStep) error {
+	if recursor.OnApprove != nil {
+		return recursor.OnApprove(step)
+	}
+
+	return nil
+}
+
 // VisitTry recurses through to the wrapped step.
 func (recursor StepRecursor) VisitTry(step *TryStep) error {
 	return step.Step.Config.Visit(recursor)
@@ -174,3 +186,8 @@ func (recursor StepRecursor) VisitEnsure(step *EnsureStep) error {
 
 	return step.Hook.Config.Visit(recursor)
 }
+
+// VisitIf recurses through to the wrapped step.
+func (recursor StepRecursor) VisitIf(step *IfStep) error {
+	return step.Step.Visit(recursor)
+}
@@ -103,9 +103,49 @@ func (validator *StepValidator) VisitTask(plan *TaskStep) error {
 		validator.popContext()
 	}
 
+	if plan.IDToken != nil {
+		validator.validateIDToken(*plan.IDToken)
+	}
+
 	return nil
 }
 
+func (validator *StepValidator) validateIDToken(config IDTokenConfig) {
+	validator.pushContext(".id_token")
+	defer validator.popContext()
+
+	if !envVarNameRegex.MatchString(config.ParamName()) {
+		validator.pushContext(".param")
+		validator.recordError("'%s' is not a valid environment variable name", config.ParamName())
+		validator.popContext()
+	}
+
+	for i, audience := range config.Audience {
+		if audience == "" {
+			validator.pushContext(fmt.Sprintf(".audience[%d]", i))
+			validator.recordError("must not be empty")
+			validator.popContext()
+		}
+	}
+
+	if config.ExpiresIn == "" {
+		return
+	}
+
+	validator.pushContext(".expires_in")
+	defer validator.popContext()
+
+	expiry, err := config.Expiry()
+	if err != nil {
+		validator.recordError("invalid duration '%s'", config.ExpiresIn)
+		return
+	}
+
+	if expiry <= 0 || expiry > MaxIDTokenExpiry {
+		validator.recordError("must be between 0s and %s", MaxIDTokenExpiry)
+	}
+}
+
 func (validator *StepValidator) VisitGet(step *GetStep) error {
 	validator.pushContext(fmt.Sprintf(".get(%s)", step.Name))
 	defer validator.popContext()
@@ -190,7 +230,12 @@ func (validator *StepValidator) VisitPut(step *PutStep) error {
 }
 
 func (validator *StepValidator) VisitRun(step *RunStep) error {
-	validator.pushContext(".run(%s.%s)", step.Type, step.Message)
+	prototypeName := step.Type
+	if prototypeName == "" {
+		prototypeName = step.ImageArtifactName
+	}
+
+	validator.pushContext(".run(%s.%s)", prototypeName, step.Message)
 	defer validator.popContext()
 
 	warning, err := ValidateIdentifier(step.Message, validator.context...)
@@ -204,9 +249,16 @@ func (validator *StepValidator) VisitRun(step *RunStep) error {
 		validator.recordError(warning.Message)
 	}
 
-	_, found := validator.config.Prototypes.Lookup(step.Type)
-	if !found {
-		validator.recordError("unknown prototype '%s'", step.Type)
+	switch {
+	case step.Type == "" && step.ImageArtifactName == "":
+		validator.recordError("must specify either type or image")
+	case step.Type != "" && step.ImageArtifactName != "":
+		validator.recordError("cannot specify both type and image")
+	case step.Type != "":
+		_, found := validator.config.Prototypes.Lookup(step.Type)
+		if !found {
+			validator.recordError("unknown prototype '%s'", step.Type)
+		}
 	}
 
 	return nil
@@ -252,6 +304,35 @@ func (validator *StepValidator) VisitLoadVar(step *LoadVarStep) error {
 	return nil
 }
 
+func (validator *StepValidator) VisitApprove(step *ApproveStep) error {
+	validator.pushContext(".approve(%s)", step.Name)
+	defer validator.popContext()
+
+	warning, err := ValidateIdentifier(step.Name, validator.context...)
+	if err != nil {
+		validator.recordError(err.Error())
+	}
+	if warning != nil {
+		validator.recordWarning(*warning)
+	}
+
+	if step.Role != "" {
+		var valid bool
+		for _, role := range ApprovalRoles {
+			if step.Role == role {
+				valid = true
+				break
+			}
+		}
+
+		if !valid {
+			validator.recordError("unknown role '%s' (must be one of %s)", step.Role, strings.Join(ApprovalRoles, ", "))
+		}
+	}
+
+	return nil
+}
+
 func (validator *StepValidator) VisitTry(step *TryStep) error {
 	validator.pushContext(".try")
 	defer validator.popContext()
@@ -350,11 +431,50 @@ func (validator *StepValidator) VisitRetry(step *RetryStep) error {
 	}
 
 	validator.pushContext(".attempts")
-	defer validator.popContext()
-
 	if step.Attempts <= 0 {
 		validator.recordError("must be greater than 0")
 	}
+	validator.popContext()
+
+	validator.validateDuration(".backoff", step.Backoff)
+	validator.validateDuration(".max_backoff", step.MaxBackoff)
+
+	if step.Jitter < 0 || step.Jitter > 1 {
+		validator.pushContext(".jitter")
+		validator.recordError("must be between 0 and 1")
+		validator.popContext()
+	}
+
+	return nil
+}
+
+func (validator *StepValidator) validateDuration(context string, duration string) {
+	if duration == "" {
+		return
+	}
+
+	validator.pushContext(context)
+	defer validator.popContext()
+
+	_, err := time.ParseDuration(duration)
+	if err != nil {
+		validator.recordError("invalid duration '%s'", duration)
+	}
+}
+
+func (validator *StepValidator) VisitIf(step *IfStep) error {
+	err := step.Step.Visit(validator)
+	if err != nil {
+		return err
+	}
+
+	validator.pushContext(".if")
+	defer validator.popContext()
+
+	_, err = ParseCondition(step.Condition)
+	if err != nil {
+		validator.recordError("invalid condition '%s': %s", step.Condition, err)
+	}
 
 	return nil
 }
@@ -194,6 +194,7 @@ type StepVisitor interface {
 	VisitRun(*RunStep) error
 	VisitSetPipeline(*SetPipelineStep) error
 	VisitLoadVar(*LoadVarStep) error
+	VisitApprove(*ApproveStep) error
 	VisitTry(*TryStep) error
 	VisitDo(*DoStep) error
 	VisitInParallel(*InParallelStep) error
@@ -205,6 +206,7 @@ type StepVisitor interface {
 	VisitOnAbort(*OnAbortStep) error
 	VisitOnError(*OnErrorStep) error
 	VisitEnsure(*EnsureStep) error
+	VisitIf(*IfStep) error
 }
 
 // StepDetector is a simple structure used to detect whether a step type is
@@ -246,6 +248,10 @@ var StepPrecedence = []StepDetector{
 		Key: "across",
 		New: func() StepConfig { return &AcrossStep{} },
 	},
+	{
+		Key: "if",
+		New: func() StepConfig { return &IfStep{} },
+	},
 	{
 		Key: "attempts",
 		New: func() StepConfig { return &RetryStep{} },
@@ -278,6 +284,10 @@ var StepPrecedence = []StepDetector{
 		Key: "load_var",
 		New: func() StepConfig { return &LoadVarStep{} },
 	},
+	{
+		Key: "approve",
+		New: func() StepConfig { return &ApproveStep{} },
+	},
 	{
 		Key: "try",
 		New: func() StepConfig { return &TryStep{} },
@@ -351,6 +361,8 @@ type TaskStep struct {
 	OutputMapping     map[string]string `json:"output_mapping,omitempty"`
 	ImageArtifactName string            `json:"image,omitempty"`
 	Timeout           string            `json:"timeout,omitempty"`
+	CacheResult       bool              `json:"cache_result,omitempty"`
+	IDToken           *IDTokenConfig    `json:"id_token,omitempty"`
 }
 
 func (step *TaskStep) Visit(v StepVisitor) error {
@@ -359,20 +371,25 @@ func (step *TaskStep) Visit(v StepVisitor) error {
 
 type RunStep struct {
 	Message    string           `json:"run"`
-	Type       string           `json:"type"`
+	Type       string           `json:"type,omitempty"`
 	Params     Params           `json:"params,omitempty"`
 	Privileged bool             `json:"privileged,omitempty"`
 	Tags       Tags             `json:"tags,omitempty"`
 	Limits     *ContainerLimits `json:"container_limits,omitempty"`
 	Timeout    string           `json:"timeout,omitempty"`
 
-	// XXX(prototypes): inputs, outputs, input_mapping, output_mapping?
-	// see https://github.com/concourse/rfcs/pull/103
+	// An artifact to use as the prototype's image, in place of type. That way,
+	// a prototype can be built and run in the same pipeline.
+	ImageArtifactName string `json:"image,omitempty"`
 
-	// XXX(prototypes): set_vars?
+	Inputs        []string          `json:"inputs,omitempty"`
+	Outputs       []string          `json:"outputs,omitempty"`
+	InputMapping  map[string]string `json:"input_mapping,omitempty"`
+	OutputMapping map[string]string `json:"output_mapping,omitempty"`
 
-	// XXX(prototypes): image? That way, you can build a prototype and run it
-	// in the same pipeline. This would be in place of type.
+	// Local vars to set from the fields of the prototype's response, keyed by
+	// var name.
+	SetVars map[string]string `json:"set_vars,omitempty"`
 }
 
 func (step *RunStep) Visit(v StepVisitor) error {
@@ -403,6 +420,28 @@ func (step *LoadVarStep) Visit(v StepVisitor) error {
 	return v.VisitLoadVar(step)
 }
 
+// ApproveStep pauses the build until a user with the given role on the
+// build's team approves or rejects it.
+//
+// The step waits for a decision for as long as the build runs. A deadline is
+// set with the timeout modifier; once it passes, the request is withdrawn and
+// the step fails.
+type ApproveStep struct {
+	Name string `json:"approve"`
+	Role string `json:"role,omitempty"`
+}
+
+// DefaultApprovalRole is the role required to approve an ApproveStep which
+// does not configure one.
+const DefaultApprovalRole = MemberRole
+
+// ApprovalRoles are the team roles which may be required by an ApproveStep.
+var ApprovalRoles = TeamRoles
+
+func (step *ApproveStep) Visit(v StepVisitor) error {
+	return v.VisitApprove(step)
+}
+
 type TryStep struct {
 	Step Step `json:"try"`
 }
@@ -506,6 +545,34 @@ func (step *AcrossStep) Unwrap() StepConfig {
 type RetryStep struct {
 	Step     StepConfig `json:"-"`
 	Attempts int        `json:"attempts"`
+
+	// Backoff is the delay before the second attempt. It doubles for each
+	// subsequent attempt, up to MaxBackoff.
+	Backoff    string `json:"backoff,omitempty"`
+	MaxBackoff string `json:"max_backoff,omitempty"`
+
+	// Jitter randomly shortens each delay by up to the given fraction (0-1) to
+	// avoid retrying many steps in lockstep.
+	Jitter float64 `json:"jitter,omitempty"`
+
+	// RetryOn restricts which outcomes are retried. By default, both failures
+	// and errors are retried.
+	RetryOn *RetryOnConfig `json:"retry_on,omitempty"`
+}
+
+// RetryPolicy returns the backoff and retry conditions configured on the
+// step, or nil if only attempts are configured.
+func (step *RetryStep) RetryPolicy() *RetryPolicy {
+	if step.Backoff == "" && step.MaxBackoff == "" && step.Jitter == 0 && step.RetryOn == nil {
+		return nil
+	}
+
+	return &RetryPolicy{
+		Backoff:    step.Backoff,
+		MaxBackoff: step.MaxBackoff,
+		Jitter:     step.Jitter,
+		RetryOn:    step.RetryOn,
+	}
 }
 
 func (step *RetryStep) Wrap(sub StepConfig) {
@@ -625,6 +692,28 @@ func (step *EnsureStep) Visit(v StepVisitor) error {
 	return v.VisitEnsure(step)
 }
 
+// IfStep only runs the wrapped step when its condition evaluates to true.
+// Otherwise the step is skipped and treated as having succeeded.
+//
+// It is parsed after `across:` so that the condition may refer to the values
+// being iterated over.
+type IfStep struct {
+	Step      StepConfig `json:"-"`
+	Condition string     `json:"if"`
+}
+
+func (step *IfStep) Wrap(sub StepConfig) {
+	step.Step = sub
+}
+
+func (step *IfStep) Unwrap() StepConfig {
+	return step.Step
+}
+
+func (step *IfStep) Visit(v StepVisitor) error {
+	return v.VisitIf(step)
+}
+
 // MaxInFlightConfig can represent either running all values in an AcrossStep
 // in parallel or a applying a limit to the sub-steps that can run at once.
 type MaxInFlightConfig struct {
@@ -732,6 +821,91 @@ func (c *VersionConfig) MarshalJSON() ([]byte, error) {
 	return json.Marshal("")
 }
 
+const (
+	RetryOnFailed  = "failed"
+	RetryOnErrored = "errored"
+)
+
+// A RetryOnConfig represents the outcomes of an attempt that should be
+// retried. It is configured as a list containing "failed", "errored" and/or
+// task exit codes, e.g. `retry_on: [errored, 75]`.
+type RetryOnConfig struct {
+	Failed    bool
+	Errored   bool
+	ExitCodes []int
+}
+
+func (c *RetryOnConfig) UnmarshalJSON(data []byte) error {
+	var values []interface{}
+
+	decoder := json.NewDecoder(bytes.NewBuffer(data))
+	decoder.UseNumber()
+
+	err := decoder.Decode(&values)
+	if err != nil {
+		return errors.New("retry_on must be a list")
+	}
+
+	for _, v := range values {
+		switch actual := v.(type) {
+		case string:
+			switch actual {
+			case RetryOnFailed:
+				c.Failed = true
+			case RetryOnErrored:
+				c.Errored = true
+			default:
+				return fmt.Errorf("unknown retry_on value '%s'", actual)
+			}
+		case json.Number:
+			code, err := actual.Int64()
+			if err != nil {
+				return fmt.Errorf("invalid exit code %s", actual)
+			}
+
+			c.ExitCodes = append(c.ExitCodes, int(code))
+		default:
+			return fmt.Errorf("unknown retry_on value %v", actual)
+		}
+	}
+
+	return nil
+}
+
+func (c RetryOnConfig) MarshalJSON() ([]byte, error) {
+	values := []interface{}{}
+
+	if c.Failed {
+		values = append(values, RetryOnFailed)
+	}
+
+	if c.Errored {
+		values = append(values, RetryOnErrored)
+	}
+
+	for _, code := range c.ExitCodes {
+		values = append(values, code)
+	}
+
+	return json.Marshal(values)
+}
+
+// RetriesExitCode returns whether a failure with the given exit code should be
+// retried.
+func (c RetryOnConfig) RetriesExitCode(code int) bool {
+	if c.Failed {
+		return true
+	}
+
+	for _, c := range c.ExitCodes {
+		if c == code {
+			return true
+		}
+	}
+
+	return false
+}
+
 const InputsAll = "all"
 const InputsDetect = "detect"
 
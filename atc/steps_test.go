@@ -231,6 +231,34 @@ var factoryTests = []StepTest{
 			Reveal: true,
 		},
 	},
+	{
+		Title: "approve step",
+
+		ConfigYAML: `
+			approve: deploy-to-prod
+			role: owner
+		`,
+
+		StepConfig: &atc.ApproveStep{
+			Name: "deploy-to-prod",
+			Role: "owner",
+		},
+	},
+	{
+		Title: "approve step with timeout",
+
+		ConfigYAML: `
+			approve: deploy-to-prod
+			timeout: 1h
+		`,
+
+		StepConfig: &atc.TimeoutStep{
+			Step: &atc.ApproveStep{
+				Name: "deploy-to-prod",
+			},
+			Duration: "1h",
+		},
+	},
 	{
 		Title: "try step",
 
@@ -429,6 +457,23 @@ var factoryTests = []StepTest{
 			Duration: "1h",
 		},
 	},
+	{
+		Title: "if modifier",
+
+		ConfigYAML: `
+			load_var: some-var
+			file: some-file
+			if: ((.:deploy)) == "yes"
+		`,
+
+		StepConfig: &atc.IfStep{
+			Step: &atc.LoadVarStep{
+				Name: "some-var",
+				File: "some-file",
+			},
+			Condition: `((.:deploy)) == "yes"`,
+		},
+	},
 	{
 		Title: "attempts modifier",
 
@@ -446,6 +491,46 @@ var factoryTests = []StepTest{
 			Attempts: 3,
 		},
 	},
+	{
+		Title: "attempts modifier with backoff",
+
+		ConfigYAML: `
+			load_var: some-var
+			file: some-file
+			attempts: 3
+			backoff: 10s
+			max_backoff: 1m
+			jitter: 0.2
+			retry_on: [errored, 75]
+		`,
+
+		StepConfig: &atc.RetryStep{
+			Step: &atc.LoadVarStep{
+				Name: "some-var",
+				File: "some-file",
+			},
+			Attempts:   3,
+			Backoff:    "10s",
+			MaxBackoff: "1m",
+			Jitter:     0.2,
+			RetryOn: &atc.RetryOnConfig{
+				Errored:   true,
+				ExitCodes: []int{75},
+			},
+		},
+	},
+	{
+		Title: "attempts modifier with invalid retry_on",
+
+		ConfigYAML: `
+			load_var: some-var
+			file: some-file
+			attempts: 3
+			retry_on: [sometimes]
+		`,
+
+		Err: `unknown retry_on value 'sometimes'`,
+	},
 	{
 		Title: "precedence of all hooks and modifiers",
 
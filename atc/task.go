@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"path"
 	"strings"
+	"time"
 
 	"sigs.k8s.io/yaml"
 )
@@ -36,6 +38,12 @@ type TaskConfig struct {
 
 	// Path to cached directory that will be shared between builds for the same task.
 	Caches []TaskCacheConfig `json:"caches,omitempty"`
+
+	// Containers to run alongside the task container, sharing its network.
+	Services []TaskServiceConfig `json:"services,omitempty"`
+
+	// Test reports written by the task to its outputs.
+	Reports []TaskReportConfig `json:"reports,omitempty"`
 }
 
 type ImageResource struct {
@@ -99,6 +107,8 @@ func (config TaskConfig) Validate() error {
 
 	errors = append(errors, config.validateInputContainsNames()...)
 	errors = append(errors, config.validateOutputContainsNames()...)
+	errors = append(errors, config.validateServices()...)
+	errors = append(errors, config.validateReports()...)
 
 	if len(errors) > 0 {
 		return TaskValidationError{
@@ -133,6 +143,73 @@ func (config TaskConfig) validateInputContainsNames() []string {
 	return messages
 }
 
+func (config TaskConfig) validateServices() []string {
+	var messages []string
+
+	names := map[string]bool{}
+	for i, service := range config.Services {
+		if service.Name == "" {
+			messages = append(messages, fmt.Sprintf("  service in position %d is missing a name", i))
+		} else if names[service.Name] {
+			messages = append(messages, fmt.Sprintf("  service '%s' is declared more than once", service.Name))
+		}
+
+		names[service.Name] = true
+
+		if service.Run.Path == "" {
+			messages = append(messages, fmt.Sprintf("  service in position %d is missing path to executable to run", i))
+		}
+
+		if service.Readiness != nil {
+			if service.Readiness.Run.Path == "" {
+				messages = append(messages, fmt.Sprintf("  service in position %d is missing path to readiness probe executable", i))
+			}
+
+			if service.Readiness.Interval != "" {
+				if _, err := time.ParseDuration(service.Readiness.Interval); err != nil {
+					messages = append(messages, fmt.Sprintf("  service in position %d has invalid readiness interval '%s'", i, service.Readiness.Interval))
+				}
+			}
+
+			if service.Readiness.Timeout != "" {
+				if _, err := time.ParseDuration(service.Readiness.Timeout); err != nil {
+					messages = append(messages, fmt.Sprintf("  service in position %d has invalid readiness timeout '%s'", i, service.Readiness.Timeout))
+				}
+			}
+		}
+	}
+
+	return messages
+}
+
+func (config TaskConfig) validateReports() []string {
+	var messages []string
+
+	outputs := map[string]bool{}
+	for _, output := range config.Outputs {
+		outputs[output.Name] = true
+	}
+
+	for i, report := range config.Reports {
+		switch report.Format {
+		case TestReportFormatJUnit, TestReportFormatTAP, TestReportFormatGoTest:
+		case "":
+			messages = append(messages, fmt.Sprintf("  report in position %d is missing a format", i))
+		default:
+			messages = append(messages, fmt.Sprintf("  report in position %d has unknown format '%s' (must be %s, %s or %s)", i, report.Format, TestReportFormatJUnit, TestReportFormatTAP, TestReportFormatGoTest))
+		}
+
+		outputName, file := report.Output()
+		if file == "" {
+			messages = append(messages, fmt.Sprintf("  report in position %d must have a path of the form <output>/<file>", i))
+		} else if !outputs[outputName] {
+			messages = append(messages, fmt.Sprintf("  report in position %d refers to unknown output '%s'", i, outputName))
+		}
+	}
+
+	return messages
+}
+
 type TaskRunConfig struct {
 	Path string   `json:"path"`
 	Args []string `json:"args,omitempty"`
@@ -157,6 +234,63 @@ type TaskCacheConfig struct {
 	Path string `json:"path,omitempty"`
 }
 
+// TaskReportConfig configures a test report which the task writes to one of
+// its outputs. Once the task has exited, the report is parsed and its results
+// are stored with the build.
+type TaskReportConfig struct {
+	// Path to the report, starting with the name of the output.
+	Path string `json:"path"`
+
+	// Format of the report: junit, tap or gotest.
+	Format string `json:"format"`
+}
+
+// Output splits the report's path into the name of the output and the path
+// of the report within it.
+func (report TaskReportConfig) Output() (string, string) {
+	segs := strings.SplitN(path.Clean(report.Path), "/", 2)
+	if len(segs) != 2 {
+		return segs[0], ""
+	}
+
+	return segs[0], segs[1]
+}
+
+// TaskServiceConfig configures a container which is started on the same
+// worker as the task container before the task runs, e.g. a database used by
+// integration tests. Services share the task container's network namespace,
+// so they are reachable from the task via localhost. This requires workers
+// using the containerd runtime; the step errors on other workers.
+type TaskServiceConfig struct {
+	Name string `json:"name"`
+
+	RootfsURI     string         `json:"rootfs_uri,omitempty"`
+	ImageResource *ImageResource `json:"image_resource,omitempty"`
+
+	// Parameters to pass to the service via environment variables.
+	Params TaskEnv `json:"params,omitempty"`
+
+	// Command which starts the service. It is expected to keep running until
+	// the service container is torn down.
+	Run TaskRunConfig `json:"run"`
+
+	// Probe used to determine when the service is ready. If unset, the service
+	// is considered ready as soon as it has been started.
+	Readiness *TaskServiceReadinessConfig `json:"readiness,omitempty"`
+}
+
+// TaskServiceReadinessConfig configures a command which is run periodically
+// in the service container until it exits 0.
+type TaskServiceReadinessConfig struct {
+	Run TaskRunConfig `json:"run"`
+
+	// How long to wait between attempts. Defaults to 1s.
+	Interval string `json:"interval,omitempty"`
+
+	// How long to wait for the service to become ready. Defaults to 5m.
+	Timeout string `json:"timeout,omitempty"`
+}
+
 type TaskEnv map[string]string
 
 func (te *TaskEnv) UnmarshalJSON(p []byte) error {
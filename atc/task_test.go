@@ -385,6 +385,116 @@ run: {path: a/file}
 			})
 		})
 
+		Context("when the task has services", func() {
+			BeforeEach(func() {
+				validConfig.Services = append(validConfig.Services, TaskServiceConfig{
+					Name: "postgres",
+					Run:  TaskRunConfig{Path: "docker-entrypoint.sh", Args: []string{"postgres"}},
+					Readiness: &TaskServiceReadinessConfig{
+						Run:      TaskRunConfig{Path: "pg_isready"},
+						Interval: "2s",
+						Timeout:  "1m",
+					},
+				})
+			})
+
+			It("is valid", func() {
+				Expect(validConfig.Validate()).ToNot(HaveOccurred())
+			})
+
+			Context("when service.name is missing", func() {
+				BeforeEach(func() {
+					invalidConfig.Services = append(invalidConfig.Services, TaskServiceConfig{
+						Run: TaskRunConfig{Path: "redis-server"},
+					})
+				})
+
+				It("returns an error", func() {
+					Expect(invalidConfig.Validate()).To(MatchError(ContainSubstring("service in position 0 is missing a name")))
+				})
+			})
+
+			Context("when a service name is declared more than once", func() {
+				BeforeEach(func() {
+					invalidConfig.Services = append(
+						invalidConfig.Services,
+						TaskServiceConfig{Name: "redis", Run: TaskRunConfig{Path: "redis-server"}},
+						TaskServiceConfig{Name: "redis", Run: TaskRunConfig{Path: "redis-server"}},
+					)
+				})
+
+				It("returns an error", func() {
+					Expect(invalidConfig.Validate()).To(MatchError(ContainSubstring("service 'redis' is declared more than once")))
+				})
+			})
+
+			Context("when service.run is missing", func() {
+				BeforeEach(func() {
+					invalidConfig.Services = append(invalidConfig.Services, TaskServiceConfig{Name: "redis"})
+				})
+
+				It("returns an error", func() {
+					Expect(invalidConfig.Validate()).To(MatchError(ContainSubstring("service in position 0 is missing path to executable to run")))
+				})
+			})
+
+			Context("when the readiness probe is invalid", func() {
+				BeforeEach(func() {
+					invalidConfig.Services = append(invalidConfig.Services, TaskServiceConfig{
+						Name: "redis",
+						Run:  TaskRunConfig{Path: "redis-server"},
+						Readiness: &TaskServiceReadinessConfig{
+							Interval: "often",
+							Timeout:  "soon",
+						},
+					})
+				})
+
+				It("returns an error", func() {
+					err := invalidConfig.Validate()
+
+					Expect(err).To(MatchError(ContainSubstring("service in position 0 is missing path to readiness probe executable")))
+					Expect(err).To(MatchError(ContainSubstring("service in position 0 has invalid readiness interval 'often'")))
+					Expect(err).To(MatchError(ContainSubstring("service in position 0 has invalid readiness timeout 'soon'")))
+				})
+			})
+		})
+
+		Context("when the task has reports", func() {
+			BeforeEach(func() {
+				validConfig.Outputs = append(validConfig.Outputs, TaskOutputConfig{Name: "results"})
+				validConfig.Reports = append(validConfig.Reports, TaskReportConfig{
+					Path:   "results/junit.xml",
+					Format: TestReportFormatJUnit,
+				})
+			})
+
+			It("is valid", func() {
+				Expect(validConfig.Validate()).ToNot(HaveOccurred())
+			})
+
+			Context("when the report is invalid", func() {
+				BeforeEach(func() {
+					invalidConfig.Outputs = append(invalidConfig.Outputs, TaskOutputConfig{Name: "results"})
+					invalidConfig.Reports = append(
+						invalidConfig.Reports,
+						TaskReportConfig{Path: "results/report.xml"},
+						TaskReportConfig{Path: "results", Format: "xunit"},
+						TaskReportConfig{Path: "other/report.tap", Format: TestReportFormatTAP},
+					)
+				})
+
+				It("returns an error", func() {
+					err := invalidConfig.Validate()
+
+					Expect(err).To(MatchError(ContainSubstring("report in position 0 is missing a format")))
+					Expect(err).To(MatchError(ContainSubstring("report in position 1 has unknown format 'xunit'")))
+					Expect(err).To(MatchError(ContainSubstring("report in position 1 must have a path of the form <output>/<file>")))
+					Expect(err).To(MatchError(ContainSubstring("report in position 2 refers to unknown output 'other'")))
+				})
+			})
+		})
+
 		Context("when run is missing", func() {
 			BeforeEach(func() {
 				invalidConfig.Run.Path = ""
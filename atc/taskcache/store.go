@@ -0,0 +1,208 @@
+package taskcache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/concourse/concourse/atc/blobstore"
+	"github.com/concourse/concourse/atc/compression"
+	"github.com/concourse/concourse/atc/runtime"
+)
+
+const (
+	keySuffix    = ".tgz"
+	digestSuffix = ".sha256"
+)
+
+// Key returns the key under which the cache at the given path of a job's
+// task step is stored.
+func Key(jobID int, stepName string, path string) string {
+	return fmt.Sprintf("%d/%s/%s%s", jobID, url.PathEscape(stepName), url.PathEscape(path), keySuffix)
+}
+
+// DigestKey returns the key under which the digest of the contents stored
+// under the given key is kept.
+func DigestKey(key string) string {
+	return strings.TrimSuffix(key, keySuffix) + digestSuffix
+}
+
+// ParseKey returns the job ID, step name and cache path of a key returned by
+// Key. It returns false if the key is malformed.
+func ParseKey(key string) (int, string, string, bool) {
+	segments := strings.Split(strings.TrimSuffix(key, keySuffix), "/")
+	if len(segments) != 3 || !strings.HasSuffix(key, keySuffix) {
+		return 0, "", "", false
+	}
+
+	jobID, err := strconv.Atoi(segments[0])
+	if err != nil {
+		return 0, "", "", false
+	}
+
+	stepName, err := url.PathUnescape(segments[1])
+	if err != nil {
+		return 0, "", "", false
+	}
+
+	path, err := url.PathUnescape(segments[2])
+	if err != nil {
+		return 0, "", "", false
+	}
+
+	return jobID, stepName, path, true
+}
+
+// Store uploads the contents of task cache volumes to an ObjectStore and
+// restores them into volumes on other workers.
+type Store struct {
+	objects blobstore.ObjectStore
+
+	savingL sync.Mutex
+	saving  map[string]bool
+}
+
+func NewStore(objects blobstore.ObjectStore) *Store {
+	return &Store{
+		objects: objects,
+		saving:  map[string]bool{},
+	}
+}
+
+// Restore streams the stored contents of the cache into the volume. It
+// returns false if nothing has been stored for the cache yet.
+func (store *Store) Restore(ctx context.Context, jobID int, stepName string, path string, volume runtime.Volume) (bool, error) {
+	content, found, err := store.objects.Get(ctx, Key(jobID, stepName, path))
+	if err != nil {
+		return false, err
+	}
+
+	if !found {
+		return false, nil
+	}
+
+	defer content.Close()
+
+	err = volume.StreamIn(ctx, ".", compression.NewGzipCompression(), content)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Save uploads the contents of the volume as the cache, replacing anything
+// stored for it before.
+//
+// The contents are only uploaded if their digest differs from the one stored
+// along with the cache, as most runs leave their caches as they were. Saving
+// a cache which is already being saved does nothing.
+func (store *Store) Save(ctx context.Context, jobID int, stepName string, path string, volume runtime.Volume) error {
+	key := Key(jobID, stepName, path)
+
+	if !store.startSaving(key) {
+		return nil
+	}
+
+	defer store.finishSaving(key)
+
+	digest, err := store.volumeDigest(ctx, volume)
+	if err != nil {
+		return err
+	}
+
+	storedDigest, err := store.storedDigest(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	if digest == storedDigest {
+		return nil
+	}
+
+	content, err := volume.StreamOut(ctx, ".", compression.NewGzipCompression())
+	if err != nil {
+		return err
+	}
+
+	defer content.Close()
+
+	// the digest stored is that of the contents actually uploaded
+	hasher := sha256.New()
+
+	err = store.objects.Put(ctx, key, io.TeeReader(content, hasher))
+	if err != nil {
+		return err
+	}
+
+	return store.objects.Put(ctx, DigestKey(key), strings.NewReader(hexDigest(hasher)))
+}
+
+func (store *Store) startSaving(key string) bool {
+	store.savingL.Lock()
+	defer store.savingL.Unlock()
+
+	if store.saving[key] {
+		return false
+	}
+
+	store.saving[key] = true
+
+	return true
+}
+
+func (store *Store) finishSaving(key string) {
+	store.savingL.Lock()
+	defer store.savingL.Unlock()
+
+	delete(store.saving, key)
+}
+
+func (store *Store) volumeDigest(ctx context.Context, volume runtime.Volume) (string, error) {
+	content, err := volume.StreamOut(ctx, ".", compression.NewGzipCompression())
+	if err != nil {
+		return "", err
+	}
+
+	defer content.Close()
+
+	hasher := sha256.New()
+
+	_, err = io.Copy(hasher, content)
+	if err != nil {
+		return "", err
+	}
+
+	return hexDigest(hasher), nil
+}
+
+func (store *Store) storedDigest(ctx context.Context, key string) (string, error) {
+	content, found, err := store.objects.Get(ctx, DigestKey(key))
+	if err != nil {
+		return "", err
+	}
+
+	if !found {
+		return "", nil
+	}
+
+	defer content.Close()
+
+	digest, err := io.ReadAll(content)
+	if err != nil {
+		return "", err
+	}
+
+	return string(digest), nil
+}
+
+func hexDigest(hasher hash.Hash) string {
+	return hex.EncodeToString(hasher.Sum(nil))
+}
@@ -0,0 +1,151 @@
+package taskcache_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+
+	"github.com/concourse/concourse/atc/blobstore/blobstorefakes"
+	"github.com/concourse/concourse/atc/runtime/runtimetest"
+	"github.com/concourse/concourse/atc/taskcache"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Store", func() {
+	var (
+		ctx              context.Context
+		fakeObjectStore  *blobstorefakes.FakeObjectStore
+		store            *taskcache.Store
+		uploadedContents map[string][]byte
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		uploadedContents = map[string][]byte{}
+
+		fakeObjectStore = new(blobstorefakes.FakeObjectStore)
+		fakeObjectStore.PutStub = func(_ context.Context, key string, content io.Reader) error {
+			var err error
+			uploadedContents[key], err = io.ReadAll(content)
+			return err
+		}
+		fakeObjectStore.GetStub = func(_ context.Context, key string) (io.ReadCloser, bool, error) {
+			content, found := uploadedContents[key]
+			if !found {
+				return nil, false, nil
+			}
+
+			return io.NopCloser(bytes.NewReader(content)), true, nil
+		}
+
+		store = taskcache.NewStore(fakeObjectStore)
+	})
+
+	Describe("Save and Restore", func() {
+		It("round-trips the contents of the cache volume", func() {
+			volume := runtimetest.NewVolume("cache-volume").WithContent(runtimetest.VolumeContent{
+				"some-file":         {Data: []byte("some-content")},
+				"some-dir/sub-file": {Data: []byte("sub-content")},
+			})
+
+			err := store.Save(ctx, 1, "some-step", "some/path", volume)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, key, _ := fakeObjectStore.PutArgsForCall(0)
+			Expect(key).To(Equal("1/some-step/some%2Fpath.tgz"))
+
+			restoredVolume := runtimetest.NewVolume("other-cache-volume")
+			restored, err := store.Restore(ctx, 1, "some-step", "some/path", restoredVolume)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(restored).To(BeTrue())
+
+			Expect(restoredVolume.Content).To(HaveKeyWithValue("some-file", HaveField("Data", []byte("some-content"))))
+			Expect(restoredVolume.Content).To(HaveKeyWithValue("some-dir/sub-file", HaveField("Data", []byte("sub-content"))))
+		})
+
+		It("stores the digest of the contents along with them", func() {
+			volume := runtimetest.NewVolume("cache-volume").WithContent(runtimetest.VolumeContent{
+				"some-file": {Data: []byte("some-content")},
+			})
+
+			err := store.Save(ctx, 1, "some-step", "some/path", volume)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(uploadedContents).To(HaveKey("1/some-step/some%2Fpath.sha256"))
+			digest := sha256.Sum256(uploadedContents["1/some-step/some%2Fpath.tgz"])
+			Expect(string(uploadedContents["1/some-step/some%2Fpath.sha256"])).To(Equal(hex.EncodeToString(digest[:])))
+		})
+
+		It("does not upload contents which have not changed since they were saved", func() {
+			volume := runtimetest.NewVolume("cache-volume").WithContent(runtimetest.VolumeContent{
+				"some-file": {Data: []byte("some-content")},
+			})
+
+			err := store.Save(ctx, 1, "some-step", "some/path", volume)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeObjectStore.PutCallCount()).To(Equal(2))
+
+			err = store.Save(ctx, 1, "some-step", "some/path", volume)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeObjectStore.PutCallCount()).To(Equal(2))
+
+			changedVolume := runtimetest.NewVolume("cache-volume").WithContent(runtimetest.VolumeContent{
+				"some-file": {Data: []byte("some-other-content")},
+			})
+
+			err = store.Save(ctx, 1, "some-step", "some/path", changedVolume)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeObjectStore.PutCallCount()).To(Equal(4))
+		})
+
+		It("does not restore caches that were never saved", func() {
+			restored, err := store.Restore(ctx, 1, "some-step", "some/path", runtimetest.NewVolume("cache-volume"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(restored).To(BeFalse())
+		})
+
+		Context("when the object store errors", func() {
+			disaster := errors.New("nope")
+
+			BeforeEach(func() {
+				fakeObjectStore.GetReturns(nil, false, disaster)
+			})
+
+			It("returns the error", func() {
+				_, err := store.Restore(ctx, 1, "some-step", "some/path", runtimetest.NewVolume("cache-volume"))
+				Expect(err).To(Equal(disaster))
+			})
+		})
+	})
+
+	Describe("ParseKey", func() {
+		It("parses keys returned by Key", func() {
+			jobID, stepName, path, ok := taskcache.ParseKey(taskcache.Key(42, "some/step", "some/path"))
+			Expect(ok).To(BeTrue())
+			Expect(jobID).To(Equal(42))
+			Expect(stepName).To(Equal("some/step"))
+			Expect(path).To(Equal("some/path"))
+		})
+
+		It("rejects digest keys", func() {
+			_, _, _, ok := taskcache.ParseKey(taskcache.DigestKey(taskcache.Key(42, "some-step", "some-path")))
+			Expect(ok).To(BeFalse())
+		})
+
+		It("rejects malformed keys", func() {
+			_, _, _, ok := taskcache.ParseKey("not-a-job/some-step/some-path.tgz")
+			Expect(ok).To(BeFalse())
+
+			_, _, _, ok = taskcache.ParseKey("1/some-step.tgz")
+			Expect(ok).To(BeFalse())
+
+			_, _, _, ok = taskcache.ParseKey("1/some-step/some-path")
+			Expect(ok).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package taskcache_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestTaskCache(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Task Cache Suite")
+}
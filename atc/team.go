@@ -13,6 +13,15 @@ type Team struct {
 	ID   int      `json:"id,omitempty"`
 	Name string   `json:"name,omitempty"`
 	Auth TeamAuth `json:"auth,omitempty"`
+
+	// VarSources replace the team's var_sources when set. They are left
+	// untouched when nil, so that setting a team without them (e.g. from an
+	// older fly) does not remove the ones its pipelines rely on.
+	VarSources *VarSourceConfigs `json:"var_sources,omitempty"`
+
+	// Notifications replace the team's notifications when set, and like
+	// VarSources are left untouched when nil.
+	Notifications *NotificationConfigs `json:"notifications,omitempty"`
 }
 
 func (team Team) Validate() error {
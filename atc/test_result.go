@@ -0,0 +1,45 @@
+package atc
+
+type TestStatus string
+
+const (
+	TestStatusPassed  TestStatus = "passed"
+	TestStatusFailed  TestStatus = "failed"
+	TestStatusErrored TestStatus = "errored"
+	TestStatusSkipped TestStatus = "skipped"
+)
+
+const (
+	TestReportFormatJUnit  = "junit"
+	TestReportFormatTAP    = "tap"
+	TestReportFormatGoTest = "gotest"
+)
+
+// TestResult is the result of a single test case, as parsed from a test
+// report written by a task.
+type TestResult struct {
+	Step     string     `json:"step,omitempty"`
+	Suite    string     `json:"suite,omitempty"`
+	Name     string     `json:"name"`
+	Status   TestStatus `json:"status"`
+	Duration float64    `json:"duration,omitempty"`
+	Message  string     `json:"message,omitempty"`
+}
+
+// TestSummary aggregates the results of a test across the recent builds of a
+// job. A test which has both passed and failed is likely to be flaky.
+type TestSummary struct {
+	Suite string `json:"suite,omitempty"`
+	Name  string `json:"name"`
+
+	Runs    int `json:"runs"`
+	Passed  int `json:"passed"`
+	Failed  int `json:"failed"`
+	Skipped int `json:"skipped"`
+
+	LastFailedBuild string `json:"last_failed_build,omitempty"`
+}
+
+func (summary TestSummary) Flaky() bool {
+	return summary.Passed > 0 && summary.Failed > 0
+}
@@ -0,0 +1,87 @@
+package testreport
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+type goTestEvent struct {
+	Action  string
+	Package string
+	Test    string
+	Elapsed float64
+	Output  string
+}
+
+type goTestKey struct {
+	pkg  string
+	test string
+}
+
+// parseGoTest reads the output of 'go test -json'. The output of failed tests
+// is kept as their message.
+func parseGoTest(report io.Reader) ([]atc.TestResult, error) {
+	var results []atc.TestResult
+	output := map[goTestKey]*strings.Builder{}
+
+	decoder := json.NewDecoder(report)
+	for {
+		var event goTestEvent
+		err := decoder.Decode(&event)
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if event.Test == "" {
+			continue
+		}
+
+		key := goTestKey{event.Package, event.Test}
+
+		var status atc.TestStatus
+		switch event.Action {
+		case "output":
+			if output[key] == nil {
+				output[key] = &strings.Builder{}
+			}
+
+			if output[key].Len() < maxMessageLength {
+				output[key].WriteString(event.Output)
+			}
+
+			continue
+		case "pass":
+			status = atc.TestStatusPassed
+		case "fail":
+			status = atc.TestStatusFailed
+		case "skip":
+			status = atc.TestStatusSkipped
+		default:
+			continue
+		}
+
+		result := atc.TestResult{
+			Suite:    event.Package,
+			Name:     event.Test,
+			Status:   status,
+			Duration: event.Elapsed,
+		}
+
+		if status == atc.TestStatusFailed && output[key] != nil {
+			result.Message = output[key].String()
+		}
+
+		delete(output, key)
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
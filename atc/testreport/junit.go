@@ -0,0 +1,95 @@
+package testreport
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+type junitSuite struct {
+	Name   string       `xml:"name,attr"`
+	Suites []junitSuite `xml:"testsuite"`
+	Cases  []junitCase  `xml:"testcase"`
+}
+
+type junitCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      float64       `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure"`
+	Error     *junitMessage `xml:"error"`
+	Skipped   *junitMessage `xml:"skipped"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+func (message junitMessage) String() string {
+	text := strings.TrimSpace(message.Text)
+	if text == "" {
+		return message.Message
+	}
+
+	if message.Message == "" || strings.Contains(text, message.Message) {
+		return text
+	}
+
+	return message.Message + "\n" + text
+}
+
+// parseJUnit reads a JUnit XML report, whose root element is either a
+// <testsuites> or a single <testsuite>.
+func parseJUnit(report io.Reader) ([]atc.TestResult, error) {
+	var root junitSuite
+	err := xml.NewDecoder(report).Decode(&root)
+	if err != nil {
+		return nil, err
+	}
+
+	return junitResults(root, ""), nil
+}
+
+func junitResults(suite junitSuite, parentName string) []atc.TestResult {
+	name := suite.Name
+	if name == "" {
+		name = parentName
+	}
+
+	var results []atc.TestResult
+	for _, testCase := range suite.Cases {
+		result := atc.TestResult{
+			Suite:    name,
+			Name:     testCase.Name,
+			Status:   atc.TestStatusPassed,
+			Duration: testCase.Time,
+		}
+
+		if testCase.ClassName != "" {
+			result.Suite = testCase.ClassName
+		}
+
+		switch {
+		case testCase.Failure != nil:
+			result.Status = atc.TestStatusFailed
+			result.Message = testCase.Failure.String()
+		case testCase.Error != nil:
+			result.Status = atc.TestStatusErrored
+			result.Message = testCase.Error.String()
+		case testCase.Skipped != nil:
+			result.Status = atc.TestStatusSkipped
+			result.Message = testCase.Skipped.String()
+		}
+
+		results = append(results, result)
+	}
+
+	for _, child := range suite.Suites {
+		results = append(results, junitResults(child, name)...)
+	}
+
+	return results
+}
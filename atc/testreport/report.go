@@ -0,0 +1,49 @@
+// Package testreport parses the test reports written by tasks into test
+// results.
+package testreport
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// maxMessageLength limits the size of the failure message kept for each
+// test, as some test runners include the complete output of the test.
+const maxMessageLength = 16 * 1024
+
+// Parse reads a test report in the given format.
+func Parse(format string, report io.Reader) ([]atc.TestResult, error) {
+	var results []atc.TestResult
+	var err error
+
+	switch format {
+	case atc.TestReportFormatJUnit:
+		results, err = parseJUnit(report)
+	case atc.TestReportFormatTAP:
+		results, err = parseTAP(report)
+	case atc.TestReportFormatGoTest:
+		results, err = parseGoTest(report)
+	default:
+		return nil, fmt.Errorf("unknown test report format '%s'", format)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("parse %s report: %w", format, err)
+	}
+
+	for i := range results {
+		results[i].Message = truncate(results[i].Message)
+	}
+
+	return results, nil
+}
+
+func truncate(message string) string {
+	if len(message) <= maxMessageLength {
+		return message
+	}
+
+	return message[:maxMessageLength] + "\n[truncated]"
+}
@@ -0,0 +1,117 @@
+package testreport_test
+
+import (
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/testreport"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Parse", func() {
+	Describe("junit", func() {
+		It("parses the test cases of nested suites", func() {
+			results, err := testreport.Parse(atc.TestReportFormatJUnit, strings.NewReader(`<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="api" tests="4">
+    <testcase name="creates a user" classname="api.UserTest" time="0.25"/>
+    <testcase name="deletes a user" classname="api.UserTest" time="1.5">
+      <failure message="expected 204, got 500">at UserTest.java:42</failure>
+    </testcase>
+    <testcase name="lists users">
+      <error message="connection refused"/>
+    </testcase>
+    <testcase name="paginates users">
+      <skipped/>
+    </testcase>
+    <testsuite name="nested">
+      <testcase name="works"/>
+    </testsuite>
+  </testsuite>
+</testsuites>`))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(results).To(Equal([]atc.TestResult{
+				{Suite: "api.UserTest", Name: "creates a user", Status: atc.TestStatusPassed, Duration: 0.25},
+				{Suite: "api.UserTest", Name: "deletes a user", Status: atc.TestStatusFailed, Duration: 1.5, Message: "expected 204, got 500\nat UserTest.java:42"},
+				{Suite: "api", Name: "lists users", Status: atc.TestStatusErrored, Message: "connection refused"},
+				{Suite: "api", Name: "paginates users", Status: atc.TestStatusSkipped},
+				{Suite: "nested", Name: "works", Status: atc.TestStatusPassed},
+			}))
+		})
+
+		It("parses a single suite", func() {
+			results, err := testreport.Parse(atc.TestReportFormatJUnit, strings.NewReader(`<testsuite name="unit"><testcase name="adds"/></testsuite>`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(Equal([]atc.TestResult{
+				{Suite: "unit", Name: "adds", Status: atc.TestStatusPassed},
+			}))
+		})
+
+		It("errors on malformed reports", func() {
+			_, err := testreport.Parse(atc.TestReportFormatJUnit, strings.NewReader(`<testsuite`))
+			Expect(err).To(MatchError(ContainSubstring("parse junit report")))
+		})
+	})
+
+	Describe("tap", func() {
+		It("parses the test points", func() {
+			results, err := testreport.Parse(atc.TestReportFormatTAP, strings.NewReader(`TAP version 13
+1..5
+ok 1 - adds numbers
+not ok 2 - divides by zero
+  ---
+  message: division by zero
+  severity: fail
+  ...
+ok 3 - talks to the network # SKIP no network
+not ok 4 - flies # TODO not implemented yet
+ok 5
+    ok 1 - subtests are ignored
+`))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(results).To(Equal([]atc.TestResult{
+				{Name: "adds numbers", Status: atc.TestStatusPassed},
+				{Name: "divides by zero", Status: atc.TestStatusFailed, Message: "message: division by zero\nseverity: fail"},
+				{Name: "talks to the network", Status: atc.TestStatusSkipped, Message: "no network"},
+				{Name: "flies", Status: atc.TestStatusSkipped, Message: "not implemented yet"},
+				{Name: "5", Status: atc.TestStatusPassed},
+			}))
+		})
+	})
+
+	Describe("gotest", func() {
+		It("parses the test events", func() {
+			results, err := testreport.Parse(atc.TestReportFormatGoTest, strings.NewReader(`{"Action":"run","Package":"example.com/math","Test":"TestAdd"}
+{"Action":"output","Package":"example.com/math","Test":"TestAdd","Output":"=== RUN   TestAdd\n"}
+{"Action":"pass","Package":"example.com/math","Test":"TestAdd","Elapsed":0.01}
+{"Action":"run","Package":"example.com/math","Test":"TestDivide"}
+{"Action":"output","Package":"example.com/math","Test":"TestDivide","Output":"=== RUN   TestDivide\n"}
+{"Action":"output","Package":"example.com/math","Test":"TestDivide","Output":"    math_test.go:12: division by zero\n"}
+{"Action":"fail","Package":"example.com/math","Test":"TestDivide","Elapsed":0.02}
+{"Action":"skip","Package":"example.com/math","Test":"TestNetwork"}
+{"Action":"fail","Package":"example.com/math","Elapsed":0.5}
+`))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(results).To(Equal([]atc.TestResult{
+				{Suite: "example.com/math", Name: "TestAdd", Status: atc.TestStatusPassed, Duration: 0.01},
+				{Suite: "example.com/math", Name: "TestDivide", Status: atc.TestStatusFailed, Duration: 0.02, Message: "=== RUN   TestDivide\n    math_test.go:12: division by zero\n"},
+				{Suite: "example.com/math", Name: "TestNetwork", Status: atc.TestStatusSkipped},
+			}))
+		})
+	})
+
+	It("truncates long messages", func() {
+		results, err := testreport.Parse(atc.TestReportFormatJUnit, strings.NewReader(`<testsuite name="unit"><testcase name="fails"><failure>`+strings.Repeat("x", 20*1024)+`</failure></testcase></testsuite>`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results[0].Message).To(HaveLen(16*1024 + len("\n[truncated]")))
+	})
+
+	It("errors on unknown formats", func() {
+		_, err := testreport.Parse("xunit", strings.NewReader(""))
+		Expect(err).To(MatchError("unknown test report format 'xunit'"))
+	})
+})
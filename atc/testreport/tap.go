@@ -0,0 +1,73 @@
+package testreport
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var tapResultRegexp = regexp.MustCompile(`^(not )?ok\b\s*(\d+)?\s*(?:-\s*)?([^#]*?)\s*(?:#\s*(\S+)\s*(.*))?$`)
+
+// parseTAP reads the top-level test points of a TAP report. The YAML
+// diagnostics following a failed test point are kept as its message.
+func parseTAP(report io.Reader) ([]atc.TestResult, error) {
+	var results []atc.TestResult
+
+	var diagnostics []string
+	inDiagnostics := false
+
+	scanner := bufio.NewScanner(report)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if inDiagnostics {
+			if strings.TrimSpace(line) == "..." {
+				inDiagnostics = false
+				results[len(results)-1].Message = strings.Join(diagnostics, "\n")
+				continue
+			}
+
+			diagnostics = append(diagnostics, strings.TrimPrefix(line, "  "))
+			continue
+		}
+
+		if strings.TrimSpace(line) == "---" && len(results) > 0 {
+			inDiagnostics = true
+			diagnostics = nil
+			continue
+		}
+
+		match := tapResultRegexp.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		result := atc.TestResult{
+			Name:   match[3],
+			Status: atc.TestStatusPassed,
+		}
+
+		if result.Name == "" {
+			result.Name = match[2]
+		}
+
+		if match[1] != "" {
+			result.Status = atc.TestStatusFailed
+		}
+
+		switch strings.ToUpper(match[4]) {
+		case "SKIP", "TODO":
+			result.Status = atc.TestStatusSkipped
+			result.Message = match[5]
+		}
+
+		results = append(results, result)
+	}
+
+	return results, scanner.Err()
+}
@@ -0,0 +1,13 @@
+package testreport_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestTestReport(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Test Report Suite")
+}
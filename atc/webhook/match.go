@@ -0,0 +1,85 @@
+package webhook
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// Match returns whether the payload satisfies every filter against the
+// resource's source. A filter matches when the source field equals the value
+// found at the filter's path in the payload. Git refs in the payload, e.g.
+// 'refs/heads/main', also match their short name so that a branch can be
+// compared against a push event's ref.
+func Match(filters atc.WebhookFilters, source atc.Source, payload interface{}) bool {
+	for field, path := range filters {
+		expected, found := source[field]
+		if !found {
+			return false
+		}
+
+		actual, found := Lookup(payload, path)
+		if !found {
+			return false
+		}
+
+		if !equal(fmt.Sprint(expected), fmt.Sprint(actual)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Lookup returns the value at the dot-separated path in the payload, e.g.
+// 'repository.clone_url' or 'commits.0.id'.
+func Lookup(payload interface{}, path string) (interface{}, bool) {
+	value := payload
+
+	for _, segment := range strings.Split(path, ".") {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			next, found := v[segment]
+			if !found {
+				return nil, false
+			}
+
+			value = next
+
+		case []interface{}:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(v) {
+				return nil, false
+			}
+
+			value = v[index]
+
+		default:
+			return nil, false
+		}
+	}
+
+	if value == nil {
+		return nil, false
+	}
+
+	return value, true
+}
+
+var refPrefixes = []string{"refs/heads/", "refs/tags/"}
+
+func equal(expected string, actual string) bool {
+	if expected == actual {
+		return true
+	}
+
+	for _, prefix := range refPrefixes {
+		if strings.HasPrefix(actual, prefix) && strings.TrimPrefix(actual, prefix) == expected {
+			return true
+		}
+	}
+
+	return false
+}
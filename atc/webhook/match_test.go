@@ -0,0 +1,69 @@
+package webhook_test
+
+import (
+	"encoding/json"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/webhook"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Match", func() {
+	var (
+		filters atc.WebhookFilters
+		source  atc.Source
+		payload interface{}
+	)
+
+	BeforeEach(func() {
+		filters = atc.WebhookFilters{
+			"uri":    "repository.clone_url",
+			"branch": "ref",
+		}
+
+		source = atc.Source{
+			"uri":    "https://example.com/some-repo.git",
+			"branch": "main",
+		}
+
+		err := json.Unmarshal([]byte(`{
+			"ref": "refs/heads/main",
+			"repository": {"clone_url": "https://example.com/some-repo.git"},
+			"commits": [{"id": "abc"}]
+		}`), &payload)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("matches when every filter matches", func() {
+		Expect(webhook.Match(filters, source, payload)).To(BeTrue())
+	})
+
+	It("matches when there are no filters", func() {
+		Expect(webhook.Match(nil, source, payload)).To(BeTrue())
+	})
+
+	It("does not match when a value differs", func() {
+		source["branch"] = "develop"
+		Expect(webhook.Match(filters, source, payload)).To(BeFalse())
+	})
+
+	It("does not match when the source does not have the field", func() {
+		delete(source, "branch")
+		Expect(webhook.Match(filters, source, payload)).To(BeFalse())
+	})
+
+	It("does not match when the payload does not have the path", func() {
+		filters["uri"] = "project.git_http_url"
+		Expect(webhook.Match(filters, source, payload)).To(BeFalse())
+	})
+
+	It("looks up values within arrays", func() {
+		source["commit"] = "abc"
+		filters = atc.WebhookFilters{"commit": "commits.0.id"}
+		Expect(webhook.Match(filters, source, payload)).To(BeTrue())
+
+		filters = atc.WebhookFilters{"commit": "commits.1.id"}
+		Expect(webhook.Match(filters, source, payload)).To(BeFalse())
+	})
+})
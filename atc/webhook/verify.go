@@ -0,0 +1,100 @@
+// Package webhook verifies the payloads sent to check webhooks and matches
+// them against the resources listening to them.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+const (
+	GitHubSignatureHeader = "X-Hub-Signature-256"
+	GitLabTokenHeader     = "X-Gitlab-Token"
+)
+
+var (
+	ErrMissingSignature = errors.New("missing signature")
+	ErrInvalidSignature = errors.New("invalid signature")
+)
+
+// Verify checks that the payload was signed with the given secret according
+// to the webhook's scheme.
+func Verify(config atc.WebhookConfig, secret string, header http.Header, payload []byte) error {
+	signature, err := signatureFrom(config, header)
+	if err != nil {
+		return err
+	}
+
+	if config.SchemeOrDefault() == atc.WebhookSchemeGitLab {
+		if subtle.ConstantTimeCompare(signature, []byte(secret)) != 1 {
+			return ErrInvalidSignature
+		}
+
+		return nil
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// Signed checks that the request carries a well-formed signature for the
+// webhook's scheme without verifying it. Unlike Verify it doesn't need the
+// secret, so it can be used to turn away unsigned requests before the secret
+// is resolved through the credential manager.
+func Signed(config atc.WebhookConfig, header http.Header) error {
+	_, err := signatureFrom(config, header)
+	return err
+}
+
+func signatureFrom(config atc.WebhookConfig, header http.Header) ([]byte, error) {
+	switch config.SchemeOrDefault() {
+	case atc.WebhookSchemeGitHub:
+		return decodeHMAC(header.Get(GitHubSignatureHeader))
+
+	case atc.WebhookSchemeGitLab:
+		token := header.Get(GitLabTokenHeader)
+		if token == "" {
+			return nil, ErrMissingSignature
+		}
+
+		return []byte(token), nil
+
+	case atc.WebhookSchemeHMAC:
+		name := config.Header
+		if name == "" {
+			name = atc.DefaultWebhookSignatureHeader
+		}
+
+		return decodeHMAC(header.Get(name))
+
+	default:
+		return nil, fmt.Errorf("unknown webhook scheme '%s'", config.Scheme)
+	}
+}
+
+func decodeHMAC(signature string) ([]byte, error) {
+	if signature == "" {
+		return nil, ErrMissingSignature
+	}
+
+	decoded, err := hex.DecodeString(strings.TrimPrefix(signature, "sha256="))
+	if err != nil || len(decoded) != sha256.Size {
+		return nil, ErrInvalidSignature
+	}
+
+	return decoded, nil
+}
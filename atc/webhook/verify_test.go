@@ -0,0 +1,209 @@
+package webhook_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/webhook"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+var _ = Describe("Verify", func() {
+	var (
+		config  atc.WebhookConfig
+		header  http.Header
+		payload []byte
+		err     error
+	)
+
+	BeforeEach(func() {
+		config = atc.WebhookConfig{Secret: "((secret))"}
+		header = http.Header{}
+		payload = []byte(`{"ref":"refs/heads/main"}`)
+	})
+
+	JustBeforeEach(func() {
+		err = webhook.Verify(config, "s3cr3t", header, payload)
+	})
+
+	Context("with the default hmac scheme", func() {
+		Context("when the payload is signed with the secret", func() {
+			BeforeEach(func() {
+				header.Set("X-Concourse-Signature", sign("s3cr3t", payload))
+			})
+
+			It("succeeds", func() {
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when the signature is prefixed with the algorithm", func() {
+			BeforeEach(func() {
+				header.Set("X-Concourse-Signature", "sha256="+sign("s3cr3t", payload))
+			})
+
+			It("succeeds", func() {
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when a custom header is configured", func() {
+			BeforeEach(func() {
+				config.Header = "X-Signature"
+				header.Set("X-Signature", sign("s3cr3t", payload))
+			})
+
+			It("reads the signature from it", func() {
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when the payload is signed with another secret", func() {
+			BeforeEach(func() {
+				header.Set("X-Concourse-Signature", sign("wrong", payload))
+			})
+
+			It("fails", func() {
+				Expect(err).To(Equal(webhook.ErrInvalidSignature))
+			})
+		})
+
+		Context("when the signature is not hex encoded", func() {
+			BeforeEach(func() {
+				header.Set("X-Concourse-Signature", "not-hex")
+			})
+
+			It("fails", func() {
+				Expect(err).To(Equal(webhook.ErrInvalidSignature))
+			})
+		})
+
+		Context("when there is no signature", func() {
+			It("fails", func() {
+				Expect(err).To(Equal(webhook.ErrMissingSignature))
+			})
+		})
+	})
+
+	Context("with the github scheme", func() {
+		BeforeEach(func() {
+			config.Scheme = atc.WebhookSchemeGitHub
+		})
+
+		Context("when the payload is signed with the secret", func() {
+			BeforeEach(func() {
+				header.Set("X-Hub-Signature-256", "sha256="+sign("s3cr3t", payload))
+			})
+
+			It("succeeds", func() {
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when the payload was tampered with", func() {
+			BeforeEach(func() {
+				header.Set("X-Hub-Signature-256", "sha256="+sign("s3cr3t", []byte("{}")))
+			})
+
+			It("fails", func() {
+				Expect(err).To(Equal(webhook.ErrInvalidSignature))
+			})
+		})
+	})
+
+	Context("with the gitlab scheme", func() {
+		BeforeEach(func() {
+			config.Scheme = atc.WebhookSchemeGitLab
+		})
+
+		Context("when the token matches the secret", func() {
+			BeforeEach(func() {
+				header.Set("X-Gitlab-Token", "s3cr3t")
+			})
+
+			It("succeeds", func() {
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when the token does not match", func() {
+			BeforeEach(func() {
+				header.Set("X-Gitlab-Token", "wrong")
+			})
+
+			It("fails", func() {
+				Expect(err).To(Equal(webhook.ErrInvalidSignature))
+			})
+		})
+
+		Context("when there is no token", func() {
+			It("fails", func() {
+				Expect(err).To(Equal(webhook.ErrMissingSignature))
+			})
+		})
+	})
+})
+
+var _ = Describe("Signed", func() {
+	var (
+		config atc.WebhookConfig
+		header http.Header
+		err    error
+	)
+
+	BeforeEach(func() {
+		config = atc.WebhookConfig{Secret: "((secret))"}
+		header = http.Header{}
+	})
+
+	JustBeforeEach(func() {
+		err = webhook.Signed(config, header)
+	})
+
+	Context("when the request carries a signature", func() {
+		BeforeEach(func() {
+			header.Set("X-Concourse-Signature", sign("anything", []byte("{}")))
+		})
+
+		It("succeeds without knowing the secret", func() {
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	Context("when the signature is not a sha256 hmac", func() {
+		BeforeEach(func() {
+			header.Set("X-Concourse-Signature", "abcd")
+		})
+
+		It("fails", func() {
+			Expect(err).To(Equal(webhook.ErrInvalidSignature))
+		})
+	})
+
+	Context("when there is no signature", func() {
+		It("fails", func() {
+			Expect(err).To(Equal(webhook.ErrMissingSignature))
+		})
+	})
+
+	Context("with the gitlab scheme", func() {
+		BeforeEach(func() {
+			config.Scheme = atc.WebhookSchemeGitLab
+			header.Set("X-Gitlab-Token", "some-token")
+		})
+
+		It("succeeds when there is a token", func() {
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+})
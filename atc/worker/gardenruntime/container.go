@@ -18,6 +18,17 @@ const userPropertyName = "user"
 
 const exitStatusPropertyName = "concourse:exit-status"
 
+// networkContainerPropertyName is understood by the containerd runtime, which
+// will make the container join the network namespace of the container with
+// the given handle.
+const networkContainerPropertyName = "concourse:network-container"
+
+// networkJoinedPropertyName is set by the containerd runtime once the
+// container has joined the network of the container given by
+// networkContainerPropertyName. Other runtimes ignore the property and leave
+// the container in a network of its own.
+const networkJoinedPropertyName = "concourse:network-joined"
+
 type Container struct {
 	DBContainer_    db.CreatedContainer
 	GardenContainer gclient.Container
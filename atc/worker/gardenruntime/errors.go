@@ -34,3 +34,13 @@ type MountedVolumeMissingFromWorker struct {
 func (e MountedVolumeMissingFromWorker) Error() string {
 	return fmt.Sprintf("volume mounted to container is missing '%s' from worker '%s'", e.Handle, e.WorkerName)
 }
+
+// NetworkSharingUnsupportedError is returned when a container is to join the
+// network of another container, but the worker's runtime does not support it.
+type NetworkSharingUnsupportedError struct {
+	WorkerName string
+}
+
+func (e NetworkSharingUnsupportedError) Error() string {
+	return fmt.Sprintf("worker '%s' cannot share a container's network with another container, which requires the containerd runtime", e.WorkerName)
+}
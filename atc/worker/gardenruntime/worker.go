@@ -204,6 +204,14 @@ func (worker *Worker) createGardenContainer(
 		return nil, err
 	}
 
+	properties := garden.Properties{
+		userPropertyName: fetchedImage.Metadata.User,
+	}
+
+	if containerSpec.NetworkContainerHandle != "" {
+		properties[networkContainerPropertyName] = containerSpec.NetworkContainerHandle
+	}
+
 	logger.Debug("creating-garden-container")
 
 	gardenContainer, err := worker.gardenClient.Create(
@@ -214,9 +222,7 @@ func (worker *Worker) createGardenContainer(
 			BindMounts: bindMounts,
 			Limits:     toGardenLimits(containerSpec.Limits),
 			Env:        worker.containerEnv(containerSpec, fetchedImage),
-			Properties: garden.Properties{
-				userPropertyName: fetchedImage.Metadata.User,
-			},
+			Properties: properties,
 		})
 	if err != nil {
 		logger.Error("failed-to-create-container-in-garden", err)
@@ -224,9 +230,35 @@ func (worker *Worker) createGardenContainer(
 		return nil, err
 	}
 
+	if containerSpec.NetworkContainerHandle != "" {
+		err = worker.checkNetworkJoined(gardenContainer, containerSpec.NetworkContainerHandle)
+		if err != nil {
+			logger.Error("failed-to-join-network", err)
+			_ = worker.gardenClient.Destroy(creatingContainer.Handle())
+			markContainerAsFailed(logger, creatingContainer)
+			return nil, err
+		}
+	}
+
 	return gardenContainer, nil
 }
 
+// checkNetworkJoined makes sure that the container joined the network of the
+// container with the given handle, rather than having the property silently
+// ignored by the worker's runtime.
+func (worker *Worker) checkNetworkJoined(gardenContainer gclient.Container, networkContainerHandle string) error {
+	properties, err := gardenContainer.Properties()
+	if err != nil {
+		return err
+	}
+
+	if properties[networkJoinedPropertyName] != networkContainerHandle {
+		return NetworkSharingUnsupportedError{WorkerName: worker.Name()}
+	}
+
+	return nil
+}
+
 func (worker *Worker) containerEnv(containerSpec runtime.ContainerSpec, fetchedImage FetchedImage) []string {
 	env := append(fetchedImage.Metadata.Env, containerSpec.Env...)
 
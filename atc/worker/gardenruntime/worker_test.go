@@ -1433,6 +1433,39 @@ var _ = Describe("Garden Worker", func() {
 		})
 	})
 
+	Test("joining the network of another container on a runtime that does not support it", func() {
+		scenario := Setup(
+			workertest.WithWorkers(
+				grt.NewWorker("worker"),
+			),
+		)
+		worker := scenario.Worker("worker")
+
+		containerOwner := db.NewFixedHandleContainerOwner("service-handle")
+		_, _, err := worker.FindOrCreateContainer(
+			ctx,
+			containerOwner,
+			db.ContainerMetadata{},
+			runtime.ContainerSpec{
+				ImageSpec: runtime.ImageSpec{
+					ImageURL: "raw:///img/rootfs",
+				},
+				NetworkContainerHandle: "task-handle",
+			},
+			delegate,
+		)
+		Expect(err).To(Equal(gardenruntime.NetworkSharingUnsupportedError{WorkerName: "worker"}))
+
+		By("validating the container is removed from garden", func() {
+			Expect(gardenServer(worker).ContainerList).To(BeEmpty())
+		})
+
+		By("validating the container is marked as failed", func() {
+			_, isDBContainerFound := scenario.DB.FindContainer(worker.Name(), containerOwner)
+			Expect(isDBContainerFound).To(BeFalse())
+		})
+	})
+
 	Test("run/attach process context cancellation", func() {
 		scenario := Setup(
 			workertest.WithWorkers(
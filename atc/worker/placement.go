@@ -343,19 +343,23 @@ type limitActiveContainersStrategy struct {
 }
 
 func (strategy limitActiveContainersStrategy) Order(logger lager.Logger, pool Pool, workers []db.Worker, spec runtime.ContainerSpec) ([]db.Worker, error) {
-	return partitionWorkersBy(workers, strategy.workerSatisfies), nil
+	return partitionWorkersBy(workers, func(worker db.Worker) bool {
+		return strategy.workerSatisfies(worker, spec)
+	}), nil
 }
 
-func (strategy limitActiveContainersStrategy) workerSatisfies(worker db.Worker) bool {
+// workerSatisfies checks that the worker has room for the container along
+// with any service containers which must be placed on the same worker.
+func (strategy limitActiveContainersStrategy) workerSatisfies(worker db.Worker, spec runtime.ContainerSpec) bool {
 	if strategy.MaxContainers == 0 {
 		return true
 	}
 
-	return worker.ActiveContainers() < strategy.MaxContainers
+	return worker.ActiveContainers()+spec.ServiceContainers < strategy.MaxContainers
 }
 
-func (strategy limitActiveContainersStrategy) Approve(_ lager.Logger, worker db.Worker, _ runtime.ContainerSpec) error {
-	if !strategy.workerSatisfies(worker) {
+func (strategy limitActiveContainersStrategy) Approve(_ lager.Logger, worker db.Worker, spec runtime.ContainerSpec) error {
+	if !strategy.workerSatisfies(worker, spec) {
 		return ErrTooManyContainers
 	}
 
@@ -631,6 +631,38 @@ var _ = Describe("Container Placement Strategies", func() {
 			Expect(err).To(MatchError(worker.ErrTooManyContainers))
 		})
 
+		Test("accounts for service containers", func() {
+			scenario := Setup(
+				workertest.WithBasicJob(),
+				workertest.WithWorkers(
+					grt.NewWorker("worker1").
+						WithContainersCreatedInDBAndGarden(
+							grt.NewContainer("c1"),
+						),
+					grt.NewWorker("worker2"),
+				),
+			)
+
+			strategy := limitActiveContainersStrategy(3)
+			spec := runtime.ContainerSpec{
+				TeamID:   scenario.TeamID,
+				JobID:    scenario.JobID,
+				StepName: scenario.StepName,
+
+				ServiceContainers: 2,
+			}
+
+			workers, err := strategy.Order(logger, scenario.Pool, scenario.DB.Workers, spec)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(workerNames(workers)).To(Equal([]string{"worker2", "worker1"}))
+
+			err = strategy.Approve(logger, workers[0], spec)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = strategy.Approve(logger, workers[1], spec)
+			Expect(err).To(MatchError(worker.ErrTooManyContainers))
+		})
+
 		Test("noop if limit is unset", func() {
 			scenario := Setup(
 				workertest.WithBasicJob(),
@@ -25,7 +25,7 @@ type Pool struct {
 	db            DB
 	workerVersion version.Version
 
-	waker chan struct{}
+	queue *waitQueue
 }
 
 func NewPool(factory Factory, db DB, workerVersion version.Version) Pool {
@@ -34,12 +34,14 @@ func NewPool(factory Factory, db DB, workerVersion version.Version) Pool {
 		db:            db,
 		workerVersion: workerVersion,
 
-		waker: make(chan struct{}),
+		queue: newWaitQueue(),
 	}
 }
 
 type PoolCallback interface {
-	WaitingForWorker(lager.Logger)
+	// WaitingForWorker is called when the step starts waiting for a worker,
+	// and again whenever its position in the queue of waiting steps changes.
+	WaitingForWorker(logger lager.Logger, queuePosition int)
 }
 
 func (pool Pool) FindOrSelectWorker(
@@ -61,22 +63,34 @@ func (pool Pool) FindOrSelectWorker(
 		WorkerTags: strings.Join(workerSpec.Tags, "_"),
 	}
 	var worker db.Worker
+	var waiting *waiter
 	var pollingTicker *time.Ticker
+	var queuePosition int
+
+	// a step only tries to select a worker straight away if no step of the
+	// same or a higher priority is already waiting, so that it joins the back
+	// of the queue rather than beating them to a freed up worker
+	attempt := !pool.queue.HasPriorityAtLeast(workerSpec.Priority)
 	for {
-		var err error
-		worker, err = pool.findOrSelectWorker(logger, owner, containerSpec, workerSpec, strategy)
-		if err != nil {
-			return nil, err
-		}
-		if worker != nil {
-			break
+		if attempt {
+			var err error
+			worker, err = pool.findOrSelectWorker(logger, owner, containerSpec, workerSpec, strategy)
+			if err != nil {
+				return nil, err
+			}
+			if worker != nil {
+				break
+			}
 		}
 
-		if pollingTicker == nil {
+		if waiting == nil {
+			waiting = pool.queue.Enqueue(workerSpec.Priority)
+			defer pool.queue.Remove(waiting)
+
 			pollingTicker = time.NewTicker(PollingInterval)
 			defer pollingTicker.Stop()
 
-			logger.Debug("waiting-for-available-worker")
+			logger.Debug("waiting-for-available-worker", lager.Data{"priority": workerSpec.Priority})
 
 			_, ok := metric.Metrics.StepsWaiting[labels]
 			if !ok {
@@ -85,18 +99,34 @@ func (pool Pool) FindOrSelectWorker(
 
 			metric.Metrics.StepsWaiting[labels].Inc()
 			defer metric.Metrics.StepsWaiting[labels].Dec()
+		} else if attempt {
+			// let the next step in the queue have a go
+			pool.queue.PassTurn(waiting)
+		}
+
+		attempt = false
+		for !attempt {
+			position := pool.queue.Position(waiting)
+			if position != queuePosition {
+				queuePosition = position
 
-			if callback != nil {
-				callback.WaitingForWorker(logger)
+				if callback != nil {
+					callback.WaitingForWorker(logger, queuePosition)
+				}
 			}
-		}
 
-		select {
-		case <-ctx.Done():
-			logger.Info("aborted-waiting-for-worker")
-			return nil, ctx.Err()
-		case <-pollingTicker.C:
-		case <-pool.waker:
+			select {
+			case <-ctx.Done():
+				logger.Info("aborted-waiting-for-worker")
+				return nil, ctx.Err()
+			case <-pollingTicker.C:
+				// the first step in the queue periodically starts a pass through
+				// the queue, in case a worker has freed up without being released
+				// by this ATC
+				attempt = pool.queue.Position(waiting) == 1
+			case <-waiting.Turn():
+				attempt = true
+			}
 		}
 	}
 
@@ -144,13 +174,10 @@ func (pool Pool) findOrSelectWorker(logger lager.Logger, owner db.ContainerOwner
 func (pool Pool) ReleaseWorker(logger lager.Logger, containerSpec runtime.ContainerSpec, worker runtime.Worker, strategy PlacementStrategy) {
 	strategy.Release(logger, worker.DBWorker(), containerSpec)
 
-	// Attempt to wake a random waiting step to see if it can be
-	// scheduled on the recently released worker.
-	select {
-	case pool.waker <- struct{}{}:
-		logger.Debug("attempted-to-wake-waiting-step")
-	default:
-	}
+	// Give the first waiting step a turn to see if it can be scheduled on the
+	// recently released worker. If it can't, it will pass the turn on to the
+	// next waiting step.
+	pool.queue.Wake()
 }
 
 func (pool Pool) FindResourceCacheVolume(ctx context.Context, teamID int, resourceCache db.ResourceCache, workerSpec Spec, shouldBeValidBefore time.Time) (runtime.Volume, bool, error) {
@@ -1,6 +1,7 @@
 package worker_test
 
 import (
+	"context"
 	"sync/atomic"
 	"time"
 
@@ -280,7 +281,7 @@ var _ = Describe("Pool", func() {
 
 			var callbackInvocations int32
 			callback := PoolCallback{
-				waitingForWorker: func() { atomic.AddInt32(&callbackInvocations, 1) },
+				waitingForWorker: func(int) { atomic.AddInt32(&callbackInvocations, 1) },
 			}
 
 			By("selecting a worker when there are no satisfiable workers", func() {
@@ -327,6 +328,93 @@ var _ = Describe("Pool", func() {
 				Expect(worker.Name()).To(Equal("worker1"))
 			})
 		})
+
+		Test("higher priority steps are given a worker first", func() {
+			scenario := Setup(
+				workertest.WithWorkers(
+					grt.NewWorker("worker1").
+						WithActiveTasks(1),
+				),
+			)
+
+			strategy, _, _, err := worker.NewPlacementStrategy(worker.PlacementOptions{
+				Strategies:              []string{"limit-active-tasks"},
+				MaxActiveTasksPerWorker: 1,
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			taskSpec := runtime.ContainerSpec{Type: db.ContainerTypeTask}
+
+			worker.PollingInterval = 10 * time.Millisecond
+
+			var lowPosition, highPosition int32
+			lowCallback := PoolCallback{
+				waitingForWorker: func(position int) { atomic.StoreInt32(&lowPosition, int32(position)) },
+			}
+			highCallback := PoolCallback{
+				waitingForWorker: func(position int) { atomic.StoreInt32(&highPosition, int32(position)) },
+			}
+
+			lowCtx, cancelLow := context.WithCancel(ctx)
+			defer cancelLow()
+
+			lowErr := make(chan error, 1)
+			highWorker := make(chan runtime.Worker, 1)
+
+			By("waiting with a low priority step", func() {
+				go func() {
+					defer GinkgoRecover()
+
+					_, err := scenario.Pool.FindOrSelectWorker(
+						lowCtx,
+						db.NewFixedHandleContainerOwner("low-priority-container"),
+						taskSpec,
+						worker.Spec{Priority: 0},
+						strategy,
+						lowCallback,
+					)
+					lowErr <- err
+				}()
+
+				Eventually(func() int32 { return atomic.LoadInt32(&lowPosition) }).Should(Equal(int32(1)))
+			})
+
+			By("waiting with a high priority step", func() {
+				go func() {
+					defer GinkgoRecover()
+
+					worker, err := scenario.Pool.FindOrSelectWorker(
+						ctx,
+						db.NewFixedHandleContainerOwner("high-priority-container"),
+						taskSpec,
+						worker.Spec{Priority: 10},
+						strategy,
+						highCallback,
+					)
+					Expect(err).ToNot(HaveOccurred())
+
+					highWorker <- worker
+				}()
+
+				Eventually(func() int32 { return atomic.LoadInt32(&highPosition) }).Should(Equal(int32(1)))
+				Eventually(func() int32 { return atomic.LoadInt32(&lowPosition) }).Should(Equal(int32(2)))
+			})
+
+			By("freeing up the worker", func() {
+				scenario.Pool.ReleaseWorker(logger, taskSpec, scenario.Worker("worker1"), strategy)
+
+				var worker runtime.Worker
+				Eventually(highWorker).Should(Receive(&worker))
+				Expect(worker.Name()).To(Equal("worker1"))
+
+				Consistently(lowErr).ShouldNot(Receive())
+			})
+
+			By("aborting the low priority step", func() {
+				cancelLow()
+				Eventually(lowErr).Should(Receive(Equal(context.Canceled)))
+			})
+		})
 	})
 
 	Describe("FindResourceCacheVolume", func() {
@@ -485,9 +573,9 @@ var _ = Describe("Pool", func() {
 })
 
 type PoolCallback struct {
-	waitingForWorker func()
+	waitingForWorker func(int)
 }
 
-func (p PoolCallback) WaitingForWorker(_ lager.Logger) {
-	p.waitingForWorker()
+func (p PoolCallback) WaitingForWorker(_ lager.Logger, queuePosition int) {
+	p.waitingForWorker(queuePosition)
 }
@@ -10,6 +10,10 @@ type Spec struct {
 	ResourceType string
 	Tags         []string
 	TeamID       int
+
+	// Priority orders the step amongst other steps waiting for a worker to
+	// become available. Higher priority steps are given workers first.
+	Priority int
 }
 
 func (spec Spec) Description() string {
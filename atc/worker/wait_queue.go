@@ -0,0 +1,132 @@
+package worker
+
+import (
+	"sort"
+	"sync"
+)
+
+// waitQueue orders the steps which are waiting for a worker to become
+// available. Rather than each step polling on its own, a waiting step is given
+// a turn to select a worker, and then passes the turn on to the step behind
+// it. Steps are ordered by priority, and then by how long they have been
+// waiting, so that a step is not beaten to a freed up worker by a lower
+// priority step.
+//
+// The queue is local to the ATC; steps waiting on other ATCs are not taken
+// into account.
+type waitQueue struct {
+	lock    sync.Mutex
+	waiters []*waiter
+}
+
+type waiter struct {
+	priority int
+	turn     chan struct{}
+}
+
+func newWaitQueue() *waitQueue {
+	return &waitQueue{}
+}
+
+// Enqueue adds a waiter with the given priority to the queue.
+func (queue *waitQueue) Enqueue(priority int) *waiter {
+	queue.lock.Lock()
+	defer queue.lock.Unlock()
+
+	w := &waiter{
+		priority: priority,
+		turn:     make(chan struct{}, 1),
+	}
+
+	// waiters of the same priority are kept in the order they were added
+	i := sort.Search(len(queue.waiters), func(i int) bool {
+		return queue.waiters[i].priority < priority
+	})
+
+	queue.waiters = append(queue.waiters, nil)
+	copy(queue.waiters[i+1:], queue.waiters[i:])
+	queue.waiters[i] = w
+
+	return w
+}
+
+// Remove takes the waiter out of the queue, passing its turn on to the waiter
+// behind it.
+func (queue *waitQueue) Remove(w *waiter) {
+	queue.lock.Lock()
+	defer queue.lock.Unlock()
+
+	i := queue.index(w)
+	if i == -1 {
+		return
+	}
+
+	queue.waiters = append(queue.waiters[:i], queue.waiters[i+1:]...)
+
+	if i < len(queue.waiters) {
+		queue.waiters[i].notify()
+	}
+}
+
+// Position returns the 1-based position of the waiter in the queue, or 0 if
+// it is not in the queue.
+func (queue *waitQueue) Position(w *waiter) int {
+	queue.lock.Lock()
+	defer queue.lock.Unlock()
+
+	return queue.index(w) + 1
+}
+
+// HasPriorityAtLeast returns whether any waiter in the queue has the given
+// priority or a higher one.
+func (queue *waitQueue) HasPriorityAtLeast(priority int) bool {
+	queue.lock.Lock()
+	defer queue.lock.Unlock()
+
+	return len(queue.waiters) > 0 && queue.waiters[0].priority >= priority
+}
+
+// Wake gives the first waiter in the queue a turn.
+func (queue *waitQueue) Wake() {
+	queue.lock.Lock()
+	defer queue.lock.Unlock()
+
+	if len(queue.waiters) > 0 {
+		queue.waiters[0].notify()
+	}
+}
+
+// PassTurn gives the waiter behind the given one a turn.
+func (queue *waitQueue) PassTurn(w *waiter) {
+	queue.lock.Lock()
+	defer queue.lock.Unlock()
+
+	i := queue.index(w)
+	if i != -1 && i+1 < len(queue.waiters) {
+		queue.waiters[i+1].notify()
+	}
+}
+
+func (queue *waitQueue) index(w *waiter) int {
+	for i, candidate := range queue.waiters {
+		if candidate == w {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Turn returns a channel which receives when the waiter is given a turn.
+// Turns which are given while the waiter already has one pending are
+// coalesced.
+func (w *waiter) Turn() <-chan struct{} {
+	return w.turn
+}
+
+func (w *waiter) notify() {
+	select {
+	case w.turn <- struct{}{}:
+	default:
+	}
+}
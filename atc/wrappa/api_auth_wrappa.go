@@ -47,12 +47,18 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		case atc.GetBuildPreparation,
 			atc.BuildEvents,
 			atc.GetBuildPlan,
-			atc.ListBuildArtifacts:
+			atc.ListBuildArtifacts,
+			atc.ListBuildTestResults:
 			newHandler = wrappa.checkBuildReadAccessHandlerFactory.CheckIfPrivateJobHandler(handler, rejector)
 
+		// build belongs to authorized team, regardless of the pipeline being public
+		case atc.ListBuildSecretAccesses:
+			newHandler = wrappa.checkBuildReadAccessHandlerFactory.TeamHandler(handler, rejector)
+
 			// resource belongs to authorized team
 		case atc.AbortBuild,
-			atc.SetBuildComment:
+			atc.SetBuildComment,
+			atc.ApproveBuild:
 			newHandler = wrappa.checkBuildWriteAccessHandlerFactory.HandlerFor(handler, rejector)
 
 		// requester is system, admin team, or worker owning team
@@ -72,8 +78,12 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.JobBadge,
 			atc.ListJobs,
 			atc.GetJob,
+			atc.GetJobStats,
 			atc.ListJobBuilds,
 			atc.ListPipelineBuilds,
+			atc.SearchPipelineBuildLogs,
+			atc.SearchJobBuildLogs,
+			atc.ListJobTestSummaries,
 			atc.GetResource,
 			atc.ListBuildsWithVersionAsInput,
 			atc.ListBuildsWithVersionAsOutput,
@@ -97,6 +107,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		// unauthenticated / delegating to handler (validate token if provided)
 		case atc.DownloadCLI,
 			atc.CheckResourceWebHook,
+			atc.CheckTeamWebHook,
 			atc.GetInfo,
 			atc.ListTeams,
 			atc.ListAllPipelines,
@@ -125,6 +136,9 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		// authorized (requested team matches resource team and has required role, or is admin)
 		case atc.GetTeam,
 			atc.SetTeam,
+			atc.ListSecrets,
+			atc.SetSecret,
+			atc.DeleteSecret,
 			atc.RenameTeam,
 			atc.ListContainers,
 			atc.GetContainer,
@@ -146,6 +160,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.GetConfig,
 			atc.GetCC,
 			atc.GetVersionsDB,
+			atc.ListNotificationDeliveries,
 			atc.ListJobInputs,
 			atc.OrderPipelines,
 			atc.OrderPipelinesWithinGroup,
@@ -162,7 +177,8 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.ClearResourceCache,
 			atc.CreateArtifact,
 			atc.ScheduleJob,
-			atc.GetArtifact:
+			atc.GetArtifact,
+			atc.SearchTeamBuildLogs:
 			newHandler = auth.CheckAuthorizationHandler(handler, rejector)
 
 		// think about it!
@@ -47,10 +47,13 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.BuildResources,
 			atc.BuildEvents,
 			atc.ListBuildArtifacts,
+			atc.ListBuildTestResults,
+			atc.ListBuildSecretAccesses,
 			atc.GetBuildPreparation,
 			atc.GetBuildPlan,
 			atc.AbortBuild,
 			atc.SetBuildComment,
+			atc.ApproveBuild,
 			atc.PruneWorker,
 			atc.LandWorker,
 			atc.ReportWorkerContainers,
@@ -64,8 +67,13 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.JobBadge,
 			atc.ListJobs,
 			atc.GetJob,
+			atc.GetJobStats,
 			atc.ListJobBuilds,
 			atc.ListPipelineBuilds,
+			atc.SearchTeamBuildLogs,
+			atc.SearchPipelineBuildLogs,
+			atc.SearchJobBuildLogs,
+			atc.ListJobTestSummaries,
 			atc.GetResource,
 			atc.ListBuildsWithVersionAsInput,
 			atc.ListBuildsWithVersionAsOutput,
@@ -89,10 +97,14 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.SetTeam,
 			atc.RenameTeam,
 			atc.DestroyTeam,
+			atc.ListSecrets,
+			atc.SetSecret,
+			atc.DeleteSecret,
 			atc.GetUser,
 			atc.GetInfo,
 			atc.DownloadCLI,
 			atc.CheckResourceWebHook,
+			atc.CheckTeamWebHook,
 			atc.ListAllPipelines,
 			atc.ListBuilds,
 			atc.ListPipelines,
@@ -110,6 +122,7 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.DeletePipeline,
 			atc.GetCC,
 			atc.GetVersionsDB,
+			atc.ListNotificationDeliveries,
 			atc.ListJobInputs,
 			atc.OrderPipelines,
 			atc.OrderPipelinesWithinGroup,
@@ -0,0 +1,57 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/vito/go-interact/interact"
+)
+
+type DeleteSecretCommand struct {
+	Secret          string               `short:"n" long:"name"     required:"true" description:"Name of the secret to delete"`
+	Pipeline        string               `short:"p" long:"pipeline" description:"Name of the pipeline the secret is scoped to, if it isn't shared by the whole team"`
+	SkipInteractive bool                 `long:"non-interactive"    description:"Delete the secret without confirmation"`
+	Team            flaghelpers.TeamFlag `long:"team"               description:"Name of the team to which the secret belongs, if different from the target default"`
+}
+
+func (command *DeleteSecretCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team, err := command.Team.LoadTeam(target)
+	if err != nil {
+		return err
+	}
+
+	path := secretPath(team.Name(), command.Pipeline, command.Secret)
+
+	confirm := command.SkipInteractive
+	if !confirm {
+		err := interact.NewInteraction(fmt.Sprintf("delete secret `%s`?", path)).Resolve(&confirm)
+		if err != nil || !confirm {
+			fmt.Println("bailing out")
+			return err
+		}
+	}
+
+	found, err := team.DeleteSecret(command.Pipeline, command.Secret)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		fmt.Printf("`%s` does not exist\n", path)
+	} else {
+		fmt.Printf("`%s` deleted\n", path)
+	}
+
+	return nil
+}
@@ -32,6 +32,10 @@ type FlyCommand struct {
 	RenameTeam  RenameTeamCommand  `command:"rename-team"   alias:"rt" description:"Rename a team"`
 	DestroyTeam DestroyTeamCommand `command:"destroy-team"  alias:"dt" description:"Destroy a team and delete all of its data"`
 
+	Secrets      SecretsCommand      `command:"secrets"       alias:"scs" description:"List the secrets stored by the built-in credential manager"`
+	SetSecret    SetSecretCommand    `command:"set-secret"    alias:"ssc" description:"Create or update a secret stored by the built-in credential manager"`
+	DeleteSecret DeleteSecretCommand `command:"delete-secret" alias:"dsc" description:"Delete a secret stored by the built-in credential manager"`
+
 	Checklist ChecklistCommand `command:"checklist" alias:"cl" description:"Print a Checkfile of the given pipeline"`
 
 	Execute ExecuteCommand `command:"execute" alias:"e" description:"Execute a one-off build using local bits"`
@@ -45,6 +49,7 @@ type FlyCommand struct {
 	PauseJob    PauseJobCommand    `command:"pause-job"       alias:"pj"  description:"Pause a job"`
 	UnpauseJob  UnpauseJobCommand  `command:"unpause-job"     alias:"uj"  description:"Unpause a job"`
 	ScheduleJob ScheduleJobCommand `command:"schedule-job"    alias:"sj"  description:"Request the scheduler to run for a job. Introduced as a recovery command for the v6.0 scheduler."`
+	JobStats    JobStatsCommand    `command:"job-stats"       alias:"jst" description:"Show build statistics of a job"`
 
 	Pipelines                 PipelinesCommand               `command:"pipelines"                 alias:"ps"   description:"List the configured pipelines"`
 	PausedPipelines           PausedPipelinesCommand         `command:"paused-pipelines"          alias:"pps"  description:"List the configured paused pipelines"`
@@ -61,6 +66,7 @@ type FlyCommand struct {
 	FormatPipeline            FormatPipelineCommand          `command:"format-pipeline"           alias:"fp"   description:"Format a pipeline config"`
 	OrderPipelines            OrderPipelinesCommand          `command:"order-pipelines"           alias:"op"   description:"Orders pipelines"`
 	OrderPipelinesWithinGroup OrderInstancedPipelinesCommand `command:"order-instanced-pipelines" alias:"oip"  description:"Orders instanced pipelines within an instance group"`
+	NotificationDeliveries    NotificationDeliveriesCommand  `command:"notification-deliveries"   alias:"nds"  description:"List the deliveries of a pipeline's build notifications"`
 
 	Resources              ResourcesCommand              `command:"resources"                  alias:"rs"   description:"List the resources in the pipeline"`
 	ResourceVersions       ResourceVersionsCommand       `command:"resource-versions"          alias:"rvs"  description:"List the versions of a resource"`
@@ -76,9 +82,13 @@ type FlyCommand struct {
 
 	ClearTaskCache ClearTaskCacheCommand `command:"clear-task-cache" alias:"ctc" description:"Clears cache from a task container"`
 
-	Builds     BuildsCommand     `command:"builds"      alias:"bs" description:"List builds data"`
-	AbortBuild AbortBuildCommand `command:"abort-build" alias:"ab" description:"Abort a build"`
-	RerunBuild RerunBuildCommand `command:"rerun-build" alias:"rb" description:"Rerun a build"`
+	Builds       BuildsCommand       `command:"builds"        alias:"bs"  description:"List builds data"`
+	AbortBuild   AbortBuildCommand   `command:"abort-build"   alias:"ab"  description:"Abort a build"`
+	ApproveBuild ApproveBuildCommand `command:"approve-build" alias:"apb" description:"Approve or reject a build waiting for approval"`
+	RerunBuild   RerunBuildCommand   `command:"rerun-build"   alias:"rb"  description:"Rerun a build"`
+	SearchLogs   SearchLogsCommand   `command:"search-logs"   alias:"sl"  description:"Search the logs of builds"`
+	TestResults  TestResultsCommand  `command:"test-results"  alias:"tr"  description:"List the test results of a build or summarize those of a job"`
+	SecretsUsed  SecretsUsedCommand  `command:"secrets-used"  alias:"su"  description:"List the secrets looked up by a build, without their values"`
 
 	TriggerJob TriggerJobCommand `command:"trigger-job" alias:"tj" description:"Start a job in a pipeline"`
 
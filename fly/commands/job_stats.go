@@ -0,0 +1,123 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type JobStatsCommand struct {
+	Job   flaghelpers.JobFlag  `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of a job to get statistics for"`
+	Since string               `long:"since" description:"Only consider builds which started at or after this time (default: 30 days ago)"`
+	Until string               `long:"until" description:"Only consider builds which started before this time (default: now)"`
+	Json  bool                 `long:"json" description:"Print command result as JSON"`
+	Team  flaghelpers.TeamFlag `long:"team" description:"Name of the team to which the pipeline belongs, if different from the target default"`
+}
+
+func (command *JobStatsCommand) Execute([]string) error {
+	since, err := parseSearchTime("Since", command.Since)
+	if err != nil {
+		return err
+	}
+
+	until, err := parseSearchTime("Until", command.Until)
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team, err := command.Team.LoadTeam(target)
+	if err != nil {
+		return err
+	}
+
+	stats, found, err := team.JobStats(command.Job.PipelineRef, command.Job.JobName, since, until)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return errors.New("job not found")
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(stats)
+	}
+
+	summary := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "stat", Color: color.New(color.Bold)},
+			{Contents: "value", Color: color.New(color.Bold)},
+		},
+		Data: []ui.TableRow{
+			{{Contents: "window"}, {Contents: fmt.Sprintf("%s - %s", time.Unix(stats.Since, 0).Local().Format(timeDateLayout), time.Unix(stats.Until, 0).Local().Format(timeDateLayout))}},
+			{{Contents: "builds"}, {Contents: strconv.Itoa(stats.Builds)}},
+			{{Contents: "succeeded"}, {Contents: strconv.Itoa(stats.Succeeded), Color: ui.SucceededColor}},
+			{{Contents: "failed"}, {Contents: strconv.Itoa(stats.Failed), Color: ui.FailedColor}},
+			{{Contents: "errored"}, {Contents: strconv.Itoa(stats.Errored), Color: ui.ErroredColor}},
+			{{Contents: "aborted"}, {Contents: strconv.Itoa(stats.Aborted), Color: ui.AbortedColor}},
+			{{Contents: "success rate"}, {Contents: fmt.Sprintf("%.1f%%", stats.SuccessRate*100)}},
+			{{Contents: "mean time to recovery"}, {Contents: formatStatsDuration(stats.MeanTimeToRecovery)}},
+			{{Contents: "median duration"}, {Contents: formatStatsDuration(stats.MedianDuration)}},
+			{{Contents: "median queue time"}, {Contents: formatStatsDuration(stats.MedianQueueTime)}},
+			{{Contents: "flaky retries"}, {Contents: strconv.Itoa(stats.FlakyRetries)}},
+			{{Contents: "flaky tests"}, {Contents: strconv.Itoa(stats.FlakyTests)}},
+		},
+	}
+
+	err = summary.Render(os.Stdout, Fly.PrintTableHeaders)
+	if err != nil {
+		return err
+	}
+
+	if len(stats.Steps) == 0 {
+		return nil
+	}
+
+	fmt.Println()
+
+	steps := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "step", Color: color.New(color.Bold)},
+			{Contents: "type", Color: color.New(color.Bold)},
+			{Contents: "runs", Color: color.New(color.Bold)},
+			{Contents: "p50", Color: color.New(color.Bold)},
+			{Contents: "p90", Color: color.New(color.Bold)},
+			{Contents: "p95", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, step := range stats.Steps {
+		steps.Data = append(steps.Data, []ui.TableCell{
+			{Contents: step.Name},
+			{Contents: step.Type},
+			{Contents: strconv.Itoa(step.Runs)},
+			{Contents: formatStatsDuration(step.P50)},
+			{Contents: formatStatsDuration(step.P90)},
+			{Contents: formatStatsDuration(step.P95)},
+		})
+	}
+
+	return steps.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func formatStatsDuration(seconds float64) string {
+	return roundSecondsOffDuration(time.Duration(seconds * float64(time.Second))).String()
+}
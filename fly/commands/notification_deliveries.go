@@ -0,0 +1,117 @@
+package commands
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type NotificationDeliveriesCommand struct {
+	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Name of the pipeline whose notification deliveries to list"`
+	Count    int                      `short:"c" long:"count" default:"50" description:"Number of deliveries you want to limit the return to"`
+	Json     bool                     `long:"json" description:"Print command result as JSON"`
+	Team     flaghelpers.TeamFlag     `long:"team" description:"Name of the team to which the pipeline belongs, if different from the target default"`
+}
+
+func (command *NotificationDeliveriesCommand) Execute([]string) error {
+	_, err := command.Pipeline.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team, err := command.Team.LoadTeam(target)
+	if err != nil {
+		return err
+	}
+
+	deliveries, found, err := team.NotificationDeliveries(command.Pipeline.Ref(), command.Count)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return errors.New("pipeline not found")
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(deliveries)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "notification", Color: color.New(color.Bold)},
+			{Contents: "build", Color: color.New(color.Bold)},
+			{Contents: "status", Color: color.New(color.Bold)},
+			{Contents: "state", Color: color.New(color.Bold)},
+			{Contents: "attempts", Color: color.New(color.Bold)},
+			{Contents: "response", Color: color.New(color.Bold)},
+			{Contents: "created", Color: color.New(color.Bold)},
+			{Contents: "error", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, delivery := range deliveries {
+		buildCell := ui.TableCell{Contents: strconv.Itoa(delivery.BuildID)}
+		if delivery.JobName != "" {
+			buildCell.Contents = delivery.JobName + "/" + delivery.BuildName
+		}
+
+		responseCell := ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+		if delivery.ResponseCode != 0 {
+			responseCell = ui.TableCell{Contents: strconv.Itoa(delivery.ResponseCode)}
+		}
+
+		errorCell := ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+		if delivery.Error != "" {
+			errorCell = ui.TableCell{Contents: delivery.Error}
+		}
+
+		table.Data = append(table.Data, []ui.TableCell{
+			{Contents: strconv.Itoa(delivery.ID)},
+			{Contents: delivery.Notification},
+			buildCell,
+			ui.BuildStatusCell(delivery.Status),
+			deliveryStateCell(delivery.State),
+			{Contents: strconv.Itoa(delivery.Attempts)},
+			responseCell,
+			{Contents: time.Unix(delivery.CreatedAt, 0).Local().Format(timeDateLayout)},
+			errorCell,
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func deliveryStateCell(state atc.NotificationDeliveryState) ui.TableCell {
+	cell := ui.TableCell{Contents: string(state)}
+
+	switch state {
+	case atc.NotificationDeliveryDelivered:
+		cell.Color = ui.SucceededColor
+	case atc.NotificationDeliveryFailed:
+		cell.Color = ui.FailedColor
+	case atc.NotificationDeliveryPending:
+		cell.Color = ui.PendingColor
+	}
+
+	return cell
+}
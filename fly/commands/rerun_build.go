@@ -6,6 +6,7 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/eventstream"
 	"github.com/concourse/concourse/fly/rc"
@@ -16,6 +17,8 @@ type RerunBuildCommand struct {
 	Job   flaghelpers.JobFlag `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of the job that you want to rerun a build for"`
 	Build string              `short:"b" long:"build" required:"true" description:"The number of the build to rerun"`
 	Watch bool                `short:"w" long:"watch" description:"Start watching the rerun build output"`
+
+	FromFailed bool `long:"from-failed" description:"Rerun from the step that failed, reusing the outputs of the steps that succeeded"`
 }
 
 func (command *RerunBuildCommand) Execute(args []string) error {
@@ -32,7 +35,12 @@ func (command *RerunBuildCommand) Execute(args []string) error {
 		return err
 	}
 
-	build, err := target.Team().RerunJobBuild(pipelineRef, jobName, buildName)
+	var build atc.Build
+	if command.FromFailed {
+		build, err = target.Team().ResumeJobBuild(pipelineRef, jobName, buildName)
+	} else {
+		build, err = target.Team().RerunJobBuild(pipelineRef, jobName, buildName)
+	}
 	if err != nil {
 		return err
 	}
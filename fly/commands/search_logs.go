@@ -0,0 +1,157 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type SearchLogsCommand struct {
+	Pipeline *flaghelpers.PipelineFlag `short:"p" long:"pipeline" description:"Name of a pipeline whose builds to search"`
+	Job      flaghelpers.JobFlag       `short:"j" long:"job" value-name:"PIPELINE/JOB" description:"Name of a job whose builds to search"`
+	Statuses []string                  `short:"s" long:"status" description:"Only search builds with this status (can be specified multiple times)"`
+	Since    string                    `long:"since" description:"Only search builds which started at or after this time"`
+	Until    string                    `long:"until" description:"Only search builds which started at or before this time"`
+	Count    int                       `short:"c" long:"count" default:"50" description:"Maximum number of builds to return"`
+	Json     bool                      `long:"json" description:"Print command result as JSON"`
+	Team     flaghelpers.TeamFlag      `long:"team" description:"Name of the team whose builds to search, if different from the target default"`
+
+	Args struct {
+		Query string `positional-arg-name:"QUERY" required:"true" description:"Words or phrase to search for in the build logs"`
+	} `positional-args:"yes"`
+}
+
+func (command *SearchLogsCommand) Execute([]string) error {
+	if command.Pipeline != nil && command.Job.JobName != "" {
+		return errors.New("Cannot specify both --pipeline and --job")
+	}
+
+	search := concourse.BuildLogSearch{
+		Query: command.Args.Query,
+		Limit: command.Count,
+	}
+
+	if command.Pipeline != nil {
+		search.PipelineRef = command.Pipeline.Ref()
+	}
+
+	if command.Job.JobName != "" {
+		search.PipelineRef = command.Job.PipelineRef
+		search.JobName = command.Job.JobName
+	}
+
+	for _, status := range command.Statuses {
+		search.Statuses = append(search.Statuses, atc.BuildStatus(status))
+	}
+
+	var err error
+	search.Since, err = parseSearchTime("Since", command.Since)
+	if err != nil {
+		return err
+	}
+
+	search.Until, err = parseSearchTime("Until", command.Until)
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team, err := command.Team.LoadTeam(target)
+	if err != nil {
+		return err
+	}
+
+	matches, found, err := team.SearchBuildLogs(search)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		if search.JobName != "" {
+			return errors.New("job not found")
+		}
+
+		return errors.New("pipeline not found")
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(matches)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "name", Color: color.New(color.Bold)},
+			{Contents: "status", Color: color.New(color.Bold)},
+			{Contents: "start", Color: color.New(color.Bold)},
+			{Contents: "line", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, match := range matches {
+		startTimeCell, _, _ := populateTimeCells(time.Unix(match.Build.StartTime, 0), time.Unix(match.Build.EndTime, 0))
+
+		for _, line := range match.Lines {
+			table.Data = append(table.Data, []ui.TableCell{
+				{Contents: strconv.Itoa(match.Build.ID)},
+				{Contents: buildFullName(match.Build)},
+				ui.BuildStatusCell(match.Build.Status),
+				startTimeCell,
+				{Contents: line.Line},
+			})
+		}
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func buildFullName(build atc.Build) string {
+	var names []string
+	if build.PipelineName != "" {
+		pipelineRef := atc.PipelineRef{
+			Name:         build.PipelineName,
+			InstanceVars: build.PipelineInstanceVars,
+		}
+
+		names = append(names, pipelineRef.String())
+	}
+
+	if build.JobName != "" {
+		names = append(names, build.JobName)
+	}
+
+	return strings.Join(append(names, build.Name), "/")
+}
+
+func parseSearchTime(name string, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.ParseInLocation(inputTimeLayout, value, time.Now().Location())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s time should be in the format: %s", name, inputTimeLayout)
+	}
+
+	return t, nil
+}
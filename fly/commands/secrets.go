@@ -0,0 +1,66 @@
+package commands
+
+import (
+	"os"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type SecretsCommand struct {
+	Json bool                 `long:"json" description:"Print command result as JSON"`
+	Team flaghelpers.TeamFlag `long:"team" description:"Name of the team whose secrets to list, if different from the target default"`
+}
+
+func (command *SecretsCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team, err := command.Team.LoadTeam(target)
+	if err != nil {
+		return err
+	}
+
+	secrets, err := team.ListSecrets()
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(secrets)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "name", Color: color.New(color.Bold)},
+			{Contents: "pipeline", Color: color.New(color.Bold)},
+			{Contents: "updated", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, secret := range secrets {
+		pipelineCell := ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+		if secret.PipelineName != "" {
+			pipelineCell = ui.TableCell{Contents: secret.PipelineName}
+		}
+
+		table.Data = append(table.Data, []ui.TableCell{
+			{Contents: secret.Name},
+			pipelineCell,
+			{Contents: time.Unix(secret.UpdatedAt, 0).Local().Format(timeDateLayout)},
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
@@ -0,0 +1,112 @@
+package commands
+
+import (
+	"errors"
+	"os"
+	"strconv"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type SecretsUsedCommand struct {
+	Job   flaghelpers.JobFlag  `short:"j" long:"job" value-name:"PIPELINE/JOB" description:"Name of a job to get the build from"`
+	Build string               `short:"b" long:"build" required:"true" description:"If job is specified: build number. If job not specified: build id"`
+	Json  bool                 `long:"json" description:"Print command result as JSON"`
+	Team  flaghelpers.TeamFlag `long:"team" description:"Name of the team to which the pipeline belongs, if different from the target default"`
+}
+
+func (command *SecretsUsedCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	buildID := command.Build
+	if command.Job.JobName != "" {
+		team, err := command.Team.LoadTeam(target)
+		if err != nil {
+			return err
+		}
+
+		build, exists, err := team.JobBuild(command.Job.PipelineRef, command.Job.JobName, command.Build)
+		if err != nil {
+			return err
+		}
+
+		if !exists {
+			return errors.New("build does not exist")
+		}
+
+		buildID = strconv.Itoa(build.ID)
+	}
+
+	accesses, found, err := target.Client().BuildSecretAccesses(buildID)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return errors.New("build does not exist")
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(accesses)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "source", Color: color.New(color.Bold)},
+			{Contents: "path", Color: color.New(color.Bold)},
+			{Contents: "credential manager", Color: color.New(color.Bold)},
+			{Contents: "found", Color: color.New(color.Bold)},
+			{Contents: "cached", Color: color.New(color.Bold)},
+			{Contents: "error", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, access := range accesses {
+		table.Data = append(table.Data, []ui.TableCell{
+			optionalCell(access.Source),
+			{Contents: access.Path},
+			optionalCell(access.CredentialManager),
+			yesNoCell(access.Found),
+			yesNoCell(access.Cached),
+			errorCell(access.Error),
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func optionalCell(contents string) ui.TableCell {
+	if contents == "" {
+		return ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+	}
+
+	return ui.TableCell{Contents: contents}
+}
+
+func errorCell(err string) ui.TableCell {
+	if err == "" {
+		return ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+	}
+
+	return ui.TableCell{Contents: err, Color: ui.FailedColor}
+}
+
+func yesNoCell(yes bool) ui.TableCell {
+	if yes {
+		return ui.TableCell{Contents: "yes"}
+	}
+
+	return ui.TableCell{Contents: "no"}
+}
@@ -0,0 +1,81 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"sigs.k8s.io/yaml"
+)
+
+type SetSecretCommand struct {
+	Secret   string               `short:"n" long:"name"     required:"true" description:"Name of the secret"`
+	Pipeline string               `short:"p" long:"pipeline" description:"Name of the pipeline the secret is scoped to. The secret is shared by the whole team when omitted"`
+	Value    string               `short:"v" long:"value"    description:"Value of the secret"`
+	File     atc.PathFlag         `short:"f" long:"file"     description:"File containing the value of the secret"`
+	Yaml     bool                 `long:"yaml"               description:"Parse the value as YAML, e.g. to set a secret with multiple fields"`
+	Team     flaghelpers.TeamFlag `long:"team"               description:"Name of the team to which the secret belongs, if different from the target default"`
+}
+
+func (command *SetSecretCommand) Execute([]string) error {
+	payload, err := command.payload()
+	if err != nil {
+		return err
+	}
+
+	var value interface{} = string(payload)
+	if command.Yaml {
+		err = yaml.Unmarshal(payload, &value)
+		if err != nil {
+			return fmt.Errorf("failed to parse value as YAML: %w", err)
+		}
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team, err := command.Team.LoadTeam(target)
+	if err != nil {
+		return err
+	}
+
+	err = team.SetSecret(command.Pipeline, command.Secret, value)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("secret `%s` set\n", secretPath(team.Name(), command.Pipeline, command.Secret))
+
+	return nil
+}
+
+func (command *SetSecretCommand) payload() ([]byte, error) {
+	switch {
+	case command.Value != "" && command.File != "":
+		return nil, errors.New("only one of --value or --file can be given")
+	case command.File != "":
+		return os.ReadFile(string(command.File))
+	case command.Value != "":
+		return []byte(command.Value), nil
+	default:
+		return nil, errors.New("either --value or --file must be given")
+	}
+}
+
+func secretPath(teamName string, pipelineName string, secretName string) string {
+	if pipelineName == "" {
+		return teamName + "/" + secretName
+	}
+
+	return teamName + "/" + pipelineName + "/" + secretName
+}
@@ -68,6 +68,16 @@ func (command *SetTeamCommand) Execute([]string) error {
 		os.Exit(1)
 	}
 
+	varSources, err := command.AuthFlags.VarSources()
+	if err != nil {
+		return err
+	}
+
+	notifications, err := command.AuthFlags.Notifications()
+	if err != nil {
+		return err
+	}
+
 	roles := []string{}
 	for role := range authRoles {
 		roles = append(roles, role)
@@ -103,6 +113,30 @@ func (command *SetTeamCommand) Execute([]string) error {
 		}
 	}
 
+	if varSources != nil {
+		fmt.Println()
+		fmt.Printf("var sources:\n")
+		if len(*varSources) > 0 {
+			for _, varSource := range *varSources {
+				fmt.Printf("  - %s (%s)\n", varSource.Name, varSource.Type)
+			}
+		} else {
+			fmt.Printf("  %s\n", ui.OffColor.Sprint("none (existing var sources will be removed)"))
+		}
+	}
+
+	if notifications != nil {
+		fmt.Println()
+		fmt.Printf("notifications:\n")
+		if len(*notifications) > 0 {
+			for _, notification := range *notifications {
+				fmt.Printf("  - %s\n", notification.Name)
+			}
+		} else {
+			fmt.Printf("  %s\n", ui.OffColor.Sprint("none (existing notifications will be removed)"))
+		}
+	}
+
 	if len(warnings) > 0 {
 		displayhelpers.ShowWarnings(warnings)
 	}
@@ -120,7 +154,7 @@ func (command *SetTeamCommand) Execute([]string) error {
 		displayhelpers.Failf("bailing out")
 	}
 
-	team := atc.Team{Auth: authRoles}
+	team := atc.Team{Auth: authRoles, VarSources: varSources, Notifications: notifications}
 
 	_, created, updated, warnings, err := target.Client().Team(teamName).CreateOrUpdate(team)
 	if err != nil {
@@ -0,0 +1,195 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type TestResultsCommand struct {
+	Job    flaghelpers.JobFlag  `short:"j" long:"job" value-name:"PIPELINE/JOB" description:"Name of a job. Without --build, summarizes the tests of the job's recent builds"`
+	Build  string               `short:"b" long:"build" description:"If job is specified: build number. If job not specified: build id"`
+	Failed bool                 `long:"failed" description:"Only show tests which failed"`
+	Count  int                  `short:"c" long:"count" default:"20" description:"Number of recent builds of the job to summarize"`
+	Json   bool                 `long:"json" description:"Print command result as JSON"`
+	Team   flaghelpers.TeamFlag `long:"team" description:"Name of the team to which the pipeline belongs, if different from the target default"`
+}
+
+func (command *TestResultsCommand) Execute([]string) error {
+	if command.Build == "" && command.Job.JobName == "" {
+		return errors.New("either --build or --job must be specified")
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team, err := command.Team.LoadTeam(target)
+	if err != nil {
+		return err
+	}
+
+	if command.Build == "" {
+		summaries, found, err := team.JobTestSummaries(command.Job.PipelineRef, command.Job.JobName, command.Count)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return errors.New("job not found")
+		}
+
+		return command.displaySummaries(summaries)
+	}
+
+	var build atc.Build
+	var exists bool
+	if command.Job.JobName == "" {
+		build, exists, err = target.Client().Build(command.Build)
+	} else {
+		build, exists, err = team.JobBuild(command.Job.PipelineRef, command.Job.JobName, command.Build)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return errors.New("build does not exist")
+	}
+
+	results, found, err := target.Client().BuildTestResults(strconv.Itoa(build.ID))
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return errors.New("build does not exist")
+	}
+
+	return command.displayResults(results)
+}
+
+func (command *TestResultsCommand) displayResults(results []atc.TestResult) error {
+	if command.Failed {
+		var failed []atc.TestResult
+		for _, result := range results {
+			if result.Status == atc.TestStatusFailed || result.Status == atc.TestStatusErrored {
+				failed = append(failed, result)
+			}
+		}
+
+		results = failed
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(results)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "step", Color: color.New(color.Bold)},
+			{Contents: "suite", Color: color.New(color.Bold)},
+			{Contents: "name", Color: color.New(color.Bold)},
+			{Contents: "status", Color: color.New(color.Bold)},
+			{Contents: "duration", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, result := range results {
+		table.Data = append(table.Data, []ui.TableCell{
+			{Contents: result.Step},
+			{Contents: result.Suite},
+			{Contents: result.Name},
+			testStatusCell(result.Status),
+			{Contents: fmt.Sprintf("%.3fs", result.Duration)},
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func (command *TestResultsCommand) displaySummaries(summaries []atc.TestSummary) error {
+	if command.Failed {
+		var failed []atc.TestSummary
+		for _, summary := range summaries {
+			if summary.Failed > 0 {
+				failed = append(failed, summary)
+			}
+		}
+
+		summaries = failed
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(summaries)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "suite", Color: color.New(color.Bold)},
+			{Contents: "name", Color: color.New(color.Bold)},
+			{Contents: "runs", Color: color.New(color.Bold)},
+			{Contents: "passed", Color: color.New(color.Bold)},
+			{Contents: "failed", Color: color.New(color.Bold)},
+			{Contents: "skipped", Color: color.New(color.Bold)},
+			{Contents: "flaky", Color: color.New(color.Bold)},
+			{Contents: "last failed build", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, summary := range summaries {
+		flakyCell := ui.TableCell{Contents: "no"}
+		if summary.Flaky() {
+			flakyCell = ui.TableCell{Contents: "yes", Color: ui.StartedColor}
+		}
+
+		lastFailedCell := ui.TableCell{Contents: summary.LastFailedBuild}
+		if summary.LastFailedBuild == "" {
+			lastFailedCell = ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+		}
+
+		table.Data = append(table.Data, []ui.TableCell{
+			{Contents: summary.Suite},
+			{Contents: summary.Name},
+			{Contents: strconv.Itoa(summary.Runs)},
+			{Contents: strconv.Itoa(summary.Passed)},
+			{Contents: strconv.Itoa(summary.Failed)},
+			{Contents: strconv.Itoa(summary.Skipped)},
+			flakyCell,
+			lastFailedCell,
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func testStatusCell(status atc.TestStatus) ui.TableCell {
+	cell := ui.TableCell{Contents: string(status)}
+
+	switch status {
+	case atc.TestStatusPassed:
+		cell.Color = ui.SucceededColor
+	case atc.TestStatusFailed:
+		cell.Color = ui.FailedColor
+	case atc.TestStatusErrored:
+		cell.Color = ui.ErroredColor
+	case atc.TestStatusSkipped:
+		cell.Color = ui.PendingColor
+	}
+
+	return cell
+}
@@ -15,9 +15,11 @@ import (
 )
 
 type TriggerJobCommand struct {
-	Job   flaghelpers.JobFlag  `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of a job to trigger"`
-	Watch bool                 `short:"w" long:"watch" description:"Start watching the build output"`
-	Team  flaghelpers.TeamFlag `long:"team" description:"Name of the team to which the job belongs, if different from the target default"`
+	Job      flaghelpers.JobFlag            `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of a job to trigger"`
+	Param    []flaghelpers.VariablePairFlag `long:"param" unquote:"false" value-name:"[NAME=VALUE]" description:"Specify a value for a param declared by the job"`
+	Priority *int                           `long:"priority" value-name:"PRIORITY" description:"Override the job's priority for placing the build's steps on workers"`
+	Watch    bool                           `short:"w" long:"watch" description:"Start watching the build output"`
+	Team     flaghelpers.TeamFlag           `long:"team" description:"Name of the team to which the job belongs, if different from the target default"`
 }
 
 func (command *TriggerJobCommand) Execute(args []string) error {
@@ -43,7 +45,18 @@ func (command *TriggerJobCommand) Execute(args []string) error {
 		return err
 	}
 
-	build, err = team.CreateJobBuild(pipelineRef, jobName)
+	var params map[string]interface{}
+	if len(command.Param) > 0 {
+		params = map[string]interface{}{}
+		for _, param := range command.Param {
+			params[param.Ref.Path] = param.Value
+		}
+	}
+
+	build, err = team.CreateJobBuild(pipelineRef, jobName, atc.CreateJobBuildBody{
+		Params:   params,
+		Priority: command.Priority,
+	})
 	if err != nil {
 		return err
 	} else {
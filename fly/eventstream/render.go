@@ -42,7 +42,11 @@ func Render(dst io.Writer, src eventstream.EventStream, options RenderOptions) i
 
 		case event.WaitingForWorker:
 			dstImpl.SetTimestamp(e.Time)
-			fmt.Fprintf(dstImpl, "\x1b[1mno suitable workers found, waiting for worker...\x1b[0m\n")
+			if e.QueuePosition > 0 {
+				fmt.Fprintf(dstImpl, "\x1b[1mno suitable workers found, waiting for worker (position %d in queue)...\x1b[0m\n", e.QueuePosition)
+			} else {
+				fmt.Fprintf(dstImpl, "\x1b[1mno suitable workers found, waiting for worker...\x1b[0m\n")
+			}
 
 		case event.SelectedWorker:
 			dstImpl.SetTimestamp(e.Time)
@@ -74,6 +78,30 @@ func Render(dst io.Writer, src eventstream.EventStream, options RenderOptions) i
 		case event.FinishTask:
 			exitStatus = e.ExitStatus
 
+		case event.Retrying:
+			dstImpl.SetTimestamp(e.Time)
+			fmt.Fprintf(dstImpl, "\x1b[1mretrying (attempt %d) in %s:\x1b[0m %s\n", e.Attempt, e.Delay, e.Reason)
+
+		case event.Skipped:
+			dstImpl.SetTimestamp(e.Time)
+			fmt.Fprintf(dstImpl, "\x1b[1mskipped:\x1b[0m condition %s was false\n", e.Condition)
+
+		case event.WaitingForApproval:
+			dstImpl.SetTimestamp(e.Time)
+			fmt.Fprintf(dstImpl, "\x1b[1mwaiting for approval\x1b[0m (requires role %s)\n", e.Role)
+
+		case event.ApprovalDecided:
+			dstImpl.SetTimestamp(e.Time)
+			if e.Approved {
+				fmt.Fprintf(dstImpl, "\x1b[1mapproved by\x1b[0m %s\n", e.DecidedBy)
+			} else {
+				fmt.Fprintf(dstImpl, "\x1b[1mrejected by\x1b[0m %s\n", e.DecidedBy)
+			}
+
+		case event.TaskCacheHit:
+			dstImpl.SetTimestamp(e.Time)
+			fmt.Fprintf(dstImpl, "\x1b[1mcache hit:\x1b[0m reusing the outputs of a previous run\n")
+
 		case event.Error:
 			errCol := ui.ErroredColor.SprintFunc()
 			dstImpl.SetTimestamp(0)
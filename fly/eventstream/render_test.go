@@ -307,6 +307,19 @@ var _ = Describe("V1.0 Renderer", func() {
 			Expect(out.Contents()).To(ContainSubstring("\x1b[1mno suitable workers found, waiting for worker...\x1b[0m\n"))
 		})
 
+		Context("with a queue position", func() {
+			BeforeEach(func() {
+				receivedEvents <- event.WaitingForWorker{
+					Time:          time.Now().Unix(),
+					QueuePosition: 2,
+				}
+			})
+
+			It("prints the position", func() {
+				Expect(out.Contents()).To(ContainSubstring("\x1b[1mno suitable workers found, waiting for worker (position 2 in queue)...\x1b[0m\n"))
+			})
+		})
+
 		Context("and time configuration enabled", func() {
 			BeforeEach(func() {
 				options.ShowTimestamp = true
@@ -318,6 +331,90 @@ var _ = Describe("V1.0 Renderer", func() {
 		})
 	})
 
+	Context("when a Retrying event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.Retrying{
+				Time:    time.Now().Unix(),
+				Attempt: 2,
+				Delay:   "10s",
+				Reason:  "failed with exit status 75",
+			}
+		})
+
+		It("prints the delay and reason", func() {
+			Expect(out.Contents()).To(ContainSubstring("\x1b[1mretrying (attempt 2) in 10s:\x1b[0m failed with exit status 75\n"))
+		})
+	})
+
+	Context("when a WaitingForApproval event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.WaitingForApproval{
+				Time: time.Now().Unix(),
+				Role: "owner",
+			}
+		})
+
+		It("prints the required role", func() {
+			Expect(out.Contents()).To(ContainSubstring("\x1b[1mwaiting for approval\x1b[0m (requires role owner)\n"))
+		})
+	})
+
+	Context("when an ApprovalDecided event is received", func() {
+		Context("when approved", func() {
+			BeforeEach(func() {
+				receivedEvents <- event.ApprovalDecided{
+					Time:      time.Now().Unix(),
+					Approved:  true,
+					DecidedBy: "some-user",
+				}
+			})
+
+			It("prints the approver", func() {
+				Expect(out.Contents()).To(ContainSubstring("\x1b[1mapproved by\x1b[0m some-user\n"))
+			})
+		})
+
+		Context("when rejected", func() {
+			BeforeEach(func() {
+				receivedEvents <- event.ApprovalDecided{
+					Time:      time.Now().Unix(),
+					Approved:  false,
+					DecidedBy: "some-user",
+				}
+			})
+
+			It("prints who rejected it", func() {
+				Expect(out.Contents()).To(ContainSubstring("\x1b[1mrejected by\x1b[0m some-user\n"))
+			})
+		})
+	})
+
+	Context("when a TaskCacheHit event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.TaskCacheHit{
+				Time: time.Now().Unix(),
+				Key:  "some-key",
+			}
+		})
+
+		It("prints that the outputs are reused", func() {
+			Expect(out.Contents()).To(ContainSubstring("\x1b[1mcache hit:\x1b[0m reusing the outputs of a previous run\n"))
+		})
+	})
+
+	Context("when a Skipped event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.Skipped{
+				Time:      time.Now().Unix(),
+				Condition: "((.:deploy))",
+			}
+		})
+
+		It("prints the condition", func() {
+			Expect(out.Contents()).To(ContainSubstring("\x1b[1mskipped:\x1b[0m condition ((.:deploy)) was false\n"))
+		})
+	})
+
 	Context("when a SelectedWorker event is received", func() {
 		BeforeEach(func() {
 			receivedEvents <- event.SelectedWorker{
@@ -0,0 +1,84 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("JobStats", func() {
+	Context("when the job exists", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/mypipeline/jobs/myjob/stats"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.JobStats{
+						Since:              100,
+						Until:              200,
+						Builds:             4,
+						Succeeded:          2,
+						Failed:             1,
+						Aborted:            1,
+						SuccessRate:        2.0 / 3.0,
+						MeanTimeToRecovery: 3600,
+						MedianDuration:     90.5,
+						MedianQueueTime:    2,
+						FlakyRetries:       1,
+						Steps: []atc.StepStats{
+							{Name: "unit", Type: "task", Runs: 3, P50: 60, P90: 120, P95: 150},
+						},
+					}),
+				),
+			)
+		})
+
+		It("prints the stats of the job and its steps", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "job-stats", "-j", "mypipeline/myjob")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(gbytes.Say(`builds\s+4`))
+			Expect(sess.Out).To(gbytes.Say(`succeeded\s+2`))
+			Expect(sess.Out).To(gbytes.Say(`failed\s+1`))
+			Expect(sess.Out).To(gbytes.Say(`success rate\s+66.7%`))
+			Expect(sess.Out).To(gbytes.Say(`mean time to recovery\s+1h0m0s`))
+			Expect(sess.Out).To(gbytes.Say(`median duration\s+1m30s`))
+			Expect(sess.Out).To(gbytes.Say(`median queue time\s+2s`))
+			Expect(sess.Out).To(gbytes.Say(`flaky retries\s+1`))
+			Expect(sess.Out).To(gbytes.Say(`unit\s+task\s+3\s+1m0s\s+2m0s\s+2m30s`))
+		})
+	})
+
+	Context("when the job does not exist", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/mypipeline/jobs/myjob/stats"),
+					ghttp.RespondWith(http.StatusNotFound, ""),
+				),
+			)
+		})
+
+		It("errors", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "job-stats", "-j", "mypipeline/myjob")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+
+			Expect(sess.Err).To(gbytes.Say("job not found"))
+		})
+	})
+})
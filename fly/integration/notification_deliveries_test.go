@@ -0,0 +1,100 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("NotificationDeliveries", func() {
+	Context("when the pipeline exists", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/mypipeline/notifications", "limit=50"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.NotificationDelivery{
+						{
+							ID:           2,
+							Notification: "slack",
+							BuildID:      12,
+							BuildName:    "3",
+							JobName:      "myjob",
+							Status:       atc.StatusFailed,
+							State:        atc.NotificationDeliveryPending,
+							Attempts:     2,
+							ResponseCode: 502,
+							Error:        "unexpected response: 502 Bad Gateway",
+							CreatedAt:    100,
+						},
+						{
+							ID:           1,
+							Notification: "slack",
+							BuildID:      12,
+							BuildName:    "3",
+							JobName:      "myjob",
+							Status:       atc.StatusStarted,
+							State:        atc.NotificationDeliveryDelivered,
+							Attempts:     1,
+							ResponseCode: 200,
+							CreatedAt:    90,
+							DeliveredAt:  91,
+						},
+					}),
+				),
+			)
+		})
+
+		It("prints the deliveries", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "notification-deliveries", "-p", "mypipeline")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(gbytes.Say(`2\s+slack\s+myjob/3\s+failed\s+pending\s+2\s+502\s+.*unexpected response: 502 Bad Gateway`))
+			Expect(sess.Out).To(gbytes.Say(`1\s+slack\s+myjob/3\s+started\s+delivered\s+1\s+200\s+.*n/a`))
+		})
+
+		It("prints the deliveries as JSON", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "notification-deliveries", "-p", "mypipeline", "--json")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(gbytes.Say(`"notification": "slack"`))
+		})
+	})
+
+	Context("when the pipeline does not exist", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/mypipeline/notifications"),
+					ghttp.RespondWith(http.StatusNotFound, ""),
+				),
+			)
+		})
+
+		It("errors", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "notification-deliveries", "-p", "mypipeline")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+
+			Expect(sess.Err).To(gbytes.Say("pipeline not found"))
+		})
+	})
+})
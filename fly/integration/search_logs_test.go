@@ -0,0 +1,90 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("SearchLogs", func() {
+	var matches = []atc.BuildLogMatch{
+		{
+			Build: atc.Build{
+				ID:           23,
+				Name:         "42",
+				Status:       "failed",
+				PipelineName: "mypipeline",
+				JobName:      "myjob",
+				TeamName:     "main",
+			},
+			Lines: []atc.BuildLogLine{
+				{EventID: 3, Line: "dial tcp: connection refused"},
+				{EventID: 5, Line: "connection refused again"},
+			},
+		},
+	}
+
+	Context("when searching the builds of the team", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/build_logs", "limit=50&query=connection+refused&status=failed"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, matches),
+				),
+			)
+		})
+
+		It("prints the matching lines of each build", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "search-logs", "--status", "failed", "connection refused")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(gbytes.Say(`23\s+mypipeline/myjob/42\s+failed\s+.*dial tcp: connection refused`))
+			Expect(sess.Out).To(gbytes.Say(`23\s+mypipeline/myjob/42\s+failed\s+.*connection refused again`))
+		})
+	})
+
+	Context("when searching the builds of a job", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/mypipeline/jobs/myjob/build_logs", "limit=50&query=oops"),
+					ghttp.RespondWith(http.StatusNotFound, ""),
+				),
+			)
+		})
+
+		It("errors when the job does not exist", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "search-logs", "-j", "mypipeline/myjob", "oops")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+
+			Expect(sess.Err).To(gbytes.Say("job not found"))
+		})
+	})
+
+	It("errors when both a pipeline and a job are given", func() {
+		flyCmd := exec.Command(flyPath, "-t", targetName, "search-logs", "-p", "mypipeline", "-j", "mypipeline/myjob", "oops")
+
+		sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+		Expect(err).NotTo(HaveOccurred())
+
+		Eventually(sess).Should(gexec.Exit(1))
+
+		Expect(sess.Err).To(gbytes.Say("Cannot specify both --pipeline and --job"))
+	})
+})
@@ -0,0 +1,174 @@
+package integration_test
+
+import (
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("Secrets", func() {
+	Describe("secrets", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/secrets"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.Secret{
+						{Name: "some-secret", TeamName: "main", UpdatedAt: 100},
+						{Name: "other-secret", TeamName: "main", PipelineName: "mypipeline", UpdatedAt: 100},
+					}),
+				),
+			)
+		})
+
+		It("prints the secrets", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "secrets")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(gbytes.Say(`some-secret\s+n/a\s+`))
+			Expect(sess.Out).To(gbytes.Say(`other-secret\s+mypipeline\s+`))
+		})
+
+		It("prints the secrets as JSON", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "secrets", "--json")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(gbytes.Say(`"pipeline_name": "mypipeline"`))
+		})
+	})
+
+	Describe("set-secret", func() {
+		Context("when a value is given", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/secrets/some-secret"),
+						ghttp.VerifyJSON(`{"pipeline_name":"mypipeline","value":"hunter2"}`),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("sets the secret", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "set-secret", "-n", "some-secret", "-p", "mypipeline", "-v", "hunter2")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(gbytes.Say("secret `main/mypipeline/some-secret` set"))
+			})
+		})
+
+		Context("when a YAML file is given", func() {
+			var valuePath string
+
+			BeforeEach(func() {
+				tmpdir, err := os.MkdirTemp("", "fly-secret")
+				Expect(err).NotTo(HaveOccurred())
+
+				DeferCleanup(os.RemoveAll, tmpdir)
+
+				valuePath = filepath.Join(tmpdir, "value.yml")
+				err = os.WriteFile(valuePath, []byte("username: admin\npassword: hunter2\n"), 0644)
+				Expect(err).NotTo(HaveOccurred())
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/secrets/some-secret"),
+						ghttp.VerifyJSON(`{"value":{"username":"admin","password":"hunter2"}}`),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("sets the team's secret to the parsed value", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "set-secret", "-n", "some-secret", "-f", valuePath, "--yaml")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(gbytes.Say("secret `main/some-secret` set"))
+			})
+		})
+
+		Context("when no value is given", func() {
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "set-secret", "-n", "some-secret")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("either --value or --file must be given"))
+			})
+		})
+	})
+
+	Describe("delete-secret", func() {
+		Context("when the secret exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/secrets/some-secret", "pipeline_name=mypipeline"),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("deletes the secret", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "delete-secret", "-n", "some-secret", "-p", "mypipeline", "--non-interactive")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(gbytes.Say("`main/mypipeline/some-secret` deleted"))
+			})
+		})
+
+		Context("when the secret does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/secrets/some-secret"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("says so", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "delete-secret", "-n", "some-secret", "--non-interactive")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(gbytes.Say("`main/some-secret` does not exist"))
+			})
+		})
+	})
+})
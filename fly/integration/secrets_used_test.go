@@ -0,0 +1,110 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("SecretsUsed", func() {
+	accesses := []atc.SecretAccess{
+		{Path: "/concourse/main/some-var", CredentialManager: "vault", Found: true, Cached: true},
+		{Source: "some-source", Path: "other-var", CredentialManager: "dummy"},
+		{Path: "/concourse/main/broken-var", CredentialManager: "vault", Error: "permission denied"},
+	}
+
+	Context("when a build id is given", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23/secret_accesses"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, accesses),
+				),
+			)
+		})
+
+		It("prints the secrets looked up by the build", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "secrets-used", "-b", "23")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(gbytes.Say(`n/a\s+/concourse/main/some-var\s+vault\s+yes\s+yes\s+n/a`))
+			Expect(sess.Out).To(gbytes.Say(`some-source\s+other-var\s+dummy\s+no\s+no\s+n/a`))
+			Expect(sess.Out).To(gbytes.Say(`n/a\s+/concourse/main/broken-var\s+vault\s+no\s+no\s+permission denied`))
+		})
+
+		It("prints them as JSON with --json", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "secrets-used", "-b", "23", "--json")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			var printed []atc.SecretAccess
+			err = json.Unmarshal(sess.Out.Contents(), &printed)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(printed).To(Equal(accesses))
+		})
+	})
+
+	Context("when a job and build number are given", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/mypipeline/jobs/myjob/builds/42"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Build{ID: 23, Name: "42"}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23/secret_accesses"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, accesses),
+				),
+			)
+		})
+
+		It("prints the secrets looked up by the job's build", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "secrets-used", "-j", "mypipeline/myjob", "-b", "42")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(gbytes.Say(`/concourse/main/some-var`))
+		})
+	})
+
+	Context("when the build does not exist", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23/secret_accesses"),
+					ghttp.RespondWith(http.StatusNotFound, ""),
+				),
+			)
+		})
+
+		It("errors", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "secrets-used", "-b", "23")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+
+			Expect(sess.Err).To(gbytes.Say("build does not exist"))
+		})
+	})
+})
@@ -404,6 +404,155 @@ var _ = Describe("Fly CLI", func() {
 			})
 		})
 
+		Describe("var sources", func() {
+			BeforeEach(func() {
+				cmdParams = []string{"-c", "fixtures/team_config_with_var_sources.yml"}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+						ghttp.VerifyJSON(`{
+							"auth": {
+								"owner":{
+									"users": ["local:some-owner"],
+									"groups": []
+								}
+							},
+							"var_sources": [
+								{
+									"name": "some-vault",
+									"type": "vault",
+									"config": {
+										"url": "https://vault.example.com",
+										"auth_backend": "approle",
+										"auth_params": {
+											"role_id": "((role-id))",
+											"secret_id": "((secret-id))"
+										}
+									}
+								}
+							]
+						}`),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Team{
+							Name: "venture",
+							ID:   8,
+						}),
+					),
+				)
+			})
+
+			It("shows the var sources and sends them with the team", func() {
+				stdin, err := flyCmd.StdinPipe()
+				Expect(err).NotTo(HaveOccurred())
+
+				sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+				Expect(err).ToNot(HaveOccurred())
+
+				Eventually(sess.Out).Should(gbytes.Say("var sources:"))
+				Eventually(sess.Out).Should(gbytes.Say(`- some-vault \(vault\)`))
+
+				Eventually(sess).Should(gbytes.Say(`apply team configuration\? \[yN\]: `))
+				yes(stdin)
+
+				Eventually(sess.Out).Should(gbytes.Say("team updated"))
+
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+		})
+
+		Describe("removing var sources", func() {
+			BeforeEach(func() {
+				cmdParams = []string{"-c", "fixtures/team_config_without_var_sources.yml"}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+						ghttp.VerifyJSON(`{
+							"auth": {
+								"owner":{
+									"users": ["local:some-owner"],
+									"groups": []
+								}
+							},
+							"var_sources": []
+						}`),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Team{
+							Name: "venture",
+							ID:   8,
+						}),
+					),
+				)
+			})
+
+			It("warns that the existing var sources will be removed", func() {
+				stdin, err := flyCmd.StdinPipe()
+				Expect(err).NotTo(HaveOccurred())
+
+				sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+				Expect(err).ToNot(HaveOccurred())
+
+				Eventually(sess.Out).Should(gbytes.Say("var sources:"))
+				Eventually(sess.Out).Should(gbytes.Say(`none \(existing var sources will be removed\)`))
+
+				Eventually(sess).Should(gbytes.Say(`apply team configuration\? \[yN\]: `))
+				yes(stdin)
+
+				Eventually(sess.Out).Should(gbytes.Say("team updated"))
+
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+		})
+
+		Describe("notifications", func() {
+			BeforeEach(func() {
+				cmdParams = []string{"-c", "fixtures/team_config_with_notifications.yml"}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+						ghttp.VerifyJSON(`{
+							"auth": {
+								"owner":{
+									"users": ["local:some-owner"],
+									"groups": []
+								}
+							},
+							"notifications": [
+								{
+									"name": "failures",
+									"url": "https://example.com/hook",
+									"secret": "((hook-secret))",
+									"statuses": ["failed", "errored"]
+								}
+							]
+						}`),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Team{
+							Name: "venture",
+							ID:   8,
+						}),
+					),
+				)
+			})
+
+			It("shows the notifications and sends them with the team", func() {
+				stdin, err := flyCmd.StdinPipe()
+				Expect(err).NotTo(HaveOccurred())
+
+				sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+				Expect(err).ToNot(HaveOccurred())
+
+				Eventually(sess.Out).Should(gbytes.Say("notifications:"))
+				Eventually(sess.Out).Should(gbytes.Say(`- failures`))
+
+				Eventually(sess).Should(gbytes.Say(`apply team configuration\? \[yN\]: `))
+				yes(stdin)
+
+				Eventually(sess.Out).Should(gbytes.Say("team updated"))
+
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+		})
+
 		Describe("handling server response", func() {
 			BeforeEach(func() {
 				cmdParams = []string{"-c", "fixtures/team_config_mixed.yml"}
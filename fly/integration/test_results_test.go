@@ -0,0 +1,118 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("TestResults", func() {
+	Context("when a build id is given", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Build{ID: 23, Name: "42"}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23/test_results"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.TestResult{
+						{Step: "unit", Suite: "pkg", Name: "TestA", Status: atc.TestStatusPassed, Duration: 0.5},
+						{Step: "unit", Suite: "pkg", Name: "TestB", Status: atc.TestStatusFailed, Message: "boom"},
+					}),
+				),
+			)
+		})
+
+		It("prints the test results of the build", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "test-results", "-b", "23")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(gbytes.Say(`unit\s+pkg\s+TestA\s+passed\s+0.500s`))
+			Expect(sess.Out).To(gbytes.Say(`unit\s+pkg\s+TestB\s+failed\s+0.000s`))
+		})
+
+		It("only prints the failed tests with --failed", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "test-results", "-b", "23", "--failed")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).NotTo(gbytes.Say(`TestA`))
+			Expect(string(sess.Out.Contents())).To(ContainSubstring("TestB"))
+		})
+	})
+
+	Context("when only a job is given", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/mypipeline/jobs/myjob/test_results", "limit=20"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.TestSummary{
+						{Suite: "pkg", Name: "TestB", Runs: 3, Passed: 2, Failed: 1, LastFailedBuild: "12"},
+						{Suite: "pkg", Name: "TestA", Runs: 3, Passed: 3},
+					}),
+				),
+			)
+		})
+
+		It("summarizes the tests of the job's recent builds", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "test-results", "-j", "mypipeline/myjob")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(gbytes.Say(`pkg\s+TestB\s+3\s+2\s+1\s+0\s+yes\s+12`))
+			Expect(sess.Out).To(gbytes.Say(`pkg\s+TestA\s+3\s+3\s+0\s+0\s+no\s+n/a`))
+		})
+	})
+
+	Context("when the job does not exist", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/mypipeline/jobs/myjob/test_results"),
+					ghttp.RespondWith(http.StatusNotFound, ""),
+				),
+			)
+		})
+
+		It("errors", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "test-results", "-j", "mypipeline/myjob")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+
+			Expect(sess.Err).To(gbytes.Say("job not found"))
+		})
+	})
+
+	It("errors when neither a build nor a job is given", func() {
+		flyCmd := exec.Command(flyPath, "-t", targetName, "test-results")
+
+		sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+		Expect(err).NotTo(HaveOccurred())
+
+		Eventually(sess).Should(gexec.Exit(1))
+
+		Expect(sess.Err).To(gbytes.Say("either --build or --job must be specified"))
+	})
+})
@@ -0,0 +1,86 @@
+package concourse
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+// BuildLogSearch describes a search of the log output of a team's builds.
+// The search covers the whole team unless a pipeline, and optionally a job
+// within it, is given.
+type BuildLogSearch struct {
+	Query string
+
+	PipelineRef atc.PipelineRef
+	JobName     string
+
+	Statuses []atc.BuildStatus
+	Since    time.Time
+	Until    time.Time
+	Limit    int
+}
+
+func (search BuildLogSearch) QueryParams() url.Values {
+	queryParams := url.Values{}
+	queryParams.Add(atc.BuildLogSearchQuery, search.Query)
+
+	for _, status := range search.Statuses {
+		queryParams.Add(atc.BuildLogSearchStatus, string(status))
+	}
+
+	if !search.Since.IsZero() {
+		queryParams.Add(atc.BuildLogSearchSince, strconv.FormatInt(search.Since.Unix(), 10))
+	}
+
+	if !search.Until.IsZero() {
+		queryParams.Add(atc.BuildLogSearchUntil, strconv.FormatInt(search.Until.Unix(), 10))
+	}
+
+	if search.Limit > 0 {
+		queryParams.Add(atc.PaginationQueryLimit, strconv.Itoa(search.Limit))
+	}
+
+	return queryParams
+}
+
+func (team *team) SearchBuildLogs(search BuildLogSearch) ([]atc.BuildLogMatch, bool, error) {
+	params := rata.Params{
+		"team_name": team.Name(),
+	}
+
+	requestName := atc.SearchTeamBuildLogs
+	query := search.QueryParams()
+
+	if search.PipelineRef.Name != "" {
+		params["pipeline_name"] = search.PipelineRef.Name
+		query = merge(query, search.PipelineRef.QueryParams())
+		requestName = atc.SearchPipelineBuildLogs
+
+		if search.JobName != "" {
+			params["job_name"] = search.JobName
+			requestName = atc.SearchJobBuildLogs
+		}
+	}
+
+	var matches []atc.BuildLogMatch
+	err := team.connection.Send(internal.Request{
+		RequestName: requestName,
+		Params:      params,
+		Query:       query,
+	}, &internal.Response{
+		Result: &matches,
+	})
+	switch err.(type) {
+	case nil:
+		return matches, true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
@@ -0,0 +1,116 @@
+package concourse_test
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Build Logs", func() {
+	Describe("team.SearchBuildLogs", func() {
+		var (
+			search          concourse.BuildLogSearch
+			expectedURL     string
+			expectedQuery   string
+			expectedMatches []atc.BuildLogMatch
+		)
+
+		BeforeEach(func() {
+			search = concourse.BuildLogSearch{Query: "connection refused"}
+			expectedURL = "/api/v1/teams/some-team/build_logs"
+			expectedQuery = "query=connection+refused"
+
+			expectedMatches = []atc.BuildLogMatch{
+				{
+					Build: atc.Build{ID: 42, Name: "7", JobName: "some-job"},
+					Lines: []atc.BuildLogLine{{EventID: 3, Line: "dial tcp: connection refused"}},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", expectedURL, expectedQuery),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedMatches),
+				),
+			)
+		})
+
+		It("searches the builds of the team", func() {
+			matches, found, err := team.SearchBuildLogs(search)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(matches).To(Equal(expectedMatches))
+		})
+
+		Context("when filters are given", func() {
+			BeforeEach(func() {
+				search.Statuses = []atc.BuildStatus{atc.StatusFailed, atc.StatusErrored}
+				search.Since = time.Unix(100, 0)
+				search.Until = time.Unix(200, 0)
+				search.Limit = 5
+
+				expectedQuery = "limit=5&query=connection+refused&since=100&status=failed&status=errored&until=200"
+			})
+
+			It("passes them as query params", func() {
+				_, _, err := team.SearchBuildLogs(search)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when a pipeline is given", func() {
+			BeforeEach(func() {
+				search.PipelineRef = atc.PipelineRef{Name: "mypipeline", InstanceVars: atc.InstanceVars{"branch": "master"}}
+				expectedURL = "/api/v1/teams/some-team/pipelines/mypipeline/build_logs"
+				expectedQuery = "query=connection+refused&vars.branch=%22master%22"
+			})
+
+			It("searches the builds of the pipeline", func() {
+				_, found, err := team.SearchBuildLogs(search)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+
+			Context("and a job is given", func() {
+				BeforeEach(func() {
+					search.JobName = "myjob"
+					expectedURL = "/api/v1/teams/some-team/pipelines/mypipeline/jobs/myjob/build_logs"
+				})
+
+				It("searches the builds of the job", func() {
+					_, found, err := team.SearchBuildLogs(search)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(found).To(BeTrue())
+				})
+			})
+		})
+	})
+
+	Describe("team.SearchBuildLogs when the pipeline does not exist", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/pipelines/mypipeline/build_logs"),
+					ghttp.RespondWith(http.StatusNotFound, ""),
+				),
+			)
+		})
+
+		It("returns false", func() {
+			_, found, err := team.SearchBuildLogs(concourse.BuildLogSearch{
+				Query:       "oops",
+				PipelineRef: atc.PipelineRef{Name: "mypipeline"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+})
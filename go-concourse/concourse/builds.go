@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
@@ -35,18 +36,28 @@ func (team *team) CreateBuild(plan atc.Plan) (atc.Build, error) {
 	return build, err
 }
 
-func (team *team) CreateJobBuild(pipelineRef atc.PipelineRef, jobName string) (atc.Build, error) {
+func (team *team) CreateJobBuild(pipelineRef atc.PipelineRef, jobName string, body atc.CreateJobBuildBody) (atc.Build, error) {
 	params := rata.Params{
 		"job_name":      jobName,
 		"pipeline_name": pipelineRef.Name,
 		"team_name":     team.Name(),
 	}
 
+	buffer := &bytes.Buffer{}
+	err := json.NewEncoder(buffer).Encode(body)
+	if err != nil {
+		return atc.Build{}, fmt.Errorf("Unable to marshal build body: %s", err)
+	}
+
 	var build atc.Build
-	err := team.connection.Send(internal.Request{
+	err = team.connection.Send(internal.Request{
 		RequestName: atc.CreateJobBuild,
 		Params:      params,
 		Query:       pipelineRef.QueryParams(),
+		Body:        buffer,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
 	}, &internal.Response{
 		Result: &build,
 	})
@@ -74,6 +85,31 @@ func (team *team) RerunJobBuild(pipelineRef atc.PipelineRef, jobName string, bui
 	return build, err
 }
 
+// ResumeJobBuild reruns a failed build from the step that failed, reusing the
+// outputs of the steps that succeeded.
+func (team *team) ResumeJobBuild(pipelineRef atc.PipelineRef, jobName string, buildName string) (atc.Build, error) {
+	params := rata.Params{
+		"build_name":    buildName,
+		"job_name":      jobName,
+		"pipeline_name": pipelineRef.Name,
+		"team_name":     team.Name(),
+	}
+
+	queryParams := url.Values{}
+	queryParams.Set("from_failed", "true")
+
+	var build atc.Build
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.RerunJobBuild,
+		Params:      params,
+		Query:       merge(queryParams, pipelineRef.QueryParams()),
+	}, &internal.Response{
+		Result: &build,
+	})
+
+	return build, err
+}
+
 func (team *team) SetJobBuildComment(pipelineRef atc.PipelineRef, jobName string, buildName string, comment string) (bool, error) {
 	params := rata.Params{
 		"build_name":    buildName,
@@ -195,6 +231,33 @@ func (client *client) AbortBuild(buildID string) error {
 	}, nil)
 }
 
+func (client *client) ApproveBuild(buildID string, step string, planID string, approved bool) error {
+	params := rata.Params{
+		"build_id": buildID,
+	}
+
+	query := url.Values{}
+	if step != "" {
+		query.Set("step", step)
+	}
+
+	if planID != "" {
+		query.Set("plan_id", planID)
+	}
+
+	if approved {
+		query.Set("decision", "approved")
+	} else {
+		query.Set("decision", "rejected")
+	}
+
+	return client.connection.Send(internal.Request{
+		RequestName: atc.ApproveBuild,
+		Params:      params,
+		Query:       query,
+	}, nil)
+}
+
 func (team *team) Builds(page Page) ([]atc.Build, Pagination, error) {
 	var builds []atc.Build
 
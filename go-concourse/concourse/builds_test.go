@@ -68,6 +68,7 @@ var _ = Describe("ATC Handler Builds", func() {
 			queryParams   string
 			jobName       string
 			expectedBuild atc.Build
+			priority      = 10
 		)
 		BeforeEach(func() {
 			queryParams = "vars.branch=%22master%22"
@@ -86,13 +87,20 @@ var _ = Describe("ATC Handler Builds", func() {
 			atcServer.AppendHandlers(
 				ghttp.CombineHandlers(
 					ghttp.VerifyRequest("POST", expectedURL, queryParams),
+					ghttp.VerifyJSONRepresenting(atc.CreateJobBuildBody{
+						Params:   map[string]interface{}{"target": "prod"},
+						Priority: &priority,
+					}),
 					ghttp.RespondWithJSONEncoded(http.StatusCreated, expectedBuild),
 				),
 			)
 		})
 
-		It("takes a pipeline and a job and creates the build", func() {
-			build, err := team.CreateJobBuild(pipelineRef, jobName)
+		It("takes a pipeline, a job and params and creates the build", func() {
+			build, err := team.CreateJobBuild(pipelineRef, jobName, atc.CreateJobBuildBody{
+				Params:   map[string]interface{}{"target": "prod"},
+				Priority: &priority,
+			})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(build).To(Equal(expectedBuild))
 		})
@@ -137,6 +145,39 @@ var _ = Describe("ATC Handler Builds", func() {
 		})
 	})
 
+	Describe("ResumeJobBuild", func() {
+		var (
+			pipelineRef   atc.PipelineRef
+			expectedBuild atc.Build
+		)
+
+		BeforeEach(func() {
+			pipelineRef = atc.PipelineRef{Name: "mypipeline", InstanceVars: atc.InstanceVars{"branch": "master"}}
+
+			expectedBuild = atc.Build{
+				ID:      123,
+				Name:    "mybuild.1",
+				Status:  "pending",
+				JobName: "myjob",
+				APIURL:  "api/v1/builds/123",
+			}
+			expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/jobs/myjob/builds/mybuild"
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", expectedURL, "from_failed=true&vars.branch=%22master%22"),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, expectedBuild),
+				),
+			)
+		})
+
+		It("resumes the build from the failed step", func() {
+			build, err := team.ResumeJobBuild(pipelineRef, "myjob", "mybuild")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build).To(Equal(expectedBuild))
+		})
+	})
+
 	Describe("JobBuild", func() {
 		var (
 			expectedBuild atc.Build
@@ -468,6 +509,34 @@ var _ = Describe("ATC Handler Builds", func() {
 		})
 	})
 
+	Describe("ApproveBuild", func() {
+		It("sends the decision to ATC", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/builds/123/approval", "decision=rejected&step=deploy"),
+					ghttp.RespondWith(http.StatusNoContent, ""),
+				),
+			)
+
+			err := client.ApproveBuild("123", "deploy", "", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		It("sends the plan id when one is given", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/builds/123/approval", "decision=approved&plan_id=abc&step=deploy"),
+					ghttp.RespondWith(http.StatusNoContent, ""),
+				),
+			)
+
+			err := client.ApproveBuild("123", "deploy", "abc", true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
 	Describe("team.Builds", func() {
 		expectedURL := "/api/v1/teams/some-team/builds"
 
@@ -20,7 +20,10 @@ type Client interface {
 	BuildEvents(buildID string) (Events, error)
 	BuildResources(buildID int) (atc.BuildInputsOutputs, bool, error)
 	ListBuildArtifacts(buildID string) ([]atc.WorkerArtifact, error)
+	BuildTestResults(buildID string) ([]atc.TestResult, bool, error)
+	BuildSecretAccesses(buildID string) ([]atc.SecretAccess, bool, error)
 	AbortBuild(buildID string) error
+	ApproveBuild(buildID string, step string, planID string, approved bool) error
 	BuildPlan(buildID int) (atc.PublicBuildPlan, bool, error)
 	SaveWorker(atc.Worker, *time.Duration) (*atc.Worker, error)
 	ListWorkers() ([]atc.Worker, error)
@@ -23,6 +23,20 @@ type FakeClient struct {
 	abortBuildReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ApproveBuildStub        func(string, string, string, bool) error
+	approveBuildMutex       sync.RWMutex
+	approveBuildArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 bool
+	}
+	approveBuildReturns struct {
+		result1 error
+	}
+	approveBuildReturnsOnCall map[int]struct {
+		result1 error
+	}
 	BuildStub        func(string) (atc.Build, bool, error)
 	buildMutex       sync.RWMutex
 	buildArgsForCall []struct {
@@ -81,6 +95,36 @@ type FakeClient struct {
 		result2 bool
 		result3 error
 	}
+	BuildSecretAccessesStub        func(string) ([]atc.SecretAccess, bool, error)
+	buildSecretAccessesMutex       sync.RWMutex
+	buildSecretAccessesArgsForCall []struct {
+		arg1 string
+	}
+	buildSecretAccessesReturns struct {
+		result1 []atc.SecretAccess
+		result2 bool
+		result3 error
+	}
+	buildSecretAccessesReturnsOnCall map[int]struct {
+		result1 []atc.SecretAccess
+		result2 bool
+		result3 error
+	}
+	BuildTestResultsStub        func(string) ([]atc.TestResult, bool, error)
+	buildTestResultsMutex       sync.RWMutex
+	buildTestResultsArgsForCall []struct {
+		arg1 string
+	}
+	buildTestResultsReturns struct {
+		result1 []atc.TestResult
+		result2 bool
+		result3 error
+	}
+	buildTestResultsReturnsOnCall map[int]struct {
+		result1 []atc.TestResult
+		result2 bool
+		result3 error
+	}
 	BuildsStub        func(concourse.Page) ([]atc.Build, concourse.Pagination, error)
 	buildsMutex       sync.RWMutex
 	buildsArgsForCall []struct {
@@ -355,6 +399,70 @@ func (fake *FakeClient) AbortBuildReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) ApproveBuild(arg1 string, arg2 string, arg3 string, arg4 bool) error {
+	fake.approveBuildMutex.Lock()
+	ret, specificReturn := fake.approveBuildReturnsOnCall[len(fake.approveBuildArgsForCall)]
+	fake.approveBuildArgsForCall = append(fake.approveBuildArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 bool
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ApproveBuildStub
+	fakeReturns := fake.approveBuildReturns
+	fake.recordInvocation("ApproveBuild", []interface{}{arg1, arg2, arg3, arg4})
+	fake.approveBuildMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) ApproveBuildCallCount() int {
+	fake.approveBuildMutex.RLock()
+	defer fake.approveBuildMutex.RUnlock()
+	return len(fake.approveBuildArgsForCall)
+}
+
+func (fake *FakeClient) ApproveBuildCalls(stub func(string, string, string, bool) error) {
+	fake.approveBuildMutex.Lock()
+	defer fake.approveBuildMutex.Unlock()
+	fake.ApproveBuildStub = stub
+}
+
+func (fake *FakeClient) ApproveBuildArgsForCall(i int) (string, string, string, bool) {
+	fake.approveBuildMutex.RLock()
+	defer fake.approveBuildMutex.RUnlock()
+	argsForCall := fake.approveBuildArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeClient) ApproveBuildReturns(result1 error) {
+	fake.approveBuildMutex.Lock()
+	defer fake.approveBuildMutex.Unlock()
+	fake.ApproveBuildStub = nil
+	fake.approveBuildReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) ApproveBuildReturnsOnCall(i int, result1 error) {
+	fake.approveBuildMutex.Lock()
+	defer fake.approveBuildMutex.Unlock()
+	fake.ApproveBuildStub = nil
+	if fake.approveBuildReturnsOnCall == nil {
+		fake.approveBuildReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.approveBuildReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeClient) Build(arg1 string) (atc.Build, bool, error) {
 	fake.buildMutex.Lock()
 	ret, specificReturn := fake.buildReturnsOnCall[len(fake.buildArgsForCall)]
@@ -620,6 +728,140 @@ func (fake *FakeClient) BuildResourcesReturnsOnCall(i int, result1 atc.BuildInpu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeClient) BuildSecretAccesses(arg1 string) ([]atc.SecretAccess, bool, error) {
+	fake.buildSecretAccessesMutex.Lock()
+	ret, specificReturn := fake.buildSecretAccessesReturnsOnCall[len(fake.buildSecretAccessesArgsForCall)]
+	fake.buildSecretAccessesArgsForCall = append(fake.buildSecretAccessesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.BuildSecretAccessesStub
+	fakeReturns := fake.buildSecretAccessesReturns
+	fake.recordInvocation("BuildSecretAccesses", []interface{}{arg1})
+	fake.buildSecretAccessesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) BuildSecretAccessesCallCount() int {
+	fake.buildSecretAccessesMutex.RLock()
+	defer fake.buildSecretAccessesMutex.RUnlock()
+	return len(fake.buildSecretAccessesArgsForCall)
+}
+
+func (fake *FakeClient) BuildSecretAccessesCalls(stub func(string) ([]atc.SecretAccess, bool, error)) {
+	fake.buildSecretAccessesMutex.Lock()
+	defer fake.buildSecretAccessesMutex.Unlock()
+	fake.BuildSecretAccessesStub = stub
+}
+
+func (fake *FakeClient) BuildSecretAccessesArgsForCall(i int) string {
+	fake.buildSecretAccessesMutex.RLock()
+	defer fake.buildSecretAccessesMutex.RUnlock()
+	argsForCall := fake.buildSecretAccessesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) BuildSecretAccessesReturns(result1 []atc.SecretAccess, result2 bool, result3 error) {
+	fake.buildSecretAccessesMutex.Lock()
+	defer fake.buildSecretAccessesMutex.Unlock()
+	fake.BuildSecretAccessesStub = nil
+	fake.buildSecretAccessesReturns = struct {
+		result1 []atc.SecretAccess
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) BuildSecretAccessesReturnsOnCall(i int, result1 []atc.SecretAccess, result2 bool, result3 error) {
+	fake.buildSecretAccessesMutex.Lock()
+	defer fake.buildSecretAccessesMutex.Unlock()
+	fake.BuildSecretAccessesStub = nil
+	if fake.buildSecretAccessesReturnsOnCall == nil {
+		fake.buildSecretAccessesReturnsOnCall = make(map[int]struct {
+			result1 []atc.SecretAccess
+			result2 bool
+			result3 error
+		})
+	}
+	fake.buildSecretAccessesReturnsOnCall[i] = struct {
+		result1 []atc.SecretAccess
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) BuildTestResults(arg1 string) ([]atc.TestResult, bool, error) {
+	fake.buildTestResultsMutex.Lock()
+	ret, specificReturn := fake.buildTestResultsReturnsOnCall[len(fake.buildTestResultsArgsForCall)]
+	fake.buildTestResultsArgsForCall = append(fake.buildTestResultsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.BuildTestResultsStub
+	fakeReturns := fake.buildTestResultsReturns
+	fake.recordInvocation("BuildTestResults", []interface{}{arg1})
+	fake.buildTestResultsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) BuildTestResultsCallCount() int {
+	fake.buildTestResultsMutex.RLock()
+	defer fake.buildTestResultsMutex.RUnlock()
+	return len(fake.buildTestResultsArgsForCall)
+}
+
+func (fake *FakeClient) BuildTestResultsCalls(stub func(string) ([]atc.TestResult, bool, error)) {
+	fake.buildTestResultsMutex.Lock()
+	defer fake.buildTestResultsMutex.Unlock()
+	fake.BuildTestResultsStub = stub
+}
+
+func (fake *FakeClient) BuildTestResultsArgsForCall(i int) string {
+	fake.buildTestResultsMutex.RLock()
+	defer fake.buildTestResultsMutex.RUnlock()
+	argsForCall := fake.buildTestResultsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) BuildTestResultsReturns(result1 []atc.TestResult, result2 bool, result3 error) {
+	fake.buildTestResultsMutex.Lock()
+	defer fake.buildTestResultsMutex.Unlock()
+	fake.BuildTestResultsStub = nil
+	fake.buildTestResultsReturns = struct {
+		result1 []atc.TestResult
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) BuildTestResultsReturnsOnCall(i int, result1 []atc.TestResult, result2 bool, result3 error) {
+	fake.buildTestResultsMutex.Lock()
+	defer fake.buildTestResultsMutex.Unlock()
+	fake.BuildTestResultsStub = nil
+	if fake.buildTestResultsReturnsOnCall == nil {
+		fake.buildTestResultsReturnsOnCall = make(map[int]struct {
+			result1 []atc.TestResult
+			result2 bool
+			result3 error
+		})
+	}
+	fake.buildTestResultsReturnsOnCall[i] = struct {
+		result1 []atc.TestResult
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeClient) Builds(arg1 concourse.Page) ([]atc.Build, concourse.Pagination, error) {
 	fake.buildsMutex.Lock()
 	ret, specificReturn := fake.buildsReturnsOnCall[len(fake.buildsArgsForCall)]
@@ -1642,6 +1884,8 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.abortBuildMutex.RLock()
 	defer fake.abortBuildMutex.RUnlock()
+	fake.approveBuildMutex.RLock()
+	defer fake.approveBuildMutex.RUnlock()
 	fake.buildMutex.RLock()
 	defer fake.buildMutex.RUnlock()
 	fake.buildEventsMutex.RLock()
@@ -1650,6 +1894,10 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.buildPlanMutex.RUnlock()
 	fake.buildResourcesMutex.RLock()
 	defer fake.buildResourcesMutex.RUnlock()
+	fake.buildSecretAccessesMutex.RLock()
+	defer fake.buildSecretAccessesMutex.RUnlock()
+	fake.buildTestResultsMutex.RLock()
+	defer fake.buildTestResultsMutex.RUnlock()
 	fake.buildsMutex.RLock()
 	defer fake.buildsMutex.RUnlock()
 	fake.findTeamMutex.RLock()
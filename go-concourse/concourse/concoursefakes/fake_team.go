@@ -4,6 +4,7 @@ package concoursefakes
 import (
 	"io"
 	"sync"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse"
@@ -249,11 +250,12 @@ type FakeTeam struct {
 		result1 atc.Build
 		result2 error
 	}
-	CreateJobBuildStub        func(atc.PipelineRef, string) (atc.Build, error)
+	CreateJobBuildStub        func(atc.PipelineRef, string, atc.CreateJobBuildBody) (atc.Build, error)
 	createJobBuildMutex       sync.RWMutex
 	createJobBuildArgsForCall []struct {
 		arg1 atc.PipelineRef
 		arg2 string
+		arg3 atc.CreateJobBuildBody
 	}
 	createJobBuildReturns struct {
 		result1 atc.Build
@@ -329,6 +331,20 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	DeleteSecretStub        func(string, string) (bool, error)
+	deleteSecretMutex       sync.RWMutex
+	deleteSecretArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	deleteSecretReturns struct {
+		result1 bool
+		result2 error
+	}
+	deleteSecretReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	DestroyTeamStub        func(string) error
 	destroyTeamMutex       sync.RWMutex
 	destroyTeamArgsForCall []struct {
@@ -484,6 +500,41 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
+	JobStatsStub        func(atc.PipelineRef, string, time.Time, time.Time) (atc.JobStats, bool, error)
+	jobStatsMutex       sync.RWMutex
+	jobStatsArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 time.Time
+		arg4 time.Time
+	}
+	jobStatsReturns struct {
+		result1 atc.JobStats
+		result2 bool
+		result3 error
+	}
+	jobStatsReturnsOnCall map[int]struct {
+		result1 atc.JobStats
+		result2 bool
+		result3 error
+	}
+	JobTestSummariesStub        func(atc.PipelineRef, string, int) ([]atc.TestSummary, bool, error)
+	jobTestSummariesMutex       sync.RWMutex
+	jobTestSummariesArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 int
+	}
+	jobTestSummariesReturns struct {
+		result1 []atc.TestSummary
+		result2 bool
+		result3 error
+	}
+	jobTestSummariesReturnsOnCall map[int]struct {
+		result1 []atc.TestSummary
+		result2 bool
+		result3 error
+	}
 	ListContainersStub        func(map[string]string) ([]atc.Container, error)
 	listContainersMutex       sync.RWMutex
 	listContainersArgsForCall []struct {
@@ -535,6 +586,18 @@ type FakeTeam struct {
 		result1 []atc.Resource
 		result2 error
 	}
+	ListSecretsStub        func() ([]atc.Secret, error)
+	listSecretsMutex       sync.RWMutex
+	listSecretsArgsForCall []struct {
+	}
+	listSecretsReturns struct {
+		result1 []atc.Secret
+		result2 error
+	}
+	listSecretsReturnsOnCall map[int]struct {
+		result1 []atc.Secret
+		result2 error
+	}
 	ListSharedForResourceStub        func(atc.PipelineRef, string) (atc.ResourcesAndTypes, bool, error)
 	listSharedForResourceMutex       sync.RWMutex
 	listSharedForResourceArgsForCall []struct {
@@ -589,6 +652,22 @@ type FakeTeam struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	NotificationDeliveriesStub        func(atc.PipelineRef, int) ([]atc.NotificationDelivery, bool, error)
+	notificationDeliveriesMutex       sync.RWMutex
+	notificationDeliveriesArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 int
+	}
+	notificationDeliveriesReturns struct {
+		result1 []atc.NotificationDelivery
+		result2 bool
+		result3 error
+	}
+	notificationDeliveriesReturnsOnCall map[int]struct {
+		result1 []atc.NotificationDelivery
+		result2 bool
+		result3 error
+	}
 	OrderingPipelinesStub        func([]string) error
 	orderingPipelinesMutex       sync.RWMutex
 	orderingPipelinesArgsForCall []struct {
@@ -802,6 +881,21 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
+	ResumeJobBuildStub        func(atc.PipelineRef, string, string) (atc.Build, error)
+	resumeJobBuildMutex       sync.RWMutex
+	resumeJobBuildArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 string
+	}
+	resumeJobBuildReturns struct {
+		result1 atc.Build
+		result2 error
+	}
+	resumeJobBuildReturnsOnCall map[int]struct {
+		result1 atc.Build
+		result2 error
+	}
 	ScheduleJobStub        func(atc.PipelineRef, string) (bool, error)
 	scheduleJobMutex       sync.RWMutex
 	scheduleJobArgsForCall []struct {
@@ -816,6 +910,21 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	SearchBuildLogsStub        func(concourse.BuildLogSearch) ([]atc.BuildLogMatch, bool, error)
+	searchBuildLogsMutex       sync.RWMutex
+	searchBuildLogsArgsForCall []struct {
+		arg1 concourse.BuildLogSearch
+	}
+	searchBuildLogsReturns struct {
+		result1 []atc.BuildLogMatch
+		result2 bool
+		result3 error
+	}
+	searchBuildLogsReturnsOnCall map[int]struct {
+		result1 []atc.BuildLogMatch
+		result2 bool
+		result3 error
+	}
 	SetJobBuildCommentStub        func(atc.PipelineRef, string, string, string) (bool, error)
 	setJobBuildCommentMutex       sync.RWMutex
 	setJobBuildCommentArgsForCall []struct {
@@ -847,6 +956,19 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	SetSecretStub        func(string, string, interface{}) error
+	setSecretMutex       sync.RWMutex
+	setSecretArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 interface{}
+	}
+	setSecretReturns struct {
+		result1 error
+	}
+	setSecretReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UnpauseJobStub        func(atc.PipelineRef, string) (bool, error)
 	unpauseJobMutex       sync.RWMutex
 	unpauseJobArgsForCall []struct {
@@ -1943,19 +2065,20 @@ func (fake *FakeTeam) CreateBuildReturnsOnCall(i int, result1 atc.Build, result2
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) CreateJobBuild(arg1 atc.PipelineRef, arg2 string) (atc.Build, error) {
+func (fake *FakeTeam) CreateJobBuild(arg1 atc.PipelineRef, arg2 string, arg3 atc.CreateJobBuildBody) (atc.Build, error) {
 	fake.createJobBuildMutex.Lock()
 	ret, specificReturn := fake.createJobBuildReturnsOnCall[len(fake.createJobBuildArgsForCall)]
 	fake.createJobBuildArgsForCall = append(fake.createJobBuildArgsForCall, struct {
 		arg1 atc.PipelineRef
 		arg2 string
-	}{arg1, arg2})
+		arg3 atc.CreateJobBuildBody
+	}{arg1, arg2, arg3})
 	stub := fake.CreateJobBuildStub
 	fakeReturns := fake.createJobBuildReturns
-	fake.recordInvocation("CreateJobBuild", []interface{}{arg1, arg2})
+	fake.recordInvocation("CreateJobBuild", []interface{}{arg1, arg2, arg3})
 	fake.createJobBuildMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -1969,17 +2092,17 @@ func (fake *FakeTeam) CreateJobBuildCallCount() int {
 	return len(fake.createJobBuildArgsForCall)
 }
 
-func (fake *FakeTeam) CreateJobBuildCalls(stub func(atc.PipelineRef, string) (atc.Build, error)) {
+func (fake *FakeTeam) CreateJobBuildCalls(stub func(atc.PipelineRef, string, atc.CreateJobBuildBody) (atc.Build, error)) {
 	fake.createJobBuildMutex.Lock()
 	defer fake.createJobBuildMutex.Unlock()
 	fake.CreateJobBuildStub = stub
 }
 
-func (fake *FakeTeam) CreateJobBuildArgsForCall(i int) (atc.PipelineRef, string) {
+func (fake *FakeTeam) CreateJobBuildArgsForCall(i int) (atc.PipelineRef, string, atc.CreateJobBuildBody) {
 	fake.createJobBuildMutex.RLock()
 	defer fake.createJobBuildMutex.RUnlock()
 	argsForCall := fake.createJobBuildArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeTeam) CreateJobBuildReturns(result1 atc.Build, result2 error) {
@@ -2288,6 +2411,71 @@ func (fake *FakeTeam) DeletePipelineReturnsOnCall(i int, result1 bool, result2 e
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) DeleteSecret(arg1 string, arg2 string) (bool, error) {
+	fake.deleteSecretMutex.Lock()
+	ret, specificReturn := fake.deleteSecretReturnsOnCall[len(fake.deleteSecretArgsForCall)]
+	fake.deleteSecretArgsForCall = append(fake.deleteSecretArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteSecretStub
+	fakeReturns := fake.deleteSecretReturns
+	fake.recordInvocation("DeleteSecret", []interface{}{arg1, arg2})
+	fake.deleteSecretMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) DeleteSecretCallCount() int {
+	fake.deleteSecretMutex.RLock()
+	defer fake.deleteSecretMutex.RUnlock()
+	return len(fake.deleteSecretArgsForCall)
+}
+
+func (fake *FakeTeam) DeleteSecretCalls(stub func(string, string) (bool, error)) {
+	fake.deleteSecretMutex.Lock()
+	defer fake.deleteSecretMutex.Unlock()
+	fake.DeleteSecretStub = stub
+}
+
+func (fake *FakeTeam) DeleteSecretArgsForCall(i int) (string, string) {
+	fake.deleteSecretMutex.RLock()
+	defer fake.deleteSecretMutex.RUnlock()
+	argsForCall := fake.deleteSecretArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) DeleteSecretReturns(result1 bool, result2 error) {
+	fake.deleteSecretMutex.Lock()
+	defer fake.deleteSecretMutex.Unlock()
+	fake.DeleteSecretStub = nil
+	fake.deleteSecretReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) DeleteSecretReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.deleteSecretMutex.Lock()
+	defer fake.deleteSecretMutex.Unlock()
+	fake.DeleteSecretStub = nil
+	if fake.deleteSecretReturnsOnCall == nil {
+		fake.deleteSecretReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.deleteSecretReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) DestroyTeam(arg1 string) error {
 	fake.destroyTeamMutex.Lock()
 	ret, specificReturn := fake.destroyTeamReturnsOnCall[len(fake.destroyTeamArgsForCall)]
@@ -2999,6 +3187,145 @@ func (fake *FakeTeam) JobBuildsReturnsOnCall(i int, result1 []atc.Build, result2
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) JobStats(arg1 atc.PipelineRef, arg2 string, arg3 time.Time, arg4 time.Time) (atc.JobStats, bool, error) {
+	fake.jobStatsMutex.Lock()
+	ret, specificReturn := fake.jobStatsReturnsOnCall[len(fake.jobStatsArgsForCall)]
+	fake.jobStatsArgsForCall = append(fake.jobStatsArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 time.Time
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.JobStatsStub
+	fakeReturns := fake.jobStatsReturns
+	fake.recordInvocation("JobStats", []interface{}{arg1, arg2, arg3, arg4})
+	fake.jobStatsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) JobStatsCallCount() int {
+	fake.jobStatsMutex.RLock()
+	defer fake.jobStatsMutex.RUnlock()
+	return len(fake.jobStatsArgsForCall)
+}
+
+func (fake *FakeTeam) JobStatsCalls(stub func(atc.PipelineRef, string, time.Time, time.Time) (atc.JobStats, bool, error)) {
+	fake.jobStatsMutex.Lock()
+	defer fake.jobStatsMutex.Unlock()
+	fake.JobStatsStub = stub
+}
+
+func (fake *FakeTeam) JobStatsArgsForCall(i int) (atc.PipelineRef, string, time.Time, time.Time) {
+	fake.jobStatsMutex.RLock()
+	defer fake.jobStatsMutex.RUnlock()
+	argsForCall := fake.jobStatsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTeam) JobStatsReturns(result1 atc.JobStats, result2 bool, result3 error) {
+	fake.jobStatsMutex.Lock()
+	defer fake.jobStatsMutex.Unlock()
+	fake.JobStatsStub = nil
+	fake.jobStatsReturns = struct {
+		result1 atc.JobStats
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) JobStatsReturnsOnCall(i int, result1 atc.JobStats, result2 bool, result3 error) {
+	fake.jobStatsMutex.Lock()
+	defer fake.jobStatsMutex.Unlock()
+	fake.JobStatsStub = nil
+	if fake.jobStatsReturnsOnCall == nil {
+		fake.jobStatsReturnsOnCall = make(map[int]struct {
+			result1 atc.JobStats
+			result2 bool
+			result3 error
+		})
+	}
+	fake.jobStatsReturnsOnCall[i] = struct {
+		result1 atc.JobStats
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) JobTestSummaries(arg1 atc.PipelineRef, arg2 string, arg3 int) ([]atc.TestSummary, bool, error) {
+	fake.jobTestSummariesMutex.Lock()
+	ret, specificReturn := fake.jobTestSummariesReturnsOnCall[len(fake.jobTestSummariesArgsForCall)]
+	fake.jobTestSummariesArgsForCall = append(fake.jobTestSummariesArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.JobTestSummariesStub
+	fakeReturns := fake.jobTestSummariesReturns
+	fake.recordInvocation("JobTestSummaries", []interface{}{arg1, arg2, arg3})
+	fake.jobTestSummariesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) JobTestSummariesCallCount() int {
+	fake.jobTestSummariesMutex.RLock()
+	defer fake.jobTestSummariesMutex.RUnlock()
+	return len(fake.jobTestSummariesArgsForCall)
+}
+
+func (fake *FakeTeam) JobTestSummariesCalls(stub func(atc.PipelineRef, string, int) ([]atc.TestSummary, bool, error)) {
+	fake.jobTestSummariesMutex.Lock()
+	defer fake.jobTestSummariesMutex.Unlock()
+	fake.JobTestSummariesStub = stub
+}
+
+func (fake *FakeTeam) JobTestSummariesArgsForCall(i int) (atc.PipelineRef, string, int) {
+	fake.jobTestSummariesMutex.RLock()
+	defer fake.jobTestSummariesMutex.RUnlock()
+	argsForCall := fake.jobTestSummariesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) JobTestSummariesReturns(result1 []atc.TestSummary, result2 bool, result3 error) {
+	fake.jobTestSummariesMutex.Lock()
+	defer fake.jobTestSummariesMutex.Unlock()
+	fake.JobTestSummariesStub = nil
+	fake.jobTestSummariesReturns = struct {
+		result1 []atc.TestSummary
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) JobTestSummariesReturnsOnCall(i int, result1 []atc.TestSummary, result2 bool, result3 error) {
+	fake.jobTestSummariesMutex.Lock()
+	defer fake.jobTestSummariesMutex.Unlock()
+	fake.JobTestSummariesStub = nil
+	if fake.jobTestSummariesReturnsOnCall == nil {
+		fake.jobTestSummariesReturnsOnCall = make(map[int]struct {
+			result1 []atc.TestSummary
+			result2 bool
+			result3 error
+		})
+	}
+	fake.jobTestSummariesReturnsOnCall[i] = struct {
+		result1 []atc.TestSummary
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) ListContainers(arg1 map[string]string) ([]atc.Container, error) {
 	fake.listContainersMutex.Lock()
 	ret, specificReturn := fake.listContainersReturnsOnCall[len(fake.listContainersArgsForCall)]
@@ -3247,6 +3574,62 @@ func (fake *FakeTeam) ListResourcesReturnsOnCall(i int, result1 []atc.Resource,
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) ListSecrets() ([]atc.Secret, error) {
+	fake.listSecretsMutex.Lock()
+	ret, specificReturn := fake.listSecretsReturnsOnCall[len(fake.listSecretsArgsForCall)]
+	fake.listSecretsArgsForCall = append(fake.listSecretsArgsForCall, struct {
+	}{})
+	stub := fake.ListSecretsStub
+	fakeReturns := fake.listSecretsReturns
+	fake.recordInvocation("ListSecrets", []interface{}{})
+	fake.listSecretsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ListSecretsCallCount() int {
+	fake.listSecretsMutex.RLock()
+	defer fake.listSecretsMutex.RUnlock()
+	return len(fake.listSecretsArgsForCall)
+}
+
+func (fake *FakeTeam) ListSecretsCalls(stub func() ([]atc.Secret, error)) {
+	fake.listSecretsMutex.Lock()
+	defer fake.listSecretsMutex.Unlock()
+	fake.ListSecretsStub = stub
+}
+
+func (fake *FakeTeam) ListSecretsReturns(result1 []atc.Secret, result2 error) {
+	fake.listSecretsMutex.Lock()
+	defer fake.listSecretsMutex.Unlock()
+	fake.ListSecretsStub = nil
+	fake.listSecretsReturns = struct {
+		result1 []atc.Secret
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ListSecretsReturnsOnCall(i int, result1 []atc.Secret, result2 error) {
+	fake.listSecretsMutex.Lock()
+	defer fake.listSecretsMutex.Unlock()
+	fake.ListSecretsStub = nil
+	if fake.listSecretsReturnsOnCall == nil {
+		fake.listSecretsReturnsOnCall = make(map[int]struct {
+			result1 []atc.Secret
+			result2 error
+		})
+	}
+	fake.listSecretsReturnsOnCall[i] = struct {
+		result1 []atc.Secret
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) ListSharedForResource(arg1 atc.PipelineRef, arg2 string) (atc.ResourcesAndTypes, bool, error) {
 	fake.listSharedForResourceMutex.Lock()
 	ret, specificReturn := fake.listSharedForResourceReturnsOnCall[len(fake.listSharedForResourceArgsForCall)]
@@ -3492,6 +3875,74 @@ func (fake *FakeTeam) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeTeam) NotificationDeliveries(arg1 atc.PipelineRef, arg2 int) ([]atc.NotificationDelivery, bool, error) {
+	fake.notificationDeliveriesMutex.Lock()
+	ret, specificReturn := fake.notificationDeliveriesReturnsOnCall[len(fake.notificationDeliveriesArgsForCall)]
+	fake.notificationDeliveriesArgsForCall = append(fake.notificationDeliveriesArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.NotificationDeliveriesStub
+	fakeReturns := fake.notificationDeliveriesReturns
+	fake.recordInvocation("NotificationDeliveries", []interface{}{arg1, arg2})
+	fake.notificationDeliveriesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) NotificationDeliveriesCallCount() int {
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
+	return len(fake.notificationDeliveriesArgsForCall)
+}
+
+func (fake *FakeTeam) NotificationDeliveriesCalls(stub func(atc.PipelineRef, int) ([]atc.NotificationDelivery, bool, error)) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = stub
+}
+
+func (fake *FakeTeam) NotificationDeliveriesArgsForCall(i int) (atc.PipelineRef, int) {
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
+	argsForCall := fake.notificationDeliveriesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) NotificationDeliveriesReturns(result1 []atc.NotificationDelivery, result2 bool, result3 error) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = nil
+	fake.notificationDeliveriesReturns = struct {
+		result1 []atc.NotificationDelivery
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) NotificationDeliveriesReturnsOnCall(i int, result1 []atc.NotificationDelivery, result2 bool, result3 error) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = nil
+	if fake.notificationDeliveriesReturnsOnCall == nil {
+		fake.notificationDeliveriesReturnsOnCall = make(map[int]struct {
+			result1 []atc.NotificationDelivery
+			result2 bool
+			result3 error
+		})
+	}
+	fake.notificationDeliveriesReturnsOnCall[i] = struct {
+		result1 []atc.NotificationDelivery
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) OrderingPipelines(arg1 []string) error {
 	var arg1Copy []string
 	if arg1 != nil {
@@ -4438,6 +4889,72 @@ func (fake *FakeTeam) ResourceVersionsReturnsOnCall(i int, result1 []atc.Resourc
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) ResumeJobBuild(arg1 atc.PipelineRef, arg2 string, arg3 string) (atc.Build, error) {
+	fake.resumeJobBuildMutex.Lock()
+	ret, specificReturn := fake.resumeJobBuildReturnsOnCall[len(fake.resumeJobBuildArgsForCall)]
+	fake.resumeJobBuildArgsForCall = append(fake.resumeJobBuildArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ResumeJobBuildStub
+	fakeReturns := fake.resumeJobBuildReturns
+	fake.recordInvocation("ResumeJobBuild", []interface{}{arg1, arg2, arg3})
+	fake.resumeJobBuildMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ResumeJobBuildCallCount() int {
+	fake.resumeJobBuildMutex.RLock()
+	defer fake.resumeJobBuildMutex.RUnlock()
+	return len(fake.resumeJobBuildArgsForCall)
+}
+
+func (fake *FakeTeam) ResumeJobBuildCalls(stub func(atc.PipelineRef, string, string) (atc.Build, error)) {
+	fake.resumeJobBuildMutex.Lock()
+	defer fake.resumeJobBuildMutex.Unlock()
+	fake.ResumeJobBuildStub = stub
+}
+
+func (fake *FakeTeam) ResumeJobBuildArgsForCall(i int) (atc.PipelineRef, string, string) {
+	fake.resumeJobBuildMutex.RLock()
+	defer fake.resumeJobBuildMutex.RUnlock()
+	argsForCall := fake.resumeJobBuildArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) ResumeJobBuildReturns(result1 atc.Build, result2 error) {
+	fake.resumeJobBuildMutex.Lock()
+	defer fake.resumeJobBuildMutex.Unlock()
+	fake.ResumeJobBuildStub = nil
+	fake.resumeJobBuildReturns = struct {
+		result1 atc.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ResumeJobBuildReturnsOnCall(i int, result1 atc.Build, result2 error) {
+	fake.resumeJobBuildMutex.Lock()
+	defer fake.resumeJobBuildMutex.Unlock()
+	fake.ResumeJobBuildStub = nil
+	if fake.resumeJobBuildReturnsOnCall == nil {
+		fake.resumeJobBuildReturnsOnCall = make(map[int]struct {
+			result1 atc.Build
+			result2 error
+		})
+	}
+	fake.resumeJobBuildReturnsOnCall[i] = struct {
+		result1 atc.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) ScheduleJob(arg1 atc.PipelineRef, arg2 string) (bool, error) {
 	fake.scheduleJobMutex.Lock()
 	ret, specificReturn := fake.scheduleJobReturnsOnCall[len(fake.scheduleJobArgsForCall)]
@@ -4503,6 +5020,73 @@ func (fake *FakeTeam) ScheduleJobReturnsOnCall(i int, result1 bool, result2 erro
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) SearchBuildLogs(arg1 concourse.BuildLogSearch) ([]atc.BuildLogMatch, bool, error) {
+	fake.searchBuildLogsMutex.Lock()
+	ret, specificReturn := fake.searchBuildLogsReturnsOnCall[len(fake.searchBuildLogsArgsForCall)]
+	fake.searchBuildLogsArgsForCall = append(fake.searchBuildLogsArgsForCall, struct {
+		arg1 concourse.BuildLogSearch
+	}{arg1})
+	stub := fake.SearchBuildLogsStub
+	fakeReturns := fake.searchBuildLogsReturns
+	fake.recordInvocation("SearchBuildLogs", []interface{}{arg1})
+	fake.searchBuildLogsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) SearchBuildLogsCallCount() int {
+	fake.searchBuildLogsMutex.RLock()
+	defer fake.searchBuildLogsMutex.RUnlock()
+	return len(fake.searchBuildLogsArgsForCall)
+}
+
+func (fake *FakeTeam) SearchBuildLogsCalls(stub func(concourse.BuildLogSearch) ([]atc.BuildLogMatch, bool, error)) {
+	fake.searchBuildLogsMutex.Lock()
+	defer fake.searchBuildLogsMutex.Unlock()
+	fake.SearchBuildLogsStub = stub
+}
+
+func (fake *FakeTeam) SearchBuildLogsArgsForCall(i int) concourse.BuildLogSearch {
+	fake.searchBuildLogsMutex.RLock()
+	defer fake.searchBuildLogsMutex.RUnlock()
+	argsForCall := fake.searchBuildLogsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) SearchBuildLogsReturns(result1 []atc.BuildLogMatch, result2 bool, result3 error) {
+	fake.searchBuildLogsMutex.Lock()
+	defer fake.searchBuildLogsMutex.Unlock()
+	fake.SearchBuildLogsStub = nil
+	fake.searchBuildLogsReturns = struct {
+		result1 []atc.BuildLogMatch
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) SearchBuildLogsReturnsOnCall(i int, result1 []atc.BuildLogMatch, result2 bool, result3 error) {
+	fake.searchBuildLogsMutex.Lock()
+	defer fake.searchBuildLogsMutex.Unlock()
+	fake.SearchBuildLogsStub = nil
+	if fake.searchBuildLogsReturnsOnCall == nil {
+		fake.searchBuildLogsReturnsOnCall = make(map[int]struct {
+			result1 []atc.BuildLogMatch
+			result2 bool
+			result3 error
+		})
+	}
+	fake.searchBuildLogsReturnsOnCall[i] = struct {
+		result1 []atc.BuildLogMatch
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) SetJobBuildComment(arg1 atc.PipelineRef, arg2 string, arg3 string, arg4 string) (bool, error) {
 	fake.setJobBuildCommentMutex.Lock()
 	ret, specificReturn := fake.setJobBuildCommentReturnsOnCall[len(fake.setJobBuildCommentArgsForCall)]
@@ -4636,6 +5220,69 @@ func (fake *FakeTeam) SetPinCommentReturnsOnCall(i int, result1 bool, result2 er
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) SetSecret(arg1 string, arg2 string, arg3 interface{}) error {
+	fake.setSecretMutex.Lock()
+	ret, specificReturn := fake.setSecretReturnsOnCall[len(fake.setSecretArgsForCall)]
+	fake.setSecretArgsForCall = append(fake.setSecretArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 interface{}
+	}{arg1, arg2, arg3})
+	stub := fake.SetSecretStub
+	fakeReturns := fake.setSecretReturns
+	fake.recordInvocation("SetSecret", []interface{}{arg1, arg2, arg3})
+	fake.setSecretMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) SetSecretCallCount() int {
+	fake.setSecretMutex.RLock()
+	defer fake.setSecretMutex.RUnlock()
+	return len(fake.setSecretArgsForCall)
+}
+
+func (fake *FakeTeam) SetSecretCalls(stub func(string, string, interface{}) error) {
+	fake.setSecretMutex.Lock()
+	defer fake.setSecretMutex.Unlock()
+	fake.SetSecretStub = stub
+}
+
+func (fake *FakeTeam) SetSecretArgsForCall(i int) (string, string, interface{}) {
+	fake.setSecretMutex.RLock()
+	defer fake.setSecretMutex.RUnlock()
+	argsForCall := fake.setSecretArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) SetSecretReturns(result1 error) {
+	fake.setSecretMutex.Lock()
+	defer fake.setSecretMutex.Unlock()
+	fake.SetSecretStub = nil
+	fake.setSecretReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SetSecretReturnsOnCall(i int, result1 error) {
+	fake.setSecretMutex.Lock()
+	defer fake.setSecretMutex.Unlock()
+	fake.SetSecretStub = nil
+	if fake.setSecretReturnsOnCall == nil {
+		fake.setSecretReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setSecretReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTeam) UnpauseJob(arg1 atc.PipelineRef, arg2 string) (bool, error) {
 	fake.unpauseJobMutex.Lock()
 	ret, specificReturn := fake.unpauseJobReturnsOnCall[len(fake.unpauseJobArgsForCall)]
@@ -4875,6 +5522,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.createPipelineBuildMutex.RUnlock()
 	fake.deletePipelineMutex.RLock()
 	defer fake.deletePipelineMutex.RUnlock()
+	fake.deleteSecretMutex.RLock()
+	defer fake.deleteSecretMutex.RUnlock()
 	fake.destroyTeamMutex.RLock()
 	defer fake.destroyTeamMutex.RUnlock()
 	fake.disableResourceVersionMutex.RLock()
@@ -4897,6 +5546,10 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.jobBuildMutex.RUnlock()
 	fake.jobBuildsMutex.RLock()
 	defer fake.jobBuildsMutex.RUnlock()
+	fake.jobStatsMutex.RLock()
+	defer fake.jobStatsMutex.RUnlock()
+	fake.jobTestSummariesMutex.RLock()
+	defer fake.jobTestSummariesMutex.RUnlock()
 	fake.listContainersMutex.RLock()
 	defer fake.listContainersMutex.RUnlock()
 	fake.listJobsMutex.RLock()
@@ -4905,6 +5558,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.listPipelinesMutex.RUnlock()
 	fake.listResourcesMutex.RLock()
 	defer fake.listResourcesMutex.RUnlock()
+	fake.listSecretsMutex.RLock()
+	defer fake.listSecretsMutex.RUnlock()
 	fake.listSharedForResourceMutex.RLock()
 	defer fake.listSharedForResourceMutex.RUnlock()
 	fake.listSharedForResourceTypeMutex.RLock()
@@ -4913,6 +5568,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.listVolumesMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
 	fake.orderingPipelinesMutex.RLock()
 	defer fake.orderingPipelinesMutex.RUnlock()
 	fake.orderingPipelinesWithinGroupMutex.RLock()
@@ -4941,12 +5598,18 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.resourceTypesMutex.RUnlock()
 	fake.resourceVersionsMutex.RLock()
 	defer fake.resourceVersionsMutex.RUnlock()
+	fake.resumeJobBuildMutex.RLock()
+	defer fake.resumeJobBuildMutex.RUnlock()
 	fake.scheduleJobMutex.RLock()
 	defer fake.scheduleJobMutex.RUnlock()
+	fake.searchBuildLogsMutex.RLock()
+	defer fake.searchBuildLogsMutex.RUnlock()
 	fake.setJobBuildCommentMutex.RLock()
 	defer fake.setJobBuildCommentMutex.RUnlock()
 	fake.setPinCommentMutex.RLock()
 	defer fake.setPinCommentMutex.RUnlock()
+	fake.setSecretMutex.RLock()
+	defer fake.setSecretMutex.RUnlock()
 	fake.unpauseJobMutex.RLock()
 	defer fake.unpauseJobMutex.RUnlock()
 	fake.unpausePipelineMutex.RLock()
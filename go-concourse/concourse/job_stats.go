@@ -0,0 +1,45 @@
+package concourse
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) JobStats(pipelineRef atc.PipelineRef, jobName string, since time.Time, until time.Time) (atc.JobStats, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineRef.Name,
+		"job_name":      jobName,
+		"team_name":     team.Name(),
+	}
+
+	query := url.Values{}
+	if !since.IsZero() {
+		query.Add(atc.JobStatsSince, strconv.FormatInt(since.Unix(), 10))
+	}
+
+	if !until.IsZero() {
+		query.Add(atc.JobStatsUntil, strconv.FormatInt(until.Unix(), 10))
+	}
+
+	var stats atc.JobStats
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.GetJobStats,
+		Params:      params,
+		Query:       merge(query, pipelineRef.QueryParams()),
+	}, &internal.Response{
+		Result: &stats,
+	})
+	switch err.(type) {
+	case nil:
+		return stats, true, nil
+	case internal.ResourceNotFoundError:
+		return stats, false, nil
+	default:
+		return stats, false, err
+	}
+}
@@ -0,0 +1,66 @@
+package concourse_test
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Job Stats", func() {
+	Describe("team.JobStats", func() {
+		pipelineRef := atc.PipelineRef{Name: "mypipeline", InstanceVars: atc.InstanceVars{"branch": "master"}}
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/jobs/myjob/stats"
+
+		Context("when the job exists", func() {
+			expectedStats := atc.JobStats{
+				Since:       100,
+				Until:       200,
+				Builds:      3,
+				Succeeded:   2,
+				Failed:      1,
+				SuccessRate: 2.0 / 3.0,
+				Steps: []atc.StepStats{
+					{Name: "unit", Type: "task", Runs: 3, P50: 10, P90: 20, P95: 25},
+				},
+			}
+
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "since=100&until=200&vars.branch=%22master%22"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedStats),
+					),
+				)
+			})
+
+			It("returns the stats of the job", func() {
+				stats, found, err := team.JobStats(pipelineRef, "myjob", time.Unix(100, 0), time.Unix(200, 0))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(stats).To(Equal(expectedStats))
+			})
+		})
+
+		Context("when the job does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "vars.branch=%22master%22"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false in the found value and no error", func() {
+				_, found, err := team.JobStats(pipelineRef, "myjob", time.Time{}, time.Time{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
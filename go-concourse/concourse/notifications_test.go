@@ -0,0 +1,69 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Notifications", func() {
+	Describe("team.NotificationDeliveries", func() {
+		pipelineRef := atc.PipelineRef{Name: "mypipeline", InstanceVars: atc.InstanceVars{"branch": "master"}}
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/notifications"
+
+		Context("when the pipeline exists", func() {
+			expectedDeliveries := []atc.NotificationDelivery{
+				{
+					ID:           1,
+					Notification: "some-hook",
+					BuildID:      12,
+					BuildName:    "3",
+					JobName:      "myjob",
+					Status:       atc.StatusSucceeded,
+					State:        atc.NotificationDeliveryDelivered,
+					Attempts:     1,
+					ResponseCode: 200,
+					CreatedAt:    100,
+					DeliveredAt:  101,
+				},
+			}
+
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "limit=10&vars.branch=%22master%22"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedDeliveries),
+					),
+				)
+			})
+
+			It("returns the deliveries", func() {
+				deliveries, found, err := team.NotificationDeliveries(pipelineRef, 10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(deliveries).To(Equal(expectedDeliveries))
+			})
+		})
+
+		Context("when the pipeline does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "vars.branch=%22master%22"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false in the found value and no error", func() {
+				_, found, err := team.NotificationDeliveries(pipelineRef, 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
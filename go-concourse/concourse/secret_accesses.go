@@ -0,0 +1,29 @@
+package concourse
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (client *client) BuildSecretAccesses(buildID string) ([]atc.SecretAccess, bool, error) {
+	params := rata.Params{
+		"build_id": buildID,
+	}
+
+	var accesses []atc.SecretAccess
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.ListBuildSecretAccesses,
+		Params:      params,
+	}, &internal.Response{
+		Result: &accesses,
+	})
+	switch err.(type) {
+	case nil:
+		return accesses, true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
@@ -0,0 +1,57 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Secret Accesses", func() {
+	Describe("BuildSecretAccesses", func() {
+		expectedURL := "/api/v1/builds/6/secret_accesses"
+
+		Context("when the build exists", func() {
+			expectedAccesses := []atc.SecretAccess{
+				{Path: "/concourse/main/some-var", CredentialManager: "vault", Found: true},
+				{Source: "some-source", Path: "other-var", CredentialManager: "dummy", Cached: true},
+			}
+
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedAccesses),
+					),
+				)
+			})
+
+			It("returns the secret accesses of the build", func() {
+				accesses, found, err := client.BuildSecretAccesses("6")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(accesses).To(Equal(expectedAccesses))
+			})
+		})
+
+		Context("when the build does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false in the found value and no error", func() {
+				_, found, err := client.BuildSecretAccesses("6")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
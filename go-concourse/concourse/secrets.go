@@ -0,0 +1,70 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) ListSecrets() ([]atc.Secret, error) {
+	var secrets []atc.Secret
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListSecrets,
+		Params:      rata.Params{"team_name": team.Name()},
+	}, &internal.Response{
+		Result: &secrets,
+	})
+
+	return secrets, err
+}
+
+func (team *team) SetSecret(pipelineName string, name string, value interface{}) error {
+	body, err := json.Marshal(atc.SetSecretRequest{
+		PipelineName: pipelineName,
+		Value:        value,
+	})
+	if err != nil {
+		return err
+	}
+
+	return team.connection.Send(internal.Request{
+		RequestName: atc.SetSecret,
+		Params: rata.Params{
+			"team_name":   team.Name(),
+			"secret_name": name,
+		},
+		Body: bytes.NewBuffer(body),
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+	}, nil)
+}
+
+func (team *team) DeleteSecret(pipelineName string, name string) (bool, error) {
+	query := url.Values{}
+	if pipelineName != "" {
+		query.Set("pipeline_name", pipelineName)
+	}
+
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.DeleteSecret,
+		Params: rata.Params{
+			"team_name":   team.Name(),
+			"secret_name": name,
+		},
+		Query: query,
+	}, nil)
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
@@ -0,0 +1,107 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Secrets", func() {
+	Describe("team.ListSecrets", func() {
+		expectedSecrets := []atc.Secret{
+			{Name: "some-secret", TeamName: "some-team", UpdatedAt: 42},
+			{Name: "other-secret", TeamName: "some-team", PipelineName: "some-pipeline", UpdatedAt: 43},
+		}
+
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/secrets"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedSecrets),
+				),
+			)
+		})
+
+		It("returns the team's secrets", func() {
+			secrets, err := team.ListSecrets()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secrets).To(Equal(expectedSecrets))
+		})
+	})
+
+	Describe("team.SetSecret", func() {
+		Context("when the secret is set", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/secrets/some-secret"),
+						ghttp.VerifyJSON(`{"pipeline_name":"some-pipeline","value":{"username":"admin"}}`),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("sends the value", func() {
+				err := team.SetSecret("some-pipeline", "some-secret", map[string]interface{}{"username": "admin"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
+
+		Context("when the request is rejected", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/secrets/some-secret"),
+						ghttp.RespondWith(http.StatusBadRequest, `{"errors":["secret value must be provided"]}`),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				err := team.SetSecret("", "some-secret", nil)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("team.DeleteSecret", func() {
+		Context("when the secret exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/teams/some-team/secrets/some-secret", "pipeline_name=some-pipeline"),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("deletes the secret", func() {
+				found, err := team.DeleteSecret("some-pipeline", "some-secret")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		Context("when the secret does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/teams/some-team/secrets/some-secret", ""),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				found, err := team.DeleteSecret("", "some-secret")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
@@ -2,6 +2,7 @@ package concourse
 
 import (
 	"io"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
@@ -30,6 +31,7 @@ type Team interface {
 	ListPipelines() ([]atc.Pipeline, error)
 	PipelineConfig(pipelineRef atc.PipelineRef) (atc.Config, string, bool, error)
 	CreateOrUpdatePipelineConfig(pipelineRef atc.PipelineRef, configVersion string, passedConfig []byte, checkCredentials bool) (bool, bool, []ConfigWarning, error)
+	NotificationDeliveries(pipelineRef atc.PipelineRef, limit int) ([]atc.NotificationDelivery, bool, error)
 
 	CreatePipelineBuild(pipelineRef atc.PipelineRef, plan atc.Plan) (atc.Build, error)
 
@@ -38,8 +40,9 @@ type Team interface {
 	Job(pipelineRef atc.PipelineRef, jobName string) (atc.Job, bool, error)
 	JobBuild(pipelineRef atc.PipelineRef, jobName, buildName string) (atc.Build, bool, error)
 	JobBuilds(pipelineRef atc.PipelineRef, jobName string, page Page) ([]atc.Build, Pagination, bool, error)
-	CreateJobBuild(pipelineRef atc.PipelineRef, jobName string) (atc.Build, error)
+	CreateJobBuild(pipelineRef atc.PipelineRef, jobName string, body atc.CreateJobBuildBody) (atc.Build, error)
 	RerunJobBuild(pipelineRef atc.PipelineRef, jobName string, buildName string) (atc.Build, error)
+	ResumeJobBuild(pipelineRef atc.PipelineRef, jobName string, buildName string) (atc.Build, error)
 	SetJobBuildComment(pipelineRef atc.PipelineRef, jobName string, buildName string, comment string) (bool, error)
 	ListJobs(pipelineRef atc.PipelineRef) ([]atc.Job, error)
 	ScheduleJob(pipelineRef atc.PipelineRef, jobName string) (bool, error)
@@ -48,6 +51,8 @@ type Team interface {
 	UnpauseJob(pipelineRef atc.PipelineRef, jobName string) (bool, error)
 
 	ClearTaskCache(pipelineRef atc.PipelineRef, jobName string, stepName string, cachePath string) (int64, error)
+	JobTestSummaries(pipelineRef atc.PipelineRef, jobName string, limit int) ([]atc.TestSummary, bool, error)
+	JobStats(pipelineRef atc.PipelineRef, jobName string, since time.Time, until time.Time) (atc.JobStats, bool, error)
 
 	Resource(pipelineRef atc.PipelineRef, resourceName string) (atc.Resource, bool, error)
 	ListResources(pipelineRef atc.PipelineRef) ([]atc.Resource, error)
@@ -76,9 +81,14 @@ type Team interface {
 	ListVolumes() ([]atc.Volume, error)
 	CreateBuild(plan atc.Plan) (atc.Build, error)
 	Builds(page Page) ([]atc.Build, Pagination, error)
+	SearchBuildLogs(search BuildLogSearch) ([]atc.BuildLogMatch, bool, error)
 	OrderingPipelines(pipelineNames []string) error
 	OrderingPipelinesWithinGroup(groupName string, instanceVars []atc.InstanceVars) error
 
+	ListSecrets() ([]atc.Secret, error)
+	SetSecret(pipelineName string, name string, value interface{}) error
+	DeleteSecret(pipelineName string, name string) (bool, error)
+
 	CreateArtifact(io.Reader, string, []string) (atc.WorkerArtifact, error)
 	GetArtifact(int) (io.ReadCloser, error)
 }
@@ -0,0 +1,62 @@
+package concourse
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (client *client) BuildTestResults(buildID string) ([]atc.TestResult, bool, error) {
+	params := rata.Params{
+		"build_id": buildID,
+	}
+
+	var results []atc.TestResult
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.ListBuildTestResults,
+		Params:      params,
+	}, &internal.Response{
+		Result: &results,
+	})
+	switch err.(type) {
+	case nil:
+		return results, true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
+
+func (team *team) JobTestSummaries(pipelineRef atc.PipelineRef, jobName string, limit int) ([]atc.TestSummary, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineRef.Name,
+		"job_name":      jobName,
+		"team_name":     team.Name(),
+	}
+
+	query := url.Values{}
+	if limit > 0 {
+		query.Add(atc.PaginationQueryLimit, strconv.Itoa(limit))
+	}
+
+	var summaries []atc.TestSummary
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListJobTestSummaries,
+		Params:      params,
+		Query:       merge(query, pipelineRef.QueryParams()),
+	}, &internal.Response{
+		Result: &summaries,
+	})
+	switch err.(type) {
+	case nil:
+		return summaries, true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
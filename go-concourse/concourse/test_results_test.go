@@ -0,0 +1,101 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Test Results", func() {
+	Describe("BuildTestResults", func() {
+		expectedURL := "/api/v1/builds/6/test_results"
+
+		Context("when the build exists", func() {
+			expectedResults := []atc.TestResult{
+				{Step: "unit", Suite: "pkg", Name: "TestA", Status: atc.TestStatusPassed, Duration: 0.5},
+				{Step: "unit", Suite: "pkg", Name: "TestB", Status: atc.TestStatusFailed, Message: "boom"},
+			}
+
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedResults),
+					),
+				)
+			})
+
+			It("returns the test results of the build", func() {
+				results, found, err := client.BuildTestResults("6")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(results).To(Equal(expectedResults))
+			})
+		})
+
+		Context("when the build does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false in the found value and no error", func() {
+				_, found, err := client.BuildTestResults("6")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("team.JobTestSummaries", func() {
+		pipelineRef := atc.PipelineRef{Name: "mypipeline", InstanceVars: atc.InstanceVars{"branch": "master"}}
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/jobs/myjob/test_results"
+
+		Context("when the job exists", func() {
+			expectedSummaries := []atc.TestSummary{
+				{Suite: "pkg", Name: "TestB", Runs: 3, Passed: 2, Failed: 1, LastFailedBuild: "12"},
+			}
+
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "limit=5&vars.branch=%22master%22"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedSummaries),
+					),
+				)
+			})
+
+			It("returns the test summaries of the job", func() {
+				summaries, found, err := team.JobTestSummaries(pipelineRef, "myjob", 5)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(summaries).To(Equal(expectedSummaries))
+			})
+		})
+
+		Context("when the job does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false in the found value and no error", func() {
+				_, found, err := team.JobTestSummaries(pipelineRef, "myjob", 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
@@ -65,6 +65,7 @@ require (
 	github.com/pkg/term v1.1.1-0.20201205102247-e502d17f6e7f
 	github.com/prometheus/client_golang v1.14.0
 	github.com/racksec/srslog v0.0.0-20180709174129-a4725f04ec91
+	github.com/robfig/cron/v3 v3.0.1
 	github.com/sirupsen/logrus v1.9.0
 	github.com/skratchdot/open-golang v0.0.0-20200116055534-eef842397966
 	github.com/square/certstrap v1.3.0
@@ -151,6 +151,56 @@ func (flag *AuthTeamFlags) formatFromFile() (atc.TeamAuth, error) {
 	return auth, nil
 }
 
+// VarSources returns the var_sources given in the configuration file, which
+// are available to every pipeline in the team. They can only be configured
+// from a file, and are nil if the file does not mention them at all.
+func (flag *AuthTeamFlags) VarSources() (*atc.VarSourceConfigs, error) {
+	config, err := flag.configFile()
+	if err != nil {
+		return nil, err
+	}
+
+	return config.VarSources, nil
+}
+
+// Notifications returns the notifications given in the configuration file,
+// which are sent for builds of every pipeline in the team. Like var_sources
+// they can only be configured from a file, and are nil if it does not mention
+// them at all.
+func (flag *AuthTeamFlags) Notifications() (*atc.NotificationConfigs, error) {
+	config, err := flag.configFile()
+	if err != nil {
+		return nil, err
+	}
+
+	return config.Notifications, nil
+}
+
+type teamConfigFile struct {
+	VarSources    *atc.VarSourceConfigs    `json:"var_sources"`
+	Notifications *atc.NotificationConfigs `json:"notifications"`
+}
+
+func (flag *AuthTeamFlags) configFile() (teamConfigFile, error) {
+	var data teamConfigFile
+
+	path := flag.Config.Path()
+	if path == "" {
+		return data, nil
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return data, err
+	}
+
+	if err = yaml.Unmarshal(content, &data); err != nil {
+		return data, err
+	}
+
+	return data, nil
+}
+
 // When formatting team config from the command line flags, the connector's
 // TeamConfig has already been populated by the flags library. All we need to
 // do is grab the teamConfig object and extract the users and groups.
@@ -185,6 +185,26 @@ handleEvent event ( model, effects ) =
             , effects
             )
 
+        Skipped origin condition time ->
+            ( updateStep origin.id (finishStep True (Just time) << appendStepLog ("\u{001B}[1mskipped: \u{001B}[0m" ++ condition ++ " is false\n") (Just time)) model
+            , effects
+            )
+
+        Retrying origin attempt delay reason time ->
+            ( updateStep origin.id (appendStepLog ("\u{001B}[1mretrying as attempt " ++ String.fromInt attempt ++ " in " ++ delay ++ "\u{001B}[0m (" ++ reason ++ ")\n") (Just time)) model
+            , effects
+            )
+
+        WaitingForApproval origin role time ->
+            ( updateStep origin.id (setRunning << appendStepLog ("\u{001B}[1mwaiting for approval by a team " ++ role ++ "\u{001B}[0m (plan id: " ++ origin.id ++ ")\n") (Just time)) model
+            , effects
+            )
+
+        ApprovalDecided origin approved decidedBy time ->
+            ( updateStep origin.id (appendStepLog (approvalDecision approved ++ " by " ++ decidedBy ++ "\n") (Just time)) model
+            , effects
+            )
+
         InitializeTask origin time ->
             ( updateStep origin.id (setInitialize time) model
             , effects
@@ -205,6 +225,11 @@ handleEvent event ( model, effects ) =
             , effects
             )
 
+        TaskCacheHit origin key time ->
+            ( updateStep origin.id (setRunning << appendStepLog ("\u{001B}[1musing cached result\u{001B}[0m (key: " ++ key ++ ")\n") (Just time)) model
+            , effects
+            )
+
         Initialize origin time ->
             ( updateStep origin.id (setInitialize time) model
             , effects
@@ -362,6 +387,15 @@ finishStep succeeded mtime step =
     setStepFinish mtime (setStepState stepState step)
 
 
+approvalDecision : Bool -> String
+approvalDecision approved =
+    if approved then
+        "\u{001B}[32mapproved\u{001B}[0m"
+
+    else
+        "\u{001B}[31mrejected\u{001B}[0m"
+
+
 setResourceInfo : Concourse.Version -> Concourse.Metadata -> Step -> Step
 setResourceInfo version metadata step =
     { step | version = Just version, metadata = metadata }
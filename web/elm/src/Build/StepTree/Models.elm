@@ -52,6 +52,7 @@ type StepTree
     | Put StepID
     | SetPipeline StepID
     | LoadVar StepID
+    | Approve StepID
     | ArtifactInput StepID
     | ArtifactOutput StepID
     | Aggregate (Array StepTree)
@@ -66,6 +67,7 @@ type StepTree
     | Ensure HookedStep
     | Try StepTree
     | Timeout StepTree
+    | If StepTree
 
 
 type alias HookedStep =
@@ -198,6 +200,7 @@ type BuildEvent
     | InitializeCheck Origin Time.Posix String
     | StartTask Origin Time.Posix
     | FinishTask Origin Int Time.Posix
+    | TaskCacheHit Origin String Time.Posix
     | Initialize Origin Time.Posix
     | Start Origin Time.Posix
     | Finish Origin Time.Posix Bool
@@ -214,6 +217,10 @@ type BuildEvent
     | StreamingVolume Origin String String (Maybe Time.Posix)
     | WaitingForStreamedVolume Origin String (Maybe Time.Posix)
     | Error Origin String Time.Posix
+    | Skipped Origin String Time.Posix
+    | Retrying Origin Int String String Time.Posix
+    | WaitingForApproval Origin String Time.Posix
+    | ApprovalDecided Origin Bool String Time.Posix
     | ImageCheck Origin Concourse.BuildPlan
     | ImageGet Origin Concourse.BuildPlan
     | AcrossSubsteps Origin (List Concourse.AcrossSubstep)
@@ -287,6 +294,9 @@ activeStepIds model tree =
         LoadVar stepId ->
             [ stepId ]
 
+        Approve stepId ->
+            [ stepId ]
+
         Aggregate trees ->
             List.concatMap (activeStepIds model) (Array.toList trees)
 
@@ -320,6 +330,9 @@ activeStepIds model tree =
         Timeout subTree ->
             activeStepIds model subTree
 
+        If subTree ->
+            activeStepIds model subTree
+
         Retry _ trees ->
             trees
                 |> Array.toList
@@ -365,6 +378,9 @@ updateTreeNodeAt id fn tree =
         LoadVar stepId ->
             updateSelf stepId
 
+        Approve stepId ->
+            updateSelf stepId
+
         Aggregate trees ->
             Aggregate <| Array.map (updateTreeNodeAt id fn) trees
 
@@ -421,6 +437,9 @@ updateTreeNodeAt id fn tree =
         Timeout subTree ->
             Timeout <| updateTreeNodeAt id fn subTree
 
+        If subTree ->
+            If <| updateTreeNodeAt id fn subTree
+
         Retry stepId trees ->
             let
                 withUpdatedChildren =
@@ -108,6 +108,9 @@ init buildId hl resources plan =
         Concourse.BuildStepLoadVar _ ->
             step |> initBottom buildId hl resources plan LoadVar
 
+        Concourse.BuildStepApprove _ ->
+            step |> initBottom buildId hl resources plan Approve
+
         Concourse.BuildStepAggregate plans ->
             initMultiStep buildId hl resources plan.id Aggregate plans Nothing
 
@@ -178,6 +181,9 @@ init buildId hl resources plan =
         Concourse.BuildStepTimeout subPlan ->
             initWrappedStep buildId hl resources Timeout subPlan
 
+        Concourse.BuildStepIf _ subPlan ->
+            initWrappedStep buildId hl resources If subPlan
+
 
 setImagePlans : Maybe Concourse.JobBuildIdentifier -> StepID -> Maybe Concourse.ImageBuildPlans -> StepTreeModel -> StepTreeModel
 setImagePlans buildId stepId imagePlans model =
@@ -597,6 +603,9 @@ viewTree session model tree depth =
         LoadVar stepId ->
             viewStep model session depth stepId
 
+        Approve stepId ->
+            viewStep model session depth stepId
+
         Try subTree ->
             viewTree session model subTree depth
 
@@ -636,32 +645,46 @@ viewTree session model tree depth =
 
         Retry stepId steps ->
             assumeStep model stepId <|
-                \{ tabFocus } ->
+                \retryStep ->
                     let
                         activeTab =
-                            case tabFocus of
+                            case retryStep.tabFocus of
                                 Manual i ->
                                     i
 
                                 Auto ->
                                     Maybe.withDefault 0 (lastActive model steps)
+
+                        retryLog =
+                            if Array.isEmpty retryStep.log.lines then
+                                []
+
+                            else
+                                [ Html.pre [ class "timestamped-logs" ] <|
+                                    viewLogs retryStep.log retryStep.timestamps model.highlight session.timeZone stepId
+                                ]
                     in
                     Html.div [ class "retry" ]
-                        [ Html.ul
+                        (Html.ul
                             (class "retry-tabs" :: Styles.retryTabList)
                             (Array.toList <| Array.indexedMap (viewRetryTab session model stepId activeTab) steps)
-                        , case Array.get activeTab steps of
-                            Just step ->
-                                viewTree session model step depth
-
-                            Nothing ->
-                                -- impossible (bogus tab selected)
-                                Html.text ""
-                        ]
+                            :: retryLog
+                            ++ [ case Array.get activeTab steps of
+                                    Just step ->
+                                        viewTree session model step depth
+
+                                    Nothing ->
+                                        -- impossible (bogus tab selected)
+                                        Html.text ""
+                               ]
+                        )
 
         Timeout subTree ->
             viewTree session model subTree depth
 
+        If subTree ->
+            viewTree session model subTree depth
+
         Aggregate trees ->
             Html.div [ class "aggregate" ]
                 (Array.toList <| Array.map (viewSeq session model depth) trees)
@@ -1262,6 +1285,9 @@ viewStepHeader step =
         Concourse.BuildStepLoadVar name ->
             simpleHeader "load_var:" Nothing name
 
+        Concourse.BuildStepApprove name ->
+            simpleHeader "approve:" Nothing name
+
         Concourse.BuildStepCheck name _ ->
             simpleHeader "check:" Nothing name
 
@@ -1316,6 +1342,9 @@ viewStepHeader step =
         Concourse.BuildStepTimeout _ ->
             Html.text ""
 
+        Concourse.BuildStepIf _ _ ->
+            Html.text ""
+
 
 stepName : Concourse.BuildStep -> Maybe String
 stepName header =
@@ -1329,6 +1358,9 @@ stepName header =
         Concourse.BuildStepLoadVar name ->
             Just name
 
+        Concourse.BuildStepApprove name ->
+            Just name
+
         Concourse.BuildStepArtifactInput name ->
             Just name
 
@@ -1383,6 +1415,9 @@ stepName header =
         Concourse.BuildStepTimeout _ ->
             Nothing
 
+        Concourse.BuildStepIf _ _ ->
+            Nothing
+
 
 resourceName : Concourse.BuildStep -> Maybe String
 resourceName step =
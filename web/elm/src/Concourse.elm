@@ -399,6 +399,9 @@ mapBuildPlan fn plan =
                 BuildStepLoadVar _ ->
                     []
 
+                BuildStepApprove _ ->
+                    []
+
                 BuildStepArtifactInput _ ->
                     []
 
@@ -468,6 +471,9 @@ mapBuildPlan fn plan =
 
                 BuildStepTimeout step ->
                     mapBuildPlan fn step
+
+                BuildStepIf _ step ->
+                    mapBuildPlan fn step
            )
 
 
@@ -489,6 +495,7 @@ type BuildStep
     = BuildStepTask StepName
     | BuildStepSetPipeline StepName InstanceVars
     | BuildStepLoadVar StepName
+    | BuildStepApprove StepName
     | BuildStepArtifactInput StepName
     | BuildStepCheck StepName (Maybe ImageBuildPlans)
     | BuildStepGet StepName (Maybe ResourceName) (Maybe Version) (Maybe ImageBuildPlans)
@@ -507,6 +514,7 @@ type BuildStep
     | BuildStepTry BuildPlan
     | BuildStepRetry (Array BuildPlan)
     | BuildStepTimeout BuildPlan
+    | BuildStepIf String BuildPlan
 
 
 type alias HookedPlan =
@@ -702,10 +710,14 @@ decodeBuildPlan =
                     lazy (\_ -> decodeBuildStepRetry)
                 , Json.Decode.field "timeout" <|
                     lazy (\_ -> decodeBuildStepTimeout)
+                , Json.Decode.field "if" <|
+                    lazy (\_ -> decodeBuildStepIf)
                 , Json.Decode.field "set_pipeline" <|
                     lazy (\_ -> decodeBuildSetPipeline)
                 , Json.Decode.field "load_var" <|
                     lazy (\_ -> decodeBuildStepLoadVar)
+                , Json.Decode.field "approve" <|
+                    lazy (\_ -> decodeBuildStepApprove)
                 , Json.Decode.field "across" <|
                     lazy (\_ -> decodeBuildStepAcross)
                 ]
@@ -848,6 +860,13 @@ decodeBuildStepTimeout =
         |> andMap (Json.Decode.field "step" <| lazy (\_ -> decodeBuildPlan))
 
 
+decodeBuildStepIf : Json.Decode.Decoder BuildStep
+decodeBuildStepIf =
+    Json.Decode.succeed BuildStepIf
+        |> andMap (Json.Decode.field "condition" Json.Decode.string)
+        |> andMap (Json.Decode.field "step" <| lazy (\_ -> decodeBuildPlan))
+
+
 decodeBuildSetPipeline : Json.Decode.Decoder BuildStep
 decodeBuildSetPipeline =
     Json.Decode.succeed BuildStepSetPipeline
@@ -861,6 +880,12 @@ decodeBuildStepLoadVar =
         |> andMap (Json.Decode.field "name" Json.Decode.string)
 
 
+decodeBuildStepApprove : Json.Decode.Decoder BuildStep
+decodeBuildStepApprove =
+    Json.Decode.succeed BuildStepApprove
+        |> andMap (Json.Decode.field "name" Json.Decode.string)
+
+
 decodeBuildStepAcross : Json.Decode.Decoder BuildStep
 decodeBuildStepAcross =
     Json.Decode.map BuildStepAcross
@@ -125,6 +125,45 @@ decodeBuildEvent =
                     "error" ->
                         Json.Decode.field "data" decodeErrorEvent
 
+                    "skipped" ->
+                        Json.Decode.field
+                            "data"
+                            (Json.Decode.map3 Skipped
+                                (Json.Decode.field "origin" decodeOrigin)
+                                (Json.Decode.field "condition" Json.Decode.string)
+                                (Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.int)
+                            )
+
+                    "retrying" ->
+                        Json.Decode.field
+                            "data"
+                            (Json.Decode.map5 Retrying
+                                (Json.Decode.field "origin" decodeOrigin)
+                                (Json.Decode.field "attempt" Json.Decode.int)
+                                (Json.Decode.field "delay" Json.Decode.string)
+                                (Json.Decode.field "reason" Json.Decode.string)
+                                (Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.int)
+                            )
+
+                    "waiting-for-approval" ->
+                        Json.Decode.field
+                            "data"
+                            (Json.Decode.map3 WaitingForApproval
+                                (Json.Decode.field "origin" decodeOrigin)
+                                (Json.Decode.field "role" Json.Decode.string)
+                                (Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.int)
+                            )
+
+                    "approval-decided" ->
+                        Json.Decode.field
+                            "data"
+                            (Json.Decode.map4 ApprovalDecided
+                                (Json.Decode.field "origin" decodeOrigin)
+                                (Json.Decode.field "approved" Json.Decode.bool)
+                                (Json.Decode.field "decided_by" Json.Decode.string)
+                                (Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.int)
+                            )
+
                     "initialize-task" ->
                         Json.Decode.field
                             "data"
@@ -159,6 +198,15 @@ decodeBuildEvent =
                                 (Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.int)
                             )
 
+                    "task-cache-hit" ->
+                        Json.Decode.field
+                            "data"
+                            (Json.Decode.map3 TaskCacheHit
+                                (Json.Decode.field "origin" decodeOrigin)
+                                (Json.Decode.field "key" Json.Decode.string)
+                                (Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.int)
+                            )
+
                     "initialize" ->
                         Json.Decode.field
                             "data"
@@ -0,0 +1,120 @@
+module BuildEventsTests exposing (all)
+
+import Build.StepTree.Models exposing (BuildEvent(..))
+import Concourse.BuildEvents as BuildEvents
+import Expect
+import Json.Decode
+import Test exposing (Test, describe, test)
+import Time
+
+
+all : Test
+all =
+    describe "decodeBuildEvent"
+        [ test "decodes skipped events" <|
+            \_ ->
+                """
+                { "event": "skipped"
+                , "version": "1.0"
+                , "data":
+                    { "origin": { "id": "some-id" }
+                    , "time": 1
+                    , "condition": "((.:deploy))"
+                    }
+                }
+                """
+                    |> Json.Decode.decodeString BuildEvents.decodeBuildEvent
+                    |> Expect.equal
+                        (Ok <|
+                            Skipped
+                                { source = "", id = "some-id" }
+                                "((.:deploy))"
+                                (Time.millisToPosix 1000)
+                        )
+        , test "decodes retrying events" <|
+            \_ ->
+                """
+                { "event": "retrying"
+                , "version": "1.0"
+                , "data":
+                    { "origin": { "id": "some-id" }
+                    , "time": 1
+                    , "attempt": 2
+                    , "delay": "10s"
+                    , "reason": "failed"
+                    }
+                }
+                """
+                    |> Json.Decode.decodeString BuildEvents.decodeBuildEvent
+                    |> Expect.equal
+                        (Ok <|
+                            Retrying
+                                { source = "", id = "some-id" }
+                                2
+                                "10s"
+                                "failed"
+                                (Time.millisToPosix 1000)
+                        )
+        , test "decodes task-cache-hit events" <|
+            \_ ->
+                """
+                { "event": "task-cache-hit"
+                , "version": "1.0"
+                , "data":
+                    { "origin": { "id": "some-id" }
+                    , "time": 1
+                    , "key": "some-key"
+                    }
+                }
+                """
+                    |> Json.Decode.decodeString BuildEvents.decodeBuildEvent
+                    |> Expect.equal
+                        (Ok <|
+                            TaskCacheHit
+                                { source = "", id = "some-id" }
+                                "some-key"
+                                (Time.millisToPosix 1000)
+                        )
+        , test "decodes waiting-for-approval events" <|
+            \_ ->
+                """
+                { "event": "waiting-for-approval"
+                , "version": "1.0"
+                , "data":
+                    { "origin": { "id": "some-id" }
+                    , "time": 1
+                    , "role": "owner"
+                    }
+                }
+                """
+                    |> Json.Decode.decodeString BuildEvents.decodeBuildEvent
+                    |> Expect.equal
+                        (Ok <|
+                            WaitingForApproval
+                                { source = "", id = "some-id" }
+                                "owner"
+                                (Time.millisToPosix 1000)
+                        )
+        , test "decodes approval-decided events" <|
+            \_ ->
+                """
+                { "event": "approval-decided"
+                , "version": "1.0"
+                , "data":
+                    { "origin": { "id": "some-id" }
+                    , "time": 1
+                    , "approved": false
+                    , "decided_by": "some-user"
+                    }
+                }
+                """
+                    |> Json.Decode.decodeString BuildEvents.decodeBuildEvent
+                    |> Expect.equal
+                        (Ok <|
+                            ApprovalDecided
+                                { source = "", id = "some-id" }
+                                False
+                                "some-user"
+                                (Time.millisToPosix 1000)
+                        )
+        ]
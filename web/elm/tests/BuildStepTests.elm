@@ -86,6 +86,11 @@ all =
                 given iVisitABuildWithARetryStep
                     >> when iAmLookingAtTheRetryStepInTheBuildOutput
                     >> then_ iSeeTwoChildren
+            , test "shows why an attempt is retried" <|
+                given iVisitABuildWithARetryStep
+                    >> given theRetryStepIsRetrying
+                    >> when iAmLookingAtTheRetryStepInTheBuildOutput
+                    >> then_ iSeeTheRetryReason
             , describe "tab list"
                 [ test "is a list" <|
                     given iVisitABuildWithARetryStep
@@ -350,6 +355,12 @@ all =
                     >> given theTaskStepIsExpanded
                     >> when iAmLookingAtTheStepBody
                     >> then_ iSeeATimestamp
+            , test "shows that a cached result was used" <|
+                given iVisitABuildWithATaskStep
+                    >> given theTaskStepHitTheCache
+                    >> given theTaskStepIsExpanded
+                    >> when iAmLookingAtTheStepBody
+                    >> then_ iSeeTheCacheKey
             , test "shows image check sub-step" <|
                 given iVisitABuildWithATaskStep
                     >> given (thereIsAnImageCheckStep taskStepId)
@@ -461,6 +472,48 @@ all =
                     >> when iAmLookingAtTheStepBody
                     >> then_ iSeeTheLoadVarName
             ]
+        , describe "approve step"
+            [ test "should show step name" <|
+                given iVisitABuildWithAnApproveStep
+                    >> when iAmLookingAtTheStepBody
+                    >> then_ iSeeTheApproveStepName
+            , test "shows that it is waiting for approval" <|
+                given iVisitABuildWithAnApproveStep
+                    >> given theApproveStepIsWaiting
+                    >> given theApproveStepIsExpanded
+                    >> when iAmLookingAtTheStepBody
+                    >> then_ iSeeItIsWaitingForAnOwner
+            , test "shows the plan id to approve it with" <|
+                given iVisitABuildWithAnApproveStep
+                    >> given theApproveStepIsWaiting
+                    >> given theApproveStepIsExpanded
+                    >> when iAmLookingAtTheStepBody
+                    >> then_ iSeeThePlanId
+            , test "shows who decided" <|
+                given iVisitABuildWithAnApproveStep
+                    >> given theApproveStepIsWaiting
+                    >> given theApproveStepWasRejected
+                    >> given theApproveStepIsExpanded
+                    >> when iAmLookingAtTheStepBody
+                    >> then_ iSeeWhoRejectedIt
+            ]
+        , describe "if step"
+            [ test "shows the nested step" <|
+                given iVisitABuildWithAnIfStep
+                    >> when iAmLookingAtTheStepBody
+                    >> then_ iSeeATaskHeader
+            , test "a skipped step succeeds" <|
+                given iVisitABuildWithAnIfStep
+                    >> given theTaskStepWasSkipped
+                    >> when iAmLookingAtTheStepBody
+                    >> then_ (iSeeStatusIcon Assets.SuccessCheckIcon)
+            , test "a skipped step shows the condition" <|
+                given iVisitABuildWithAnIfStep
+                    >> given theTaskStepWasSkipped
+                    >> given theTaskStepIsExpanded
+                    >> when iAmLookingAtTheStepBody
+                    >> then_ iSeeTheCondition
+            ]
         ]
 
 
@@ -641,6 +694,18 @@ iVisitABuildWithALoadVarStep =
         >> thePlanContainsALoadVarStep
 
 
+iVisitABuildWithAnApproveStep =
+    iOpenTheBuildPage
+        >> myBrowserFetchedTheBuild
+        >> thePlanContainsAnApproveStep
+
+
+iVisitABuildWithAnIfStep =
+    iOpenTheBuildPage
+        >> myBrowserFetchedTheBuild
+        >> thePlanContainsAnIfStep
+
+
 theGetStepIsExpanded =
     Tuple.first
         >> Application.update (Update <| Message.Click <| StepHeader "getStepId")
@@ -681,6 +746,11 @@ theLoadVarStepIsExpanded =
         >> Application.update (Update <| Message.Click <| StepHeader setLoadVarStepId)
 
 
+theApproveStepIsExpanded =
+    Tuple.first
+        >> Application.update (Update <| Message.Click <| StepHeader approveStepId)
+
+
 theAcrossStepIsExpanded =
     Tuple.first
         >> Application.update (Update <| Message.Click <| StepHeader acrossStepId)
@@ -924,6 +994,25 @@ taskStepId =
     "taskStepId"
 
 
+thePlanContainsAnIfStep =
+    Tuple.first
+        >> Application.handleCallback
+            (Callback.PlanAndResourcesFetched 1 <|
+                Ok
+                    ( { id = "ifStepId"
+                      , step =
+                            Concourse.BuildStepIf "((.:deploy))"
+                                { id = taskStepId
+                                , step = Concourse.BuildStepTask "task-name"
+                                }
+                      }
+                    , { inputs = []
+                      , outputs = []
+                      }
+                    )
+            )
+
+
 imageCheckStepId =
     "imageCheckStepId"
 
@@ -1019,6 +1108,25 @@ setLoadVarStepId =
     "loadVarStep"
 
 
+thePlanContainsAnApproveStep =
+    Tuple.first
+        >> Application.handleCallback
+            (Callback.PlanAndResourcesFetched 1 <|
+                Ok
+                    ( { id = approveStepId
+                      , step = Concourse.BuildStepApprove "approve-name"
+                      }
+                    , { inputs = []
+                      , outputs = []
+                      }
+                    )
+            )
+
+
+approveStepId =
+    "approveStepId"
+
+
 acrossStepId =
     "acrossStep"
 
@@ -1393,6 +1501,43 @@ iSeeTheLoadVarName =
     Query.has [ text "var-name" ]
 
 
+iSeeTheApproveStepName =
+    Query.has [ text "approve-name" ]
+
+
+iSeeItIsWaitingForAnOwner =
+    Query.has [ text "waiting for approval by a team owner" ]
+
+
+iSeeThePlanId =
+    Query.has [ text "approveStepId" ]
+
+
+iSeeWhoRejectedIt =
+    Expect.all
+        [ Query.has [ text "rejected" ]
+        , Query.has [ text "by some-user" ]
+        ]
+
+
+iSeeTheCacheKey =
+    Expect.all
+        [ Query.has [ text "using cached result" ]
+        , Query.has [ text "(key: some-key)" ]
+        ]
+
+
+iSeeTheRetryReason =
+    Expect.all
+        [ Query.has [ text "retrying as attempt 2 in 10s" ]
+        , Query.has [ text "failed with exit status 1" ]
+        ]
+
+
+iSeeTheCondition =
+    Query.has [ text "((.:deploy)) is false" ]
+
+
 iSeeTheVarNames =
     Query.has [ text "var1, var2" ]
 
@@ -1481,6 +1626,59 @@ taskFinished stepId exitCode =
             (Time.millisToPosix 0)
 
 
+theApproveStepIsWaiting =
+    taskEvent <|
+        WaitingForApproval
+            { source = ""
+            , id = approveStepId
+            }
+            "owner"
+            (Time.millisToPosix 0)
+
+
+theApproveStepWasRejected =
+    taskEvent <|
+        ApprovalDecided
+            { source = ""
+            , id = approveStepId
+            }
+            False
+            "some-user"
+            (Time.millisToPosix 0)
+
+
+theTaskStepHitTheCache =
+    taskEvent <|
+        TaskCacheHit
+            { source = ""
+            , id = taskStepId
+            }
+            "some-key"
+            (Time.millisToPosix 0)
+
+
+theRetryStepIsRetrying =
+    taskEvent <|
+        Retrying
+            { source = ""
+            , id = "retryStepId"
+            }
+            2
+            "10s"
+            "failed with exit status 1"
+            (Time.millisToPosix 0)
+
+
+theTaskStepWasSkipped =
+    taskEvent <|
+        Skipped
+            { source = ""
+            , id = taskStepId
+            }
+            "((.:deploy))"
+            (Time.millisToPosix 0)
+
+
 taskErrored stepId =
     taskEvent <|
         Error
@@ -86,4 +86,39 @@ all =
                     |> Concourse.encodeTeam
                     |> Json.Decode.decodeValue Concourse.decodeTeam
                     |> Expect.equal (Ok team)
+        , test "build plans with if steps are decoded" <|
+            \_ ->
+                """
+                { "id": "if-id"
+                , "if":
+                    { "condition": "((.:deploy))"
+                    , "step": { "id": "task-id", "task": { "name": "some-task" } }
+                    }
+                }
+                """
+                    |> Json.Decode.decodeString Concourse.decodeBuildPlan
+                    |> Expect.equal
+                        (Ok
+                            { id = "if-id"
+                            , step =
+                                Concourse.BuildStepIf "((.:deploy))"
+                                    { id = "task-id"
+                                    , step = Concourse.BuildStepTask "some-task"
+                                    }
+                            }
+                        )
+        , test "build plans with approve steps are decoded" <|
+            \_ ->
+                """
+                { "id": "approve-id"
+                , "approve": { "name": "deploy", "role": "owner" }
+                }
+                """
+                    |> Json.Decode.decodeString Concourse.decodeBuildPlan
+                    |> Expect.equal
+                        (Ok
+                            { id = "approve-id"
+                            , step = Concourse.BuildStepApprove "deploy"
+                            }
+                        )
         ]
@@ -1,9 +1,11 @@
 module StepTreeTests exposing
     ( all
     , initAggregate
+    , initApprove
     , initAggregateNested
     , initEnsure
     , initGet
+    , initIf
     , initInParallel
     , initInParallelNested
     , initOnFailure
@@ -31,6 +33,7 @@ all =
         [ initTask
         , initSetPipeline
         , initLoadVar
+        , initApprove
         , initCheck
         , initRun
         , initGet
@@ -47,6 +50,7 @@ all =
         , initEnsure
         , initTry
         , initTimeout
+        , initIf
         ]
 
 
@@ -164,6 +168,29 @@ initLoadVar =
         ]
 
 
+initApprove : Test
+initApprove =
+    let
+        { tree, steps } =
+            StepTree.init Nothing
+                Routes.HighlightNothing
+                emptyResources
+                { id = "some-id"
+                , step = BuildStepApprove "some-name"
+                }
+    in
+    describe "init with Approve"
+        [ test "the tree" <|
+            \_ ->
+                Expect.equal (Models.Approve "some-id") tree
+        , test "the step" <|
+            \_ ->
+                assertSteps
+                    [ someStep "some-id" (BuildStepApprove "some-name") Models.StepStatePending ]
+                    steps
+        ]
+
+
 initCheck : Test
 initCheck =
     let
@@ -790,6 +817,32 @@ initTimeout =
         ]
 
 
+initIf : Test
+initIf =
+    let
+        { tree, steps } =
+            StepTree.init Nothing
+                Routes.HighlightNothing
+                emptyResources
+                { id = "if-id"
+                , step =
+                    BuildStepIf "((.:deploy))" { id = "task-a-id", step = task "a" }
+                }
+    in
+    describe "init with If"
+        [ test "the tree" <|
+            \_ ->
+                Expect.equal
+                    (Models.If <|
+                        Models.Task "task-a-id"
+                    )
+                    tree
+        , test "the steps" <|
+            \_ ->
+                assertSteps [ someStep "task-a-id" (task "a") Models.StepStatePending ] steps
+        ]
+
+
 assertSteps : List Models.Step -> Dict Routes.StepID Models.Step -> Expectation
 assertSteps expected actual =
     Expect.equalDicts (Dict.fromList (List.map (\s -> ( s.id, s )) expected)) actual
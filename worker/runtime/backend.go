@@ -295,7 +295,7 @@ func (b *GardenBackend) Create(gdnSpec garden.ContainerSpec) (garden.Container,
 		return nil, fmt.Errorf("new container: %w", err)
 	}
 
-	err = b.startTask(ctx, cont)
+	err = b.startTask(ctx, cont, gdnSpec.Properties[NetworkContainerKey] != "")
 	if err != nil {
 		return nil, fmt.Errorf("starting task: %w", err)
 	}
@@ -330,6 +330,19 @@ func (b *GardenBackend) createContainer(ctx context.Context, gdnSpec garden.Cont
 		return nil, fmt.Errorf("garden spec to oci spec: %w", err)
 	}
 
+	properties := gdnSpec.Properties
+	if networkContainer := gdnSpec.Properties[NetworkContainerKey]; networkContainer != "" {
+		err = b.joinNetwork(ctx, oci, networkContainer)
+		if err != nil {
+			return nil, fmt.Errorf("join network of %s: %w", networkContainer, err)
+		}
+
+		properties = garden.Properties{NetworkJoinedKey: networkContainer}
+		for key, value := range gdnSpec.Properties {
+			properties[key] = value
+		}
+	}
+
 	netMounts, err := b.network.SetupMounts(gdnSpec.Handle)
 	if err != nil {
 		return nil, fmt.Errorf("network setup mounts: %w", err)
@@ -337,22 +350,53 @@ func (b *GardenBackend) createContainer(ctx context.Context, gdnSpec garden.Cont
 
 	oci.Mounts = append(oci.Mounts, netMounts...)
 
-	labels, err := propertiesToLabels(gdnSpec.Properties)
+	labels, err := propertiesToLabels(properties)
 	if err != nil {
 		return nil, fmt.Errorf("convert properties to labels: %w", err)
 	}
 	return b.client.NewContainer(ctx, gdnSpec.Handle, labels, oci)
 }
 
-func (b *GardenBackend) startTask(ctx context.Context, cont containerd.Container) error {
+// joinNetwork modifies the spec so that the container shares the network
+// namespace of the running task of the container with the given handle.
+func (b *GardenBackend) joinNetwork(ctx context.Context, oci *specs.Spec, handle string) error {
+	cont, err := b.client.GetContainer(ctx, handle)
+	if err != nil {
+		return fmt.Errorf("get container: %w", err)
+	}
+
+	task, err := cont.Task(ctx, cio.Load)
+	if err != nil {
+		return fmt.Errorf("task lookup: %w", err)
+	}
+
+	// the namespaces are shared between specs, so they must be copied rather
+	// than modified in place
+	namespaces := make([]specs.LinuxNamespace, len(oci.Linux.Namespaces))
+	for i, namespace := range oci.Linux.Namespaces {
+		if namespace.Type == specs.NetworkNamespace {
+			namespace.Path = netNsPath(task)
+		}
+
+		namespaces[i] = namespace
+	}
+
+	oci.Linux.Namespaces = namespaces
+
+	return nil
+}
+
+func (b *GardenBackend) startTask(ctx context.Context, cont containerd.Container, joinedNetwork bool) error {
 	task, err := cont.NewTask(ctx, cio.NullIO, containerd.WithNoNewKeyring)
 	if err != nil {
 		return fmt.Errorf("new task: %w", err)
 	}
 
-	err = b.network.Add(ctx, task, cont.ID())
-	if err != nil {
-		return fmt.Errorf("network add: %w", err)
+	if !joinedNetwork {
+		err = b.network.Add(ctx, task, cont.ID())
+		if err != nil {
+			return fmt.Errorf("network add: %w", err)
+		}
 	}
 
 	return task.Start(ctx)
@@ -391,9 +435,23 @@ func (b *GardenBackend) Destroy(handle string) error {
 		return fmt.Errorf("gracefully killing task: %w", err)
 	}
 
-	err = b.network.Remove(ctx, task, handle)
+	labels, err := container.Labels(ctx)
 	if err != nil {
-		return fmt.Errorf("network remove: %w", err)
+		return fmt.Errorf("labels retrieval: %w", err)
+	}
+
+	// containers which joined the network of another container must not
+	// tear it down, as it is still in use
+	if labelsToProperties(labels)[NetworkContainerKey] != "" {
+		err = b.network.RemoveMounts(handle)
+		if err != nil {
+			return fmt.Errorf("network remove mounts: %w", err)
+		}
+	} else {
+		err = b.network.Remove(ctx, task, handle)
+		if err != nil {
+			return fmt.Errorf("network remove: %w", err)
+		}
 	}
 
 	_, err = task.Delete(ctx, containerd.WithProcessKill)
@@ -13,6 +13,7 @@ import (
 	"github.com/concourse/concourse/worker/runtime"
 	"github.com/concourse/concourse/worker/runtime/libcontainerd/libcontainerdfakes"
 	"github.com/concourse/concourse/worker/runtime/runtimefakes"
+	bespec "github.com/concourse/concourse/worker/runtime/spec"
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/errdefs"
 	"github.com/opencontainers/runtime-spec/specs-go"
@@ -147,6 +148,63 @@ func (s *BackendSuite) TestCreateContainerSetsHandle() {
 	s.Equal("handle", cont.Handle())
 }
 
+func (s *BackendSuite) TestCreateJoiningNetworkOfAnotherContainer() {
+	parentTask := new(libcontainerdfakes.FakeTask)
+	parentTask.PidReturns(123)
+	parentContainer := new(libcontainerdfakes.FakeContainer)
+	parentContainer.TaskReturns(parentTask, nil)
+	s.client.GetContainerReturns(parentContainer, nil)
+
+	fakeTask := new(libcontainerdfakes.FakeTask)
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+	fakeContainer.NewTaskReturns(fakeTask, nil)
+	s.client.NewContainerReturns(fakeContainer, nil)
+
+	gdnSpec := minimumValidGdnSpec
+	gdnSpec.Properties = garden.Properties{
+		runtime.NetworkContainerKey: "parent-handle",
+	}
+
+	_, err := s.backend.Create(gdnSpec)
+	s.NoError(err)
+
+	_, handle := s.client.GetContainerArgsForCall(0)
+	s.Equal("parent-handle", handle)
+
+	_, _, labels, spec := s.client.NewContainerArgsForCall(0)
+	s.Equal("parent-handle", labels[runtime.NetworkJoinedKey+".0"])
+
+	var netns specs.LinuxNamespace
+	for _, namespace := range spec.Linux.Namespaces {
+		if namespace.Type == specs.NetworkNamespace {
+			netns = namespace
+		}
+	}
+	s.Equal("/proc/123/ns/net", netns.Path)
+
+	for _, namespace := range bespec.UnprivilegedContainerNamespaces {
+		s.Empty(namespace.Path)
+	}
+
+	s.Equal(1, s.network.SetupMountsCallCount())
+	s.Equal(0, s.network.AddCallCount())
+	s.Equal(1, fakeTask.StartCallCount())
+}
+
+func (s *BackendSuite) TestCreateJoiningNetworkOfMissingContainer() {
+	s.client.GetContainerReturns(nil, errors.New("not-found"))
+
+	gdnSpec := minimumValidGdnSpec
+	gdnSpec.Properties = garden.Properties{
+		runtime.NetworkContainerKey: "parent-handle",
+	}
+
+	_, err := s.backend.Create(gdnSpec)
+	s.Error(err)
+
+	s.Equal(0, s.client.NewContainerCallCount())
+}
+
 func (s *BackendSuite) TestCreateMaxContainersReached() {
 	backend, err := runtime.NewGardenBackend(s.client,
 		runtime.WithKiller(s.killer),
@@ -475,6 +533,23 @@ func (s *BackendSuite) TestDestroyContainerDeleteFailsAndDeleteTaskSucceeds() {
 	s.True(errors.Is(err, expectedError))
 }
 
+func (s *BackendSuite) TestDestroyJoinedNetworkOnlyRemovesMounts() {
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+	fakeTask := new(libcontainerdfakes.FakeTask)
+	s.client.GetContainerReturns(fakeContainer, nil)
+	fakeContainer.TaskReturns(fakeTask, nil)
+	fakeContainer.LabelsReturns(map[string]string{
+		runtime.NetworkContainerKey + ".0": "parent-handle",
+	}, nil)
+
+	err := s.backend.Destroy("some-handle")
+	s.NoError(err)
+
+	s.Equal(0, s.network.RemoveCallCount())
+	s.Equal(1, s.network.RemoveMountsCallCount())
+	s.Equal("some-handle", s.network.RemoveMountsArgsForCall(0))
+}
+
 func (s *BackendSuite) TestDestroySucceeds() {
 	fakeContainer := new(libcontainerdfakes.FakeContainer)
 	fakeTask := new(libcontainerdfakes.FakeTask)
@@ -320,6 +320,15 @@ func (n cniNetwork) SetupMounts(handle string) ([]specs.Mount, error) {
 	}, nil
 }
 
+func (n cniNetwork) RemoveMounts(handle string) error {
+	err := n.store.Delete(handle)
+	if err != nil {
+		return fmt.Errorf("cni network mounts teardown: %w", err)
+	}
+
+	return nil
+}
+
 const filterTable = "filter"
 
 func (n cniNetwork) setupRestrictedNetworks() error {
@@ -407,9 +416,9 @@ func (n cniNetwork) Remove(ctx context.Context, task containerd.Task, handle str
 
 	id, netns := netId(task), netNsPath(task)
 
-	err = n.store.Delete(handle)
+	err = n.RemoveMounts(handle)
 	if err != nil {
-		return fmt.Errorf("cni network mounts teardown: %w", err)
+		return err
 	}
 
 	err = n.client.Remove(ctx, id, netns)
@@ -359,3 +359,13 @@ func (s *CNINetworkSuite) TestRemove() {
 	path := s.store.DeleteArgsForCall(0)
 	s.Equal("some-handle", path)
 }
+
+func (s *CNINetworkSuite) TestRemoveMounts() {
+	err := s.network.RemoveMounts("some-handle")
+	s.NoError(err)
+
+	s.Equal(0, s.cni.RemoveCallCount())
+	s.Equal(1, s.store.DeleteCallCount())
+	path := s.store.DeleteArgsForCall(0)
+	s.Equal("some-handle", path)
+}
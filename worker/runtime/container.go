@@ -19,6 +19,16 @@ const (
 	Path          = "PATH=/usr/local/bin:/usr/bin:/bin"
 
 	GraceTimeKey = "garden.grace-time"
+
+	// NetworkContainerKey is the property which, when set at creation time,
+	// causes the container to join the network namespace of the container
+	// with the given handle instead of being added to the network itself.
+	NetworkContainerKey = "concourse:network-container"
+
+	// NetworkJoinedKey is set on a container which has joined the network
+	// namespace of the container given by NetworkContainerKey, so that clients
+	// can tell that the property was understood.
+	NetworkJoinedKey = "concourse:network-joined"
 )
 
 type UserNotFoundError struct {
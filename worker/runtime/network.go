@@ -19,6 +19,12 @@ type Network interface {
 	//
 	SetupMounts(handle string) (mounts []specs.Mount, err error)
 
+	// RemoveMounts removes the mounts prepared by SetupMounts. This is only
+	// necessary for containers which were never added to the network, as
+	// Remove takes care of it otherwise.
+	//
+	RemoveMounts(handle string) (err error)
+
 	// Add adds a task to the network.
 	//
 	Add(ctx context.Context, task containerd.Task, containerHandle string) (err error)